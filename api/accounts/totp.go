@@ -0,0 +1,114 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type TotpEnrollResult struct {
+	Item       *Account `json:"item,omitempty"`
+	OtpauthUri string   `json:"otpauth_uri,omitempty"`
+	response   *api.Response
+}
+
+func (n TotpEnrollResult) GetItem() *Account {
+	return n.Item
+}
+
+func (n TotpEnrollResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TotpVerifyResult struct {
+	Item          *Account `json:"item,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	response      *api.Response
+}
+
+func (n TotpVerifyResult) GetItem() *Account {
+	return n.Item
+}
+
+func (n TotpVerifyResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollTotp creates a new TOTP enrollment for a password account. The result
+// contains an otpauth URI which can be imported into an authenticator app. The
+// enrollment must be verified with VerifyTotp before it is used for
+// authentication and it replaces any existing enrollment once verified.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*TotpEnrollResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), map[string]interface{}{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(TotpEnrollResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// VerifyTotp verifies the TOTP enrollment of a password account with a code
+// generated by an authenticator app. The result contains single use recovery
+// codes which can be used in place of a TOTP code when authenticating.
+func (c *Client) VerifyTotp(ctx context.Context, accountId, code string, opt ...Option) (*TotpVerifyResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into VerifyTotp request")
+	}
+	if code == "" {
+		return nil, fmt.Errorf("empty code value passed into VerifyTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in VerifyTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"code": code,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:verify-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VerifyTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during VerifyTotp call: %w", err)
+	}
+
+	target := new(TotpVerifyResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding VerifyTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
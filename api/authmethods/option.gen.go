@@ -87,6 +87,30 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithJwtAuthMethodAccountClaimMaps(inAccountClaimMaps []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_claim_maps"] = inAccountClaimMaps
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodAccountClaimMaps() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["account_claim_maps"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodAccountClaimMaps(inAccountClaimMaps []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -135,6 +159,30 @@ func DefaultOidcAuthMethodAllowedAudiences() Option {
 	}
 }
 
+func WithLdapAuthMethodAnonGroupSearch(inAnonGroupSearch bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = inAnonGroupSearch
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodAnonGroupSearch() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodApiUrlPrefix(inApiUrlPrefix string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -171,6 +219,174 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithAuthTokenTimeToLiveSeconds(inAuthTokenTimeToLiveSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = inAuthTokenTimeToLiveSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToLiveSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_live_seconds"] = nil
+	}
+}
+
+func WithAuthTokenTimeToStaleSeconds(inAuthTokenTimeToStaleSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = inAuthTokenTimeToStaleSeconds
+	}
+}
+
+func DefaultAuthTokenTimeToStaleSeconds() Option {
+	return func(o *options) {
+		o.postMap["auth_token_time_to_stale_seconds"] = nil
+	}
+}
+
+func WithLdapAuthMethodBindDn(inBindDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = inBindDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodBindPassword(inBindPassword string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = inBindPassword
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindPassword() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodBoundAudiences(inBoundAudiences []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_audiences"] = inBoundAudiences
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodBoundAudiences() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_audiences"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodBoundClaims(inBoundClaims []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = inBoundClaims
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodBoundClaims() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_claims"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodBoundIssuer(inBoundIssuer string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_issuer"] = inBoundIssuer
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodBoundIssuer() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bound_issuer"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodCertificates(inCertificates []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = inCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodClaimsScopes(inClaimsScopes []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -279,182 +495,830 @@ func DefaultOidcAuthMethodDisableDiscoveredConfigValidation() Option {
 	}
 }
 
-func WithOidcAuthMethodDryRun(inDryRun bool) Option {
+func WithPasswordAuthMethodDisallowLoginName(inDisallowLoginName bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["dry_run"] = inDryRun
+		val["disallow_login_name"] = inDisallowLoginName
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodDryRun() Option {
+func DefaultPasswordAuthMethodDisallowLoginName() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["dry_run"] = nil
+		val["disallow_login_name"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
+func WithPasswordAuthMethodDisallowedWords(inDisallowedWords []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["idp_ca_certs"] = inIdpCaCerts
+		val["disallowed_words"] = inDisallowedWords
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodIdpCaCerts() Option {
+func DefaultPasswordAuthMethodDisallowedWords() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["idp_ca_certs"] = nil
+		val["disallowed_words"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodIssuer(inIssuer string) Option {
+func WithLdapAuthMethodDiscoverDn(inDiscoverDn bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["issuer"] = inIssuer
+		val["discover_dn"] = inDiscoverDn
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodIssuer() Option {
+func DefaultLdapAuthMethodDiscoverDn() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["issuer"] = nil
+		val["discover_dn"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
+func WithOidcAuthMethodDryRun(inDryRun bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["max_age"] = inMaxAge
+		val["dry_run"] = inDryRun
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodMaxAge() Option {
+func DefaultOidcAuthMethodDryRun() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["max_age"] = nil
+		val["dry_run"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
+func WithLdapAuthMethodEnableGroups(inEnableGroups bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_login_name_length"] = inMinLoginNameLength
+		val["enable_groups"] = inEnableGroups
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultPasswordAuthMethodMinLoginNameLength() Option {
+func DefaultLdapAuthMethodEnableGroups() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_login_name_length"] = nil
+		val["enable_groups"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithPasswordAuthMethodMinPasswordLength(inMinPasswordLength uint32) Option {
+func WithLdapAuthMethodGroupAttr(inGroupAttr string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_password_length"] = inMinPasswordLength
+		val["group_attr"] = inGroupAttr
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultPasswordAuthMethodMinPasswordLength() Option {
+func DefaultLdapAuthMethodGroupAttr() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["min_password_length"] = nil
+		val["group_attr"] = nil
 		o.postMap["attributes"] = val
 	}
 }
 
-func WithName(inName string) Option {
+func WithLdapAuthMethodGroupDn(inGroupDn string) Option {
 	return func(o *options) {
-		o.postMap["name"] = inName
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_dn"] = inGroupDn
+		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultName() Option {
+func DefaultLdapAuthMethodGroupDn() Option {
 	return func(o *options) {
-		o.postMap["name"] = nil
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_dn"] = nil
+		o.postMap["attributes"] = val
 	}
 }
 
-func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+func WithLdapAuthMethodGroupFilter(inGroupFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["signing_algorithms"] = inSigningAlgorithms
+		val["group_filter"] = inGroupFilter
 		o.postMap["attributes"] = val
 	}
 }
 
-func DefaultOidcAuthMethodSigningAlgorithms() Option {
+func DefaultLdapAuthMethodGroupFilter() Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
 		if !ok {
 			raw = interface{}(map[string]interface{}{})
 		}
 		val := raw.(map[string]interface{})
-		val["signing_algorithms"] = nil
+		val["group_filter"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_ca_certs"] = inIdpCaCerts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodIdpCaCerts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idp_ca_certs"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodInsecureTls(inInsecureTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = inInsecureTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodInsecureTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodIssuer(inIssuer string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = inIssuer
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodIssuer() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["issuer"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodJwksCaCert(inJwksCaCert string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_ca_cert"] = inJwksCaCert
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodJwksCaCert() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_ca_cert"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodJwksUrl(inJwksUrl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_url"] = inJwksUrl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodJwksUrl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["jwks_url"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodLockoutDurationSeconds(inLockoutDurationSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = inLockoutDurationSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutDurationSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodLockoutThreshold(inLockoutThreshold uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_threshold"] = inLockoutThreshold
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutThreshold() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_threshold"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_age"] = inMaxAge
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodMaxAge() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_age"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMaxPasswordAgeSeconds(inMaxPasswordAgeSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_seconds"] = inMaxPasswordAgeSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMaxPasswordAgeSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["max_password_age_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMfaRequired(inMfaRequired bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mfa_required"] = inMfaRequired
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMfaRequired() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mfa_required"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_login_name_length"] = inMinLoginNameLength
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinLoginNameLength() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_login_name_length"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodMinPasswordLength(inMinPasswordLength uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_password_length"] = inMinPasswordLength
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMinPasswordLength() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["min_password_length"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithName(inName string) Option {
+	return func(o *options) {
+		o.postMap["name"] = inName
+	}
+}
+
+func DefaultName() Option {
+	return func(o *options) {
+		o.postMap["name"] = nil
+	}
+}
+
+func WithPasswordAuthMethodPasswordHistoryCount(inPasswordHistoryCount uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = inPasswordHistoryCount
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodPasswordHistoryCount() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["password_history_count"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodPublicKeys(inPublicKeys []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["public_keys"] = inPublicKeys
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodPublicKeys() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["public_keys"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireDigit(inRequireDigit bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_digit"] = inRequireDigit
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireDigit() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_digit"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireLowercase(inRequireLowercase bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_lowercase"] = inRequireLowercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireLowercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_lowercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireSpecial(inRequireSpecial bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_special"] = inRequireSpecial
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireSpecial() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_special"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodRequireUppercase(inRequireUppercase bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_uppercase"] = inRequireUppercase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodRequireUppercase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["require_uppercase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithJwtAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = inSigningAlgorithms
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultJwtAuthMethodSigningAlgorithms() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithOidcAuthMethodSigningAlgorithms(inSigningAlgorithms []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = inSigningAlgorithms
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultOidcAuthMethodSigningAlgorithms() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signing_algorithms"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodStartTls(inStartTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = inStartTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodStartTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = inUpnDomain
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUpnDomain() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUrls(inUrls []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = inUrls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUrls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserAttr(inUserAttr string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = inUserAttr
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserAttr() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserDn(inUserDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = inUserDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserFilter(inUserFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = inUserFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = nil
 		o.postMap["attributes"] = val
 	}
 }
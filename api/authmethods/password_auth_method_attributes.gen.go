@@ -8,8 +8,19 @@ import (
 )
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength     uint32   `json:"min_login_name_length,omitempty"`
+	MinPasswordLength      uint32   `json:"min_password_length,omitempty"`
+	RequireUppercase       bool     `json:"require_uppercase,omitempty"`
+	RequireLowercase       bool     `json:"require_lowercase,omitempty"`
+	RequireDigit           bool     `json:"require_digit,omitempty"`
+	RequireSpecial         bool     `json:"require_special,omitempty"`
+	DisallowLoginName      bool     `json:"disallow_login_name,omitempty"`
+	DisallowedWords        []string `json:"disallowed_words,omitempty"`
+	PasswordHistoryCount   uint32   `json:"password_history_count,omitempty"`
+	MaxPasswordAgeSeconds  uint32   `json:"max_password_age_seconds,omitempty"`
+	LockoutThreshold       uint32   `json:"lockout_threshold,omitempty"`
+	LockoutDurationSeconds uint32   `json:"lockout_duration_seconds,omitempty"`
+	MfaRequired            bool     `json:"mfa_required,omitempty"`
 }
 
 func AttributesMapToPasswordAuthMethodAttributes(in map[string]interface{}) (*PasswordAuthMethodAttributes, error) {
@@ -0,0 +1,42 @@
+package authtokens
+
+import (
+	"context"
+	"fmt"
+)
+
+// Refresh extends the expiration time of an auth token using the time-to-live
+// of the auth method which issued it. Stale and expired auth tokens cannot be
+// refreshed.
+func (c *Client) Refresh(ctx context.Context, authTokenId string, opt ...Option) (*AuthTokenReadResult, error) {
+	if authTokenId == "" {
+		return nil, fmt.Errorf("empty authTokenId value passed into Refresh request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in Refresh request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-tokens/%s:refresh", authTokenId), map[string]interface{}{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Refresh request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Refresh call: %w", err)
+	}
+
+	target := new(AuthTokenReadResult)
+	target.Item = new(AuthToken)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Refresh response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -98,6 +98,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithJwtManagedGroupFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcManagedGroupFilter(inFilter string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -110,6 +122,18 @@ func WithOidcManagedGroupFilter(inFilter string) Option {
 	}
 }
 
+func WithLdapManagedGroupGroupNames(inGroupNames []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_names"] = inGroupNames
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -0,0 +1,69 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type ExplainResult struct {
+	Item     *Explanation
+	response *api.Response
+}
+
+func (n ExplainResult) GetItem() *Explanation {
+	return n.Item
+}
+
+func (n ExplainResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Explain returns whether an action is allowed on a resource of the given type
+// within a scope, along with the grants applying to the scope and whether each
+// of them allows the action. The grants of the user set with WithUserId are
+// evaluated, or else the hypothetical grants set with WithGrantStrings. The
+// resource is identified with WithResourceId and, for resources within another
+// resource such as hosts, WithPinId.
+func (c *Client) Explain(ctx context.Context, scopeId, resourceType, actionName string, opt ...Option) (*ExplainResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into Explain request")
+	}
+	if resourceType == "" {
+		return nil, fmt.Errorf("empty resourceType value passed into Explain request")
+	}
+	if actionName == "" {
+		return nil, fmt.Errorf("empty actionName value passed into Explain request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in Explain request")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["scope_id"] = scopeId
+	opts.postMap["resource_type"] = resourceType
+	opts.postMap["action"] = actionName
+
+	req, err := c.client.NewRequest(ctx, "POST", "roles:explain", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Explain request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Explain call: %w", err)
+	}
+
+	target := new(ExplainResult)
+	target.Item = new(Explanation)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Explain response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
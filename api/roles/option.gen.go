@@ -99,6 +99,12 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithExpiresInSeconds(inExpiresInSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["expires_in_seconds"] = inExpiresInSeconds
+	}
+}
+
 func WithGrantScopeId(inGrantScopeId string) Option {
 	return func(o *options) {
 		o.postMap["grant_scope_id"] = inGrantScopeId
@@ -111,6 +117,12 @@ func DefaultGrantScopeId() Option {
 	}
 }
 
+func WithGrantStrings(inGrantStrings []string) Option {
+	return func(o *options) {
+		o.postMap["grant_strings"] = inGrantStrings
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -122,3 +134,21 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithPinId(inPinId string) Option {
+	return func(o *options) {
+		o.postMap["pin_id"] = inPinId
+	}
+}
+
+func WithResourceId(inResourceId string) Option {
+	return func(o *options) {
+		o.postMap["resource_id"] = inResourceId
+	}
+}
+
+func WithUserId(inUserId string) Option {
+	return func(o *options) {
+		o.postMap["user_id"] = inUserId
+	}
+}
@@ -0,0 +1,127 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type KeyListResult struct {
+	Items    []*Key
+	response *api.Response
+}
+
+func (n KeyListResult) GetItems() []*Key {
+	return n.Items
+}
+
+func (n KeyListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type KeyVersionDestroyResult struct {
+	response *api.Response
+}
+
+// GetItem will always be nil for KeyVersionDestroyResult
+func (n KeyVersionDestroyResult) GetItem() interface{} {
+	return nil
+}
+
+func (n KeyVersionDestroyResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListKeys lists the root key and data encryption keys of the scope along
+// with their versions.
+func (c *Client) ListKeys(ctx context.Context, scopeId string, opt ...Option) (*KeyListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into ListKeys request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("scopes/%s:list-keys", url.PathEscape(scopeId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListKeys request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListKeys call: %w", err)
+	}
+
+	target := new(KeyListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListKeys response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// DestroyKeyVersion destroys a version of the root key or of a data
+// encryption key of the scope. The latest version of a key and key versions
+// which still protect data can not be destroyed.
+func (c *Client) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string, opt ...Option) (*KeyVersionDestroyResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into DestroyKeyVersion request")
+	}
+	if keyVersionId == "" {
+		return nil, fmt.Errorf("empty keyVersionId value passed into DestroyKeyVersion request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["key_version_id"] = keyVersionId
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:destroy-key-version", url.PathEscape(scopeId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DestroyKeyVersion request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DestroyKeyVersion call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DestroyKeyVersion response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	target := &KeyVersionDestroyResult{
+		response: resp,
+	}
+	return target, nil
+}
@@ -0,0 +1,69 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type ScopeRotateKeysResult struct {
+	response *api.Response
+}
+
+// GetItem will always be nil for ScopeRotateKeysResult
+func (n ScopeRotateKeysResult) GetItem() interface{} {
+	return nil
+}
+
+func (n ScopeRotateKeysResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// RotateKeys creates a new version of the root key and of every data
+// encryption key of the scope. Data is encrypted using the new key versions
+// from then on, while data encrypted with the previous versions can still be
+// decrypted.
+func (c *Client) RotateKeys(ctx context.Context, scopeId string, opt ...Option) (*ScopeRotateKeysResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into RotateKeys request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:rotate-keys", url.PathEscape(scopeId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RotateKeys request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RotateKeys call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RotateKeys response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	target := &ScopeRotateKeysResult{
+		response: resp,
+	}
+	return target, nil
+}
@@ -0,0 +1,169 @@
+package serviceaccounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type TokenCreateResult struct {
+	Item     *ServiceAccountToken
+	response *api.Response
+}
+
+func (n TokenCreateResult) GetItem() *ServiceAccountToken {
+	return n.Item
+}
+
+func (n TokenCreateResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TokenListResult struct {
+	Items    []*ServiceAccountToken
+	response *api.Response
+}
+
+func (n TokenListResult) GetItems() []*ServiceAccountToken {
+	return n.Items
+}
+
+func (n TokenListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type TokenRevokeResult struct {
+	response *api.Response
+}
+
+// GetItem will always be nil for TokenRevokeResult
+func (n TokenRevokeResult) GetItem() interface{} {
+	return nil
+}
+
+func (n TokenRevokeResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// CreateToken creates a token for the service account which expires after
+// timeToLiveSeconds. The token value is only included in the result of this
+// call. The token can be restricted to client address ranges with
+// allowedCidrs, and WithDescription sets the description of the token.
+func (c *Client) CreateToken(ctx context.Context, serviceAccountId string, timeToLiveSeconds uint32, allowedCidrs []string, opt ...Option) (*TokenCreateResult, error) {
+	if serviceAccountId == "" {
+		return nil, fmt.Errorf("empty serviceAccountId value passed into CreateToken request")
+	}
+	if timeToLiveSeconds == 0 {
+		return nil, fmt.Errorf("zero timeToLiveSeconds value passed into CreateToken request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["time_to_live_seconds"] = timeToLiveSeconds
+	if len(allowedCidrs) > 0 {
+		opts.postMap["allowed_cidrs"] = allowedCidrs
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("service-accounts/%s:create-token", url.PathEscape(serviceAccountId)), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CreateToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CreateToken call: %w", err)
+	}
+
+	target := new(TokenCreateResult)
+	target.Item = new(ServiceAccountToken)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CreateToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ListTokens lists the tokens of the service account. The token values are
+// not included.
+func (c *Client) ListTokens(ctx context.Context, serviceAccountId string, opt ...Option) (*TokenListResult, error) {
+	if serviceAccountId == "" {
+		return nil, fmt.Errorf("empty serviceAccountId value passed into ListTokens request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("service-accounts/%s:list-tokens", url.PathEscape(serviceAccountId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListTokens request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListTokens call: %w", err)
+	}
+
+	target := new(TokenListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListTokens response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RevokeToken revokes a token of the service account. Revoked tokens can no
+// longer be used and are removed from the list of tokens.
+func (c *Client) RevokeToken(ctx context.Context, serviceAccountId, tokenId string, opt ...Option) (*TokenRevokeResult, error) {
+	if serviceAccountId == "" {
+		return nil, fmt.Errorf("empty serviceAccountId value passed into RevokeToken request")
+	}
+	if tokenId == "" {
+		return nil, fmt.Errorf("empty tokenId value passed into RevokeToken request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+	reqBody := map[string]interface{}{
+		"token_id": tokenId,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("service-accounts/%s:revoke-token", url.PathEscape(serviceAccountId)), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RevokeToken request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RevokeToken call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RevokeToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	target := &TokenRevokeResult{
+		response: resp,
+	}
+	return target, nil
+}
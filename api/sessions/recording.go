@@ -0,0 +1,132 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type ConnectionRecording struct {
+	ConnectionId string    `json:"connection_id,omitempty"`
+	SessionId    string    `json:"session_id,omitempty"`
+	WorkerId     string    `json:"worker_id,omitempty"`
+	Size         uint64    `json:"size,omitempty"`
+	StartTime    time.Time `json:"start_time,omitempty"`
+	EndTime      time.Time `json:"end_time,omitempty"`
+	CreatedTime  time.Time `json:"created_time,omitempty"`
+	Data         []byte    `json:"data,omitempty"`
+}
+
+type ConnectionRecordingListResult struct {
+	Items    []*ConnectionRecording
+	response *api.Response
+}
+
+func (n ConnectionRecordingListResult) GetItems() []*ConnectionRecording {
+	return n.Items
+}
+
+func (n ConnectionRecordingListResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type ConnectionRecordingReadResult struct {
+	Item     *ConnectionRecording
+	response *api.Response
+}
+
+func (n ConnectionRecordingReadResult) GetItem() *ConnectionRecording {
+	return n.Item
+}
+
+func (n ConnectionRecordingReadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+func (c *Client) ListRecordings(ctx context.Context, sessionId string, opt ...Option) (*ConnectionRecordingListResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ListRecordings request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s/recordings", url.PathEscape(sessionId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListRecordings request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListRecordings call: %w", err)
+	}
+
+	target := new(ConnectionRecordingListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListRecordings response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+func (c *Client) DownloadRecording(ctx context.Context, sessionId, connectionId string, opt ...Option) (*ConnectionRecordingReadResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into DownloadRecording request")
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("empty connectionId value passed into DownloadRecording request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s/recordings/%s", url.PathEscape(sessionId), url.PathEscape(connectionId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DownloadRecording request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DownloadRecording call: %w", err)
+	}
+
+	target := new(ConnectionRecordingReadResult)
+	target.Item = new(ConnectionRecording)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DownloadRecording response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
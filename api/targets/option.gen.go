@@ -87,6 +87,18 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithAddress(inAddress string) Option {
+	return func(o *options) {
+		o.postMap["address"] = inAddress
+	}
+}
+
+func DefaultAddress() Option {
+	return func(o *options) {
+		o.postMap["address"] = nil
+	}
+}
+
 func WithApplicationCredentialSourceIds(inApplicationCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["application_credential_source_ids"] = inApplicationCredentialSourceIds
@@ -111,6 +123,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithHttpTargetAuthScheme(inAuthScheme string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_scheme"] = inAuthScheme
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetAuthScheme() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["auth_scheme"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithBrokeredCredentialSourceIds(inBrokeredCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["brokered_credential_source_ids"] = inBrokeredCredentialSourceIds
@@ -123,6 +159,54 @@ func DefaultBrokeredCredentialSourceIds() Option {
 	}
 }
 
+func WithHttpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -183,12 +267,96 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithEnableSessionRecording(inEnableSessionRecording bool) Option {
+	return func(o *options) {
+		o.postMap["enable_session_recording"] = inEnableSessionRecording
+	}
+}
+
+func DefaultEnableSessionRecording() Option {
+	return func(o *options) {
+		o.postMap["enable_session_recording"] = nil
+	}
+}
+
+func WithHttpTargetEnableTls(inEnableTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["enable_tls"] = inEnableTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetEnableTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["enable_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
 	}
 }
 
+func WithSshTargetHostKey(inHostKey string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["host_key"] = inHostKey
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetHostKey() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["host_key"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTcpTargetIdleTimeoutSeconds(inIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idle_timeout_seconds"] = inIdleTimeoutSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTcpTargetIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["idle_timeout_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithInjectedApplicationCredentialSourceIds(inInjectedApplicationCredentialSourceIds []string) Option {
 	return func(o *options) {
 		o.postMap["injected_application_credential_source_ids"] = inInjectedApplicationCredentialSourceIds
@@ -249,6 +417,54 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithPostgresTargetSslMode(inSslMode string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_mode"] = inSslMode
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetSslMode() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_mode"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetSslRootCert(inSslRootCert string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_root_cert"] = inSslRootCert
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetSslRootCert() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssl_root_cert"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
@@ -9,6 +9,7 @@ import (
 
 type SshTargetAttributes struct {
 	DefaultPort uint32 `json:"default_port,omitempty"`
+	HostKey     string `json:"host_key,omitempty"`
 }
 
 func AttributesMapToSshTargetAttributes(in map[string]interface{}) (*SshTargetAttributes, error) {
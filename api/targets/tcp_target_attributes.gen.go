@@ -8,7 +8,8 @@ import (
 )
 
 type TcpTargetAttributes struct {
-	DefaultPort uint32 `json:"default_port,omitempty"`
+	DefaultPort        uint32 `json:"default_port,omitempty"`
+	IdleTimeoutSeconds uint32 `json:"idle_timeout_seconds,omitempty"`
 }
 
 func AttributesMapToTcpTargetAttributes(in map[string]interface{}) (*TcpTargetAttributes, error) {
@@ -110,3 +110,15 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithState(inState string) Option {
+	return func(o *options) {
+		o.postMap["state"] = inState
+	}
+}
+
+func DefaultState() Option {
+	return func(o *options) {
+		o.postMap["state"] = nil
+	}
+}
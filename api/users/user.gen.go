@@ -28,6 +28,7 @@ type User struct {
 	FullName          string            `json:"full_name,omitempty"`
 	Email             string            `json:"email,omitempty"`
 	PrimaryAccountId  string            `json:"primary_account_id,omitempty"`
+	State             string            `json:"state,omitempty"`
 
 	response *api.Response
 }
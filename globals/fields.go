@@ -15,6 +15,8 @@ const (
 	AccountIdField                              = "account_id"
 	UserIdField                                 = "user_id"
 	IsPrimaryField                              = "is_primary"
+	AuthTokenTimeToLiveSecondsField             = "auth_token_time_to_live_seconds"
+	AuthTokenTimeToStaleSecondsField            = "auth_token_time_to_stale_seconds"
 	AuthorizedActionsField                      = "authorized_actions"
 	AuthorizedCollectionActionsField            = "authorized_collection_actions"
 	ExpirationTimeField                         = "expiration_time"
@@ -85,4 +87,6 @@ const (
 	WorkerProvidedConfigurationField            = "worker_provided_configuration"
 	ActiveConnectionCountField                  = "active_connection_count"
 	ControllerGeneratedActivationToken          = "controller_generated_activation_token"
+	EnableSessionRecordingField                 = "enable_session_recording"
+	StateField                                  = "state"
 )
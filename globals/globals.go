@@ -6,6 +6,24 @@ const (
 	TcpProxyV1     = "boundary-tcp-proxy-v1"
 	ServiceTokenV1 = "s1"
 	SessionPrefix  = "s_"
+
+	// EndpointAuthSchemeParam is the query parameter of a session endpoint
+	// that tells the worker which scheme of Authorization header to set on the
+	// requests it proxies to the endpoint.
+	EndpointAuthSchemeParam = "auth_scheme"
+
+	// EndpointHostKeyParam is the query parameter of a session endpoint that
+	// tells the worker which public key the endpoint must present.
+	EndpointHostKeyParam = "host_key"
+
+	// EndpointSslModeParam is the query parameter of a session endpoint that
+	// tells the worker how to secure its postgres connection to the endpoint.
+	EndpointSslModeParam = "sslmode"
+
+	// EndpointSslRootCertParam is the query parameter of a session endpoint
+	// that tells the worker which certificate authorities the certificate of
+	// the endpoint is verified against.
+	EndpointSslRootCertParam = "sslrootcert"
 )
 
 type (
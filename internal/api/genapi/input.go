@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/serviceaccounts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/users"
@@ -143,6 +144,16 @@ var inputStructs = []*structInfo{
 		outFile:     "scopes/scope_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &scopes.Key{},
+		outFile:     "scopes/key.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &scopes.KeyVersion{},
+		outFile:     "scopes/key_version.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &plugins.PluginInfo{},
 		outFile:     "plugins/plugin_info.gen.go",
@@ -234,6 +245,28 @@ var inputStructs = []*structInfo{
 		createResponseTypes: true,
 		recursiveListing:    true,
 	},
+	// Service account related resources
+	{
+		inProto:     &serviceaccounts.ServiceAccountToken{},
+		outFile:     "serviceaccounts/service_account_token.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto: &serviceaccounts.ServiceAccount{},
+		outFile: "serviceaccounts/service_account.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			commonCreateTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "service-accounts",
+		versionEnabled:      true,
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
 	// Role related resources
 	{
 		inProto:     &roles.Grant{},
@@ -250,6 +283,16 @@ var inputStructs = []*structInfo{
 		outFile:     "roles/grant_json.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &roles.GrantExplanation{},
+		outFile:     "roles/grant_explanation.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &roles.Explanation{},
+		outFile:     "roles/explanation.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &roles.Role{},
 		outFile: "roles/role.gen.go",
@@ -271,6 +314,38 @@ var inputStructs = []*structInfo{
 				VarName:   "grantStrings",
 			},
 		},
+		extraFields: []fieldInfo{
+			{
+				Name:        "UserId",
+				ProtoName:   "user_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "GrantStrings",
+				ProtoName:   "grant_strings",
+				FieldType:   "[]string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ResourceId",
+				ProtoName:   "resource_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PinId",
+				ProtoName:   "pin_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ExpiresInSeconds",
+				ProtoName:   "expires_in_seconds",
+				FieldType:   "uint32",
+				SkipDefault: true,
+			},
+		},
 		pluralResourceName:  "roles",
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -300,6 +375,29 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.OidcAuthMethodAuthenticateDeviceStartResponse{},
+		outFile:     "authmethods/oidc_auth_method_authenticate_device_start_response.gen.go",
+		subtypeName: "OidcAuthMethod",
+	},
+	{
+		inProto:        &authmethods.LdapAuthMethodAttributes{},
+		outFile:        "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName:    "LdapAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &authmethods.JwtAuthMethodAttributes{},
+		outFile:        "authmethods/jwt_auth_method_attributes.gen.go",
+		subtypeName:    "JwtAuthMethod",
+		parentTypeName: "AuthMethod",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &authmethods.AuthMethod{},
 		outFile: "authmethods/authmethods.gen.go",
@@ -354,6 +452,24 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &accounts.LdapAccountAttributes{},
+		outFile:        "accounts/ldap_account_attributes.gen.go",
+		subtypeName:    "LdapAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &accounts.JwtAccountAttributes{},
+		outFile:        "accounts/jwt_account_attributes.gen.go",
+		subtypeName:    "JwtAccount",
+		parentTypeName: "Account",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -386,6 +502,36 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &managedgroups.LdapManagedGroupAttributes{},
+		outFile:     "managedgroups/ldap_managed_group_attributes.gen.go",
+		subtypeName: "LdapManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "GroupNames",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "ManagedGroup",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &managedgroups.JwtManagedGroupAttributes{},
+		outFile:     "managedgroups/jwt_managed_group_attributes.gen.go",
+		subtypeName: "JwtManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Filter",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "ManagedGroup",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -729,6 +875,24 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &targets.HttpTargetAttributes{},
+		outFile:        "targets/http_target_attributes.gen.go",
+		subtypeName:    "HttpTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &targets.PostgresTargetAttributes{},
+		outFile:        "targets/postgres_target_attributes.gen.go",
+		subtypeName:    "PostgresTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
@@ -814,6 +978,10 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.SessionState{},
 		outFile: "sessions/state.gen.go",
 	},
+	{
+		inProto: &sessions.HttpRequest{},
+		outFile: "sessions/http_request.gen.go",
+	},
 	{
 		inProto: &sessions.Connection{},
 		outFile: "sessions/connection.gen.go",
@@ -0,0 +1,24 @@
+package auth
+
+// AccountState defines the possible states of an account.
+type AccountState string
+
+const (
+	ActiveAccountState   AccountState = "active"
+	DisabledAccountState AccountState = "disabled"
+)
+
+// ValidAccountState returns true if s is a valid account state.
+func ValidAccountState(s string) bool {
+	switch AccountState(s) {
+	case ActiveAccountState, DisabledAccountState:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDisabledAccount returns true if the account is disabled.
+func IsDisabledAccount(a Account) bool {
+	return AccountState(a.GetState()) == DisabledAccountState
+}
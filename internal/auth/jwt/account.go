@@ -0,0 +1,111 @@
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAccountTableName defines the default table name for an Account
+const defaultAccountTableName = "auth_jwt_account"
+
+// Account contains a JWT auth account. It is assigned to a JWT AuthMethod
+// and updates/deletes to that AuthMethod are cascaded to its Accounts.
+type Account struct {
+	*store.Account
+	tableName string
+}
+
+// NewAccount creates a new in memory Account assigned to JWT AuthMethod.
+// WithName, WithDescription, WithFullName and WithEmail are the only valid
+// options. All other options are ignored.
+//
+// Subject is the value of the sub claim, or of the claim mapped to it, of the
+// tokens the account authenticates with. FullName, Email and TokenClaims are
+// updated every time the account authenticates.
+func NewAccount(ctx context.Context, authMethodId string, subject string, opt ...Option) (*Account, error) {
+	const op = "jwt.NewAccount"
+	opts := getOpts(opt...)
+	a := &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethodId,
+			Subject:      subject,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			FullName:     opts.withFullName,
+			Email:        opts.withEmail,
+		},
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+
+	return a, nil
+}
+
+// validate the Account.  On success, it will return nil.
+func (a *Account) validate(ctx context.Context, caller errors.Op) error {
+	if a.AuthMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing auth method id")
+	}
+	if a.Subject == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing subject")
+	}
+	if len(a.Subject) > 255 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "subject is too long")
+	}
+	if a.Email != "" && len(a.Email) > 320 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "email address is too long")
+	}
+	if a.FullName != "" && len(a.FullName) > 512 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "full name is too long")
+	}
+	return nil
+}
+
+// AllocAccount makes an empty one in memory
+func AllocAccount() *Account {
+	return &Account{
+		Account: &store.Account{},
+	}
+}
+
+// Clone an Account.
+func (a *Account) Clone() *Account {
+	cp := proto.Clone(a.Account)
+	return &Account{
+		Account: cp.(*store.Account),
+	}
+}
+
+// TableName returns the table name.
+func (a *Account) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAccountTableName
+}
+
+// SetTableName sets the table name.
+func (a *Account) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the Account.
+func (a *Account) oplog(op oplog.OpType, authMethodScopeId string) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"jwt account"},
+		"op-type":            []string{op.String()},
+	}
+	if a.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{a.AuthMethodId}
+	}
+	if authMethodScopeId != "" {
+		metadata["scope-id"] = []string{authMethodScopeId}
+	}
+	return metadata
+}
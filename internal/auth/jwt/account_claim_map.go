@@ -0,0 +1,80 @@
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAcctClaimMapTableName defines the default table name for an AccountClaimMap
+const defaultAcctClaimMapTableName = "auth_jwt_account_claim_map"
+
+// AccountClaimMap defines an optional map from a custom claim of a token to
+// one of the standard account claims of sub, name and email. The to claims
+// are the same as the ones of OIDC auth methods.
+type AccountClaimMap struct {
+	*store.AccountClaimMap
+	tableName string
+}
+
+// NewAccountClaimMap creates a new in memory account claim map assigned to a
+// JWT auth method.
+func NewAccountClaimMap(ctx context.Context, authMethodId, fromClaim string, toClaim oidc.AccountToClaim) (*AccountClaimMap, error) {
+	const op = "jwt.NewAccountClaimMap"
+	cm := &AccountClaimMap{
+		AccountClaimMap: &store.AccountClaimMap{
+			JwtMethodId: authMethodId,
+			FromClaim:   fromClaim,
+			ToClaim:     string(toClaim),
+		},
+	}
+	if err := cm.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return cm, nil
+}
+
+// validate the AccountClaimMap.  On success, it will return nil.
+func (cm *AccountClaimMap) validate(ctx context.Context, caller errors.Op) error {
+	if cm.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if cm.FromClaim == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing from claim")
+	}
+	if _, err := oidc.ConvertToAccountToClaim(ctx, cm.ToClaim); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	return nil
+}
+
+// AllocAccountClaimMap makes an empty one in memory
+func AllocAccountClaimMap() AccountClaimMap {
+	return AccountClaimMap{
+		AccountClaimMap: &store.AccountClaimMap{},
+	}
+}
+
+// Clone an AccountClaimMap
+func (cm *AccountClaimMap) Clone() *AccountClaimMap {
+	cp := proto.Clone(cm.AccountClaimMap)
+	return &AccountClaimMap{
+		AccountClaimMap: cp.(*store.AccountClaimMap),
+	}
+}
+
+// TableName returns the table name.
+func (cm *AccountClaimMap) TableName() string {
+	if cm.tableName != "" {
+		return cm.tableName
+	}
+	return defaultAcctClaimMapTableName
+}
+
+// SetTableName sets the table name.
+func (cm *AccountClaimMap) SetTableName(n string) {
+	cm.tableName = n
+}
@@ -0,0 +1,308 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_jwt_method"
+
+// AuthMethod contains a JWT auth method configuration. It is owned by a
+// scope. AuthMethods can have Accounts, ManagedGroups, PublicKeys,
+// BoundAudiences, SigningAlgs, BoundClaims and AccountClaimMaps.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId. The
+// signature of tokens is verified using the keys of the JWKS URL provided
+// using WithJwksUrl or the keys provided using WithPublicKeys, and exactly
+// one of the two must be provided.
+//
+// Supports the options of WithName, WithDescription, WithBoundIssuer,
+// WithJwksUrl, WithJwksCaCert, WithPublicKeys, WithBoundAudiences,
+// WithSigningAlgs, WithBoundClaims and WithAccountClaimMap and all other
+// options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
+	const op = "jwt.NewAuthMethod"
+	opts := getOpts(opt...)
+
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:           scopeId,
+			Name:              opts.withName,
+			Description:       opts.withDescription,
+			BoundIssuer:       opts.withBoundIssuer,
+			JwksCaCert:        opts.withJwksCaCert,
+			PublicKeys:        opts.withPublicKeys,
+			BoundAudiences:    opts.withBoundAudiences,
+			SigningAlgorithms: opts.withSigningAlgs,
+			BoundClaims:       opts.withBoundClaims,
+		},
+	}
+	if opts.withJwksUrl != nil {
+		a.JwksUrl = opts.withJwksUrl.String()
+	}
+	if len(opts.withAccountClaimMap) > 0 {
+		a.AccountClaimMaps = make([]string, 0, len(opts.withAccountClaimMap))
+		for k, v := range opts.withAccountClaimMap {
+			a.AccountClaimMaps = append(a.AccountClaimMaps, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(a.AccountClaimMaps)
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod. On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	switch {
+	case a.JwksUrl == "" && len(a.PublicKeys) == 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwks url or public keys (you must specify one)")
+	case a.JwksUrl != "" && len(a.PublicKeys) > 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "jwks url and public keys are mutually exclusive")
+	}
+	if a.JwksUrl != "" {
+		u, err := url.Parse(a.JwksUrl)
+		if err != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("not a valid jwks url: %s", a.JwksUrl), errors.WithWrap(err))
+		}
+		switch strings.ToLower(u.Scheme) {
+		case "http", "https":
+		default:
+			return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("%s scheme in jwks url %q is not either http or https", u.Scheme, a.JwksUrl))
+		}
+	}
+	if a.JwksCaCert != "" && a.JwksUrl == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwks url for jwks ca cert")
+	}
+	for _, k := range a.PublicKeys {
+		if err := validatePublicKey(ctx, caller, k); err != nil {
+			return err
+		}
+	}
+	for _, alg := range a.SigningAlgorithms {
+		if err := validateSigningAlg(ctx, caller, alg); err != nil {
+			return err
+		}
+	}
+	if _, err := ParseBoundClaims(ctx, a.BoundClaims...); err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	acms, err := oidc.ParseAccountClaimMaps(ctx, a.AccountClaimMaps...)
+	if err != nil {
+		return errors.Wrap(ctx, err, caller)
+	}
+	for _, m := range acms {
+		if _, err := oidc.ConvertToAccountToClaim(ctx, m.To); err != nil {
+			return errors.Wrap(ctx, err, caller)
+		}
+	}
+	return nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"jwt auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
+
+type convertedValues struct {
+	PublicKeys       []interface{}
+	Audiences        []interface{}
+	Algs             []interface{}
+	BoundClaims      []interface{}
+	AccountClaimMaps []interface{}
+}
+
+// convertValueObjects converts the embedded value objects. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues, error) {
+	const op = "jwt.(AuthMethod).convertValueObjects"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	var err error
+	vo := &convertedValues{}
+	if vo.PublicKeys, err = a.convertPublicKeys(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if vo.Audiences, err = a.convertBoundAudiences(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if vo.Algs, err = a.convertSigningAlgs(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if vo.BoundClaims, err = a.convertBoundClaims(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if vo.AccountClaimMaps, err = a.convertAccountClaimMaps(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return vo, nil
+}
+
+// convertPublicKeys converts the embedded public keys from []string to
+// []interface{} where each slice element is a *PublicKey. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertPublicKeys(ctx context.Context) ([]interface{}, error) {
+	const op = "jwt.(AuthMethod).convertPublicKeys"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]interface{}, 0, len(a.PublicKeys))
+	for _, k := range a.PublicKeys {
+		obj, err := NewPublicKey(ctx, a.PublicId, k)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertBoundAudiences converts the embedded bound audiences from []string
+// to []interface{} where each slice element is a *BoundAudience. It will
+// return an error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertBoundAudiences(ctx context.Context) ([]interface{}, error) {
+	const op = "jwt.(AuthMethod).convertBoundAudiences"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]interface{}, 0, len(a.BoundAudiences))
+	for _, aud := range a.BoundAudiences {
+		obj, err := NewBoundAudience(ctx, a.PublicId, aud)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertSigningAlgs converts the embedded signing algorithms from []string
+// to []interface{} where each slice element is a *SigningAlg. It will return
+// an error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertSigningAlgs(ctx context.Context) ([]interface{}, error) {
+	const op = "jwt.(AuthMethod).convertSigningAlgs"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]interface{}, 0, len(a.SigningAlgorithms))
+	for _, alg := range a.SigningAlgorithms {
+		obj, err := NewSigningAlg(ctx, a.PublicId, alg)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertBoundClaims converts the embedded bound claims from []string to
+// []interface{} where each slice element is a *BoundClaim. It will return an
+// error if the AuthMethod's public id is not set or it can't parse the bound
+// claims.
+func (a *AuthMethod) convertBoundClaims(ctx context.Context) ([]interface{}, error) {
+	const op = "jwt.(AuthMethod).convertBoundClaims"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	parsed, err := ParseBoundClaims(ctx, a.BoundClaims...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	claims := make([]string, 0, len(parsed))
+	for c := range parsed {
+		claims = append(claims, c)
+	}
+	sort.Strings(claims)
+	newInterfaces := make([]interface{}, 0, len(a.BoundClaims))
+	for _, c := range claims {
+		for _, v := range parsed[c] {
+			obj, err := NewBoundClaim(ctx, a.PublicId, c, v)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			newInterfaces = append(newInterfaces, obj)
+		}
+	}
+	return newInterfaces, nil
+}
+
+// convertAccountClaimMaps converts the embedded account claim maps from
+// []string to []interface{} where each slice element is a *AccountClaimMap.
+// It will return an error if the AuthMethod's public id is not set or it
+// can't convert the account claim maps.
+func (a *AuthMethod) convertAccountClaimMaps(ctx context.Context) ([]interface{}, error) {
+	const op = "jwt.(AuthMethod).convertAccountClaimMaps"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	acms, err := oidc.ParseAccountClaimMaps(ctx, a.AccountClaimMaps...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	newInterfaces := make([]interface{}, 0, len(acms))
+	for _, m := range acms {
+		toClaim, err := oidc.ConvertToAccountToClaim(ctx, m.To)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		obj, err := NewAccountClaimMap(ctx, a.PublicId, m.From, toClaim)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
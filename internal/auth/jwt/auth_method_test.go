@@ -0,0 +1,138 @@
+package jwt
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pub := TestEncodePublicKey(t, &TestGenerateKey(t).PublicKey)
+	jwksUrl, err := url.Parse("https://example.com/.well-known/jwks.json")
+	require.NoError(t, err)
+	ldapUrl, err := url.Parse("ldap://example.com")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		scopeId   string
+		opts      []Option
+		want      *AuthMethod
+		wantIsErr errors.Code
+	}{
+		{
+			name:    "valid-jwks-url",
+			scopeId: "o_1234567890",
+			opts: []Option{
+				WithName("k8s"),
+				WithDescription("cluster workloads"),
+				WithBoundIssuer("https://kubernetes.default.svc"),
+				WithJwksUrl(jwksUrl),
+				WithBoundAudiences("boundary"),
+				WithSigningAlgs("ES256"),
+				WithBoundClaims("namespace=ci", "namespace=build"),
+				WithAccountClaimMap(map[string]oidc.AccountToClaim{"oid": oidc.ToSubClaim}),
+			},
+			want: &AuthMethod{
+				AuthMethod: &store.AuthMethod{
+					ScopeId:           "o_1234567890",
+					Name:              "k8s",
+					Description:       "cluster workloads",
+					BoundIssuer:       "https://kubernetes.default.svc",
+					JwksUrl:           "https://example.com/.well-known/jwks.json",
+					BoundAudiences:    []string{"boundary"},
+					SigningAlgorithms: []string{"ES256"},
+					BoundClaims:       []string{"namespace=ci", "namespace=build"},
+					AccountClaimMaps:  []string{"oid=sub"},
+				},
+			},
+		},
+		{
+			name:    "valid-public-keys",
+			scopeId: "o_1234567890",
+			opts:    []Option{WithPublicKeys(pub)},
+			want: &AuthMethod{
+				AuthMethod: &store.AuthMethod{
+					ScopeId:    "o_1234567890",
+					PublicKeys: []string{pub},
+				},
+			},
+		},
+		{
+			name:      "missing-scope-id",
+			opts:      []Option{WithPublicKeys(pub)},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "missing-keys",
+			scopeId:   "o_1234567890",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "jwks-url-and-public-keys",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithJwksUrl(jwksUrl), WithPublicKeys(pub)},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-jwks-url-scheme",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithJwksUrl(ldapUrl)},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "ca-cert-without-jwks-url",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithPublicKeys(pub), WithJwksCaCert("cert")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-public-key",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithPublicKeys("not-a-key")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-signing-alg",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithPublicKeys(pub), WithSigningAlgs("none")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-bound-claim",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithPublicKeys(pub), WithBoundClaims("namespace")},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-account-claim-map",
+			scopeId:   "o_1234567890",
+			opts:      []Option{WithPublicKeys(pub), WithAccountClaimMap(map[string]oidc.AccountToClaim{"oid": "groups"})},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tc.scopeId, tc.opts...)
+			if tc.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
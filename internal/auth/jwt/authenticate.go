@@ -0,0 +1,379 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/oplog"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"github.com/hashicorp/go-bexpr"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/protobuf/proto"
+)
+
+// Account must implement oplog.Replayable for upsertAccount to work
+var _ oplog.ReplayableMessage = (*Account)(nil)
+
+// Account must implement proto.Message for upsertAccount to work
+var _ proto.Message = (*Account)(nil)
+
+// Authenticate validates token against the configuration of the auth method
+// authMethodId. If the token is valid, the account for the token's subject is
+// created or updated with the token's claims, its managed group memberships
+// are set and the account is returned. If the token is rejected or the
+// account is disabled, nil, nil is returned.
+//
+// No options are currently supported.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, token string, _ ...Option) (*Account, error) {
+	const op = "jwt.(Repository).Authenticate"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id", errors.WithoutEvent())
+	}
+	if token == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token", errors.WithoutEvent())
+	}
+
+	am, err := r.LookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+
+	claims, err := am.validateToken(ctx, token)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("token rejected", "auth_method_id", authMethodId))
+		return nil, nil
+	}
+
+	acct, err := r.upsertAccount(ctx, am, claims)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if auth.IsDisabledAccount(acct) {
+		return nil, nil
+	}
+
+	// Get the set of all managed groups so we can filter
+	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		evalData := map[string]interface{}{
+			"token": claims,
+		}
+		// Iterate through and check claims against filters
+		for _, mg := range mgs {
+			eval, err := bexpr.CreateEvaluator(mg.Filter)
+			if err != nil {
+				// We check all filters on ingress so this should never happen,
+				// but we validate anyways
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			match, err := eval.Evaluate(evalData)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if match {
+				matchedMgs = append(matchedMgs, mg)
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return acct, nil
+}
+
+// validateToken verifies the signature of token using the keys of the auth
+// method's JWKS URL or its public keys, validates the token's issuer,
+// audience, signing algorithm and time based claims and ensures its claims
+// satisfy the auth method's bound claims. It returns the claims of the token.
+func (am *AuthMethod) validateToken(ctx context.Context, token string) (map[string]interface{}, error) {
+	const op = "jwt.(AuthMethod).validateToken"
+	var keySet capjwt.KeySet
+	var err error
+	switch {
+	case am.JwksUrl != "":
+		keySet, err = capjwt.NewJSONWebKeySet(ctx, am.JwksUrl, am.JwksCaCert)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to create jwks key set", errors.WithWrap(err))
+		}
+	default:
+		keys := make([]crypto.PublicKey, 0, len(am.PublicKeys))
+		for _, k := range am.PublicKeys {
+			key, err := capjwt.ParsePublicKeyPEM([]byte(k))
+			if err != nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse public key", errors.WithWrap(err))
+			}
+			keys = append(keys, key)
+		}
+		keySet, err = capjwt.NewStaticKeySet(keys)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to create static key set", errors.WithWrap(err))
+		}
+	}
+	validator, err := capjwt.NewValidator(keySet)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "unable to create validator", errors.WithWrap(err))
+	}
+
+	algs := make([]capjwt.Alg, 0, len(am.SigningAlgorithms))
+	for _, a := range am.SigningAlgorithms {
+		algs = append(algs, capjwt.Alg(a))
+	}
+	claims, err := validator.Validate(ctx, token, capjwt.Expected{
+		Issuer:            am.BoundIssuer,
+		Audiences:         am.BoundAudiences,
+		SigningAlgorithms: algs,
+	})
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unauthorized, op, "invalid token", errors.WithWrap(err))
+	}
+
+	boundClaims, err := ParseBoundClaims(ctx, am.BoundClaims...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for claim, values := range boundClaims {
+		if !claimMatches(claims[claim], values) {
+			return nil, errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("claim %q does not match any of its bound values", claim))
+		}
+	}
+	return claims, nil
+}
+
+// claimMatches reports whether the value of a claim matches any of the
+// values. When the claim is a list, any of its elements may match.
+func claimMatches(claim interface{}, values []string) bool {
+	var actual []string
+	switch c := claim.(type) {
+	case nil:
+		return false
+	case string:
+		actual = []string{c}
+	case []interface{}:
+		for _, e := range c {
+			actual = append(actual, fmt.Sprint(e))
+		}
+	default:
+		actual = []string{fmt.Sprint(c)}
+	}
+	for _, a := range actual {
+		for _, v := range values {
+			if a == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// upsertAccount will create or update the account for the token's subject
+// using the token's claims and the auth method's account claim maps.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, claims map[string]interface{}) (*Account, error) {
+	const op = "jwt.(Repository).upsertAccount"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if claims == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token claims")
+	}
+
+	fromSub, fromName, fromEmail := string(oidc.ToSubClaim), string(oidc.ToNameClaim), string(oidc.ToEmailClaim)
+	if len(am.AccountClaimMaps) > 0 {
+		acms, err := oidc.ParseAccountClaimMaps(ctx, am.AccountClaimMaps...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, m := range acms {
+			toClaim, err := oidc.ConvertToAccountToClaim(ctx, m.To)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			switch toClaim {
+			case oidc.ToSubClaim:
+				fromSub = m.From
+			case oidc.ToEmailClaim:
+				fromEmail = m.From
+			case oidc.ToNameClaim:
+				fromName = m.From
+			default:
+				// should never happen, but including it just in case.
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s=%s is not a valid account claim map", m.From, m.To))
+			}
+		}
+	}
+
+	sub, ok := claims[fromSub].(string)
+	if !ok || sub == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("mapping 'claim' %s to account subject and it is not present in token", fromSub))
+	}
+	// the name and email claims are optional and ignored when they're not
+	// strings.
+	fullName, _ := claims[fromName].(string)
+	email, _ := claims[fromEmail].(string)
+
+	marshaledClaims, err := json.Marshal(claims)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	pubId, err := newAccountId(ctx, am.GetPublicId(), sub)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	acctForOplog, err := NewAccount(ctx, am.PublicId, sub, WithFullName(fullName), WithEmail(email))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create new acct for oplog"))
+	}
+	acctForOplog.PublicId = pubId
+	acctForOplog.TokenClaims = string(marshaledClaims)
+
+	columns := []string{"public_id", "auth_method_id", "subject"}
+	values := []interface{}{
+		sql.Named("1", pubId),
+		sql.Named("2", am.PublicId),
+		sql.Named("3", sub),
+	}
+	var conflictClauses, fieldMasks, nullMasks []string
+	for _, c := range []struct {
+		column, field, value string
+	}{
+		{"full_name", FullNameField, acctForOplog.FullName},
+		{"email", EmailField, acctForOplog.Email},
+		{"token_claims", TokenClaimsField, acctForOplog.TokenClaims},
+	} {
+		if c.value == "" {
+			conflictClauses = append(conflictClauses, fmt.Sprintf("%s = NULL", c.column))
+			nullMasks = append(nullMasks, c.field)
+			continue
+		}
+		columns, values = append(columns, c.column), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), c.value))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("%s = @%d", c.column, len(values)))
+		fieldMasks = append(fieldMasks, c.field)
+	}
+
+	placeHolders := make([]string, 0, len(columns))
+	for colNum := range columns {
+		placeHolders = append(placeHolders, fmt.Sprintf("@%d", colNum+1))
+	}
+	query := fmt.Sprintf(acctUpsertQuery, strings.Join(columns, ", "), strings.Join(placeHolders, ", "), strings.Join(conflictClauses, ", "))
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	updatedAcct := AllocAccount()
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := w.Query(ctx, query, values)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert/update auth jwt account"))
+			}
+			defer rows.Close()
+			result := struct {
+				PublicId string
+				Version  int
+			}{}
+			var rowCnt int
+			for rows.Next() {
+				rowCnt += 1
+				if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan rows for account"))
+				}
+			}
+			if rowCnt > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 row but got: %d", rowCnt))
+			}
+			if err := reader.LookupWhere(ctx, &updatedAcct, "auth_method_id = ? and subject = ?", []interface{}{am.PublicId, sub}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up auth jwt account for: %s / %s", am.PublicId, sub)))
+			}
+			// include the version incase of predictable account public ids based on a calculation using authmethod id and subject
+			if result.Version == 1 && updatedAcct.PublicId == pubId {
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_CREATE, am.ScopeId, updatedAcct, nil, nil); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write create oplog for account"))
+				}
+			} else {
+				acctForOplog.PublicId = updatedAcct.PublicId
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_UPDATE, am.ScopeId, acctForOplog, fieldMasks, nullMasks); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write update oplog for account"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedAcct, nil
+}
+
+// upsertOplog will write oplog msgs for account upserts. The db.Writer needs to be the writer for the current
+// transaction that's executing the upsert. Both fieldMasks and nullMasks are allowed to be nil for update operations.
+func upsertOplog(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, operation oplog.OpType, scopeId string, acct *Account, fieldMasks, nullMasks []string) error {
+	const op = "jwt.upsertOplog"
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db writer")
+	}
+	if oplogWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	if operation != oplog.OpType_OP_TYPE_CREATE && operation != oplog.OpType_OP_TYPE_UPDATE {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("not a supported operation: %s", operation))
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if acct == nil || acct.Account == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account")
+	}
+	if operation == oplog.OpType_OP_TYPE_UPDATE && len(fieldMasks) == 0 && len(nullMasks) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "update operations must specify field masks and/or null masks")
+	}
+	ticket, err := w.GetTicket(ctx, acct)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	metadata := acct.oplog(operation, scopeId)
+	acctAsReplayable, ok := interface{}(acct).(oplog.ReplayableMessage)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, "account is not replayable")
+	}
+	acctAsProto, ok := interface{}(acct).(proto.Message)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, "account is not a proto message")
+	}
+	msg := oplog.Message{
+		Message:        acctAsProto,
+		TypeName:       acctAsReplayable.TableName(),
+		OpType:         operation,
+		FieldMaskPaths: fieldMasks,
+		SetToNullPaths: nullMasks,
+	}
+	if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, []*oplog.Message{&msg}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
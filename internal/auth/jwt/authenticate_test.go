@@ -0,0 +1,271 @@
+package jwt
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	capoidc "github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	priv := TestGenerateKey(t)
+	jwks := TestJwksServer(t, &priv.PublicKey)
+	jwksUrl, err := url.Parse(jwks.URL)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, conn, org.PublicId,
+		WithJwksUrl(jwksUrl),
+		WithSigningAlgs("ES256"),
+		WithBoundAudiences("boundary"),
+		WithAccountClaimMap(map[string]oidc.AccountToClaim{"runner_id": oidc.ToSubClaim}),
+	)
+	ciMg := TestManagedGroup(t, conn, am, `"/token/namespace" == "ci"`)
+	prodMg := TestManagedGroup(t, conn, am, `"/token/namespace" == "prod"`)
+
+	now := time.Now()
+	token := capoidc.TestSignJWT(t, priv, "ES256", map[string]interface{}{
+		"runner_id": "runner-1",
+		"aud":       []string{"boundary"},
+		"iat":       now.Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+		"name":      "Runner One",
+		"namespace": "ci",
+	}, nil)
+
+	t.Run("missing-auth-method-id", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, "", token)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+	t.Run("missing-token", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, am.PublicId, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	})
+	t.Run("unknown-auth-method", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, AuthMethodPrefix+"_1234567890", token)
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error: %v", err)
+	})
+	t.Run("rejected-token", func(t *testing.T) {
+		other := TestGenerateKey(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, capoidc.TestSignJWT(t, other, "ES256", map[string]interface{}{
+			"runner_id": "runner-1",
+			"aud":       []string{"boundary"},
+			"iat":       now.Unix(),
+		}, nil))
+		require.NoError(t, err)
+		assert.Nil(t, acct)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, token)
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Equal("runner-1", acct.Subject)
+		assert.Equal("Runner One", acct.FullName)
+		assert.Contains(acct.TokenClaims, `"namespace":"ci"`)
+
+		// authenticating again must update the same account
+		again, err := repo.Authenticate(ctx, am.PublicId, token)
+		require.NoError(err)
+		assert.Equal(acct.PublicId, again.PublicId)
+
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId)
+		require.NoError(err)
+		require.Len(mgs, 1)
+		assert.Equal(ciMg.PublicId, mgs[0].ManagedGroupId)
+		assert.NotEqual(prodMg.PublicId, mgs[0].ManagedGroupId)
+	})
+}
+
+func TestAuthMethod_validateToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	priv := TestGenerateKey(t)
+	otherPriv := TestGenerateKey(t)
+	jwks := TestJwksServer(t, &priv.PublicKey)
+
+	const issuer = "https://ci.example.com"
+	now := time.Now()
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":       issuer,
+			"sub":       "system:serviceaccount:ci:runner",
+			"aud":       []string{"boundary"},
+			"iat":       now.Unix(),
+			"nbf":       now.Add(-time.Minute).Unix(),
+			"exp":       now.Add(time.Hour).Unix(),
+			"namespace": "ci",
+			"groups":    []string{"builders", "deployers"},
+		}
+	}
+	withClaim := func(k string, v interface{}) map[string]interface{} {
+		c := validClaims()
+		if v == nil {
+			delete(c, k)
+			return c
+		}
+		c[k] = v
+		return c
+	}
+	jwksMethod := &AuthMethod{AuthMethod: &store.AuthMethod{
+		BoundIssuer:       issuer,
+		JwksUrl:           jwks.URL,
+		BoundAudiences:    []string{"boundary"},
+		SigningAlgorithms: []string{"ES256"},
+		BoundClaims:       []string{"namespace=ci", "namespace=build", "groups=deployers"},
+	}}
+	staticMethod := &AuthMethod{AuthMethod: &store.AuthMethod{
+		PublicKeys:        []string{TestEncodePublicKey(t, &priv.PublicKey)},
+		SigningAlgorithms: []string{"ES256"},
+	}}
+
+	tests := []struct {
+		name      string
+		am        *AuthMethod
+		token     string
+		wantSub   string
+		wantIsErr errors.Code
+	}{
+		{
+			name:    "jwks-valid",
+			am:      jwksMethod,
+			token:   capoidc.TestSignJWT(t, priv, "ES256", validClaims(), nil),
+			wantSub: "system:serviceaccount:ci:runner",
+		},
+		{
+			name:    "static-keys-valid",
+			am:      staticMethod,
+			token:   capoidc.TestSignJWT(t, priv, "ES256", validClaims(), nil),
+			wantSub: "system:serviceaccount:ci:runner",
+		},
+		{
+			name:      "wrong-key",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, otherPriv, "ES256", validClaims(), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "static-wrong-key",
+			am:        staticMethod,
+			token:     capoidc.TestSignJWT(t, otherPriv, "ES256", validClaims(), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name: "alg-not-allowed",
+			am: &AuthMethod{AuthMethod: &store.AuthMethod{
+				PublicKeys: []string{TestEncodePublicKey(t, &priv.PublicKey)},
+			}},
+			token:     capoidc.TestSignJWT(t, priv, "ES256", validClaims(), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "wrong-issuer",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("iss", "https://other.example.com"), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "wrong-audience",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("aud", []string{"vault"}), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "expired",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("exp", now.Add(-time.Hour).Unix()), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:    "bound-claim-second-value",
+			am:      jwksMethod,
+			token:   capoidc.TestSignJWT(t, priv, "ES256", withClaim("namespace", "build"), nil),
+			wantSub: "system:serviceaccount:ci:runner",
+		},
+		{
+			name:      "bound-claim-mismatch",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("namespace", "prod"), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "bound-claim-missing",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("namespace", nil), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "bound-list-claim-mismatch",
+			am:        jwksMethod,
+			token:     capoidc.TestSignJWT(t, priv, "ES256", withClaim("groups", []string{"builders"}), nil),
+			wantIsErr: errors.Unauthorized,
+		},
+		{
+			name:      "not-a-jwt",
+			am:        jwksMethod,
+			token:     "not-a-jwt",
+			wantIsErr: errors.Unauthorized,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			claims, err := tc.am.validateToken(ctx, tc.token)
+			if tc.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				assert.Nil(claims)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantSub, claims["sub"])
+		})
+	}
+}
+
+func Test_claimMatches(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		claim  interface{}
+		values []string
+		want   bool
+	}{
+		{name: "nil", claim: nil, values: []string{"a"}},
+		{name: "string", claim: "a", values: []string{"b", "a"}, want: true},
+		{name: "string-mismatch", claim: "a", values: []string{"b"}},
+		{name: "list", claim: []interface{}{"x", "a"}, values: []string{"a"}, want: true},
+		{name: "list-mismatch", claim: []interface{}{"x", "y"}, values: []string{"a"}},
+		{name: "bool", claim: true, values: []string{"true"}, want: true},
+		{name: "number", claim: float64(42), values: []string{"42"}, want: true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, claimMatches(tc.claim, tc.values))
+		})
+	}
+}
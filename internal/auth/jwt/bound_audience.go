@@ -0,0 +1,78 @@
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultBoundAudienceTableName defines the default table name for a
+// BoundAudience
+const defaultBoundAudienceTableName = "auth_jwt_bound_audience"
+
+// BoundAudience defines an audience a token must be issued for. It is
+// assigned to a JWT AuthMethod and updates/deletes to that AuthMethod are
+// cascaded to its BoundAudiences. BoundAudiences are value objects of an
+// AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type BoundAudience struct {
+	*store.BoundAudience
+	tableName string
+}
+
+// NewBoundAudience creates a new in memory audience assigned to a JWT auth
+// method.
+func NewBoundAudience(ctx context.Context, authMethodId string, aud string) (*BoundAudience, error) {
+	const op = "jwt.NewBoundAudience"
+	a := &BoundAudience{
+		BoundAudience: &store.BoundAudience{
+			JwtMethodId: authMethodId,
+			Aud:         aud,
+		},
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return a, nil
+}
+
+// validate the BoundAudience and on success return nil
+func (a *BoundAudience) validate(ctx context.Context, caller errors.Op) error {
+	if a.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if a.Aud == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing audience")
+	}
+	return nil
+}
+
+// AllocBoundAudience makes an empty one in memory
+func AllocBoundAudience() BoundAudience {
+	return BoundAudience{
+		BoundAudience: &store.BoundAudience{},
+	}
+}
+
+// Clone a BoundAudience
+func (a *BoundAudience) Clone() *BoundAudience {
+	cp := proto.Clone(a.BoundAudience)
+	return &BoundAudience{
+		BoundAudience: cp.(*store.BoundAudience),
+	}
+}
+
+// TableName returns the table name.
+func (a *BoundAudience) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultBoundAudienceTableName
+}
+
+// SetTableName sets the table name.
+func (a *BoundAudience) SetTableName(n string) {
+	a.tableName = n
+}
@@ -0,0 +1,99 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultBoundClaimTableName defines the default table name for a BoundClaim
+const defaultBoundClaimTableName = "auth_jwt_bound_claim"
+
+// BoundClaim defines a value a claim of a token is allowed to have. It is
+// assigned to a JWT AuthMethod and updates/deletes to that AuthMethod are
+// cascaded to its BoundClaims. BoundClaims are value objects of an
+// AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type BoundClaim struct {
+	*store.BoundClaim
+	tableName string
+}
+
+// NewBoundClaim creates a new in memory bound claim assigned to a JWT auth
+// method.
+func NewBoundClaim(ctx context.Context, authMethodId string, claim, value string) (*BoundClaim, error) {
+	const op = "jwt.NewBoundClaim"
+	c := &BoundClaim{
+		BoundClaim: &store.BoundClaim{
+			JwtMethodId: authMethodId,
+			Claim:       claim,
+			Value:       value,
+		},
+	}
+	if err := c.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return c, nil
+}
+
+// validate the BoundClaim and on success return nil
+func (c *BoundClaim) validate(ctx context.Context, caller errors.Op) error {
+	if c.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if c.Claim == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing claim")
+	}
+	if c.Value == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing value")
+	}
+	return nil
+}
+
+// ParseBoundClaims parses bound claims in the format of claim=value into a
+// map of claims to the values they're allowed to have.
+func ParseBoundClaims(ctx context.Context, claims ...string) (map[string][]string, error) {
+	const op = "jwt.ParseBoundClaims"
+	parsed := make(map[string][]string, len(claims))
+	for _, c := range claims {
+		claim, value, ok := strings.Cut(c, "=")
+		claim, value = strings.TrimSpace(claim), strings.TrimSpace(value)
+		if !ok || claim == "" || value == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("bound claim %q is not in the format of claim=value", c))
+		}
+		parsed[claim] = append(parsed[claim], value)
+	}
+	return parsed, nil
+}
+
+// AllocBoundClaim makes an empty one in memory
+func AllocBoundClaim() BoundClaim {
+	return BoundClaim{
+		BoundClaim: &store.BoundClaim{},
+	}
+}
+
+// Clone a BoundClaim
+func (c *BoundClaim) Clone() *BoundClaim {
+	cp := proto.Clone(c.BoundClaim)
+	return &BoundClaim{
+		BoundClaim: cp.(*store.BoundClaim),
+	}
+}
+
+// TableName returns the table name.
+func (c *BoundClaim) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return defaultBoundClaimTableName
+}
+
+// SetTableName sets the table name.
+func (c *BoundClaim) SetTableName(n string) {
+	c.tableName = n
+}
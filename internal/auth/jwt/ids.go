@@ -0,0 +1,59 @@
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/intglobals"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(auth.Domain, Subtype, AuthMethodPrefix, AccountPrefix, intglobals.JwtManagedGroupPrefix); err != nil {
+		panic(err)
+	}
+}
+
+const (
+	// AuthMethodPrefix defines the prefix for AuthMethod public ids.
+	AuthMethodPrefix = "amjwt"
+	// AccountPrefix defines the prefix for Account public ids.
+	AccountPrefix = "acctjwt"
+
+	Subtype = subtypes.Subtype("jwt")
+)
+
+func newAuthMethodId(ctx context.Context) (string, error) {
+	const op = "jwt.newAuthMethodId"
+	id, err := db.NewPublicId(AuthMethodPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newAccountId(ctx context.Context, authMethodId, subject string) (string, error) {
+	const op = "jwt.newAccountId"
+	if authMethodId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if subject == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing subject")
+	}
+	id, err := db.NewPublicId(AccountPrefix, db.WithPrngValues([]string{authMethodId, subject}))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newManagedGroupId(ctx context.Context) (string, error) {
+	const op = "jwt.newManagedGroupId"
+	id, err := db.NewPublicId(intglobals.JwtManagedGroupPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
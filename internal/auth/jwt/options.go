@@ -0,0 +1,168 @@
+package jwt
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName              string
+	withDescription       string
+	withLimit             int
+	withPublicId          string
+	withOrderByCreateTime bool
+	ascending             bool
+	withReader            db.Reader
+	withBoundIssuer       string
+	withJwksUrl           *url.URL
+	withJwksCaCert        string
+	withPublicKeys        []string
+	withBoundAudiences    []string
+	withSigningAlgs       []string
+	withBoundClaims       []string
+	withAccountClaimMap   map[string]oidc.AccountToClaim
+	withFullName          string
+	withEmail             string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(ascending bool) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = true
+		o.ascending = ascending
+	}
+}
+
+// WithReader provides an option for specifying a reader to use for the
+// operation.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithBoundIssuer provides an optional issuer which must match the iss claim
+// of tokens.
+func WithBoundIssuer(iss string) Option {
+	return func(o *options) {
+		o.withBoundIssuer = iss
+	}
+}
+
+// WithJwksUrl provides an optional URL of the JSON Web Key Set used to
+// verify the signature of tokens.
+func WithJwksUrl(u *url.URL) Option {
+	return func(o *options) {
+		o.withJwksUrl = u
+	}
+}
+
+// WithJwksCaCert provides an optional PEM encoded CA certificate bundle used
+// when connecting to the JWKS URL.
+func WithJwksCaCert(pem string) Option {
+	return func(o *options) {
+		o.withJwksCaCert = pem
+	}
+}
+
+// WithPublicKeys provides optional PEM encoded public keys used to verify the
+// signature of tokens.
+func WithPublicKeys(pems ...string) Option {
+	return func(o *options) {
+		o.withPublicKeys = pems
+	}
+}
+
+// WithBoundAudiences provides optional audiences, one of which must be
+// contained in the aud claim of tokens.
+func WithBoundAudiences(aud ...string) Option {
+	return func(o *options) {
+		o.withBoundAudiences = aud
+	}
+}
+
+// WithSigningAlgs provides optional signing algorithms
+func WithSigningAlgs(alg ...string) Option {
+	return func(o *options) {
+		o.withSigningAlgs = alg
+	}
+}
+
+// WithBoundClaims provides optional claim constraints in the format of
+// claim=value.
+func WithBoundClaims(claims ...string) Option {
+	return func(o *options) {
+		o.withBoundClaims = claims
+	}
+}
+
+// WithAccountClaimMap provides an option for specifying an Account Claim map.
+func WithAccountClaimMap(acm map[string]oidc.AccountToClaim) Option {
+	return func(o *options) {
+		o.withAccountClaimMap = acm
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
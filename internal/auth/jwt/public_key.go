@@ -0,0 +1,89 @@
+package jwt
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultPublicKeyTableName defines the default table name for a PublicKey
+const defaultPublicKeyTableName = "auth_jwt_public_key"
+
+// PublicKey defines a PEM encoded public key used to verify the signature of
+// tokens. It is assigned to a JWT AuthMethod and updates/deletes to that
+// AuthMethod are cascaded to its PublicKeys. PublicKeys are value objects of
+// an AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type PublicKey struct {
+	*store.PublicKey
+	tableName string
+}
+
+// NewPublicKey creates a new in memory public key assigned to a JWT auth
+// method.
+func NewPublicKey(ctx context.Context, authMethodId string, keyPem string) (*PublicKey, error) {
+	const op = "jwt.NewPublicKey"
+	k := &PublicKey{
+		PublicKey: &store.PublicKey{
+			JwtMethodId: authMethodId,
+			Key:         keyPem,
+		},
+	}
+	if err := k.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return k, nil
+}
+
+// validate the PublicKey and on success return nil
+func (k *PublicKey) validate(ctx context.Context, caller errors.Op) error {
+	if k.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if err := validatePublicKey(ctx, caller, k.Key); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validatePublicKey ensures keyPem is a PEM encoded public key.
+func validatePublicKey(ctx context.Context, caller errors.Op, keyPem string) error {
+	if keyPem == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "empty public key")
+	}
+	if _, err := capjwt.ParsePublicKeyPEM([]byte(keyPem)); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "failed to parse public key: "+err.Error(), errors.WithWrap(err))
+	}
+	return nil
+}
+
+// AllocPublicKey makes an empty one in memory
+func AllocPublicKey() PublicKey {
+	return PublicKey{
+		PublicKey: &store.PublicKey{},
+	}
+}
+
+// Clone a PublicKey
+func (k *PublicKey) Clone() *PublicKey {
+	cp := proto.Clone(k.PublicKey)
+	return &PublicKey{
+		PublicKey: cp.(*store.PublicKey),
+	}
+}
+
+// TableName returns the table name.
+func (k *PublicKey) TableName() string {
+	if k.tableName != "" {
+		return k.tableName
+	}
+	return defaultPublicKeyTableName
+}
+
+// SetTableName sets the table name.
+func (k *PublicKey) SetTableName(n string) {
+	k.tableName = n
+}
@@ -0,0 +1,15 @@
+package jwt
+
+const (
+	acctUpsertQuery = `
+	insert into auth_jwt_account
+			(%s)
+	values
+			(%s)
+	on conflict on constraint
+			auth_jwt_account_auth_method_id_subject_uq
+	do update set
+			%s
+	returning public_id, version
+       `
+)
@@ -0,0 +1,115 @@
+package jwt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAuthMethod creates am (*AuthMethod) in the repo along with its
+// associated embedded value objects of PublicKeys, BoundAudiences,
+// SigningAlgs, BoundClaims and AccountClaimMaps and returns the newly created
+// AuthMethod (with its PublicId set)
+//
+// The AuthMethod's public id and version must be empty (zero values).
+//
+// The WithPublicId option is supported and all other options are ignored.
+func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	const op = "jwt.(Repository).CreateAuthMethod"
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if am.Version != 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version must be empty")
+	}
+	if err := am.validate(ctx, op); err != nil {
+		return nil, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	opts := getOpts(opt...)
+	am = am.Clone()
+	am.PublicId = opts.withPublicId
+	if am.PublicId == "" {
+		id, err := newAuthMethodId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		am.PublicId = id
+	} else {
+		if !strings.HasPrefix(am.PublicId, AuthMethodPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "wrong auth method id prefix")
+		}
+	}
+
+	vo, err := am.convertValueObjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 6)
+			ticket, err := w.GetTicket(ctx, am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			var amOplogMsg oplog.Message
+			if err := w.Create(ctx, am.Clone(), db.NewOplogMsg(&amOplogMsg)); err != nil {
+				return err
+			}
+			msgs = append(msgs, &amOplogMsg)
+
+			for _, items := range [][]interface{}{vo.PublicKeys, vo.Audiences, vo.Algs, vo.BoundClaims, vo.AccountClaimMaps} {
+				if len(items) == 0 {
+					continue
+				}
+				voOplogMsgs := make([]*oplog.Message, 0, len(items))
+				if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&voOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, voOplogMsgs...)
+			}
+			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+				// intentionally not setting the defaultLimit, so we'll get all
+				// the value objects
+			}
+			returnedAuthMethod, err = txRepo.lookupAuthMethod(ctx, am.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup created auth method"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAuthMethod, nil
+}
@@ -0,0 +1,170 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// LookupAuthMethod will lookup an auth method in the repo, along with its
+// associated Value Objects of PublicKeys, BoundAudiences, SigningAlgs,
+// BoundClaims and AccountClaimMaps. If it's not found, it will return nil,
+// nil. All options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ...Option) (*AuthMethod, error) {
+	const op = "jwt.(Repository).LookupAuthMethod"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	return r.lookupAuthMethod(ctx, publicId)
+}
+
+// ListAuthMethods returns a slice of AuthMethods for the scopeId. The
+// WithLimit and WithOrderByCreateTime options are supported and all other
+// options are ignored.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "jwt.(Repository).ListAuthMethods"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope IDs")
+	}
+	authMethods, err := r.getAuthMethods(ctx, "", scopeIds, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return authMethods, nil
+}
+
+// lookupAuthMethod will lookup a single auth method
+func (r *Repository) lookupAuthMethod(ctx context.Context, authMethodId string, opt ...Option) (*AuthMethod, error) {
+	const op = "jwt.(Repository).lookupAuthMethod"
+	ams, err := r.getAuthMethods(ctx, authMethodId, nil, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case len(ams) == 0:
+		return nil, nil // not an error to return no rows for a "lookup"
+	case len(ams) > 1:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%s matched more than 1 ", authMethodId))
+	default:
+		return ams[0], nil
+	}
+}
+
+// getAuthMethods allows the caller to either lookup a specific AuthMethod via
+// its id or search for a set AuthMethods within a set of scopes. Passing both
+// scopeIds and a authMethod is an error. The WithLimit and
+// WithOrderByCreateTime options are supported and all other options are
+// ignored.
+//
+// The AuthMethod returned has its value objects populated (PublicKeys,
+// BoundAudiences, SigningAlgorithms, BoundClaims and AccountClaimMaps) and
+// its IsPrimaryAuthMethod bool set.
+//
+// When no record is found it returns nil, nil
+func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "jwt.(Repository).getAuthMethods"
+	if authMethodId == "" && len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing search criteria: both auth method id and Scope IDs are empty")
+	}
+	if authMethodId != "" && len(scopeIds) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "searching for both an auth method id and Scope IDs is not supported")
+	}
+
+	const aggregateDelimiter = "|"
+
+	dbArgs := []db.Option{}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	dbArgs = append(dbArgs, db.WithLimit(limit))
+
+	if opts.withOrderByCreateTime {
+		if opts.ascending {
+			dbArgs = append(dbArgs, db.WithOrder("create_time asc"))
+		} else {
+			dbArgs = append(dbArgs, db.WithOrder("create_time"))
+		}
+	}
+
+	var where string
+	var args []interface{}
+	switch {
+	case authMethodId != "":
+		where, args = "public_id = ?", append(args, authMethodId)
+	default:
+		where, args = "scope_id in(?)", append(args, scopeIds)
+	}
+
+	var aggAuthMethods []*authMethodAgg
+	if err := r.reader.SearchWhere(ctx, &aggAuthMethods, where, args, dbArgs...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if len(aggAuthMethods) == 0 { // we're done if nothing is found.
+		return nil, nil
+	}
+
+	authMethods := make([]*AuthMethod, 0, len(aggAuthMethods))
+	for _, agg := range aggAuthMethods {
+		am := AllocAuthMethod()
+		am.PublicId = agg.PublicId
+		am.ScopeId = agg.ScopeId
+		am.IsPrimaryAuthMethod = agg.IsPrimaryAuthMethod
+		am.Name = agg.Name
+		am.Description = agg.Description
+		am.CreateTime = agg.CreateTime
+		am.UpdateTime = agg.UpdateTime
+		am.Version = agg.Version
+		am.BoundIssuer = agg.BoundIssuer
+		am.JwksUrl = agg.JwksUrl
+		am.JwksCaCert = agg.JwksCaCert
+		if agg.PublicKeys != "" {
+			am.PublicKeys = strings.Split(agg.PublicKeys, aggregateDelimiter)
+		}
+		if agg.Audiences != "" {
+			am.BoundAudiences = strings.Split(agg.Audiences, aggregateDelimiter)
+		}
+		if agg.Algs != "" {
+			am.SigningAlgorithms = strings.Split(agg.Algs, aggregateDelimiter)
+		}
+		if agg.BoundClaims != "" {
+			am.BoundClaims = strings.Split(agg.BoundClaims, aggregateDelimiter)
+		}
+		if agg.AccountClaimMaps != "" {
+			am.AccountClaimMaps = strings.Split(agg.AccountClaimMaps, aggregateDelimiter)
+		}
+		authMethods = append(authMethods, &am)
+	}
+	return authMethods, nil
+}
+
+// authMethodAgg is a view that aggregates the auth method's value objects in to
+// string fields delimited with the aggregateDelimiter of "|"
+type authMethodAgg struct {
+	PublicId            string `gorm:"primary_key"`
+	ScopeId             string
+	IsPrimaryAuthMethod bool
+	Name                string
+	Description         string
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	Version             uint32
+	BoundIssuer         string
+	JwksUrl             string
+	JwksCaCert          string
+	PublicKeys          string
+	Audiences           string
+	Algs                string
+	BoundClaims         string
+	AccountClaimMaps    string
+}
+
+// TableName returns the table name for gorm
+func (agg *authMethodAgg) TableName() string { return "jwt_auth_method_with_value_obj" }
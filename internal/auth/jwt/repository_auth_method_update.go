@@ -0,0 +1,311 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+const (
+	VersionField           = "Version"
+	NameField              = "Name"
+	DescriptionField       = "Description"
+	StateField             = "State"
+	BoundIssuerField       = "BoundIssuer"
+	JwksUrlField           = "JwksUrl"
+	JwksCaCertField        = "JwksCaCert"
+	PublicKeysField        = "PublicKeys"
+	BoundAudiencesField    = "BoundAudiences"
+	SigningAlgorithmsField = "SigningAlgorithms"
+	BoundClaimsField       = "BoundClaims"
+	AccountClaimMapsField  = "AccountClaimMaps"
+	FilterField            = "Filter"
+	FullNameField          = "FullName"
+	EmailField             = "Email"
+	TokenClaimsField       = "TokenClaims"
+
+	AuthTokenTimeToLiveSecondsField  = "AuthTokenTimeToLiveSeconds"
+	AuthTokenTimeToStaleSecondsField = "AuthTokenTimeToStaleSeconds"
+)
+
+// UpdateAuthMethod will retrieve the auth method from the repository, and
+// update it based on the field masks provided.
+//
+// fieldMaskPaths provides field_mask.proto paths for fields that should be
+// updated. Fields will be set to NULL if the field is a zero value and
+// included in fieldMask, except for the auth token lifetime fields which are
+// set to their zero value. Name, Description, BoundIssuer, JwksUrl,
+// JwksCaCert, AuthTokenTimeToLiveSeconds and AuthTokenTimeToStaleSeconds are
+// all updatable fields. The AuthMethod's Value Objects of
+// PublicKeys, BoundAudiences, SigningAlgorithms, BoundClaims and
+// AccountClaimMaps are also updatable and are replaced as a whole. If no
+// updatable fields are included in the fieldMaskPaths, then an error is
+// returned.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "jwt.(Repository).UpdateAuthMethod"
+	if am == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+
+	if err := validateFieldMask(ctx, fieldMaskPaths); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]interface{}{
+			NameField:              am.Name,
+			DescriptionField:       am.Description,
+			BoundIssuerField:       am.BoundIssuer,
+			JwksUrlField:           am.JwksUrl,
+			JwksCaCertField:        am.JwksCaCert,
+			PublicKeysField:        am.PublicKeys,
+			BoundAudiencesField:    am.BoundAudiences,
+			SigningAlgorithmsField: am.SigningAlgorithms,
+			BoundClaimsField:       am.BoundClaims,
+			AccountClaimMapsField:  am.AccountClaimMaps,
+
+			AuthTokenTimeToLiveSecondsField:  am.AuthTokenTimeToLiveSeconds,
+			AuthTokenTimeToStaleSecondsField: am.AuthTokenTimeToStaleSeconds,
+		},
+		fieldMaskPaths,
+		[]string{
+			AuthTokenTimeToLiveSecondsField,
+			AuthTokenTimeToStaleSecondsField,
+		},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	origAm, err := r.lookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s", am.PublicId))
+	}
+	// there's no reason to continue if another controller has already updated this auth method.
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+	if err := applyUpdate(am, origAm, dbMask, nullFields).validate(ctx, op); err != nil {
+		return nil, db.NoRowsAffected, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	am = am.Clone()
+	am.ScopeId = origAm.ScopeId
+
+	// the value objects are replaced as a whole, so the original ones are
+	// deleted and the new ones are added whether the field is being set or
+	// cleared.
+	type voConverter func(context.Context) ([]interface{}, error)
+	voConverters := map[string][2]voConverter{
+		PublicKeysField:        {origAm.convertPublicKeys, am.convertPublicKeys},
+		BoundAudiencesField:    {origAm.convertBoundAudiences, am.convertBoundAudiences},
+		SigningAlgorithmsField: {origAm.convertSigningAlgs, am.convertSigningAlgs},
+		BoundClaimsField:       {origAm.convertBoundClaims, am.convertBoundClaims},
+		AccountClaimMapsField:  {origAm.convertAccountClaimMaps, am.convertAccountClaimMaps},
+	}
+	var deleteVos, addVos [][]interface{}
+	var filteredDbMask, filteredNullFields []string
+	for _, f := range dbMask {
+		c, ok := voConverters[f]
+		if !ok {
+			filteredDbMask = append(filteredDbMask, f)
+			continue
+		}
+		del, err := c[0](ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		add, err := c[1](ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		deleteVos, addVos = append(deleteVos, del), append(addVos, add)
+	}
+	for _, f := range nullFields {
+		c, ok := voConverters[f]
+		if !ok {
+			filteredNullFields = append(filteredNullFields, f)
+			continue
+		}
+		del, err := c[0](ctx)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		deleteVos = append(deleteVos, del)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var updatedAm *AuthMethod
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 11) // AuthMethod, value objects*2
+			ticket, err := w.GetTicket(ctx, am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			var authMethodOplogMsg oplog.Message
+			switch {
+			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
+				// the auth method's fields are not being updated, just it's
+				// value objects, so we need to just update the auth method's
+				// version.
+				updatedAm = am.Clone()
+				updatedAm.Version = uint32(version) + 1
+				rowsUpdated, err = w.Update(ctx, updatedAm, []string{VersionField}, nil, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method version"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method version and %d rows updated", rowsUpdated))
+				}
+			default:
+				updatedAm = am.Clone()
+				rowsUpdated, err = w.Update(ctx, updatedAm, filteredDbMask, filteredNullFields, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+				}
+			}
+			msgs = append(msgs, &authMethodOplogMsg)
+
+			for _, items := range deleteVos {
+				if len(items) == 0 {
+					continue
+				}
+				deleteOplogMsgs := make([]*oplog.Message, 0, len(items))
+				rowsDeleted, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&deleteOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete value objects"))
+				}
+				if rowsDeleted != len(items) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("value objects deleted %d did not match request for %d", rowsDeleted, len(items)))
+				}
+				msgs = append(msgs, deleteOplogMsgs...)
+			}
+			for _, items := range addVos {
+				if len(items) == 0 {
+					continue
+				}
+				addOplogMsgs := make([]*oplog.Message, 0, len(items))
+				if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&addOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add value objects"))
+				}
+				msgs = append(msgs, addOplogMsgs...)
+			}
+
+			metadata := updatedAm.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+				// intentionally not setting the defaultLimit, so we'll get all
+				// the value objects without a limit
+			}
+			updatedAm, err = txRepo.lookupAuthMethod(ctx, updatedAm.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+			}
+			if updatedAm == nil {
+				return errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return updatedAm, rowsUpdated, nil
+}
+
+// validateFieldMask ensures every path in the field mask is updatable.
+func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
+	const op = "jwt.validateFieldMask"
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(BoundIssuerField, f):
+		case strings.EqualFold(JwksUrlField, f):
+		case strings.EqualFold(JwksCaCertField, f):
+		case strings.EqualFold(PublicKeysField, f):
+		case strings.EqualFold(BoundAudiencesField, f):
+		case strings.EqualFold(SigningAlgorithmsField, f):
+		case strings.EqualFold(BoundClaimsField, f):
+		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(AuthTokenTimeToLiveSecondsField, f):
+		case strings.EqualFold(AuthTokenTimeToStaleSecondsField, f):
+		default:
+			return errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return nil
+}
+
+// applyUpdate takes the new and applies it to the orig using the db mask and
+// null fields.
+func applyUpdate(new, orig *AuthMethod, dbMask, nullFields []string) *AuthMethod {
+	cp := orig.Clone()
+	fields := make([]string, 0, len(dbMask)+len(nullFields))
+	fields = append(fields, dbMask...)
+	fields = append(fields, nullFields...)
+	for _, f := range fields {
+		switch f {
+		case NameField:
+			cp.Name = new.Name
+		case DescriptionField:
+			cp.Description = new.Description
+		case BoundIssuerField:
+			cp.BoundIssuer = new.BoundIssuer
+		case JwksUrlField:
+			cp.JwksUrl = new.JwksUrl
+		case JwksCaCertField:
+			cp.JwksCaCert = new.JwksCaCert
+		case PublicKeysField:
+			cp.PublicKeys = new.PublicKeys
+		case BoundAudiencesField:
+			cp.BoundAudiences = new.BoundAudiences
+		case SigningAlgorithmsField:
+			cp.SigningAlgorithms = new.SigningAlgorithms
+		case BoundClaimsField:
+			cp.BoundClaims = new.BoundClaims
+		case AccountClaimMapsField:
+			cp.AccountClaimMaps = new.AccountClaimMaps
+		case AuthTokenTimeToLiveSecondsField:
+			cp.AuthTokenTimeToLiveSeconds = new.AuthTokenTimeToLiveSeconds
+		case AuthTokenTimeToStaleSecondsField:
+			cp.AuthTokenTimeToStaleSeconds = new.AuthTokenTimeToStaleSeconds
+		}
+	}
+	return cp
+}
@@ -0,0 +1,87 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultSigningAlgTableName defines the default table name for a SigningAlg
+const defaultSigningAlgTableName = "auth_jwt_signing_alg"
+
+// SigningAlg defines a signing algorithm allowed for the tokens of a JWT auth
+// method. It is assigned to a JWT AuthMethod and updates/deletes to that
+// AuthMethod are cascaded to its SigningAlgs. SigningAlgs are value objects of
+// an AuthMethod, therefore there's no need for oplog metadata, since only the
+// AuthMethod will have metadata because it's the root aggregate.
+type SigningAlg struct {
+	*store.SigningAlg
+	tableName string
+}
+
+// NewSigningAlg creates a new in memory signing algorithm assigned to a JWT
+// auth method.
+func NewSigningAlg(ctx context.Context, authMethodId string, alg string) (*SigningAlg, error) {
+	const op = "jwt.NewSigningAlg"
+	s := &SigningAlg{
+		SigningAlg: &store.SigningAlg{
+			JwtMethodId: authMethodId,
+			Alg:         alg,
+		},
+	}
+	if err := s.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return s, nil
+}
+
+// validate the SigningAlg and on success return nil
+func (s *SigningAlg) validate(ctx context.Context, caller errors.Op) error {
+	if s.JwtMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing jwt auth method id")
+	}
+	if err := validateSigningAlg(ctx, caller, s.Alg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateSigningAlg ensures alg is a signing algorithm supported for tokens.
+func validateSigningAlg(ctx context.Context, caller errors.Op, alg string) error {
+	if err := capjwt.SupportedSigningAlgorithm(capjwt.Alg(alg)); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("unsupported signing algorithm: %s", alg), errors.WithWrap(err))
+	}
+	return nil
+}
+
+// AllocSigningAlg makes an empty one in memory
+func AllocSigningAlg() SigningAlg {
+	return SigningAlg{
+		SigningAlg: &store.SigningAlg{},
+	}
+}
+
+// Clone a SigningAlg
+func (s *SigningAlg) Clone() *SigningAlg {
+	cp := proto.Clone(s.SigningAlg)
+	return &SigningAlg{
+		SigningAlg: cp.(*store.SigningAlg),
+	}
+}
+
+// TableName returns the table name.
+func (s *SigningAlg) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return defaultSigningAlgTableName
+}
+
+// SetTableName sets the table name.
+func (s *SigningAlg) SetTableName(n string) {
+	s.tableName = n
+}
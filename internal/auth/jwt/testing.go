@@ -0,0 +1,129 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a test jwt auth method with its value objects. All
+// the options supported by NewAuthMethod are supported.
+func TestAuthMethod(t testing.TB, conn *db.DB, scopeId string, opt ...Option) *AuthMethod {
+	t.Helper()
+	ctx := context.TODO()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	authMethod, err := NewAuthMethod(ctx, scopeId, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	authMethod.PublicId = id
+	require.NoError(rw.Create(ctx, authMethod))
+
+	vo, err := authMethod.convertValueObjects(ctx)
+	require.NoError(err)
+	for _, items := range [][]interface{}{vo.PublicKeys, vo.Audiences, vo.Algs, vo.BoundClaims, vo.AccountClaimMaps} {
+		if len(items) > 0 {
+			require.NoError(rw.CreateItems(ctx, items))
+		}
+	}
+	return authMethod
+}
+
+// TestAccount creates a test jwt account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, subject string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, subject, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.GetPublicId(), a.Subject)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test jwt managed group.
+func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, filter string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, filter, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestGenerateKey generates an ECDSA P-256 key pair for signing test tokens
+// with ES256.
+func TestGenerateKey(t testing.TB) *ecdsa.PrivateKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return priv
+}
+
+// TestEncodePublicKey returns the PEM encoding of pub.
+func TestEncodePublicKey(t testing.TB, pub *ecdsa.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// TestJwksServer starts a local http server which serves a JSON Web Key Set
+// containing keys at its root. The server is closed when the test completes.
+func TestJwksServer(t testing.TB, keys ...*ecdsa.PublicKey) *httptest.Server {
+	t.Helper()
+	type jwk struct {
+		Kty string `json:"kty"`
+		Crv string `json:"crv"`
+		Use string `json:"use"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+	set := struct {
+		Keys []jwk `json:"keys"`
+	}{}
+	enc := base64.RawURLEncoding
+	for _, k := range keys {
+		require.Equal(t, elliptic.P256(), k.Curve, "only P-256 keys are supported")
+		x, y := make([]byte, 32), make([]byte, 32)
+		k.X.FillBytes(x)
+		k.Y.FillBytes(y)
+		set.Keys = append(set.Keys, jwk{Kty: "EC", Crv: "P-256", Use: "sig", X: enc.EncodeToString(x), Y: enc.EncodeToString(y)})
+	}
+	body, err := json.Marshal(set)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
@@ -0,0 +1,69 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		authMethodId string
+		loginName    string
+		opts         []Option
+		wantLogin    string
+		wantGroups   []string
+		wantErr      bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amldap_1234567890",
+			loginName:    " Alice ",
+			opts: []Option{
+				WithName("alice"),
+				WithFullName("Alice Eve Smith"),
+				WithEmail("alice@example.org"),
+				WithDn("cn=alice,ou=people,dc=example,dc=org"),
+				WithMemberOfGroups("admins", "devs"),
+			},
+			wantLogin:  "alice",
+			wantGroups: []string{"admins", "devs"},
+		},
+		{
+			name:      "missing-auth-method-id",
+			loginName: "alice",
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:         "missing-login-name",
+			authMethodId: "amldap_1234567890",
+			loginName:    "  ",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAccount(ctx, tc.authMethodId, tc.loginName, tc.opts...)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantLogin, got.LoginName)
+			groups, err := got.GetMemberOfGroupNames(ctx)
+			require.NoError(err)
+			assert.Equal(tc.wantGroups, groups)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/ldap/testdirectory"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	d := testdirectory.Start(t)
+	pem, err := EncodeCertificates(ctx, d.Cert())
+	require.NoError(t, err)
+
+	type args struct {
+		scopeId string
+		opts    []Option
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *AuthMethod
+		wantErr   bool
+		wantIsErr errors.Code
+	}{
+		{
+			name: "valid",
+			args: args{
+				scopeId: "o_1234567890",
+				opts: []Option{
+					WithName("ad"),
+					WithDescription("corp active directory"),
+					WithUrls(TestConvertToUrls(t, "ldaps://ad1.example.org", "ldap://ad2.example.org:389")...),
+					WithStartTLS(),
+					WithUpnDomain("example.org"),
+					WithUserDn(testdirectory.DefaultUserDn),
+					WithEnableGroups(),
+					WithGroupDn(testdirectory.DefaultGroupDn),
+					WithCertificates(d.Cert()),
+					WithBindCredential("cn=admin,dc=example,dc=org", "secret"),
+				},
+			},
+			want: &AuthMethod{
+				AuthMethod: &store.AuthMethod{
+					ScopeId:      "o_1234567890",
+					Name:         "ad",
+					Description:  "corp active directory",
+					Urls:         []string{"ldaps://ad1.example.org", "ldap://ad2.example.org:389"},
+					StartTls:     true,
+					UpnDomain:    "example.org",
+					UserDn:       testdirectory.DefaultUserDn,
+					EnableGroups: true,
+					GroupDn:      testdirectory.DefaultGroupDn,
+					Certificates: pem,
+					BindDn:       "cn=admin,dc=example,dc=org",
+					BindPassword: "secret",
+				},
+			},
+		},
+		{
+			name: "missing-scope-id",
+			args: args{
+				opts: []Option{WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...)},
+			},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-urls",
+			args: args{
+				scopeId: "o_1234567890",
+			},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-group-dn",
+			args: args{
+				scopeId: "o_1234567890",
+				opts: []Option{
+					WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...),
+					WithEnableGroups(),
+				},
+			},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-bind-dn",
+			args: args{
+				scopeId: "o_1234567890",
+				opts: []Option{
+					WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...),
+					WithBindCredential("", "secret"),
+				},
+			},
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tc.args.scopeId, tc.args.opts...)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.True(proto.Equal(tc.want.AuthMethod, got.AuthMethod))
+		})
+	}
+}
+
+func Test_validateUrl(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const op = "Test_validateUrl"
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "ldap", url: "ldap://ad.example.org"},
+		{name: "ldaps-with-port", url: "ldaps://ad.example.org:636"},
+		{name: "https", url: "https://ad.example.org", wantErr: true},
+		{name: "missing-host", url: "ldap://", wantErr: true},
+		{name: "invalid", url: "ldap://%zz", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateUrl(ctx, op, tc.url)
+			if tc.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
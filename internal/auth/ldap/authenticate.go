@@ -0,0 +1,229 @@
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// Authenticate authenticates loginName and password against the LDAP
+// directory of the auth method authMethodId. If the directory accepts the
+// credentials, the account for loginName is created or updated with the
+// entry's full name, email, dn and groups, and returned. If the credentials
+// are rejected or the account is disabled, nil, nil is returned.
+//
+// No options are currently supported.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName, password string, _ ...Option) (*Account, error) {
+	const op = "ldap.(Repository).Authenticate"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id", errors.WithoutEvent())
+	}
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name", errors.WithoutEvent())
+	}
+	if password == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password", errors.WithoutEvent())
+	}
+
+	am, err := r.LookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+
+	c, err := newClient(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer c.close()
+
+	e, err := c.authenticate(ctx, loginName, password)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if e == nil {
+		return nil, nil
+	}
+
+	acct, err := r.upsertAccount(ctx, am, loginName, e)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if auth.IsDisabledAccount(acct) {
+		return nil, nil
+	}
+	return acct, nil
+}
+
+// upsertAccount will create or update the account for loginName with the
+// attributes of the user's directory entry.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, loginName string, e *entry) (*Account, error) {
+	const op = "ldap.(Repository).upsertAccount"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	if e == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing entry")
+	}
+	loginName = strings.ToLower(loginName)
+
+	pubId, err := newAccountId(ctx, am.GetPublicId(), loginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	opts := []Option{WithDn(e.dn), WithFullName(e.fullName), WithEmail(e.email)}
+	if am.EnableGroups {
+		opts = append(opts, WithMemberOfGroups(e.groups...))
+	}
+	acctForOplog, err := NewAccount(ctx, am.PublicId, loginName, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create new acct for oplog"))
+	}
+	acctForOplog.PublicId = pubId
+
+	columns := []string{"public_id", "auth_method_id", "login_name"}
+	values := []interface{}{
+		sql.Named("1", pubId),
+		sql.Named("2", am.PublicId),
+		sql.Named("3", loginName),
+	}
+	var conflictClauses, fieldMasks, nullMasks []string
+	for _, c := range []struct {
+		column, field, value string
+	}{
+		{"dn", DnField, acctForOplog.Dn},
+		{"full_name", FullNameField, acctForOplog.FullName},
+		{"email", EmailField, acctForOplog.Email},
+		{"member_of_groups", MemberOfGroupsField, acctForOplog.MemberOfGroups},
+	} {
+		if c.value == "" {
+			conflictClauses = append(conflictClauses, fmt.Sprintf("%s = NULL", c.column))
+			nullMasks = append(nullMasks, c.field)
+			continue
+		}
+		columns, values = append(columns, c.column), append(values, sql.Named(fmt.Sprintf("%d", len(values)+1), c.value))
+		conflictClauses = append(conflictClauses, fmt.Sprintf("%s = @%d", c.column, len(values)))
+		fieldMasks = append(fieldMasks, c.field)
+	}
+
+	placeHolders := make([]string, 0, len(columns))
+	for colNum := range columns {
+		placeHolders = append(placeHolders, fmt.Sprintf("@%d", colNum+1))
+	}
+	query := fmt.Sprintf(acctUpsertQuery, strings.Join(columns, ", "), strings.Join(placeHolders, ", "), strings.Join(conflictClauses, ", "))
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	updatedAcct := AllocAccount()
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := w.Query(ctx, query, values)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to insert/update auth ldap account"))
+			}
+			defer rows.Close()
+			result := struct {
+				PublicId string
+				Version  int
+			}{}
+			var rowCnt int
+			for rows.Next() {
+				rowCnt += 1
+				if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan rows for account"))
+				}
+			}
+			if rowCnt > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 row but got: %d", rowCnt))
+			}
+			if err := reader.LookupWhere(ctx, &updatedAcct, "auth_method_id = ? and login_name = ?", []interface{}{am.PublicId, loginName}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up auth ldap account for: %s / %s", am.PublicId, loginName)))
+			}
+			// include the version incase of predictable account public ids based on a calculation using authmethod id and login name
+			if result.Version == 1 && updatedAcct.PublicId == pubId {
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_CREATE, am.ScopeId, updatedAcct, nil, nil); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write create oplog for account"))
+				}
+			} else {
+				acctForOplog.PublicId = updatedAcct.PublicId
+				if err := upsertOplog(ctx, w, oplogWrapper, oplog.OpType_OP_TYPE_UPDATE, am.ScopeId, acctForOplog, fieldMasks, nullMasks); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write update oplog for account"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return updatedAcct, nil
+}
+
+// upsertOplog will write oplog msgs for account upserts. The db.Writer needs to be the writer for the current
+// transaction that's executing the upsert. Both fieldMasks and nullMasks are allowed to be nil for update operations.
+func upsertOplog(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, operation oplog.OpType, scopeId string, acct *Account, fieldMasks, nullMasks []string) error {
+	const op = "ldap.upsertOplog"
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db writer")
+	}
+	if oplogWrapper == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing oplog wrapper")
+	}
+	if operation != oplog.OpType_OP_TYPE_CREATE && operation != oplog.OpType_OP_TYPE_UPDATE {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("not a supported operation: %s", operation))
+	}
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if acct == nil || acct.Account == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account")
+	}
+	if operation == oplog.OpType_OP_TYPE_UPDATE && len(fieldMasks) == 0 && len(nullMasks) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "update operations must specify field masks and/or null masks")
+	}
+	ticket, err := w.GetTicket(ctx, acct)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	metadata := acct.oplog(operation, scopeId)
+	acctAsReplayable, ok := interface{}(acct).(oplog.ReplayableMessage)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, "account is not replayable")
+	}
+	acctAsProto, ok := interface{}(acct).(proto.Message)
+	if !ok {
+		return errors.New(ctx, errors.Internal, op, "account is not a proto message")
+	}
+	msg := oplog.Message{
+		Message:        acctAsProto,
+		TypeName:       acctAsReplayable.TableName(),
+		OpType:         operation,
+		FieldMaskPaths: fieldMasks,
+		SetToNullPaths: nullMasks,
+	}
+	if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, []*oplog.Message{&msg}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/testdirectory"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	users := testdirectory.NewUsers(t, "alice", "bob")
+	d := testdirectory.Start(t, testdirectory.WithLDAPS())
+	d.SetUsers(users...)
+	d.SetGroups(
+		testdirectory.NewGroup(t, "admins", users[0]),
+		testdirectory.NewGroup(t, "devs", users...),
+	)
+
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{d.Url()},
+		WithCertificates(d.Cert()),
+		WithUserDn(testdirectory.DefaultUserDn),
+		WithEnableGroups(),
+		WithGroupDn(testdirectory.DefaultGroupDn),
+	)
+	admins := TestManagedGroup(t, conn, am, []string{"admins"})
+	devs := TestManagedGroup(t, conn, am, []string{"devs"})
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		loginName    string
+		password     string
+		wantGroups   []string
+		wantMgs      []string
+		wantNil      bool
+		wantErrMatch *errors.Template
+	}{
+		{
+			name:         "alice",
+			authMethodId: am.PublicId,
+			loginName:    "Alice",
+			password:     testdirectory.DefaultUserPassword,
+			wantGroups:   []string{"admins", "devs"},
+			wantMgs:      []string{admins.PublicId, devs.PublicId},
+		},
+		{
+			name:         "alice-again",
+			authMethodId: am.PublicId,
+			loginName:    "alice",
+			password:     testdirectory.DefaultUserPassword,
+			wantGroups:   []string{"admins", "devs"},
+			wantMgs:      []string{admins.PublicId, devs.PublicId},
+		},
+		{
+			name:         "bob",
+			authMethodId: am.PublicId,
+			loginName:    "bob",
+			password:     testdirectory.DefaultUserPassword,
+			wantGroups:   []string{"devs"},
+			wantMgs:      []string{devs.PublicId},
+		},
+		{
+			name:         "wrong-password",
+			authMethodId: am.PublicId,
+			loginName:    "bob",
+			password:     "wrong",
+			wantNil:      true,
+		},
+		{
+			name:         "unknown-auth-method",
+			authMethodId: AuthMethodPrefix + "_1234567890",
+			loginName:    "bob",
+			password:     testdirectory.DefaultUserPassword,
+			wantErrMatch: errors.T(errors.RecordNotFound),
+		},
+		{
+			name:         "missing-login-name",
+			authMethodId: am.PublicId,
+			password:     testdirectory.DefaultUserPassword,
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.Authenticate(ctx, tc.authMethodId, tc.loginName, tc.password)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			if tc.wantNil {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(am.PublicId, got.AuthMethodId)
+			assert.Equal("cn="+got.LoginName+","+testdirectory.DefaultUserDn, got.Dn)
+			groups, err := got.GetMemberOfGroupNames(ctx)
+			require.NoError(err)
+			assert.ElementsMatch(tc.wantGroups, groups)
+
+			memberships, err := repo.ListManagedGroupMembershipsByMember(ctx, got.PublicId)
+			require.NoError(err)
+			var gotMgs []string
+			for _, m := range memberships {
+				gotMgs = append(gotMgs, m.ManagedGroupId)
+			}
+			assert.ElementsMatch(tc.wantMgs, gotMgs)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// DefaultUserAttr is the attribute of user entries used to match the login
+	// name when the auth method doesn't specify a user attr.
+	DefaultUserAttr = "cn"
+
+	// DefaultUserFilter is the template used to construct the user search
+	// filter when the auth method doesn't specify a user filter.
+	DefaultUserFilter = "({{.UserAttr}}={{.Username}})"
+
+	// DefaultGroupAttr is the attribute of group entries which contains the
+	// group's name when the auth method doesn't specify a group attr.
+	DefaultGroupAttr = "cn"
+
+	// DefaultGroupFilter is the template used to construct the group search
+	// filter when the auth method doesn't specify a group filter. It matches
+	// posix groups, groupOfNames and groupOfUniqueNames.
+	DefaultGroupFilter = "(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))"
+)
+
+// entry is the result of a successful bind as the authenticating user.
+type entry struct {
+	dn       string
+	fullName string
+	email    string
+	groups   []string
+}
+
+// client authenticates users against the LDAP directory configured by an
+// auth method.
+type client struct {
+	am   *AuthMethod
+	conn *goldap.Conn
+}
+
+// newClient connects to the first reachable LDAP server of the auth method.
+// The urls are tried in the order of their connection priority.
+func newClient(ctx context.Context, am *AuthMethod) (*client, error) {
+	const op = "ldap.newClient"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if len(am.Urls) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls")
+	}
+	var dialErrs []string
+	for _, u := range am.Urls {
+		conn, err := dial(ctx, am, u)
+		if err != nil {
+			dialErrs = append(dialErrs, fmt.Sprintf("%s: %s", u, err.Error()))
+			continue
+		}
+		return &client{am: am, conn: conn}, nil
+	}
+	return nil, errors.New(ctx, errors.Unavailable, op, fmt.Sprintf("unable to connect to any ldap server: %s", strings.Join(dialErrs, "; ")))
+}
+
+// dial connects to a single LDAP server and issues a StartTLS command when
+// the auth method requires it.
+func dial(ctx context.Context, am *AuthMethod, serverUrl string) (*goldap.Conn, error) {
+	const op = "ldap.dial"
+	u, err := url.Parse(serverUrl)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse url", errors.WithWrap(err))
+	}
+	tlsConfig, err := tlsConfig(ctx, am, u.Hostname())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	conn, err := goldap.DialURL(serverUrl, goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unavailable, op, "unable to dial", errors.WithWrap(err))
+	}
+	if am.StartTls && strings.EqualFold(u.Scheme, "ldap") {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.New(ctx, errors.Unavailable, op, "unable to start tls", errors.WithWrap(err))
+		}
+	}
+	return conn, nil
+}
+
+// tlsConfig returns the tls configuration used to connect to serverName.
+// When the auth method has certificates, they are the only trusted roots.
+func tlsConfig(ctx context.Context, am *AuthMethod, serverName string) (*tls.Config, error) {
+	const op = "ldap.tlsConfig"
+	cfg := &tls.Config{
+		ServerName:         serverName,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: am.InsecureTls,
+	}
+	if len(am.Certificates) > 0 {
+		certs, err := ParseCertificates(ctx, am.Certificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		pool := x509.NewCertPool()
+		for _, c := range certs {
+			pool.AddCert(c)
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// close the client's connection.
+func (c *client) close() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
+
+// authenticate binds as the user identified by loginName and returns the
+// user's entry. It returns nil, nil when the directory rejects the
+// credentials or the user cannot be found.
+func (c *client) authenticate(ctx context.Context, loginName, password string) (*entry, error) {
+	const op = "ldap.(client).authenticate"
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	if password == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+
+	userDn, found, err := c.userBindDn(ctx, loginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if userDn == "" {
+		return nil, nil
+	}
+	if err := c.conn.Bind(userDn, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, nil
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to bind as user", errors.WithWrap(err))
+	}
+
+	if found == nil {
+		if found, err = c.userEntry(ctx, userDn); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	e := &entry{dn: userDn}
+	if found != nil {
+		e.dn = found.DN
+		e.fullName = found.GetAttributeValue("displayName")
+		if e.fullName == "" {
+			e.fullName = found.GetAttributeValue("cn")
+		}
+		e.email = found.GetAttributeValue("mail")
+	}
+
+	if c.am.EnableGroups {
+		if e.groups, err = c.groups(ctx, loginName, e.dn); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return e, nil
+}
+
+// userBindDn returns the DN to bind with for loginName. When the DN is
+// discovered via a search, the user's entry is returned as well. An empty
+// DN is returned when the search doesn't find exactly one user.
+func (c *client) userBindDn(ctx context.Context, loginName string) (string, *goldap.Entry, error) {
+	const op = "ldap.(client).userBindDn"
+	userAttr := c.am.UserAttr
+	if userAttr == "" {
+		userAttr = DefaultUserAttr
+	}
+	switch {
+	case c.am.DiscoverDn || c.am.BindDn != "":
+		if err := c.searchBind(ctx); err != nil {
+			return "", nil, errors.Wrap(ctx, err, op)
+		}
+		filter, err := renderFilter(ctx, c.am.UserFilter, DefaultUserFilter, filterData{
+			UserAttr: userAttr,
+			Username: goldap.EscapeFilter(loginName),
+		})
+		if err != nil {
+			return "", nil, errors.Wrap(ctx, err, op)
+		}
+		res, err := c.conn.Search(goldap.NewSearchRequest(
+			c.am.UserDn,
+			goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, 0, false,
+			filter,
+			[]string{"cn", "displayName", "mail"},
+			nil,
+		))
+		if err != nil {
+			return "", nil, errors.New(ctx, errors.Unknown, op, "unable to search for user", errors.WithWrap(err))
+		}
+		if len(res.Entries) != 1 {
+			return "", nil, nil
+		}
+		return res.Entries[0].DN, res.Entries[0], nil
+	case c.am.UpnDomain != "":
+		return fmt.Sprintf("%s@%s", loginName, c.am.UpnDomain), nil, nil
+	default:
+		return fmt.Sprintf("%s=%s,%s", userAttr, escapeDnValue(loginName), c.am.UserDn), nil, nil
+	}
+}
+
+// searchBind binds with the auth method's bind credential or anonymously
+// when it doesn't have one.
+func (c *client) searchBind(ctx context.Context) error {
+	const op = "ldap.(client).searchBind"
+	var err error
+	switch {
+	case c.am.BindDn != "" && c.am.BindPassword != "":
+		err = c.conn.Bind(c.am.BindDn, c.am.BindPassword)
+	default:
+		err = c.conn.UnauthenticatedBind(c.am.BindDn)
+	}
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to bind for search", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// userEntry reads the entry of a user after binding with its DN. For
+// userPrincipalName binds the entry is found with a search of the user dn.
+func (c *client) userEntry(ctx context.Context, userDn string) (*goldap.Entry, error) {
+	const op = "ldap.(client).userEntry"
+	req := goldap.NewSearchRequest(
+		userDn,
+		goldap.ScopeBaseObject, goldap.NeverDerefAliases, 0, 0, false,
+		"(objectClass=*)",
+		[]string{"cn", "displayName", "mail"},
+		nil,
+	)
+	if c.am.UpnDomain != "" {
+		if c.am.UserDn == "" {
+			return nil, nil
+		}
+		req.BaseDN = c.am.UserDn
+		req.Scope = goldap.ScopeWholeSubtree
+		req.Filter = fmt.Sprintf("(userPrincipalName=%s)", goldap.EscapeFilter(userDn))
+	}
+	res, err := c.conn.Search(req)
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+			return nil, nil
+		}
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to read user entry", errors.WithWrap(err))
+	}
+	if len(res.Entries) != 1 {
+		return nil, nil
+	}
+	return res.Entries[0], nil
+}
+
+// groups returns the names of the groups the user is a member of.
+func (c *client) groups(ctx context.Context, loginName, userDn string) ([]string, error) {
+	const op = "ldap.(client).groups"
+	switch {
+	case c.am.AnonGroupSearch:
+		if err := c.conn.UnauthenticatedBind(""); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind anonymously for group search", errors.WithWrap(err))
+		}
+	case c.am.BindDn != "" && c.am.BindPassword != "":
+		if err := c.searchBind(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	groupAttr := c.am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = DefaultGroupAttr
+	}
+	filter, err := renderFilter(ctx, c.am.GroupFilter, DefaultGroupFilter, filterData{
+		Username: goldap.EscapeFilter(loginName),
+		UserDN:   goldap.EscapeFilter(userDn),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	res, err := c.conn.Search(goldap.NewSearchRequest(
+		c.am.GroupDn,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, 0, false,
+		filter,
+		[]string{groupAttr},
+		nil,
+	))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to search for groups", errors.WithWrap(err))
+	}
+	groups := make([]string, 0, len(res.Entries))
+	for _, e := range res.Entries {
+		if name := e.GetEqualFoldAttributeValue(groupAttr); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// filterData is the data available to user and group filter templates.
+type filterData struct {
+	UserAttr string
+	Username string
+	UserDN   string
+}
+
+// renderFilter executes the filter template, or defaultFilter when filter is
+// empty, with data.
+func renderFilter(ctx context.Context, filter, defaultFilter string, data filterData) (string, error) {
+	const op = "ldap.renderFilter"
+	if filter == "" {
+		filter = defaultFilter
+	}
+	t, err := template.New("filter").Parse(filter)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unable to parse filter template", errors.WithWrap(err))
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "unable to execute filter template", errors.WithWrap(err))
+	}
+	return buf.String(), nil
+}
+
+// escapeDnValue escapes the special characters of an attribute value which
+// is used in a DN. See RFC 4514 section 2.4.
+func escapeDnValue(v string) string {
+	var b strings.Builder
+	for i, r := range v {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, r):
+			b.WriteRune('\\')
+		case i == 0 && (r == ' ' || r == '#'):
+			b.WriteRune('\\')
+		case i == len(v)-1 && r == ' ':
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,226 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/ldap/testdirectory"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_clientAuthenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	users := testdirectory.NewUsers(t, "alice", "bob")
+	admins := testdirectory.NewGroup(t, "admins", users[0])
+	devs := testdirectory.NewGroup(t, "devs", users...)
+
+	plain := testdirectory.Start(t, testdirectory.WithAllowAnonymousBind())
+	plain.SetUsers(users...)
+	plain.SetGroups(admins, devs)
+
+	ldaps := testdirectory.Start(t, testdirectory.WithLDAPS())
+	ldaps.SetUsers(users...)
+	ldaps.SetGroups(admins, devs)
+
+	certs, err := EncodeCertificates(ctx, plain.Cert())
+	require.NoError(t, err)
+	ldapsCerts, err := EncodeCertificates(ctx, ldaps.Cert())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		am         *store.AuthMethod
+		loginName  string
+		password   string
+		want       *entry
+		wantErr    bool
+		wantErrIs  errors.Code
+		wantNewErr bool
+	}{
+		{
+			name: "valid-user-dn",
+			am: &store.AuthMethod{
+				Urls:   []string{plain.Url()},
+				UserDn: testdirectory.DefaultUserDn,
+			},
+			loginName: "alice",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=alice," + testdirectory.DefaultUserDn,
+				fullName: "alice",
+				email:    "alice@example.org",
+			},
+		},
+		{
+			name: "valid-with-groups",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				UserDn:       testdirectory.DefaultUserDn,
+				EnableGroups: true,
+				GroupDn:      testdirectory.DefaultGroupDn,
+			},
+			loginName: "alice",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=alice," + testdirectory.DefaultUserDn,
+				fullName: "alice",
+				email:    "alice@example.org",
+				groups:   []string{"admins", "devs"},
+			},
+		},
+		{
+			name: "valid-discover-dn",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				DiscoverDn:   true,
+				UserDn:       testdirectory.DefaultUserDn,
+				UserAttr:     "uid",
+				EnableGroups: true,
+				GroupDn:      testdirectory.DefaultGroupDn,
+			},
+			loginName: "bob",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=bob," + testdirectory.DefaultUserDn,
+				fullName: "bob",
+				email:    "bob@example.org",
+				groups:   []string{"devs"},
+			},
+		},
+		{
+			name: "valid-bind-credential",
+			am: &store.AuthMethod{
+				Urls:         []string{ldaps.Url()},
+				Certificates: ldapsCerts,
+				UserDn:       testdirectory.DefaultUserDn,
+				BindDn:       "cn=bob," + testdirectory.DefaultUserDn,
+				BindPassword: testdirectory.DefaultUserPassword,
+				EnableGroups: true,
+				GroupDn:      testdirectory.DefaultGroupDn,
+			},
+			loginName: "alice",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=alice," + testdirectory.DefaultUserDn,
+				fullName: "alice",
+				email:    "alice@example.org",
+				groups:   []string{"admins", "devs"},
+			},
+		},
+		{
+			name: "valid-start-tls",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				StartTls:     true,
+				Certificates: certs,
+				UserDn:       testdirectory.DefaultUserDn,
+			},
+			loginName: "bob",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=bob," + testdirectory.DefaultUserDn,
+				fullName: "bob",
+				email:    "bob@example.org",
+			},
+		},
+		{
+			name: "valid-second-url",
+			am: &store.AuthMethod{
+				Urls:   []string{"ldap://127.0.0.1:1", plain.Url()},
+				UserDn: testdirectory.DefaultUserDn,
+			},
+			loginName: "alice",
+			password:  testdirectory.DefaultUserPassword,
+			want: &entry{
+				dn:       "cn=alice," + testdirectory.DefaultUserDn,
+				fullName: "alice",
+				email:    "alice@example.org",
+			},
+		},
+		{
+			name: "invalid-password",
+			am: &store.AuthMethod{
+				Urls:   []string{plain.Url()},
+				UserDn: testdirectory.DefaultUserDn,
+			},
+			loginName: "alice",
+			password:  "wrong",
+		},
+		{
+			name: "unknown-user",
+			am: &store.AuthMethod{
+				Urls:       []string{plain.Url()},
+				DiscoverDn: true,
+				UserDn:     testdirectory.DefaultUserDn,
+			},
+			loginName: "eve",
+			password:  testdirectory.DefaultUserPassword,
+		},
+		{
+			name: "untrusted-cert",
+			am: &store.AuthMethod{
+				Urls:   []string{ldaps.Url()},
+				UserDn: testdirectory.DefaultUserDn,
+			},
+			loginName:  "alice",
+			password:   testdirectory.DefaultUserPassword,
+			wantNewErr: true,
+			wantErrIs:  errors.Unavailable,
+		},
+		{
+			name: "missing-password",
+			am: &store.AuthMethod{
+				Urls:   []string{plain.Url()},
+				UserDn: testdirectory.DefaultUserDn,
+			},
+			loginName: "alice",
+			wantErr:   true,
+			wantErrIs: errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			c, err := newClient(ctx, &AuthMethod{AuthMethod: tc.am})
+			if tc.wantNewErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantErrIs), err), "want err code: %q got: %q", tc.wantErrIs, err)
+				return
+			}
+			require.NoError(err)
+			defer c.close()
+
+			got, err := c.authenticate(ctx, tc.loginName, tc.password)
+			if tc.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantErrIs), err), "want err code: %q got: %q", tc.wantErrIs, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.want, got)
+		})
+	}
+}
+
+func Test_escapeDnValue(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "alice", want: "alice"},
+		{in: "doe, john", want: `doe\, john`},
+		{in: "#admin ", want: `\#admin\ `},
+		{in: `a+b="c"`, want: `a\+b\=\"c\"`},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, escapeDnValue(tc.in))
+	}
+}
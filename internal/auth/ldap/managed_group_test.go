@@ -0,0 +1,63 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewManagedGroup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		authMethodId string
+		groupNames   []string
+		wantErr      bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amldap_1234567890",
+			groupNames:   []string{"admins", "devs"},
+		},
+		{
+			name:       "missing-auth-method-id",
+			groupNames: []string{"admins"},
+			wantErr:    true,
+			wantIsErr:  errors.InvalidParameter,
+		},
+		{
+			name:         "missing-group-names",
+			authMethodId: "amldap_1234567890",
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "empty-group-name",
+			authMethodId: "amldap_1234567890",
+			groupNames:   []string{"admins", " "},
+			wantErr:      true,
+			wantIsErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewManagedGroup(ctx, tc.authMethodId, tc.groupNames, WithName(tc.name))
+			if tc.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.name, got.Name)
+			names, err := got.GetGroupNamesSlice(ctx)
+			require.NoError(err)
+			assert.Equal(tc.groupNames, names)
+		})
+	}
+}
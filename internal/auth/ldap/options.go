@@ -0,0 +1,239 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"net/url"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName              string
+	withDescription       string
+	withLimit             int
+	withPublicId          string
+	withOrderByCreateTime bool
+	ascending             bool
+	withStartTls          bool
+	withInsecureTls       bool
+	withDiscoverDn        bool
+	withAnonGroupSearch   bool
+	withUpnDomain         string
+	withUrls              []*url.URL
+	withCertificates      []*x509.Certificate
+	withUserDn            string
+	withUserAttr          string
+	withUserFilter        string
+	withEnableGroups      bool
+	withGroupDn           string
+	withGroupAttr         string
+	withGroupFilter       string
+	withBindDn            string
+	withBindPassword      string
+	withFullName          string
+	withEmail             string
+	withDn                string
+	withMemberOfGroups    []string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(ascending bool) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = true
+		o.ascending = ascending
+	}
+}
+
+// WithStartTLS provides an option to issue a StartTLS command after
+// establishing an unencrypted connection to an ldap:// URL.
+func WithStartTLS() Option {
+	return func(o *options) {
+		o.withStartTls = true
+	}
+}
+
+// WithInsecureTLS provides an option to skip the verification of the LDAP
+// server's certificate chain and host name.
+func WithInsecureTLS() Option {
+	return func(o *options) {
+		o.withInsecureTls = true
+	}
+}
+
+// WithDiscoverDn provides an option to use an anonymous bind to discover the
+// bind DN of a user.
+func WithDiscoverDn() Option {
+	return func(o *options) {
+		o.withDiscoverDn = true
+	}
+}
+
+// WithAnonGroupSearch provides an option to use an anonymous bind when
+// performing LDAP group searches.
+func WithAnonGroupSearch() Option {
+	return func(o *options) {
+		o.withAnonGroupSearch = true
+	}
+}
+
+// WithUpnDomain provides an optional userPrincipalDomain used to construct
+// the UPN string of an authenticating user.
+func WithUpnDomain(domain string) Option {
+	return func(o *options) {
+		o.withUpnDomain = domain
+	}
+}
+
+// WithUrls provides optional LDAP URLs, which are tried in the order they are
+// provided.
+func WithUrls(urls ...*url.URL) Option {
+	return func(o *options) {
+		o.withUrls = urls
+	}
+}
+
+// WithCertificates provides optional certificates.
+func WithCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withCertificates = certs
+	}
+}
+
+// WithUserDn provides an optional base DN under which to perform user
+// searches.
+func WithUserDn(dn string) Option {
+	return func(o *options) {
+		o.withUserDn = dn
+	}
+}
+
+// WithUserAttr provides an optional attribute of user entries which matches
+// the login name of an authenticating user.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithUserFilter provides an optional go template used to construct the LDAP
+// user search filter.
+func WithUserFilter(filter string) Option {
+	return func(o *options) {
+		o.withUserFilter = filter
+	}
+}
+
+// WithEnableGroups provides an option to find the LDAP groups of an
+// authenticating user.
+func WithEnableGroups() Option {
+	return func(o *options) {
+		o.withEnableGroups = true
+	}
+}
+
+// WithGroupDn provides an optional base DN under which to perform group
+// searches.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional attribute of group entries which
+// contains the name of the group.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithGroupFilter provides an optional go template used to construct the
+// LDAP group search filter.
+func WithGroupFilter(filter string) Option {
+	return func(o *options) {
+		o.withGroupFilter = filter
+	}
+}
+
+// WithBindCredential provides an optional DN and password to bind with when
+// performing user and group searches.
+func WithBindCredential(dn, password string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+		o.withBindPassword = password
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithDn provides an optional distinguished name for the account.
+func WithDn(dn string) Option {
+	return func(o *options) {
+		o.withDn = dn
+	}
+}
+
+// WithMemberOfGroups provides optional LDAP group names for the account.
+func WithMemberOfGroups(groups ...string) Option {
+	return func(o *options) {
+		o.withMemberOfGroups = groups
+	}
+}
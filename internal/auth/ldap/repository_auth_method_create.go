@@ -0,0 +1,126 @@
+package ldap
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAuthMethod creates am (*AuthMethod) in the repo along with its
+// associated embedded value objects of Urls and Certificates and returns the
+// newly created AuthMethod (with its PublicId set)
+//
+// The AuthMethod's public id and version must be empty (zero values).
+//
+// The WithPublicId option is supported and all other options are ignored.
+func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).CreateAuthMethod"
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if am.Version != 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version must be empty")
+	}
+	if err := am.validate(ctx, op); err != nil {
+		return nil, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	opts := getOpts(opt...)
+	am = am.Clone()
+	am.PublicId = opts.withPublicId
+	if am.PublicId == "" {
+		id, err := newAuthMethodId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		am.PublicId = id
+	} else {
+		if !strings.HasPrefix(am.PublicId, AuthMethodPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "wrong auth method id prefix")
+		}
+	}
+
+	vo, err := am.convertValueObjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := am.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 3)
+			ticket, err := w.GetTicket(ctx, am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			var amOplogMsg oplog.Message
+			if err := w.Create(ctx, am.Clone(), db.NewOplogMsg(&amOplogMsg)); err != nil {
+				return err
+			}
+			msgs = append(msgs, &amOplogMsg)
+
+			if len(vo.Urls) > 0 {
+				urlOplogMsgs := make([]*oplog.Message, 0, len(vo.Urls))
+				if err := w.CreateItems(ctx, vo.Urls, db.NewOplogMsgs(&urlOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, urlOplogMsgs...)
+			}
+			if len(vo.Certs) > 0 {
+				certOplogMsgs := make([]*oplog.Message, 0, len(vo.Certs))
+				if err := w.CreateItems(ctx, vo.Certs, db.NewOplogMsgs(&certOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, certOplogMsgs...)
+			}
+			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+				// intentionally not setting the defaultLimit, so we'll get all
+				// the value objects
+			}
+			returnedAuthMethod, err = txRepo.lookupAuthMethod(ctx, am.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup created auth method"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAuthMethod, nil
+}
@@ -0,0 +1,228 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/testdirectory"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	d := testdirectory.Start(t)
+
+	tests := []struct {
+		name         string
+		am           func() *AuthMethod
+		opt          []Option
+		wantErrMatch *errors.Template
+	}{
+		{
+			name: "valid",
+			am: func() *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId,
+					WithName("valid"),
+					WithUrls(TestConvertToUrls(t, "ldaps://ad1.example.org", "ldaps://ad2.example.org")...),
+					WithCertificates(d.Cert()),
+					WithUserDn(testdirectory.DefaultUserDn),
+					WithEnableGroups(),
+					WithGroupDn(testdirectory.DefaultGroupDn),
+					WithBindCredential("cn=admin,dc=example,dc=org", "secret"),
+				)
+				require.NoError(t, err)
+				return am
+			},
+		},
+		{
+			name: "valid-with-public-id",
+			am: func() *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...))
+				require.NoError(t, err)
+				return am
+			},
+			opt: []Option{WithPublicId(AuthMethodPrefix + "_1234567890")},
+		},
+		{
+			name: "invalid-public-id",
+			am: func() *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...))
+				require.NoError(t, err)
+				return am
+			},
+			opt:          []Option{WithPublicId("bad_1234567890")},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name: "dup-name",
+			am: func() *AuthMethod {
+				am, err := NewAuthMethod(ctx, org.PublicId, WithName("valid"), WithUrls(TestConvertToUrls(t, "ldap://ad1.example.org")...))
+				require.NoError(t, err)
+				return am
+			},
+			wantErrMatch: errors.T(errors.NotUnique),
+		},
+		{
+			name:         "nil-auth-method",
+			am:           func() *AuthMethod { return nil },
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			am := tc.am()
+			got, err := repo.CreateAuthMethod(ctx, am, tc.opt...)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(uint32(1), got.Version)
+			assert.Equal(am.Urls, got.Urls)
+			assert.Equal(am.Certificates, got.Certificates)
+			assert.Equal(am.BindPassword, got.BindPassword)
+			if am.BindPassword != "" {
+				assert.NotEmpty(got.KeyId)
+				assert.NotEmpty(got.BindPasswordHmac)
+			}
+
+			found, err := repo.LookupAuthMethod(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(got.Urls, found.Urls)
+			assert.Equal(got.BindPassword, found.BindPassword)
+		})
+	}
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		update       func(*AuthMethod) *AuthMethod
+		mask         []string
+		wantCnt      int
+		wantErrMatch *errors.Template
+		check        func(*testing.T, *AuthMethod)
+	}{
+		{
+			name: "urls-and-name",
+			update: func(am *AuthMethod) *AuthMethod {
+				am.Name = "updated"
+				am.Urls = []string{"ldaps://ad3.example.org", "ldaps://ad1.example.org"}
+				return am
+			},
+			mask:    []string{NameField, UrlsField},
+			wantCnt: 1,
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.Equal(t, "updated", got.Name)
+				assert.Equal(t, []string{"ldaps://ad3.example.org", "ldaps://ad1.example.org"}, got.Urls)
+			},
+		},
+		{
+			name: "bind-credential",
+			update: func(am *AuthMethod) *AuthMethod {
+				am.BindDn = "cn=admin,dc=example,dc=org"
+				am.BindPassword = "secret"
+				return am
+			},
+			mask:    []string{BindDnField, BindPasswordField},
+			wantCnt: 1,
+			check: func(t *testing.T, got *AuthMethod) {
+				assert.Equal(t, "secret", got.BindPassword)
+				assert.NotEmpty(t, got.KeyId)
+			},
+		},
+		{
+			name: "enable-groups-without-group-dn",
+			update: func(am *AuthMethod) *AuthMethod {
+				am.EnableGroups = true
+				return am
+			},
+			mask:         []string{EnableGroupsField},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "invalid-field",
+			update:       func(am *AuthMethod) *AuthMethod { return am },
+			mask:         []string{"CreateTime"},
+			wantErrMatch: errors.T(errors.InvalidFieldMask),
+		},
+		{
+			name:         "empty-mask",
+			update:       func(am *AuthMethod) *AuthMethod { return am },
+			wantErrMatch: errors.T(errors.EmptyFieldMask),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			orig := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ad1.example.org"})
+			got, cnt, err := repo.UpdateAuthMethod(ctx, tc.update(orig.Clone()), orig.Version, tc.mask)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "want err code: %q got: %q", tc.wantErrMatch.Code, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantCnt, cnt)
+			assert.Equal(orig.Version+1, got.Version)
+			tc.check(t, got)
+		})
+	}
+}
+
+func TestRepository_DeleteAuthMethod(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{"ldaps://ad1.example.org"})
+	TestAccount(t, conn, am, "alice")
+
+	cnt, err := repo.DeleteAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+
+	found, err := repo.LookupAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	cnt, err = repo.DeleteAuthMethod(ctx, am.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cnt)
+}
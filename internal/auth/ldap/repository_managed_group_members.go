@@ -0,0 +1,53 @@
+package ldap
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// ListManagedGroupMembershipsByMember lists managed group memberships via the
+// member (account) ID and supports WithLimit option.
+//
+// LDAP managed group memberships are derived from the LDAP groups an account
+// was a member of when it last authenticated, so they can only be listed.
+func (r *Repository) ListManagedGroupMembershipsByMember(ctx context.Context, withAcctId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
+	const op = "ldap.(Repository).ListManagedGroupMembershipsByMember"
+	if withAcctId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var mgs []*ManagedGroupMemberAccount
+	err := r.reader.SearchWhere(ctx, &mgs, "member_id = ?", []interface{}{withAcctId}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
+
+// ListManagedGroupMembershipsByGroup lists managed group memberships via the
+// group ID and supports WithLimit option.
+func (r *Repository) ListManagedGroupMembershipsByGroup(ctx context.Context, withGroupId string, opt ...Option) ([]*ManagedGroupMemberAccount, error) {
+	const op = "ldap.(Repository).ListManagedGroupMembershipsByGroup"
+	if withGroupId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing managed group id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var mgs []*ManagedGroupMemberAccount
+	err := r.reader.SearchWhere(ctx, &mgs, "managed_group_id = ?", []interface{}{withGroupId}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return mgs, nil
+}
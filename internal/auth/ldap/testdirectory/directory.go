@@ -0,0 +1,549 @@
+// Package testdirectory provides an in-process LDAP server for testing the
+// ldap auth method. It supports simple binds, searches, StartTLS and LDAPS
+// and keeps its user and group entries in memory.
+package testdirectory
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// DefaultUserDn is the base DN of the entries returned by NewUsers.
+	DefaultUserDn = "ou=people,dc=example,dc=org"
+
+	// DefaultGroupDn is the base DN of the entries returned by NewGroup.
+	DefaultGroupDn = "ou=groups,dc=example,dc=org"
+
+	// DefaultUserPassword is the password of the entries returned by
+	// NewUsers.
+	DefaultUserPassword = "password"
+
+	// startTlsOid is the OID of the StartTLS extended operation. See RFC 4511
+	// section 4.14.
+	startTlsOid = "1.3.6.1.4.1.1466.20037"
+)
+
+// LDAP application tags of the protocol operations supported by the
+// Directory. See RFC 4511 section 4.2.
+const (
+	appBindRequest        ber.Tag = 0
+	appBindResponse       ber.Tag = 1
+	appUnbindRequest      ber.Tag = 2
+	appSearchRequest      ber.Tag = 3
+	appSearchResultEntry  ber.Tag = 4
+	appSearchResultDone   ber.Tag = 5
+	appExtendedRequest    ber.Tag = 23
+	appExtendedResponse   ber.Tag = 24
+	filterAnd             ber.Tag = 0
+	filterOr              ber.Tag = 1
+	filterNot             ber.Tag = 2
+	filterEqualityMatch   ber.Tag = 3
+	filterSubstrings      ber.Tag = 4
+	filterPresent         ber.Tag = 7
+	substringInitial      ber.Tag = 0
+	substringAny          ber.Tag = 1
+	substringFinal        ber.Tag = 2
+	extendedRequestName   ber.Tag = 0
+	extendedResponseName  ber.Tag = 10
+	resultSuccess         int64   = 0
+	resultProtocolError   int64   = 2
+	resultNoSuchObject    int64   = 32
+	resultInvalidCreds    int64   = 49
+	resultInsufficientAcc int64   = 50
+	resultUnwillingToDo   int64   = 53
+)
+
+// Directory is an in-process LDAP server.
+type Directory struct {
+	t         testing.TB
+	l         net.Listener
+	tlsConfig *tls.Config
+	cert      *x509.Certificate
+	useLdaps  bool
+	allowAnon bool
+
+	mu     sync.Mutex
+	users  []*goldap.Entry
+	groups []*goldap.Entry
+	conns  map[net.Conn]struct{}
+	wg     sync.WaitGroup
+	closed bool
+}
+
+// Start an in-process LDAP server listening on 127.0.0.1. The server is
+// stopped when the test completes. Supported options: WithLDAPS and
+// WithAllowAnonymousBind.
+func Start(t testing.TB, opt ...Option) *Directory {
+	t.Helper()
+	require := require.New(t)
+	opts := getOpts(opt...)
+
+	cert, tlsCert := testCert(t)
+	d := &Directory{
+		t:         t,
+		cert:      cert,
+		useLdaps:  opts.withLdaps,
+		allowAnon: opts.withAllowAnonymousBind,
+		conns:     map[net.Conn]struct{}{},
+		tlsConfig: &tls.Config{
+			Certificates: []tls.Certificate{tlsCert},
+			MinVersion:   tls.VersionTLS12,
+		},
+	}
+	var err error
+	if d.useLdaps {
+		d.l, err = tls.Listen("tcp", "127.0.0.1:0", d.tlsConfig)
+	} else {
+		d.l, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(err)
+
+	d.wg.Add(1)
+	go d.serve()
+	t.Cleanup(d.Stop)
+	return d
+}
+
+// Stop the Directory. It is safe to call Stop more than once.
+func (d *Directory) Stop() {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return
+	}
+	d.closed = true
+	_ = d.l.Close()
+	for c := range d.conns {
+		_ = c.Close()
+	}
+	d.mu.Unlock()
+	d.wg.Wait()
+}
+
+// Host returns the host the Directory is listening on.
+func (d *Directory) Host() string {
+	return d.l.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the port the Directory is listening on.
+func (d *Directory) Port() int {
+	return d.l.Addr().(*net.TCPAddr).Port
+}
+
+// Url returns the URL of the Directory with a scheme of ldaps when it was
+// started WithLDAPS and ldap otherwise.
+func (d *Directory) Url() string {
+	scheme := "ldap"
+	if d.useLdaps {
+		scheme = "ldaps"
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(d.Host(), fmt.Sprintf("%d", d.Port())))
+}
+
+// Cert returns the self signed certificate the Directory uses for LDAPS and
+// StartTLS.
+func (d *Directory) Cert() *x509.Certificate {
+	return d.cert
+}
+
+// SetUsers replaces the user entries of the Directory.
+func (d *Directory) SetUsers(users ...*goldap.Entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.users = users
+}
+
+// SetGroups replaces the group entries of the Directory.
+func (d *Directory) SetGroups(groups ...*goldap.Entry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.groups = groups
+}
+
+// NewUsers returns a user entry under DefaultUserDn for each name with a cn
+// and uid of the name, a mail of name@example.org and a userPassword of
+// DefaultUserPassword.
+func NewUsers(t testing.TB, names ...string) []*goldap.Entry {
+	t.Helper()
+	entries := make([]*goldap.Entry, 0, len(names))
+	for _, n := range names {
+		entries = append(entries, goldap.NewEntry(
+			fmt.Sprintf("cn=%s,%s", n, DefaultUserDn),
+			map[string][]string{
+				"objectClass":  {"inetOrgPerson"},
+				"cn":           {n},
+				"uid":          {n},
+				"mail":         {fmt.Sprintf("%s@example.org", n)},
+				"userPassword": {DefaultUserPassword},
+			},
+		))
+	}
+	return entries
+}
+
+// NewGroup returns a groupOfNames entry under DefaultGroupDn with the users
+// as members.
+func NewGroup(t testing.TB, name string, members ...*goldap.Entry) *goldap.Entry {
+	t.Helper()
+	memberDns := make([]string, 0, len(members))
+	for _, m := range members {
+		memberDns = append(memberDns, m.DN)
+	}
+	return goldap.NewEntry(
+		fmt.Sprintf("cn=%s,%s", name, DefaultGroupDn),
+		map[string][]string{
+			"objectClass": {"groupOfNames"},
+			"cn":          {name},
+			"member":      memberDns,
+		},
+	)
+}
+
+func (d *Directory) serve() {
+	defer d.wg.Done()
+	for {
+		c, err := d.l.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		if d.closed {
+			d.mu.Unlock()
+			_ = c.Close()
+			return
+		}
+		d.conns[c] = struct{}{}
+		d.wg.Add(1)
+		d.mu.Unlock()
+		go d.handle(c)
+	}
+}
+
+// session is the state of a single client connection.
+type session struct {
+	conn  net.Conn
+	bound bool
+}
+
+func (d *Directory) handle(c net.Conn) {
+	defer d.wg.Done()
+	s := &session{conn: c}
+	defer func() {
+		d.mu.Lock()
+		delete(d.conns, s.conn)
+		d.mu.Unlock()
+		_ = s.conn.Close()
+	}()
+	for {
+		p, err := ber.ReadPacket(s.conn)
+		if err != nil {
+			return
+		}
+		if len(p.Children) < 2 {
+			return
+		}
+		msgId, ok := p.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		req := p.Children[1]
+		switch req.Tag {
+		case appBindRequest:
+			d.bind(s, msgId, req)
+		case appSearchRequest:
+			d.search(s, msgId, req)
+		case appExtendedRequest:
+			if !d.extended(s, msgId, req) {
+				return
+			}
+		case appUnbindRequest:
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (d *Directory) bind(s *session, msgId int64, req *ber.Packet) {
+	if len(req.Children) < 3 {
+		d.write(s, msgId, result(appBindResponse, resultProtocolError, "invalid bind request"))
+		return
+	}
+	name := req.Children[1].Data.String()
+	password := req.Children[2].Data.String()
+	s.bound = false
+	if password == "" {
+		if !d.allowAnon {
+			d.write(s, msgId, result(appBindResponse, resultUnwillingToDo, "anonymous binds are not allowed"))
+			return
+		}
+		d.write(s, msgId, result(appBindResponse, resultSuccess, ""))
+		return
+	}
+	d.mu.Lock()
+	entries := append(append([]*goldap.Entry{}, d.users...), d.groups...)
+	d.mu.Unlock()
+	for _, e := range entries {
+		if !strings.EqualFold(e.DN, name) && !strings.EqualFold(e.GetAttributeValue("userPrincipalName"), name) {
+			continue
+		}
+		for _, pw := range e.GetAttributeValues("userPassword") {
+			if pw == password {
+				s.bound = true
+				d.write(s, msgId, result(appBindResponse, resultSuccess, ""))
+				return
+			}
+		}
+	}
+	d.write(s, msgId, result(appBindResponse, resultInvalidCreds, "invalid credentials"))
+}
+
+func (d *Directory) search(s *session, msgId int64, req *ber.Packet) {
+	if len(req.Children) < 8 {
+		d.write(s, msgId, result(appSearchResultDone, resultProtocolError, "invalid search request"))
+		return
+	}
+	if !s.bound && !d.allowAnon {
+		d.write(s, msgId, result(appSearchResultDone, resultInsufficientAcc, "bind required"))
+		return
+	}
+	base := req.Children[0].Data.String()
+	scope, _ := req.Children[1].Value.(int64)
+	filter := req.Children[6]
+	var attrs []string
+	for _, a := range req.Children[7].Children {
+		attrs = append(attrs, a.Data.String())
+	}
+
+	d.mu.Lock()
+	entries := append(append([]*goldap.Entry{}, d.users...), d.groups...)
+	d.mu.Unlock()
+
+	var found bool
+	for _, e := range entries {
+		if !inScope(e.DN, base, scope) {
+			continue
+		}
+		found = true
+		if !matches(e, filter) {
+			continue
+		}
+		d.write(s, msgId, entryPacket(e, attrs))
+	}
+	if !found && int(scope) == goldap.ScopeBaseObject {
+		d.write(s, msgId, result(appSearchResultDone, resultNoSuchObject, "no such object"))
+		return
+	}
+	d.write(s, msgId, result(appSearchResultDone, resultSuccess, ""))
+}
+
+// extended handles extended requests and returns false when the connection
+// must be closed.
+func (d *Directory) extended(s *session, msgId int64, req *ber.Packet) bool {
+	var name string
+	for _, c := range req.Children {
+		if c.ClassType == ber.ClassContext && c.Tag == extendedRequestName {
+			name = c.Data.String()
+		}
+	}
+	if name != startTlsOid || d.useLdaps {
+		d.write(s, msgId, result(appExtendedResponse, resultProtocolError, "unsupported extended operation"))
+		return true
+	}
+	resp := result(appExtendedResponse, resultSuccess, "")
+	resp.AppendChild(ber.NewString(ber.ClassContext, ber.TypePrimitive, extendedResponseName, startTlsOid, "responseName"))
+	d.write(s, msgId, resp)
+
+	tlsConn := tls.Server(s.conn, d.tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return false
+	}
+	d.mu.Lock()
+	delete(d.conns, s.conn)
+	d.conns[tlsConn] = struct{}{}
+	d.mu.Unlock()
+	s.conn = tlsConn
+	return true
+}
+
+func (d *Directory) write(s *session, msgId int64, op *ber.Packet) {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "MessageID"))
+	p.AppendChild(op)
+	_, _ = s.conn.Write(p.Bytes())
+}
+
+// result returns an LDAPResult for the application tag.
+func result(tag ber.Tag, code int64, msg string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, msg, "diagnosticMessage"))
+	return p
+}
+
+// entryPacket returns a SearchResultEntry with the requested attributes of
+// e. All attributes except userPassword are returned when no attributes are
+// requested.
+func entryPacket(e *goldap.Entry, attrs []string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultEntry, nil, "SearchResultEntry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "objectName"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for _, a := range e.Attributes {
+		if strings.EqualFold(a.Name, "userPassword") || !requested(a.Name, attrs) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, a.Name, "type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, v := range a.Values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+		}
+		attr.AppendChild(vals)
+		attributes.AppendChild(attr)
+	}
+	p.AppendChild(attributes)
+	return p
+}
+
+func requested(name string, attrs []string) bool {
+	if len(attrs) == 0 {
+		return true
+	}
+	for _, a := range attrs {
+		if a == "*" || strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// inScope reports whether dn is within the search scope of base.
+func inScope(dn, base string, scope int64) bool {
+	dn, base = strings.ToLower(dn), strings.ToLower(base)
+	switch int(scope) {
+	case goldap.ScopeBaseObject:
+		return dn == base
+	case goldap.ScopeSingleLevel:
+		i := strings.Index(dn, ",")
+		return i >= 0 && dn[i+1:] == base
+	default:
+		return base == "" || dn == base || strings.HasSuffix(dn, ","+base)
+	}
+}
+
+// matches reports whether e matches the filter. Attribute names and values
+// are compared case insensitively.
+func matches(e *goldap.Entry, f *ber.Packet) bool {
+	switch f.Tag {
+	case filterAnd:
+		for _, c := range f.Children {
+			if !matches(e, c) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, c := range f.Children {
+			if matches(e, c) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(f.Children) == 1 && !matches(e, f.Children[0])
+	case filterEqualityMatch:
+		if len(f.Children) != 2 {
+			return false
+		}
+		want := f.Children[1].Data.String()
+		for _, v := range e.GetEqualFoldAttributeValues(f.Children[0].Data.String()) {
+			if strings.EqualFold(v, want) {
+				return true
+			}
+		}
+		return false
+	case filterSubstrings:
+		if len(f.Children) != 2 {
+			return false
+		}
+		for _, v := range e.GetEqualFoldAttributeValues(f.Children[0].Data.String()) {
+			if substringMatch(strings.ToLower(v), f.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		if strings.EqualFold(f.Data.String(), "objectClass") {
+			return true
+		}
+		return len(e.GetEqualFoldAttributeValues(f.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func substringMatch(v string, parts []*ber.Packet) bool {
+	for _, p := range parts {
+		s := strings.ToLower(p.Data.String())
+		switch p.Tag {
+		case substringInitial:
+			if !strings.HasPrefix(v, s) {
+				return false
+			}
+			v = v[len(s):]
+		case substringAny:
+			i := strings.Index(v, s)
+			if i < 0 {
+				return false
+			}
+			v = v[i+len(s):]
+		case substringFinal:
+			if !strings.HasSuffix(v, s) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// testCert returns a self signed certificate for 127.0.0.1 and localhost.
+func testCert(t testing.TB) (*x509.Certificate, tls.Certificate) {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "testdirectory"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return cert, tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}
+}
@@ -0,0 +1,40 @@
+package testdirectory
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withLdaps              bool
+	withAllowAnonymousBind bool
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithLDAPS provides an option to only accept LDAPS connections. Without it
+// the Directory accepts plain LDAP connections which can be upgraded with
+// StartTLS.
+func WithLDAPS() Option {
+	return func(o *options) {
+		o.withLdaps = true
+	}
+}
+
+// WithAllowAnonymousBind provides an option to allow anonymous binds and
+// unauthenticated searches.
+func WithAllowAnonymousBind() Option {
+	return func(o *options) {
+		o.withAllowAnonymousBind = true
+	}
+}
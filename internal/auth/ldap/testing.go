@@ -0,0 +1,96 @@
+package ldap
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a test ldap auth method with its urls and
+// certificates. All the options supported by NewAuthMethod are supported.
+func TestAuthMethod(
+	t testing.TB,
+	conn *db.DB,
+	databaseWrapper wrapping.Wrapper,
+	scopeId string,
+	urls []string,
+	opt ...Option,
+) *AuthMethod {
+	t.Helper()
+	ctx := context.TODO()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	opt = append(opt, WithUrls(TestConvertToUrls(t, urls...)...))
+	authMethod, err := NewAuthMethod(ctx, scopeId, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	authMethod.PublicId = id
+	require.NoError(authMethod.encrypt(ctx, databaseWrapper))
+	require.NoError(rw.Create(ctx, authMethod))
+
+	vo, err := authMethod.convertValueObjects(ctx)
+	require.NoError(err)
+	require.NoError(rw.CreateItems(ctx, vo.Urls))
+	if len(vo.Certs) > 0 {
+		require.NoError(rw.CreateItems(ctx, vo.Certs))
+	}
+	return authMethod
+}
+
+// TestAccount creates a test ldap account.
+func TestAccount(t testing.TB, conn *db.DB, am *AuthMethod, loginName string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, loginName, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx, am.GetPublicId(), a.LoginName)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test ldap managed group.
+func TestManagedGroup(t testing.TB, conn *db.DB, am *AuthMethod, groupNames []string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, groupNames, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestConvertToUrls will convert URL string representations to a slice of
+// *url.URL
+func TestConvertToUrls(t testing.TB, urls ...string) []*url.URL {
+	t.Helper()
+	require := require.New(t)
+	require.NotEmpty(urls)
+	var convertedUrls []*url.URL
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		require.NoError(err)
+		require.Contains([]string{"ldap", "ldaps"}, parsed.Scheme)
+		convertedUrls = append(convertedUrls, parsed)
+	}
+	return convertedUrls
+}
@@ -0,0 +1,86 @@
+package ldap
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultUrlTableName defines the default table name for an Url
+const defaultUrlTableName = "auth_ldap_url"
+
+// Url defines an LDAP server URL of an LDAP auth method. It is assigned to an
+// LDAP AuthMethod and updates/deletes to that AuthMethod are cascaded to its
+// Urls. Urls are value objects of an AuthMethod, therefore there's no need
+// for oplog metadata, since only the AuthMethod will have metadata because
+// it's the root aggregate.
+type Url struct {
+	*store.Url
+	tableName string
+}
+
+// NewUrl creates a new in memory Url assigned to an LDAP AuthMethod. The
+// connection priority determines the order in which the urls of an auth
+// method are tried when connecting to its LDAP servers. It supports no
+// options.
+func NewUrl(ctx context.Context, authMethodId string, priority int, serverUrl string) (*Url, error) {
+	const op = "ldap.NewUrl"
+	u := &Url{
+		Url: &store.Url{
+			LdapMethodId:       authMethodId,
+			ConnectionPriority: uint32(priority),
+			ServerUrl:          serverUrl,
+		},
+	}
+	if err := u.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped
+	}
+	return u, nil
+}
+
+// validate the Url. On success, it will return nil.
+func (u *Url) validate(ctx context.Context, caller errors.Op) error {
+	if u.LdapMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing ldap auth method id")
+	}
+	if u.ConnectionPriority < 1 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "connection priority must be greater than zero")
+	}
+	if u.ServerUrl == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing url")
+	}
+	if err := validateUrl(ctx, caller, u.ServerUrl); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AllocUrl makes an empty one in memory
+func AllocUrl() Url {
+	return Url{
+		Url: &store.Url{},
+	}
+}
+
+// Clone an Url
+func (u *Url) Clone() *Url {
+	cp := proto.Clone(u.Url)
+	return &Url{
+		Url: cp.(*store.Url),
+	}
+}
+
+// TableName returns the table name.
+func (u *Url) TableName() string {
+	if u.tableName != "" {
+		return u.tableName
+	}
+	return defaultUrlTableName
+}
+
+// SetTableName sets the table name.
+func (u *Url) SetTableName(n string) {
+	u.tableName = n
+}
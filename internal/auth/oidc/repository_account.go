@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -198,9 +199,9 @@ func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId st
 // Account containing the updated values and a count of the number of
 // records updated. a is not changed.
 //
-// a must contain a valid PublicId. Only a.Name and a.Description can be
-// updated. If a.Name is set to a non-empty string, it must be unique within
-// a.AuthMethodId.
+// a must contain a valid PublicId. Only a.Name, a.Description and a.State
+// can be updated. If a.Name is set to a non-empty string, it must be unique
+// within a.AuthMethodId.
 //
 // An attribute of a will be set to NULL in the database if the attribute
 // in a is the zero value and it is included in fieldMaskPaths.
@@ -226,6 +227,10 @@ func (r *Repository) UpdateAccount(ctx context.Context, scopeId string, a *Accou
 		switch {
 		case strings.EqualFold(NameField, f):
 		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(StateField, f):
+			if !auth.ValidAccountState(a.State) {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid state: %q", a.State))
+			}
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -235,6 +240,7 @@ func (r *Repository) UpdateAccount(ctx context.Context, scopeId string, a *Accou
 		map[string]interface{}{
 			NameField:        a.Name,
 			DescriptionField: a.Description,
+			StateField:       a.State,
 		},
 		fieldMaskPaths,
 		nil,
@@ -21,6 +21,7 @@ const (
 	VersionField                           = "Version"
 	NameField                              = "Name"
 	DescriptionField                       = "Description"
+	StateField                             = "State"
 	FilterField                            = "Filter"
 	IssuerField                            = "Issuer"
 	ClientIdField                          = "ClientId"
@@ -36,6 +37,8 @@ const (
 	AccountClaimMapsField                  = "AccountClaimMaps"
 	TokenClaimsField                       = "TokenClaims"
 	UserinfoClaimsField                    = "UserinfoClaims"
+	AuthTokenTimeToLiveSecondsField        = "AuthTokenTimeToLiveSeconds"
+	AuthTokenTimeToStaleSecondsField       = "AuthTokenTimeToStaleSeconds"
 )
 
 // UpdateAuthMethod will retrieve the auth method from the repository,
@@ -53,8 +56,10 @@ const (
 //
 // fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a
-// zero value and included in fieldMask. Name, Description, Issuer,
-// ClientId, ClientSecret, MaxAge are all updatable fields.  The AuthMethod's
+// zero value and included in fieldMask, except for the auth token lifetime
+// fields which are set to their zero value. Name, Description, Issuer,
+// ClientId, ClientSecret, MaxAge, AuthTokenTimeToLiveSeconds and
+// AuthTokenTimeToStaleSeconds are all updatable fields.  The AuthMethod's
 // Value Objects of SigningAlgs, CallbackUrls, AudClaims and Certificates are
 // also updatable. if no updatable fields are included in the fieldMaskPaths,
 // then an error is returned.
@@ -105,9 +110,15 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, versi
 			CertificatesField:     am.Certificates,
 			ClaimsScopesField:     am.ClaimsScopes,
 			AccountClaimMapsField: am.AccountClaimMaps,
+
+			AuthTokenTimeToLiveSecondsField:  am.AuthTokenTimeToLiveSeconds,
+			AuthTokenTimeToStaleSecondsField: am.AuthTokenTimeToStaleSeconds,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{
+			AuthTokenTimeToLiveSecondsField,
+			AuthTokenTimeToStaleSecondsField,
+		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -576,6 +587,8 @@ func validateFieldMask(ctx context.Context, fieldMaskPaths []string) error {
 		case strings.EqualFold(CertificatesField, f):
 		case strings.EqualFold(ClaimsScopesField, f):
 		case strings.EqualFold(AccountClaimMapsField, f):
+		case strings.EqualFold(AuthTokenTimeToLiveSecondsField, f):
+		case strings.EqualFold(AuthTokenTimeToStaleSecondsField, f):
 		default:
 			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -602,6 +615,10 @@ func applyUpdate(new, orig *AuthMethod, fieldMaskPaths []string) *AuthMethod {
 			cp.MaxAge = new.MaxAge
 		case ApiUrlField:
 			cp.ApiUrl = new.ApiUrl
+		case AuthTokenTimeToLiveSecondsField:
+			cp.AuthTokenTimeToLiveSeconds = new.AuthTokenTimeToLiveSeconds
+		case AuthTokenTimeToStaleSecondsField:
+			cp.AuthTokenTimeToStaleSeconds = new.AuthTokenTimeToStaleSeconds
 		case SigningAlgsField:
 			switch {
 			case len(new.SigningAlgs) == 0:
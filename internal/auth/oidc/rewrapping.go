@@ -0,0 +1,56 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn(defaultAuthMethodTableName, authMethodRewrapFn)
+}
+
+// authMethodRewrapFn re-encrypts the client secrets of the auth methods which
+// were encrypted with the data key version using the current database wrapper
+// of the scope.
+func authMethodRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "oidc.authMethodRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	var authMethods []*AuthMethod
+	if err := reader.SearchWhere(ctx, &authMethods, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(authMethods) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, am := range authMethods {
+		if err := am.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt auth method client secret"))
+		}
+		if err := am.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt auth method client secret"))
+		}
+		if _, err := writer.Update(ctx, am, []string{"CtClientSecret", "ClientSecretHmac", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update auth method row with rewrapped fields"))
+		}
+	}
+	return nil
+}
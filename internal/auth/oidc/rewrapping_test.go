@@ -0,0 +1,59 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrap_authMethodRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := authMethodRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = authMethodRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+
+		am := TestAuthMethod(t, conn, databaseWrapper, org.GetPublicId(), InactiveState, "alice_rp", "alices-dogs-name")
+		orig := AllocAuthMethod()
+		orig.PublicId = am.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+
+		require.NoError(authMethodRewrapFn(ctx, orig.GetKeyId(), org.GetPublicId(), rw, rw, kmsCache))
+
+		got := AllocAuthMethod()
+		got.PublicId = am.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &got))
+
+		databaseWrapper, err = kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtClientSecret(), got.GetCtClientSecret())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal("alices-dogs-name", got.GetClientSecret())
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
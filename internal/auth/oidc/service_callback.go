@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/oidc/request"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/cap/oidc"
 	"github.com/hashicorp/go-bexpr"
 	"github.com/mitchellh/pointerstructure"
@@ -183,15 +185,51 @@ func Callback(
 		}
 	}
 
-	acct, err := r.upsertAccount(ctx, am, idTkClaims, userInfoClaims)
+	acct, user, err := r.loginUser(ctx, iamRepoFn, am, idTkClaims, userInfoClaims)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	// wow, we're getting close.  we just need to create a pending token for this
+	// successful authentication process, so it can be retrieved by the polling client
+	// that initialed the authentication attempt.
+	tokenRepo, err := atRepoFn()
 	if err != nil {
 		return "", errors.Wrap(ctx, err, op)
 	}
+	if _, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqState.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus)); err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+		}
+		return "", errors.Wrap(ctx, err, op)
+	}
+	// tada!  we can return a final redirect URL for the successful authentication.
+	return reqState.FinalRedirectUrl, nil
+}
+
+// loginUser uses the claims from the ID Token and userinfo of a successful
+// authentication to create/update the account, set the account's managed
+// group memberships and look up the iam.User associated with the account. An
+// error with the Unauthorized code is returned if the account is disabled.
+func (r *Repository) loginUser(
+	ctx context.Context,
+	iamRepoFn IamRepoFactory,
+	am *AuthMethod,
+	idTkClaims, userInfoClaims map[string]interface{},
+) (*Account, *iam.User, error) {
+	const op = "oidc.(Repository).loginUser"
+	acct, err := r.upsertAccount(ctx, am, idTkClaims, userInfoClaims)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if auth.IsDisabledAccount(acct) {
+		return nil, nil, errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("account %q is disabled", acct.PublicId))
+	}
 
 	// Get the set of all managed groups so we can filter
 	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	if len(mgs) > 0 {
 		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
@@ -205,11 +243,11 @@ func Callback(
 			if err != nil {
 				// We check all filters on ingress so this should never happen,
 				// but we validate anyways
-				return "", errors.Wrap(ctx, err, op)
+				return nil, nil, errors.Wrap(ctx, err, op)
 			}
 			match, err := eval.Evaluate(evalData)
 			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
-				return "", errors.Wrap(ctx, err, op)
+				return nil, nil, errors.Wrap(ctx, err, op)
 			}
 			if match {
 				matchedMgs = append(matchedMgs, mg)
@@ -218,7 +256,7 @@ func Callback(
 		// We always pass it in, even if none match, because in that case we
 		// need to remove any mappings that exist
 		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
-			return "", errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 	}
 
@@ -227,34 +265,17 @@ func Callback(
 	// autovivify users for the scope.
 	iamRepo, err := iamRepoFn()
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
 	scope, err := iamRepo.LookupScope(ctx, am.ScopeId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+scope.PublicId))
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup account scope: "+scope.PublicId))
 	}
 
 	user, err := iamRepo.LookupUserWithLogin(ctx, acct.PublicId)
 	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
-
-	// Now we need to check filters and assign managed groups by filter.
-
-	// wow, we're getting close.  we just need to create a pending token for this
-	// successful authentication process, so it can be retrieved by the polling client
-	// that initialed the authentication attempt.
-	tokenRepo, err := atRepoFn()
-	if err != nil {
-		return "", errors.Wrap(ctx, err, op)
-	}
-	if _, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqState.TokenRequestId), authtoken.WithStatus(authtoken.PendingStatus)); err != nil {
-		if errors.Match(errors.T(errors.NotUnique), err) {
-			return "", errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
-		}
-		return "", errors.Wrap(ctx, err, op)
-	}
-	// tada!  we can return a final redirect URL for the successful authentication.
-	return reqState.FinalRedirectUrl, nil
+	return acct, user, nil
 }
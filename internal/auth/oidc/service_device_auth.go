@@ -0,0 +1,479 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/auth/oidc/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/cap/oidc"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// deviceCodeGrantType is the grant type of a device access token request.
+	// See: https://www.rfc-editor.org/rfc/rfc8628#section-3.4
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// DefaultDeviceInterval is the minimum amount of time a client should wait
+	// between device access token requests when the provider doesn't return
+	// an interval.
+	DefaultDeviceInterval = 5 * time.Second
+)
+
+// DeviceAuthorization is the result of a device authorization request started
+// with StartDeviceAuth.
+type DeviceAuthorization struct {
+	// VerificationUri is the URI the user should visit on another device to
+	// authorize the request.
+	VerificationUri string
+
+	// VerificationUriComplete is the VerificationUri including the UserCode,
+	// if the provider supports it.
+	VerificationUriComplete string
+
+	// UserCode is the code the user should enter at the VerificationUri.
+	UserCode string
+
+	// Interval is the minimum amount of time to wait between calls to
+	// DeviceTokenRequest.
+	Interval time.Duration
+
+	// ExpiresIn is the lifetime of the UserCode and the TokenId.
+	ExpiresIn time.Duration
+
+	// TokenId is an encrypted payload for calls to DeviceTokenRequest.
+	TokenId string
+}
+
+// StartDeviceAuth accepts a request to start an OIDC device authorization
+// attempt as described in RFC 8628, for clients which cannot open a browser.
+// The provider of the auth method must publish a device_authorization_endpoint
+// in its discovery document.
+//
+// The returned DeviceAuthorization contains a verification URI and user code
+// which should be shown to the user, and a TokenId which is an encrypted
+// payload that includes the device code, for use with DeviceTokenRequest.
+//
+// If the auth method is in an InactiveState, then an error is returned.
+//
+// See: https://www.rfc-editor.org/rfc/rfc8628
+func StartDeviceAuth(ctx context.Context, oidcRepoFn OidcRepoFactory, authMethodId string) (*DeviceAuthorization, error) {
+	const op = "oidc.StartDeviceAuth"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if oidcRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repo function")
+	}
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+	if am.OperationalState == string(InactiveState) {
+		return nil, errors.New(ctx, errors.AuthMethodInactive, op, "not allowed to start authentication attempt")
+	}
+
+	// get the provider from the cache (if possible)
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	endpoints, err := discoverDeviceEndpoints(ctx, provider, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	scopes := append([]string{DefaultClaimsScope}, am.ClaimsScopes...)
+	form := url.Values{}
+	form.Set("client_id", am.ClientId)
+	form.Set("scope", strings.Join(scopes, " "))
+	var resp deviceAuthorizationResponse
+	if err := postDeviceForm(ctx, client, endpoints.deviceAuthUrl, am, form, &resp); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if resp.Error != "" {
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("device authorization request failed: %s", resp.errorString()))
+	}
+	switch {
+	case resp.DeviceCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing device code")
+	case resp.UserCode == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing user code")
+	case resp.VerificationUri == "":
+		return nil, errors.New(ctx, errors.Unknown, op, "device authorization response is missing verification uri")
+	}
+
+	expiresIn := time.Duration(resp.ExpiresIn) * time.Second
+	if expiresIn <= 0 {
+		expiresIn = AttemptExpiration
+	}
+	interval := time.Duration(resp.Interval) * time.Second
+	if interval <= 0 {
+		interval = DefaultDeviceInterval
+	}
+
+	tokenRequestId, err := authtoken.NewAuthTokenId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	exp := timestamppb.New(time.Now().Add(expiresIn).Truncate(time.Second))
+	t := &request.DeviceToken{
+		RequestId:          tokenRequestId,
+		ExpirationTime:     &timestamp.Timestamp{Timestamp: exp},
+		DeviceCode:         resp.DeviceCode,
+		ProviderConfigHash: hash,
+	}
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	encodedEncryptedTk, err := encryptMessage(ctx, requestWrapper, am, t)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &DeviceAuthorization{
+		VerificationUri:         resp.VerificationUri,
+		VerificationUriComplete: resp.VerificationUriComplete,
+		UserCode:                resp.UserCode,
+		Interval:                interval,
+		ExpiresIn:               expiresIn,
+		TokenId:                 encodedEncryptedTk,
+	}, nil
+}
+
+// DeviceTokenRequest is an oidc domain service function for processing a
+// device token request from a Boundary client. Device token requests are the
+// result of a Boundary client polling with the tokenId it received via
+// StartDeviceAuth.
+//
+// Each request makes a device access token request to the provider. While the
+// user hasn't completed the authorization, nil is returned for the token and
+// slowDown reports whether the client should increase its polling interval by
+// 5 seconds. Once the user has authorized the request, the ID Token is
+// verified, the account and its managed group memberships are updated the
+// same way as in Callback and a Boundary token is returned for the account's
+// user.
+//
+// DeviceTokenRequest returns an error with code Forbidden if the user denied
+// the request or the request has already been used, and AuthAttemptExpired if
+// the device code has expired.
+func DeviceTokenRequest(
+	ctx context.Context,
+	oidcRepoFn OidcRepoFactory,
+	iamRepoFn IamRepoFactory,
+	atRepoFn AuthTokenRepoFactory,
+	authMethodId, tokenId string,
+) (tk *authtoken.AuthToken, slowDown bool, e error) {
+	const op = "oidc.DeviceTokenRequest"
+	if oidcRepoFn == nil {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing oidc repository function")
+	}
+	if iamRepoFn == nil {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository function")
+	}
+	if atRepoFn == nil {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing auth token repository function")
+	}
+	if authMethodId == "" {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if tokenId == "" {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing token id")
+	}
+
+	reqTkWrapper, err := UnwrapMessage(ctx, tokenId)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	if reqTkWrapper.AuthMethodId != authMethodId {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s auth method id does not match request wrapper auth method id: %s", authMethodId, reqTkWrapper.AuthMethodId))
+	}
+
+	r, err := oidcRepoFn()
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	am, err := r.lookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, false, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+
+	// tokenId is a proto request.Wrapper, which contains a cipher text field,
+	// so we need the derived wrapper that was used to encrypt it.
+	requestWrapper, err := requestWrappingWrapper(ctx, r.kms, am.ScopeId, am.PublicId)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	reqTkBytes, err := decryptMessage(ctx, requestWrapper, reqTkWrapper)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	var reqTk request.DeviceToken
+	if err := proto.Unmarshal(reqTkBytes, &reqTk); err != nil {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "unable to unmarshal device token", errors.WithWrap(err))
+	}
+	if reqTk.ExpirationTime == nil {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing device token expiration time")
+	}
+	if reqTk.DeviceCode == "" {
+		return nil, false, errors.New(ctx, errors.InvalidParameter, op, "missing device code")
+	}
+
+	// before proceeding, make sure the request hasn't timed out
+	if time.Now().After(reqTk.ExpirationTime.Timestamp.AsTime()) {
+		return nil, false, errors.New(ctx, errors.AuthAttemptExpired, op, "device token has expired")
+	}
+
+	provider, err := providerCache().get(ctx, am)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+
+	// if auth method is inactive, we don't allow inflight requests to finish if the
+	// auth method's config has changed since the request was kicked off.
+	hash, err := provider.ConfigHash()
+	if err != nil {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "unable to get provider config hash", errors.WithWrap(err))
+	}
+	if reqTk.ProviderConfigHash != hash && am.OperationalState == string(InactiveState) {
+		return nil, false, errors.New(ctx, errors.AuthMethodInactive, op, "auth method configuration changed during in-flight authentication attempt")
+	}
+
+	client, err := provider.HTTPClient()
+	if err != nil {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	endpoints, err := discoverDeviceEndpoints(ctx, provider, am)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", deviceCodeGrantType)
+	form.Set("device_code", reqTk.DeviceCode)
+	form.Set("client_id", am.ClientId)
+	var resp deviceTokenResponse
+	if err := postDeviceForm(ctx, client, endpoints.tokenUrl, am, form, &resp); err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	// See: https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+	switch resp.Error {
+	case "":
+	case "authorization_pending":
+		return nil, false, nil
+	case "slow_down":
+		return nil, true, nil
+	case "access_denied":
+		return nil, false, errors.New(ctx, errors.Forbidden, op, "device authorization request was denied")
+	case "expired_token":
+		return nil, false, errors.New(ctx, errors.AuthAttemptExpired, op, "device code has expired")
+	default:
+		return nil, false, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("device access token request failed: %s", resp.errorString()))
+	}
+	if resp.IdToken == "" {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "device access token response is missing id token")
+	}
+
+	// the device flow has no nonce, so the ID Token is verified without one.
+	verifier := endpoints.provider.Verifier(&gooidc.Config{
+		ClientID:             am.ClientId,
+		SupportedSigningAlgs: am.SigningAlgs,
+	})
+	idTk, err := verifier.Verify(endpoints.ctx, resp.IdToken)
+	if err != nil {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "unable to verify id token", errors.WithWrap(err))
+	}
+	if len(am.AudClaims) > 0 && !audClaimsMatch(am.AudClaims, idTk.Audience) {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "id token audiences don't match the auth method's audience claims")
+	}
+
+	// okay, now we need some claims from both the ID Token and userinfo, so we can
+	// upsert an auth account
+	idTkClaims := map[string]interface{}{}     // intentionally, NOT nil for call to upsertAccount(...)
+	userInfoClaims := map[string]interface{}{} // intentionally, NOT nil for call to upsertAccount(...)
+	if err := idTk.Claims(&idTkClaims); err != nil {
+		return nil, false, errors.New(ctx, errors.Unknown, op, "unable to parse ID Token claims", errors.WithWrap(err))
+	}
+	if resp.AccessToken != "" {
+		userInfoTokenSource := oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: resp.AccessToken,
+			TokenType:   resp.TokenType,
+		})
+		if err := provider.UserInfo(ctx, userInfoTokenSource, idTk.Subject, &userInfoClaims); err != nil {
+			return nil, false, errors.New(ctx, errors.Unknown, op, "unable to get user info from provider", errors.WithWrap(err))
+		}
+	}
+
+	acct, user, err := r.loginUser(ctx, iamRepoFn, am, idTkClaims, userInfoClaims)
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+
+	tokenRepo, err := atRepoFn()
+	if err != nil {
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	authTk, err := tokenRepo.CreateAuthToken(ctx, user, acct.PublicId, authtoken.WithPublicId(reqTk.RequestId))
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return nil, false, errors.New(ctx, errors.Forbidden, op, "not a unique request", errors.WithWrap(err))
+		}
+		return nil, false, errors.Wrap(ctx, err, op)
+	}
+	if authTk.Token == "" {
+		return nil, false, errors.New(ctx, errors.Internal, op, "issued token is missing")
+	}
+	return authTk, false, nil
+}
+
+// deviceEndpoints are the endpoints discovered for a device authorization
+// request.
+type deviceEndpoints struct {
+	// ctx carries the http client of the provider
+	ctx           context.Context
+	provider      *gooidc.Provider
+	deviceAuthUrl string
+	tokenUrl      string
+}
+
+// discoverDeviceEndpoints uses the provider's discovery document to find its
+// device authorization and token endpoints.
+func discoverDeviceEndpoints(ctx context.Context, p *oidc.Provider, am *AuthMethod) (*deviceEndpoints, error) {
+	const op = "oidc.discoverDeviceEndpoints"
+	httpCtx, err := p.HTTPClientContext(ctx)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to get provider http client", errors.WithWrap(err))
+	}
+	discovered, err := gooidc.NewProvider(httpCtx, am.Issuer)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to discover provider configuration", errors.WithWrap(err))
+	}
+	var info struct {
+		DeviceAuthUrl string `json:"device_authorization_endpoint"`
+	}
+	if err := discovered.Claims(&info); err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to parse provider configuration", errors.WithWrap(err))
+	}
+	if info.DeviceAuthUrl == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "provider does not support device authorization")
+	}
+	return &deviceEndpoints{
+		ctx:           httpCtx,
+		provider:      discovered,
+		deviceAuthUrl: info.DeviceAuthUrl,
+		tokenUrl:      discovered.Endpoint().TokenURL,
+	}, nil
+}
+
+// deviceErrorResponse is the error response of the provider's device
+// authorization and token endpoints.
+type deviceErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (e deviceErrorResponse) errorString() string {
+	if e.ErrorDescription == "" {
+		return e.Error
+	}
+	return fmt.Sprintf("%s: %s", e.Error, e.ErrorDescription)
+}
+
+// deviceAuthorizationResponse is the response of the provider's device
+// authorization endpoint.
+// See: https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+type deviceAuthorizationResponse struct {
+	deviceErrorResponse
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationUri         string `json:"verification_uri"`
+	VerificationUriComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// deviceTokenResponse is the response of the provider's token endpoint to a
+// device access token request.
+// See: https://www.rfc-editor.org/rfc/rfc8628#section-3.5
+type deviceTokenResponse struct {
+	deviceErrorResponse
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IdToken     string `json:"id_token"`
+}
+
+// postDeviceForm posts form to endpoint, authenticating with the client
+// credentials of the auth method, and decodes the JSON response into resp.
+// Error responses from the provider are decoded into resp as well, any other
+// unsuccessful response results in an error.
+func postDeviceForm(ctx context.Context, client *http.Client, endpoint string, am *AuthMethod, form url.Values, resp interface{}) error {
+	const op = "oidc.postDeviceForm"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to create request", errors.WithWrap(err))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(am.ClientId), url.QueryEscape(am.ClientSecret))
+	httpResp, err := client.Do(req)
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to send request to provider", errors.WithWrap(err))
+	}
+	defer httpResp.Body.Close()
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to read provider response", errors.WithWrap(err))
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		var errResp deviceErrorResponse
+		if err := json.Unmarshal(body, &errResp); err != nil || errResp.Error == "" {
+			return errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unexpected provider response with status %s", httpResp.Status))
+		}
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unable to decode provider response with status %s", httpResp.Status), errors.WithWrap(err))
+	}
+	return nil
+}
+
+// audClaimsMatch returns true if one of the audiences is in allowed.
+func audClaimsMatch(allowed, audiences []string) bool {
+	for _, a := range audiences {
+		for _, b := range allowed {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,346 @@
+package oidc
+
+import (
+	"context"
+	"crypto/x509"
+	"net/url"
+	"testing"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hashicorp/boundary/internal/auth/oidc/request"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_deviceEndpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tp := oidc.StartTestProvider(t)
+	tp.SetClientCreds("test-rp", "fido")
+	_, _, tpAlg, _ := tp.SigningKeys()
+	dp := StartTestDeviceProvider(t, tp)
+
+	am, err := NewAuthMethod(ctx, "o_1234567890", "test-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, dp.Addr())[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://localhost:9200")[0]),
+		WithSigningAlgs(Alg(tpAlg)),
+		WithCertificates(dp.Certificate()),
+	)
+	require.NoError(t, err)
+	am.PublicId = "amoidc_1234567890"
+	p, err := convertToProvider(ctx, am)
+	require.NoError(t, err)
+	client, err := p.HTTPClient()
+	require.NoError(t, err)
+
+	endpoints, err := discoverDeviceEndpoints(ctx, p, am)
+	require.NoError(t, err)
+	assert.Equal(t, dp.Addr()+"/device", endpoints.deviceAuthUrl)
+	assert.Equal(t, dp.Addr()+"/token", endpoints.tokenUrl)
+
+	var authResp deviceAuthorizationResponse
+	require.NoError(t, postDeviceForm(ctx, client, endpoints.deviceAuthUrl, am, url.Values{"scope": {"openid"}}, &authResp))
+	assert.Empty(t, authResp.Error)
+	assert.Equal(t, dp.UserCode(), authResp.UserCode)
+	assert.NotEmpty(t, authResp.DeviceCode)
+	assert.NotEmpty(t, authResp.VerificationUri)
+
+	poll := func(t *testing.T) deviceTokenResponse {
+		t.Helper()
+		form := url.Values{}
+		form.Set("grant_type", deviceCodeGrantType)
+		form.Set("device_code", authResp.DeviceCode)
+		var resp deviceTokenResponse
+		require.NoError(t, postDeviceForm(ctx, client, endpoints.tokenUrl, am, form, &resp))
+		return resp
+	}
+	assert.Equal(t, "authorization_pending", poll(t).Error)
+	dp.SetSlowDown(true)
+	assert.Equal(t, "slow_down", poll(t).Error)
+	dp.SetAuthorized(true)
+	resp := poll(t)
+	assert.Empty(t, resp.Error)
+	assert.NotEmpty(t, resp.AccessToken)
+	require.NotEmpty(t, resp.IdToken)
+
+	verifier := endpoints.provider.Verifier(&gooidc.Config{ClientID: am.ClientId, SupportedSigningAlgs: am.SigningAlgs})
+	idTk, err := verifier.Verify(endpoints.ctx, resp.IdToken)
+	require.NoError(t, err)
+	assert.Equal(t, tp.ExpectedSubject(), idTk.Subject)
+	userInfoClaims := map[string]interface{}{}
+	require.NoError(t, p.UserInfo(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: resp.AccessToken}), idTk.Subject, &userInfoClaims))
+	assert.Equal(t, "bob", userInfoClaims["friend"])
+
+	assert.Equal(t, "invalid_grant", poll(t).Error)
+
+	t.Run("invalid-client", func(t *testing.T) {
+		badAm := am.Clone()
+		badAm.ClientSecret = "bad-secret"
+		var resp deviceAuthorizationResponse
+		require.NoError(t, postDeviceForm(ctx, client, endpoints.deviceAuthUrl, badAm, url.Values{}, &resp))
+		assert.Equal(t, "invalid_client", resp.Error)
+	})
+	t.Run("no-device-endpoint", func(t *testing.T) {
+		tpAm, err := NewAuthMethod(ctx, "o_1234567890", "test-rp", "fido",
+			WithIssuer(TestConvertToUrls(t, tp.Addr())[0]),
+			WithApiUrl(TestConvertToUrls(t, "https://localhost:9200")[0]),
+			WithSigningAlgs(Alg(tpAlg)),
+			WithCertificates(mustParseCertificates(t, tp.CACert())...),
+		)
+		require.NoError(t, err)
+		tpAm.PublicId = "amoidc_0987654321"
+		p, err := convertToProvider(ctx, tpAm)
+		require.NoError(t, err)
+		_, err = discoverDeviceEndpoints(ctx, p, tpAm)
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+}
+
+func Test_StartDeviceAuth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tp := oidc.StartTestProvider(t)
+	tp.SetClientCreds("test-rp", "fido")
+	_, _, tpAlg, _ := tp.SigningKeys()
+	dp := StartTestDeviceProvider(t, tp)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"test-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, dp.Addr())[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://localhost:9200")[0]),
+		WithSigningAlgs(Alg(tpAlg)),
+		WithCertificates(dp.Certificate()),
+	)
+	testAuthMethodInactive := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, InactiveState,
+		"test-rp2", "fido",
+		WithIssuer(TestConvertToUrls(t, dp.Addr())[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://localhost:9200")[0]),
+		WithSigningAlgs(Alg(tpAlg)),
+		WithCertificates(dp.Certificate()),
+	)
+
+	tests := []struct {
+		name            string
+		repoFn          OidcRepoFactory
+		authMethodId    string
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:         "valid",
+			repoFn:       repoFn,
+			authMethodId: testAuthMethod.PublicId,
+		},
+		{
+			name:            "missing-repoFn",
+			authMethodId:    testAuthMethod.PublicId,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing oidc repo function",
+		},
+		{
+			name:            "missing-authMethodId",
+			repoFn:          repoFn,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing auth method id",
+		},
+		{
+			name:            "not-found",
+			repoFn:          repoFn,
+			authMethodId:    "amoidc_1234567890",
+			wantErrMatch:    errors.T(errors.RecordNotFound),
+			wantErrContains: "auth method amoidc_1234567890 not found",
+		},
+		{
+			name:            "inactive",
+			repoFn:          repoFn,
+			authMethodId:    testAuthMethodInactive.PublicId,
+			wantErrMatch:    errors.T(errors.AuthMethodInactive),
+			wantErrContains: "not allowed to start authentication attempt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := StartDeviceAuth(ctx, tt.repoFn, tt.authMethodId)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err code: %q got: %q", tt.wantErrMatch.Code, err)
+				if tt.wantErrContains != "" {
+					assert.Contains(err.Error(), tt.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			assert.Equal(dp.UserCode(), got.UserCode)
+			assert.Equal(dp.Addr()+"/activate", got.VerificationUri)
+			assert.Contains(got.VerificationUriComplete, dp.UserCode())
+			assert.Equal(time.Second, got.Interval)
+			assert.Equal(5*time.Minute, got.ExpiresIn)
+
+			reqTkWrapper, err := UnwrapMessage(ctx, got.TokenId)
+			require.NoError(err)
+			assert.Equal(tt.authMethodId, reqTkWrapper.AuthMethodId)
+			requestWrapper, err := requestWrappingWrapper(ctx, kmsCache, org.PublicId, tt.authMethodId)
+			require.NoError(err)
+			reqTkBytes, err := decryptMessage(ctx, requestWrapper, reqTkWrapper)
+			require.NoError(err)
+			var reqTk request.DeviceToken
+			require.NoError(proto.Unmarshal(reqTkBytes, &reqTk))
+			assert.NotEmpty(reqTk.RequestId)
+			assert.NotEmpty(reqTk.DeviceCode)
+			assert.True(reqTk.ExpirationTime.Timestamp.AsTime().After(time.Now()))
+		})
+	}
+}
+
+func Test_DeviceTokenRequest(t *testing.T) {
+	// DO NOT run these tests under t.Parallel(), there be dragons because of
+	// dependencies on the TestDeviceProvider state
+	ctx := context.Background()
+	tp := oidc.StartTestProvider(t)
+	tp.SetClientCreds("test-rp", "fido")
+	_, _, tpAlg, _ := tp.SigningKeys()
+	dp := StartTestDeviceProvider(t, tp)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+
+	repoFn := func() (*Repository, error) {
+		return NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	testAuthMethod := TestAuthMethod(
+		t, conn, databaseWrapper, org.PublicId, ActivePublicState,
+		"test-rp", "fido",
+		WithIssuer(TestConvertToUrls(t, dp.Addr())[0]),
+		WithApiUrl(TestConvertToUrls(t, "https://localhost:9200")[0]),
+		WithSigningAlgs(Alg(tpAlg)),
+		WithCertificates(dp.Certificate()),
+	)
+	// set this as the primary so users will be created on first login
+	iam.TestSetPrimaryAuthMethod(t, iamRepo, org, testAuthMethod.PublicId)
+
+	reset := func(t *testing.T) string {
+		t.Helper()
+		dp.SetAuthorized(false)
+		dp.SetDenied(false)
+		dp.SetSlowDown(false)
+		got, err := StartDeviceAuth(ctx, repoFn, testAuthMethod.PublicId)
+		require.NoError(t, err)
+		return got.TokenId
+	}
+
+	t.Run("missing-params", func(t *testing.T) {
+		tokenId := reset(t)
+		_, _, err := DeviceTokenRequest(ctx, nil, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, _, err = DeviceTokenRequest(ctx, repoFn, nil, atRepoFn, testAuthMethod.PublicId, tokenId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, _, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, nil, testAuthMethod.PublicId, tokenId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, _, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, "", tokenId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, _, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		_, _, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, "amoidc_1234567890", tokenId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("pending-then-success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tokenId := reset(t)
+		tk, slowDown, err := DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.NoError(err)
+		assert.Nil(tk)
+		assert.False(slowDown)
+
+		dp.SetSlowDown(true)
+		tk, slowDown, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.NoError(err)
+		assert.Nil(tk)
+		assert.True(slowDown)
+
+		dp.SetAuthorized(true)
+		tk, slowDown, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.NoError(err)
+		require.NotNil(tk)
+		assert.False(slowDown)
+		assert.NotEmpty(tk.Token)
+		assert.Equal(string(authtoken.IssuedStatus), tk.Status)
+
+		acct := AllocAccount()
+		require.NoError(rw.LookupWhere(ctx, acct, "public_id = ?", []interface{}{tk.AuthAccountId}))
+		assert.Equal(tp.ExpectedSubject(), acct.Subject)
+		assert.Equal("alice@example.com", acct.Email)
+		assert.Equal("Alice Doe Smith", acct.FullName)
+
+		// the device code can only be used once
+		_, _, err = DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.Error(err)
+	})
+	t.Run("denied", func(t *testing.T) {
+		tokenId := reset(t)
+		dp.SetDenied(true)
+		tk, _, err := DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.Error(t, err)
+		assert.Nil(t, tk)
+		assert.Truef(t, errors.Match(errors.T(errors.Forbidden), err), "want err code: %q got: %q", errors.Forbidden, err)
+	})
+	t.Run("expired", func(t *testing.T) {
+		reqTk := &request.DeviceToken{
+			RequestId:      "at_1234567890",
+			ExpirationTime: &timestamp.Timestamp{Timestamp: timestamppb.New(time.Now().Add(-time.Minute))},
+			DeviceCode:     "test-device-code",
+		}
+		requestWrapper, err := requestWrappingWrapper(ctx, kmsCache, testAuthMethod.ScopeId, testAuthMethod.PublicId)
+		require.NoError(t, err)
+		tokenId, err := encryptMessage(ctx, requestWrapper, testAuthMethod, reqTk)
+		require.NoError(t, err)
+		tk, _, err := DeviceTokenRequest(ctx, repoFn, iamRepoFn, atRepoFn, testAuthMethod.PublicId, tokenId)
+		require.Error(t, err)
+		assert.Nil(t, tk)
+		assert.Truef(t, errors.Match(errors.T(errors.AuthAttemptExpired), err), "want err code: %q got: %q", errors.AuthAttemptExpired, err)
+	})
+}
+
+// mustParseCertificates parses the PEM encoded certificates in pem.
+func mustParseCertificates(t testing.TB, pem string) []*x509.Certificate {
+	t.Helper()
+	certs, err := ParseCertificates(context.Background(), pem)
+	require.NoError(t, err)
+	return certs
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
@@ -15,6 +16,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httputil"
 	"net/url"
 	"sort"
 	"sync"
@@ -524,3 +526,204 @@ func (s *testControllerSrv) Stop() {
 	s.t.Helper()
 	s.httpServer.Close()
 }
+
+// TestDeviceProvider is a test OIDC provider which supports the device
+// authorization grant (RFC 8628). It serves its own discovery document,
+// device authorization endpoint and token endpoint, and uses an
+// oidc.TestProvider for everything else (JWKs, userinfo, etc). ID Tokens are
+// signed with the oidc.TestProvider's signing keys for its expected subject.
+type TestDeviceProvider struct {
+	mu sync.Mutex
+
+	tp           *oidc.TestProvider
+	proxy        *httputil.ReverseProxy
+	httpServer   *httptest.Server
+	deviceCode   string
+	userCode     string
+	authorized   bool
+	denied       bool
+	slowDown     bool
+	used         bool
+	customClaims map[string]interface{}
+	t            testing.TB
+}
+
+// StartTestDeviceProvider returns a running TestDeviceProvider which uses tp.
+// The client credentials of tp must be set before requests are made.
+func StartTestDeviceProvider(t testing.TB, tp *oidc.TestProvider) *TestDeviceProvider {
+	t.Helper()
+	require := require.New(t)
+	require.NotNil(tp)
+	tpUrl, err := url.Parse(tp.Addr())
+	require.NoError(err)
+	p := &TestDeviceProvider{
+		t:          t,
+		tp:         tp,
+		proxy:      httputil.NewSingleHostReverseProxy(tpUrl),
+		deviceCode: "test-device-code",
+		userCode:   "ABCD-EFGH",
+		customClaims: map[string]interface{}{
+			"name":  "Alice Doe Smith",
+			"email": "alice@example.com",
+		},
+	}
+	p.proxy.Transport = tp.HTTPClient().Transport
+	p.httpServer = httptest.NewTLSServer(p)
+	p.httpServer.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	t.Cleanup(p.Stop)
+	return p
+}
+
+// Addr returns the scheme.host.port of the running provider, which is its
+// issuer.
+func (p *TestDeviceProvider) Addr() string {
+	return p.httpServer.URL
+}
+
+// Certificate returns the certificate of the running provider.
+func (p *TestDeviceProvider) Certificate() *x509.Certificate {
+	return p.httpServer.Certificate()
+}
+
+// UserCode returns the user code returned by the device authorization
+// endpoint.
+func (p *TestDeviceProvider) UserCode() string {
+	return p.userCode
+}
+
+// SetAuthorized sets whether the user has authorized the device.
+func (p *TestDeviceProvider) SetAuthorized(authorized bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.authorized = authorized
+}
+
+// SetDenied sets whether the user has denied the device authorization.
+func (p *TestDeviceProvider) SetDenied(denied bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.denied = denied
+}
+
+// SetSlowDown sets whether the token endpoint responds with slow_down while
+// the device hasn't been authorized.
+func (p *TestDeviceProvider) SetSlowDown(slowDown bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.slowDown = slowDown
+}
+
+// SetCustomClaims sets the additional claims of issued ID Tokens.
+func (p *TestDeviceProvider) SetCustomClaims(customClaims map[string]interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.customClaims = customClaims
+}
+
+// ServeHTTP satisfies the http.Handler interface
+func (p *TestDeviceProvider) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	const (
+		openidConfiguration = "/.well-known/openid-configuration"
+		deviceAuthorization = "/device"
+		token               = "/token"
+	)
+	p.t.Helper()
+	require := require.New(p.t)
+	writeJSON := func(statusCode int, out interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		require.NoError(json.NewEncoder(w).Encode(out))
+	}
+	writeError := func(errorCode string) {
+		writeJSON(http.StatusBadRequest, map[string]string{"error": errorCode})
+	}
+	clientId, clientSecret := p.tp.ClientCreds()
+	_, _, alg, _ := p.tp.SigningKeys()
+
+	switch req.URL.Path {
+	case openidConfiguration:
+		writeJSON(http.StatusOK, map[string]interface{}{
+			"issuer":                                p.Addr(),
+			"authorization_endpoint":                p.Addr() + "/authorize",
+			"token_endpoint":                        p.Addr() + token,
+			"device_authorization_endpoint":         p.Addr() + deviceAuthorization,
+			"jwks_uri":                              p.Addr() + "/.well-known/jwks.json",
+			"userinfo_endpoint":                     p.Addr() + "/userinfo",
+			"id_token_signing_alg_values_supported": []string{string(alg)},
+			"scopes_supported":                      []string{"openid"},
+			"subject_types_supported":               []string{"public"},
+			"response_types_supported":              []string{"code"},
+			"grant_types_supported":                 []string{"authorization_code", deviceCodeGrantType},
+		})
+	case deviceAuthorization:
+		require.NoError(req.ParseForm())
+		if id, secret, ok := req.BasicAuth(); !ok || id != clientId || secret != clientSecret {
+			writeJSON(http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{
+			"device_code":               p.deviceCode,
+			"user_code":                 p.userCode,
+			"verification_uri":          p.Addr() + "/activate",
+			"verification_uri_complete": p.Addr() + "/activate?user_code=" + url.QueryEscape(p.userCode),
+			"expires_in":                300,
+			"interval":                  1,
+		})
+	case token:
+		require.NoError(req.ParseForm())
+		if id, secret, ok := req.BasicAuth(); !ok || id != clientId || secret != clientSecret {
+			writeJSON(http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+		if req.FormValue("grant_type") != deviceCodeGrantType {
+			writeError("unsupported_grant_type")
+			return
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		switch {
+		case req.FormValue("device_code") != p.deviceCode || p.used:
+			writeError("invalid_grant")
+			return
+		case p.denied:
+			writeError("access_denied")
+			return
+		case !p.authorized && p.slowDown:
+			writeError("slow_down")
+			return
+		case !p.authorized:
+			writeError("authorization_pending")
+			return
+		}
+		p.used = true
+
+		privKey, _, _, _ := p.tp.SigningKeys()
+		now := time.Now()
+		claims := map[string]interface{}{
+			"sub": p.tp.ExpectedSubject(),
+			"iss": p.Addr(),
+			"nbf": float64(now.Add(-time.Minute).Unix()),
+			"exp": float64(now.Add(time.Minute).Unix()),
+			"iat": float64(now.Unix()),
+			"aud": []string{clientId},
+			"azp": clientId,
+		}
+		accessToken := oidc.TestSignJWT(p.t, privKey, string(alg), claims, nil)
+		for k, v := range p.customClaims {
+			claims[k] = v
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"id_token":     oidc.TestSignJWT(p.t, privKey, string(alg), claims, nil),
+		})
+	default:
+		p.proxy.ServeHTTP(w, req)
+	}
+}
+
+// Stop stops the running TestDeviceProvider. This is called as a test clean
+// up function which is initialized when starting the provider
+func (p *TestDeviceProvider) Stop() {
+	p.httpServer.Close()
+}
@@ -24,6 +24,8 @@ type options struct {
 	withPassword          bool
 	withOrderByCreateTime bool
 	ascending             bool
+	withNewPassword       string
+	withTotpCode          string
 }
 
 func getDefaultOptions() options {
@@ -92,3 +94,19 @@ func WithOrderByCreateTime(ascending bool) Option {
 		o.ascending = ascending
 	}
 }
+
+// WithNewPassword provides an optional new password which is used to change
+// an expired password when authenticating.
+func WithNewPassword(password string) Option {
+	return func(o *options) {
+		o.withNewPassword = password
+	}
+}
+
+// WithTotpCode provides an optional TOTP code or recovery code which is
+// checked against the TOTP enrollment of an account when authenticating.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
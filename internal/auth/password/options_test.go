@@ -65,4 +65,10 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithNewPassword", func(t *testing.T) {
+		opts := getOpts(WithNewPassword("new password"))
+		testOpts := getDefaultOptions()
+		testOpts.withNewPassword = "new password"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -0,0 +1,147 @@
+package password
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"golang.org/x/crypto/argon2"
+)
+
+// SetDisallowedWords encodes the words which passwords of the auth method
+// must not contain.
+func (a *AuthMethod) SetDisallowedWords(ctx context.Context, words []string) error {
+	const op = "password.(AuthMethod).SetDisallowedWords"
+	if len(words) == 0 {
+		a.DisallowedWords = ""
+		return nil
+	}
+	for _, w := range words {
+		if strings.TrimSpace(w) == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "empty disallowed word")
+		}
+	}
+	encoded, err := json.Marshal(words)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "unable to encode disallowed words", errors.WithWrap(err))
+	}
+	a.DisallowedWords = string(encoded)
+	return nil
+}
+
+// GetDisallowedWordsSlice returns the decoded words which passwords of the
+// auth method must not contain.
+func (a *AuthMethod) GetDisallowedWordsSlice(ctx context.Context) ([]string, error) {
+	const op = "password.(AuthMethod).GetDisallowedWordsSlice"
+	return decodeDisallowedWords(ctx, op, a.DisallowedWords)
+}
+
+func decodeDisallowedWords(ctx context.Context, op errors.Op, encoded string) ([]string, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var words []string
+	if err := json.Unmarshal([]byte(encoded), &words); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to decode disallowed words", errors.WithWrap(err))
+	}
+	return words, nil
+}
+
+// checkPolicy returns an error if password does not satisfy the character
+// class requirements of the password policy or contains loginName or a
+// disallowed word when the policy forbids it. The minimum password length is
+// checked by the callers.
+func (c *currentConfig) checkPolicy(ctx context.Context, loginName, password string) error {
+	const op = "password.(currentConfig).checkPolicy"
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSpecial = true
+		}
+	}
+	switch {
+	case c.RequireUppercase && !hasUpper:
+		return errors.New(ctx, errors.PasswordTooWeak, op, "must contain an uppercase letter")
+	case c.RequireLowercase && !hasLower:
+		return errors.New(ctx, errors.PasswordTooWeak, op, "must contain a lowercase letter")
+	case c.RequireDigit && !hasDigit:
+		return errors.New(ctx, errors.PasswordTooWeak, op, "must contain a digit")
+	case c.RequireSpecial && !hasSpecial:
+		return errors.New(ctx, errors.PasswordTooWeak, op, "must contain a special character")
+	}
+
+	lower := strings.ToLower(password)
+	if c.DisallowLoginName && loginName != "" && strings.Contains(lower, strings.ToLower(loginName)) {
+		return errors.New(ctx, errors.PasswordDisallowed, op, "must not contain the login name")
+	}
+	words, err := decodeDisallowedWords(ctx, op, c.DisallowedWords)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, w := range words {
+		if strings.Contains(lower, strings.ToLower(w)) {
+			return errors.New(ctx, errors.PasswordDisallowed, op, "must not contain a disallowed word")
+		}
+	}
+	return nil
+}
+
+// historyCredentialTableName is the table containing the previous argon2
+// credentials of accounts. Rows are inserted by a trigger when a credential is
+// deleted.
+const historyCredentialTableName = "auth_password_argon2_cred_history"
+
+type historyCredential struct {
+	*Argon2Credential
+	*Argon2Configuration
+}
+
+// checkHistory returns an error with code PasswordReused if password matches
+// the current password of accountId or one of its previous passwords kept in
+// the password history. It does nothing when the password policy does not
+// keep a password history.
+func (r *Repository) checkHistory(ctx context.Context, scopeId, accountId, password string, cc *currentConfig) error {
+	const op = "password.(Repository).checkHistory"
+	if cc.PasswordHistoryCount == 0 {
+		return nil
+	}
+	rows, err := r.reader.Query(ctx, passwordHistoryQuery, []interface{}{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var creds []historyCredential
+	for rows.Next() {
+		var hc historyCredential
+		if err := r.reader.ScanRows(ctx, rows, &hc); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, hc)
+	}
+	for _, hc := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(hc.GetKeyId()))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := hc.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt), errors.WithMsg("unable to decrypt credential"))
+		}
+		key := argon2.IDKey([]byte(password), hc.Salt, hc.Iterations, hc.Memory, uint8(hc.Threads), hc.KeyLength)
+		if subtle.ConstantTimeCompare(key, hc.DerivedKey) == 1 {
+			return errors.New(ctx, errors.PasswordReused, op, "must not match a previous password")
+		}
+	}
+	return nil
+}
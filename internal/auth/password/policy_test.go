@@ -0,0 +1,232 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMethod_DisallowedWords(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	am, err := NewAuthMethod("o_1234567890")
+	require.NoError(t, err)
+
+	require.NoError(t, am.SetDisallowedWords(ctx, []string{"boundary", "Hashicorp"}))
+	assert.Equal(t, `["boundary","Hashicorp"]`, am.DisallowedWords)
+	words, err := am.GetDisallowedWordsSlice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"boundary", "Hashicorp"}, words)
+
+	require.NoError(t, am.SetDisallowedWords(ctx, nil))
+	assert.Empty(t, am.DisallowedWords)
+	words, err = am.GetDisallowedWordsSlice(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, words)
+
+	err = am.SetDisallowedWords(ctx, []string{"boundary", " "})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestCurrentConfig_checkPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		cc        currentConfig
+		loginName string
+		password  string
+		wantIsErr errors.Code
+	}{
+		{
+			name:     "no-policy",
+			password: "password",
+		},
+		{
+			name: "all-classes",
+			cc: currentConfig{
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireDigit:     true,
+				RequireSpecial:   true,
+			},
+			password: "Passw0rd!",
+		},
+		{
+			name:      "missing-uppercase",
+			cc:        currentConfig{RequireUppercase: true},
+			password:  "passw0rd!",
+			wantIsErr: errors.PasswordTooWeak,
+		},
+		{
+			name:      "missing-lowercase",
+			cc:        currentConfig{RequireLowercase: true},
+			password:  "PASSW0RD!",
+			wantIsErr: errors.PasswordTooWeak,
+		},
+		{
+			name:      "missing-digit",
+			cc:        currentConfig{RequireDigit: true},
+			password:  "Password!",
+			wantIsErr: errors.PasswordTooWeak,
+		},
+		{
+			name:      "missing-special",
+			cc:        currentConfig{RequireSpecial: true},
+			password:  "Passw0rd",
+			wantIsErr: errors.PasswordTooWeak,
+		},
+		{
+			name:      "contains-login-name",
+			cc:        currentConfig{DisallowLoginName: true},
+			loginName: "alice",
+			password:  "my-ALICE-password",
+			wantIsErr: errors.PasswordDisallowed,
+		},
+		{
+			name:      "login-name-allowed",
+			loginName: "alice",
+			password:  "my-alice-password",
+		},
+		{
+			name:      "contains-disallowed-word",
+			cc:        currentConfig{DisallowedWords: `["boundary"]`},
+			password:  "myBoundaryPassword",
+			wantIsErr: errors.PasswordDisallowed,
+		},
+		{
+			name:     "no-disallowed-word",
+			cc:       currentConfig{DisallowedWords: `["boundary"]`},
+			password: "myVaultPassword",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.cc.checkPolicy(ctx, tc.loginName, tc.password)
+			if tc.wantIsErr != 0 {
+				require.Error(t, err)
+				assert.Truef(t, errors.Match(errors.T(tc.wantIsErr), err), "want err code: %q got: %q", tc.wantIsErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRepository_PasswordHistory(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	am.PasswordHistoryCount = 2
+	am, _, err = repo.UpdateAuthMethod(ctx, am, am.Version, []string{"PasswordHistoryCount"})
+	require.NoError(t, err)
+
+	acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+	passwords := []string{"password-1", "password-2", "password-3"}
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, passwords[0], acct.Version)
+	require.NoError(t, err)
+	for i := 1; i < len(passwords); i++ {
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[i-1], passwords[i], acct.Version)
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+	}
+
+	// The current password and the previous 2 passwords are kept.
+	for _, pw := range passwords {
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, pw, acct.Version)
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.PasswordReused), err), "want err code: %q got: %q", errors.PasswordReused, err)
+	}
+	_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[2], passwords[0], acct.Version)
+	require.Error(t, err)
+	assert.Truef(t, errors.Match(errors.T(errors.PasswordReused), err), "want err code: %q got: %q", errors.PasswordReused, err)
+
+	rows, err := rw.Query(ctx, "select private_id from auth_password_argon2_cred_history where password_account_id = @id", []interface{}{sql.Named("id", acct.PublicId)})
+	require.NoError(t, err)
+	var historyCnt int
+	for rows.Next() {
+		historyCnt++
+	}
+	require.NoError(t, rows.Err())
+	rows.Close()
+	assert.Equal(t, 2, historyCnt)
+
+	acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[2], "password-4", acct.Version)
+	require.NoError(t, err)
+	require.NotNil(t, acct)
+
+	// password-1 is no longer in the history.
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, passwords[0], acct.Version)
+	require.NoError(t, err)
+	require.NotNil(t, acct)
+}
+
+func TestRepository_AuthenticateExpiredPassword(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	am.MaxPasswordAgeSeconds = 1
+	am.RequireDigit = true
+	am, _, err = repo.UpdateAuthMethod(ctx, am, am.Version, []string{"MaxPasswordAgeSeconds", "RequireDigit"})
+	require.NoError(t, err)
+
+	acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+	acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "password-1", acct.Version)
+	require.NoError(t, err)
+
+	got, err := repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "password-1")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	credentialId := got.CredentialId
+
+	time.Sleep(2 * time.Second)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "password-1")
+	require.Error(t, err)
+	assert.Truef(t, errors.Match(errors.T(errors.PasswordExpired), err), "want err code: %q got: %q", errors.PasswordExpired, err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "wrong-password", WithNewPassword("password-2"))
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "password-1", WithNewPassword("password-two"))
+	require.Error(t, err)
+	assert.Truef(t, errors.Match(errors.T(errors.PasswordTooWeak), err), "want err code: %q got: %q", errors.PasswordTooWeak, err)
+	assert.Nil(t, got)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "password-1", WithNewPassword("password-2"))
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, acct.Version+1, got.Version)
+	assert.NotEqual(t, credentialId, got.CredentialId)
+
+	got, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", "password-2")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
@@ -11,6 +11,7 @@ select acct.name,                        -- Account.Name
        acct.create_time,                 -- Account.CreateTime
        acct.update_time,                 -- Account.UpdateTime
        acct.version,                     -- Account.Version
+       acct.state,                       -- Account.State
        cred.private_id as credential_id, -- Account.CredentialId
        cred.private_id,                  -- Argon2Credential.PrivateId
        cred.password_conf_id,            -- Argon2Credential.PasswordConfId
@@ -20,16 +21,51 @@ select acct.name,                        -- Account.Name
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
-  from auth_password_argon2_cred cred,
-       auth_password_argon2_conf conf,
-       auth_password_account acct,
-       auth_password_method meth
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       meth.max_password_age_seconds > 0
+         and cred.create_time + make_interval(secs => meth.max_password_age_seconds) < current_timestamp
+         as is_password_expired,
+       meth.lockout_threshold,
+       coalesce(lockout.failed_attempts, 0) as failed_attempts,
+       coalesce(lockout.locked_until > current_timestamp, false) as is_locked,
+       meth.mfa_required
+  from auth_password_argon2_cred cred
+  join auth_password_argon2_conf conf
+    on cred.password_conf_id = conf.private_id
+  join auth_password_account acct
+    on cred.password_account_id = acct.public_id
+  join auth_password_method meth
+    on acct.auth_method_id = meth.public_id
+  left join auth_password_account_lockout lockout
+    on acct.public_id = lockout.password_account_id
  where acct.auth_method_id = @auth_method_id
-   and acct.login_name = @login_name
-   and cred.password_conf_id = conf.private_id
-   and cred.password_account_id = acct.public_id
-   and acct.auth_method_id = meth.public_id ;
+   and acct.login_name = @login_name ;
+`
+	incrementFailedAttemptsQuery = `
+insert into auth_password_account_lockout as lockout
+       (password_account_id, failed_attempts)
+values (@password_account_id, 1)
+    on conflict (password_account_id) do update
+   set failed_attempts = lockout.failed_attempts + 1 ;
+`
+	lockAccountQuery = `
+update auth_password_account_lockout lockout
+   set failed_attempts = 0,
+       locked_until = case
+         when meth.lockout_duration_seconds = 0 then 'infinity'::timestamp with time zone
+         else current_timestamp + make_interval(secs => meth.lockout_duration_seconds)
+       end
+  from auth_password_account acct
+  join auth_password_method meth
+    on acct.auth_method_id = meth.public_id
+ where lockout.password_account_id = acct.public_id
+   and acct.public_id = @password_account_id
+   and meth.lockout_threshold > 0
+   and lockout.failed_attempts >= meth.lockout_threshold ;
+`
+	deleteAccountLockoutQuery = `
+delete from auth_password_account_lockout
+ where password_account_id = @password_account_id ;
 `
 	currentConfigForAccountQuery = `
 select *
@@ -40,5 +76,36 @@ select *
          from auth_password_account
         where public_id = @public_id
     );
+`
+	passwordHistoryQuery = `
+select cred.private_id,
+       cred.salt,
+       cred.derived_key,
+       cred.key_id,
+       conf.key_length,
+       conf.iterations,
+       conf.memory,
+       conf.threads
+  from (
+         select private_id, password_conf_id, salt, derived_key, key_id
+           from auth_password_argon2_cred
+          where password_account_id = @password_account_id
+      union all
+         select private_id, password_conf_id, salt, derived_key, key_id
+           from auth_password_argon2_cred_history
+          where password_account_id = @password_account_id
+       ) cred
+  join auth_password_argon2_conf conf
+    on cred.password_conf_id = conf.private_id;
+`
+	historyCredentialRewrapQuery = `
+select *
+  from auth_password_argon2_cred_history
+ where key_id = @key_id;
+`
+	pendingTotpRewrapQuery = `
+select *
+  from auth_password_account_totp_pending
+ where key_id = @key_id;
 `
 )
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/intglobals"
@@ -78,6 +79,9 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 		if cc.MinPasswordLength > len(opts.password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be longer than %v", cc.MinPasswordLength))
 		}
+		if err := cc.checkPolicy(ctx, a.LoginName, opts.password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		if cred, err = newArgon2Credential(a.PublicId, opts.password, cc.argon2()); err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -220,8 +224,8 @@ func validLoginName(u string) bool {
 // Account containing the updated values and a count of the number of
 // records updated. a is not changed.
 //
-// a must contain a valid PublicId. Only a.Name, a.Description and
-// a.LoginName can be updated. If a.Name is set to a non-empty string, it
+// a must contain a valid PublicId. Only a.Name, a.Description, a.LoginName
+// and a.State can be updated. If a.Name is set to a non-empty string, it
 // must be unique within a.AuthMethodId. If a.LoginName is set to a
 // non-empty string, it must be unique within a.AuthMethodId.
 //
@@ -251,6 +255,10 @@ func (r *Repository) UpdateAccount(ctx context.Context, scopeId string, a *Accou
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
+		case strings.EqualFold("State", f):
+			if !auth.ValidAccountState(a.State) {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid state: %q", a.State))
+			}
 		case strings.EqualFold("LoginName", f):
 			if !validLoginName(a.LoginName) {
 				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op,
@@ -267,6 +275,7 @@ func (r *Repository) UpdateAccount(ctx context.Context, scopeId string, a *Accou
 			"Name":        a.Name,
 			"Description": a.Description,
 			"LoginName":   a.LoginName,
+			"State":       a.State,
 		},
 		fieldMaskPaths,
 		nil,
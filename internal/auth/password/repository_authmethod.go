@@ -173,9 +173,16 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // not be set to null, but instead use the default values returned by
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
-// value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// value and included in fieldMask, except for the boolean and numeric
+// password policy, account lockout, MfaRequired and auth token lifetime fields
+// which are set to their zero value. Name, Description, MinPasswordLength,
+// MinLoginNameLength, the password policy fields RequireUppercase,
+// RequireLowercase, RequireDigit, RequireSpecial, DisallowLoginName,
+// DisallowedWords, PasswordHistoryCount and MaxPasswordAgeSeconds, the account
+// lockout fields LockoutThreshold and LockoutDurationSeconds, MfaRequired and
+// the auth token lifetime fields AuthTokenTimeToLiveSeconds and
+// AuthTokenTimeToStaleSeconds are the only updatable fields, If no updatable
+// fields are included in the fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -193,6 +200,19 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("RequireUppercase", f):
+		case strings.EqualFold("RequireLowercase", f):
+		case strings.EqualFold("RequireDigit", f):
+		case strings.EqualFold("RequireSpecial", f):
+		case strings.EqualFold("DisallowLoginName", f):
+		case strings.EqualFold("DisallowedWords", f):
+		case strings.EqualFold("PasswordHistoryCount", f):
+		case strings.EqualFold("MaxPasswordAgeSeconds", f):
+		case strings.EqualFold("LockoutThreshold", f):
+		case strings.EqualFold("LockoutDurationSeconds", f):
+		case strings.EqualFold("MfaRequired", f):
+		case strings.EqualFold("AuthTokenTimeToLiveSeconds", f):
+		case strings.EqualFold("AuthTokenTimeToStaleSeconds", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -200,13 +220,39 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":               authMethod.Name,
-			"Description":        authMethod.Description,
-			"MinPasswordLength":  authMethod.MinPasswordLength,
-			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"Name":                        authMethod.Name,
+			"Description":                 authMethod.Description,
+			"MinPasswordLength":           authMethod.MinPasswordLength,
+			"MinLoginNameLength":          authMethod.MinLoginNameLength,
+			"RequireUppercase":            authMethod.RequireUppercase,
+			"RequireLowercase":            authMethod.RequireLowercase,
+			"RequireDigit":                authMethod.RequireDigit,
+			"RequireSpecial":              authMethod.RequireSpecial,
+			"DisallowLoginName":           authMethod.DisallowLoginName,
+			"DisallowedWords":             authMethod.DisallowedWords,
+			"PasswordHistoryCount":        authMethod.PasswordHistoryCount,
+			"MaxPasswordAgeSeconds":       authMethod.MaxPasswordAgeSeconds,
+			"LockoutThreshold":            authMethod.LockoutThreshold,
+			"LockoutDurationSeconds":      authMethod.LockoutDurationSeconds,
+			"MfaRequired":                 authMethod.MfaRequired,
+			"AuthTokenTimeToLiveSeconds":  authMethod.AuthTokenTimeToLiveSeconds,
+			"AuthTokenTimeToStaleSeconds": authMethod.AuthTokenTimeToStaleSeconds,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{
+			"RequireUppercase",
+			"RequireLowercase",
+			"RequireDigit",
+			"RequireSpecial",
+			"DisallowLoginName",
+			"PasswordHistoryCount",
+			"MaxPasswordAgeSeconds",
+			"LockoutThreshold",
+			"LockoutDurationSeconds",
+			"MfaRequired",
+			"AuthTokenTimeToLiveSeconds",
+			"AuthTokenTimeToStaleSeconds",
+		},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
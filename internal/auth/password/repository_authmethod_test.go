@@ -667,6 +667,30 @@ func TestRepository_UpdateAuthMethod(t *testing.T) {
 			wantRowsUpdate:   1,
 			skipVersionCheck: true,
 		},
+		{
+			name: "change auth token lifetime",
+			args: args{
+				updates: &store.AuthMethod{
+					AuthTokenTimeToLiveSeconds:  3600,
+					AuthTokenTimeToStaleSeconds: 600,
+				},
+				fieldMaskPaths: []string{"AuthTokenTimeToLiveSeconds", "AuthTokenTimeToStaleSeconds"},
+			},
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
+		{
+			name: "stale exceeds auth token time to live",
+			args: args{
+				updates: &store.AuthMethod{
+					AuthTokenTimeToLiveSeconds:  60,
+					AuthTokenTimeToStaleSeconds: 3600,
+				},
+				fieldMaskPaths: []string{"AuthTokenTimeToLiveSeconds", "AuthTokenTimeToStaleSeconds"},
+			},
+			wantErr:        true,
+			wantRowsUpdate: 0,
+		},
 		{
 			name: "noop update",
 			args: args{
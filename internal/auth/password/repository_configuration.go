@@ -120,9 +120,17 @@ func (r *Repository) setArgon2Conf(ctx context.Context, scopeId string, c *Argon
 }
 
 type currentConfig struct {
-	ConfType           string
-	MinLoginNameLength int
-	MinPasswordLength  int
+	ConfType              string
+	MinLoginNameLength    int
+	MinPasswordLength     int
+	RequireUppercase      bool
+	RequireLowercase      bool
+	RequireDigit          bool
+	RequireSpecial        bool
+	DisallowLoginName     bool
+	DisallowedWords       string
+	PasswordHistoryCount  int
+	MaxPasswordAgeSeconds int
 
 	*Argon2Configuration
 }
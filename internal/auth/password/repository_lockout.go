@@ -0,0 +1,103 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
+	"golang.org/x/crypto/argon2"
+)
+
+// UnlockAccount unlocks accountId and resets its number of consecutive failed
+// authentication attempts. The account for the accountId is returned.
+// Unlocking an account which is not locked is not an error.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) UnlockAccount(ctx context.Context, accountId string) (*Account, error) {
+	const op = "password.(Repository).UnlockAccount"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, deleteAccountLockoutQuery, []interface{}{sql.Named("password_account_id", accountId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unlock account"))
+	}
+	if rowsDeleted > 0 {
+		writeLockoutEvent(ctx, op, acct, "unlock-account")
+	}
+	return acct, nil
+}
+
+// recordFailedAttempt increments the number of consecutive failed
+// authentication attempts of acct and locks the account once the lockout
+// threshold of its auth method is reached. It does nothing when account
+// lockout is disabled for the auth method.
+func (r *Repository) recordFailedAttempt(ctx context.Context, acct *authAccount) error {
+	const op = "password.(Repository).recordFailedAttempt"
+	if acct.LockoutThreshold == 0 {
+		return nil
+	}
+	args := []interface{}{sql.Named("password_account_id", acct.PublicId)}
+	var locked bool
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, incrementFailedAttemptsQuery, args); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to increment failed attempts"))
+			}
+			rowsUpdated, err := w.Exec(ctx, lockAccountQuery, args)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock account"))
+			}
+			locked = rowsUpdated > 0
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if locked {
+		writeLockoutEvent(ctx, op, acct.Account, "lock-account")
+	}
+	return nil
+}
+
+// resetFailedAttempts resets the number of consecutive failed authentication
+// attempts of accountId.
+func (r *Repository) resetFailedAttempts(ctx context.Context, accountId string) error {
+	const op = "password.(Repository).resetFailedAttempts"
+	if _, err := r.writer.Exec(ctx, deleteAccountLockoutQuery, []interface{}{sql.Named("password_account_id", accountId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to reset failed attempts"))
+	}
+	return nil
+}
+
+// deriveUnusedKey derives an argon2 key from password using the default
+// argon2 configuration and discards it. It is used when authentication fails
+// before a password is compared so that the time taken does not reveal whether
+// an account exists or is locked.
+func deriveUnusedKey(password string) {
+	c := NewArgon2Configuration()
+	_ = argon2.IDKey([]byte(password), make([]byte, c.SaltLength), c.Iterations, c.Memory, uint8(c.Threads), c.KeyLength)
+}
+
+// writeLockoutEvent writes an audit event for the operation which locked or
+// unlocked acct.
+func writeLockoutEvent(ctx context.Context, op event.Op, acct *Account, operation string) {
+	details := &pb.Account{
+		Id:           acct.GetPublicId(),
+		AuthMethodId: acct.GetAuthMethodId(),
+	}
+	if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{Operation: operation, Details: details})); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write account lockout audit event", "account id", acct.GetPublicId()))
+	}
+}
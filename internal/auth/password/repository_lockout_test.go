@@ -0,0 +1,201 @@
+package password
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AccountLockout(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, threshold, duration uint32) (*AuthMethod, *Account) {
+		t.Helper()
+		am := TestAuthMethod(t, conn, o.GetPublicId())
+		am.LockoutThreshold = threshold
+		am.LockoutDurationSeconds = duration
+		am, _, err := repo.UpdateAuthMethod(ctx, am, am.Version, []string{"LockoutThreshold", "LockoutDurationSeconds"})
+		require.NoError(t, err)
+		acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+		acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "password-1", acct.Version)
+		require.NoError(t, err)
+		return am, acct
+	}
+	authenticate := func(t *testing.T, am *AuthMethod, loginName, pw string) *Account {
+		t.Helper()
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), loginName, pw)
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		am, _ := setup(t, 0, 0)
+		for i := 0; i < 5; i++ {
+			assert.Nil(t, authenticate(t, am, "alice", "wrong-password"))
+		}
+		assert.NotNil(t, authenticate(t, am, "alice", "password-1"))
+	})
+	t.Run("success-resets-failed-attempts", func(t *testing.T) {
+		am, _ := setup(t, 3, 0)
+		for i := 0; i < 4; i++ {
+			assert.Nil(t, authenticate(t, am, "alice", "wrong-password"))
+			assert.Nil(t, authenticate(t, am, "alice", "wrong-password"))
+			assert.NotNil(t, authenticate(t, am, "alice", "password-1"))
+		}
+	})
+	t.Run("locked-until-unlocked", func(t *testing.T) {
+		am, acct := setup(t, 3, 0)
+		for i := 0; i < 3; i++ {
+			assert.Nil(t, authenticate(t, am, "alice", "wrong-password"))
+		}
+		assert.Nil(t, authenticate(t, am, "alice", "password-1"))
+
+		got, err := repo.UnlockAccount(ctx, acct.PublicId)
+		require.NoError(t, err)
+		assert.Equal(t, acct.PublicId, got.PublicId)
+		assert.Equal(t, acct.Version, got.Version)
+		assert.NotNil(t, authenticate(t, am, "alice", "password-1"))
+	})
+	t.Run("locked-for-duration", func(t *testing.T) {
+		am, _ := setup(t, 2, 1)
+		for i := 0; i < 2; i++ {
+			assert.Nil(t, authenticate(t, am, "alice", "wrong-password"))
+		}
+		assert.Nil(t, authenticate(t, am, "alice", "password-1"))
+		time.Sleep(2 * time.Second)
+		assert.NotNil(t, authenticate(t, am, "alice", "password-1"))
+	})
+	t.Run("unknown-login-name", func(t *testing.T) {
+		am, _ := setup(t, 1, 0)
+		assert.Nil(t, authenticate(t, am, "bob", "password-1"))
+		assert.NotNil(t, authenticate(t, am, "alice", "password-1"))
+	})
+	t.Run("audit-events", func(t *testing.T) {
+		require := require.New(t)
+		am, acct := setup(t, 2, 0)
+		c := event.TestEventerConfig(t, "TestRepository_AccountLockout", event.TestWithAuditSink(t))
+		testLock := &sync.Mutex{}
+		testLogger := hclog.New(&hclog.LoggerOptions{Mutex: testLock, Name: "test"})
+		e, err := event.NewEventer(testLogger, testLock, "TestRepository_AccountLockout", c.EventerConfig)
+		require.NoError(err)
+		eventCtx, err := event.NewEventerContext(ctx, e)
+		require.NoError(err)
+
+		for i := 0; i < 2; i++ {
+			got, err := repo.Authenticate(eventCtx, o.GetPublicId(), am.GetPublicId(), "alice", "wrong-password")
+			require.NoError(err)
+			assert.Nil(t, got)
+		}
+		_, err = repo.UnlockAccount(eventCtx, acct.PublicId)
+		require.NoError(err)
+
+		// Unlocking an account which is not locked is not audited.
+		_, err = repo.UnlockAccount(eventCtx, acct.PublicId)
+		require.NoError(err)
+
+		got := testLockoutAuditEvents(t, c.AuditEvents.Name())
+		require.Len(got, 2)
+		for i, want := range []string{"lock-account", "unlock-account"} {
+			assert.Equal(t, want, got[i]["operation"])
+			details, ok := got[i]["details"].(map[string]any)
+			require.True(ok)
+			assert.Equal(t, acct.PublicId, details["id"])
+			assert.Equal(t, am.PublicId, details["auth_method_id"])
+		}
+	})
+}
+
+// testLockoutAuditEvents returns the requests of the audit events written to
+// the audit sink file, in the order they were written. An audit event may be
+// written more than once, so the events are deduplicated by their id.
+func testLockoutAuditEvents(t *testing.T, fileName string) []map[string]any {
+	t.Helper()
+	b, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	var reqs []map[string]any
+	seen := map[string]bool{}
+	for _, l := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		if l == "" {
+			continue
+		}
+		var got struct {
+			Data struct {
+				Id      string         `json:"id"`
+				Request map[string]any `json:"request"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(l), &got))
+		if seen[got.Data.Id] {
+			continue
+		}
+		seen[got.Data.Id] = true
+		reqs = append(reqs, got.Data.Request)
+	}
+	return reqs
+}
+
+func TestRepository_UnlockAccount(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+
+	tests := []struct {
+		name      string
+		accountId string
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "missing-account-id",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "account-not-found",
+			accountId: "apw_1234567890",
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "not-locked",
+			accountId: acct.PublicId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.UnlockAccount(ctx, tt.accountId)
+			if tt.wantIsErr != 0 {
+				require.Error(t, err)
+				assert.Truef(t, errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.accountId, got.PublicId)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 
@@ -18,13 +19,33 @@ type authAccount struct {
 	*Account
 	*Argon2Credential
 	*Argon2Configuration
-	IsCurrentConf bool
+	IsCurrentConf     bool
+	IsPasswordExpired bool
+	LockoutThreshold  uint32
+	FailedAttempts    uint32
+	IsLocked          bool
+	MfaRequired       bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
 // authMethodId. The account for the loginName is returned if authentication
 // is successful. Returns nil if authentication fails.
 //
+// Each failed authentication increments the number of consecutive failed
+// attempts of the account. Once the lockout threshold of authMethodId is
+// reached the account is locked and Authenticate returns nil for the account,
+// even if the password matches, until the lockout duration has passed or the
+// account is unlocked with UnlockAccount. A successful authentication resets
+// the number of failed attempts. Authenticate also returns nil for disabled
+// accounts.
+//
+// If the account has a verified TOTP enrollment, a TOTP code or an unused
+// recovery code must be provided with WithTotpCode. An invalid code counts as
+// a failed attempt. Authenticate returns an error with code MfaCodeRequired if
+// no code is provided and an error with code MfaNotEnrolled if authMethodId
+// requires multi-factor authentication and the account has no verified
+// enrollment.
+//
 // The CredentialId in the returned account represents a user's current
 // password. A new CredentialId is generated when a user's password is
 // changed and the old one is deleted.
@@ -32,7 +53,13 @@ type authAccount struct {
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+//
+// If the password has exceeded the maximum password age of authMethodId,
+// Authenticate returns an error with code PasswordExpired unless
+// WithNewPassword is provided, in which case the password is changed to the
+// new password before the account is returned. The new password must satisfy
+// the password policy of authMethodId.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).Authenticate"
 	if authMethodId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
@@ -60,6 +87,36 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 		return nil, nil
 	}
 
+	opts := getOpts(opt...)
+	ok, err := r.checkTotp(ctx, scopeId, acct, opts.withTotpCode)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !ok {
+		return nil, nil
+	}
+	if acct.FailedAttempts > 0 {
+		if err := r.resetFailedAttempts(ctx, acct.PublicId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	if acct.IsPasswordExpired {
+		if opts.withNewPassword == "" {
+			return nil, errors.New(ctx, errors.PasswordExpired, op, "password has expired")
+		}
+		updatedAccount, err := r.ChangePassword(ctx, scopeId, acct.Account.PublicId, password, opts.withNewPassword, acct.Account.Version)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to change expired password"))
+		}
+		if updatedAccount == nil {
+			return nil, nil
+		}
+		acct.Account.Version = updatedAccount.Version
+		acct.Account.CredentialId = updatedAccount.CredentialId
+		return acct.Account, nil
+	}
+
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
 		if err != nil {
@@ -110,6 +167,9 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 // Returns nil, db.ErrorRecordNotFound if the account doesn't exist.
 // Returns nil, nil if old does not match the stored password for accountId.
 // Returns nil, error with code PasswordsEqual if old and new are equal.
+// Returns nil, error with code PasswordTooWeak, PasswordDisallowed or
+// PasswordReused if new does not satisfy the password policy of the
+// account's auth method.
 func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old, new string, version uint32) (*Account, error) {
 	const op = "password.(Repository).ChangePassword"
 	if accountId == "" {
@@ -155,6 +215,11 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if acct == nil {
 		return nil, nil
 	}
+	if acct.FailedAttempts > 0 {
+		if err := r.resetFailedAttempts(ctx, acct.PublicId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	cc, err := r.currentConfig(ctx, authAccount.GetAuthMethodId())
 	if err != nil {
@@ -163,6 +228,12 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if cc.MinPasswordLength > len(new) {
 		return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("must be at least %d", cc.MinPasswordLength))
 	}
+	if err := cc.checkPolicy(ctx, authAccount.GetLoginName(), new); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := r.checkHistory(ctx, scopeId, accountId, new, cc); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	newCred, err := newArgon2Credential(accountId, new, cc.argon2())
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -230,6 +301,7 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	var acct authAccount
 	switch {
 	case len(accts) == 0:
+		deriveUnusedKey(password)
 		return nil, nil
 	case len(accts) > 1:
 		// this should never happen
@@ -238,6 +310,16 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 		acct = accts[0]
 	}
 
+	if acct.IsLocked {
+		deriveUnusedKey(password)
+		writeLockoutEvent(ctx, op, acct.Account, "authentication attempted for locked account")
+		return nil, nil
+	}
+	if auth.IsDisabledAccount(acct.Account) {
+		deriveUnusedKey(password)
+		return nil, nil
+	}
+
 	// We don't pass a wrapper in here because for ecryption we want to indicate the expected key ID
 	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(acct.GetKeyId()))
 	if err != nil {
@@ -251,6 +333,9 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
 	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
 		// authentication failed, password does not match
+		if err := r.recordFailedAttempt(ctx, &acct); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		return nil, nil
 	}
 	return &acct, nil
@@ -258,6 +343,8 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
+// A non-empty password must satisfy the password policy of the account's
+// auth method.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
 	const op = "password.(Repository).SetPassword"
 	if accountId == "" {
@@ -291,6 +378,19 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		if cc.MinPasswordLength > len(password) {
 			return nil, errors.New(ctx, errors.PasswordTooShort, op, fmt.Sprintf("password must be at least %v", cc.MinPasswordLength))
 		}
+		authAccount, err := r.LookupAccount(ctx, accountId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if authAccount == nil {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+		}
+		if err := cc.checkPolicy(ctx, authAccount.GetLoginName(), password); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := r.checkHistory(ctx, scopeId, accountId, password, cc); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
 		newCred, err = newArgon2Credential(accountId, password, cc.argon2())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/auth/password/store"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -128,6 +129,45 @@ func TestRepository_Authenticate(t *testing.T) {
 	}
 }
 
+func TestRepository_Authenticate_disabled(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	assert, require := assert.New(t), require.New(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	ctx := context.Background()
+	passwd := "12345678"
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(passwd))
+	require.NoError(err)
+
+	acct.State = string(auth.DisabledAccountState)
+	acct, _, err = repo.UpdateAccount(ctx, o.GetPublicId(), acct, acct.Version, []string{"State"})
+	require.NoError(err)
+	assert.Equal(string(auth.DisabledAccountState), acct.GetState())
+
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+	require.NoError(err)
+	assert.Nil(authAcct)
+
+	acct.State = string(auth.ActiveAccountState)
+	acct, _, err = repo.UpdateAccount(ctx, o.GetPublicId(), acct, acct.Version, []string{"State"})
+	require.NoError(err)
+
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+}
+
 func TestRepository_AuthenticateRehash(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
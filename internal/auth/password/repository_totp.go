@@ -0,0 +1,287 @@
+package password
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// EnrollTotp creates a new unverified TOTP enrollment for accountId. The
+// account for the accountId and an otpauth URI containing the secret of the
+// enrollment are returned. The enrollment is not used for authentication until
+// it has been verified with VerifyTotp. An unverified enrollment of the account
+// is replaced right away, while a verified enrollment keeps being used for
+// authentication until the new enrollment has been verified.
+//
+// Returns nil, "", error with code RecordNotFound if the account doesn't
+// exist.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (*Account, string, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if accountId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, "", errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, "", errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	totp, err := newTotp(ctx, accountId)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	uri := totp.otpauthUri(acct.GetLoginName())
+	if err := totp.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			oldTotp := allocTotp()
+			if err := reader.LookupWhere(ctx, oldTotp, "password_account_id = ?", []interface{}{accountId}); err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up existing enrollment"))
+			}
+			totp.SetTableName(totpTableName)
+			if oldTotp.Verified {
+				// the verified enrollment stays in use until the new
+				// enrollment has been verified
+				totp.SetTableName(pendingTotpTableName)
+			}
+			oldTotp = allocTotp()
+			oldTotp.PasswordAccountId = accountId
+			oldTotp.SetTableName(totp.TableName())
+			if _, err := w.Delete(ctx, oldTotp); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete existing enrollment"))
+			}
+			if err := w.Create(ctx, totp); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create enrollment"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return acct, uri, nil
+}
+
+// VerifyTotp verifies the TOTP enrollment of accountId with code, a code
+// generated from the otpauth URI returned by EnrollTotp. Once verified the
+// enrollment is used to authenticate the account. The account for the
+// accountId and a set of single use recovery codes, which can be used in place
+// of a TOTP code when authenticating, are returned.
+//
+// If the account has a verified enrollment and a pending enrollment created by
+// EnrollTotp, the pending enrollment is verified and replaces the verified
+// enrollment.
+//
+// Returns nil, nil, error with code RecordNotFound if the account doesn't
+// exist or has no enrollment, InvalidParameter if the enrollment has already
+// been verified and MfaInvalidCode if code is not valid.
+func (r *Repository) VerifyTotp(ctx context.Context, scopeId, accountId, code string) (*Account, []string, error) {
+	const op = "password.(Repository).VerifyTotp"
+	if accountId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if code == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, "account not found")
+	}
+	totp, err := r.lookupTotp(ctx, pendingTotpTableName, scopeId, accountId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	pending := totp != nil
+	if !pending {
+		totp, err = r.lookupTotp(ctx, totpTableName, scopeId, accountId)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if totp == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, "account has no TOTP enrollment")
+	}
+	if totp.Verified {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "TOTP enrollment has already been verified")
+	}
+	step, ok := totp.validateCode(code, time.Now())
+	if !ok {
+		return nil, nil, errors.New(ctx, errors.MfaInvalidCode, op, "invalid TOTP code")
+	}
+
+	recoveryCodes, err := totp.setRecoveryCodes(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	totp.Verified = true
+	totp.LastUsedStep = step
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := totp.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if pending {
+		if err := r.replaceTotp(ctx, totp); err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		return acct, recoveryCodes, nil
+	}
+	rowsUpdated, err := r.writer.Update(ctx, totp, []string{"CtSecret", "CtRecoveryCodes", "KeyId", "Verified", "LastUsedStep"}, nil,
+		db.WithWhere("verified = false"))
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update enrollment"))
+	}
+	if rowsUpdated != 1 {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "TOTP enrollment has already been verified")
+	}
+	return acct, recoveryCodes, nil
+}
+
+// replaceTotp replaces the verified enrollment of an account with totp, its
+// verified pending enrollment.
+func (r *Repository) replaceTotp(ctx context.Context, totp *Totp) error {
+	const op = "password.(Repository).replaceTotp"
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			pendingTotp := allocTotp()
+			pendingTotp.PasswordAccountId = totp.PasswordAccountId
+			pendingTotp.SetTableName(pendingTotpTableName)
+			rowsDeleted, err := w.Delete(ctx, pendingTotp)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete pending enrollment"))
+			}
+			if rowsDeleted != 1 {
+				return errors.New(ctx, errors.InvalidParameter, op, "TOTP enrollment has already been verified")
+			}
+			oldTotp := allocTotp()
+			oldTotp.PasswordAccountId = totp.PasswordAccountId
+			if _, err := w.Delete(ctx, oldTotp); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete existing enrollment"))
+			}
+			totp.SetTableName(totpTableName)
+			if err := w.Create(ctx, totp); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create enrollment"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// lookupTotp returns the decrypted TOTP enrollment of accountId in tableName
+// or nil if the account has no enrollment in the table.
+func (r *Repository) lookupTotp(ctx context.Context, tableName, scopeId, accountId string) (*Totp, error) {
+	const op = "password.(Repository).lookupTotp"
+	totp := allocTotp()
+	totp.SetTableName(tableName)
+	if err := r.reader.LookupWhere(ctx, totp, "password_account_id = ?", []interface{}{accountId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(totp.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := totp.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return totp, nil
+}
+
+// checkTotp checks code against the verified TOTP enrollment of acct. It
+// returns true if the account has no verified enrollment and its auth method
+// does not require multi-factor authentication, or if code is a valid TOTP
+// code or an unused recovery code. A TOTP code can only be used once and a
+// recovery code is removed once it has been used. An invalid code counts as a
+// failed authentication attempt.
+//
+// Returns false, error with code MfaNotEnrolled if the auth method requires
+// multi-factor authentication and the account has no verified enrollment,
+// and MfaCodeRequired if code is empty.
+func (r *Repository) checkTotp(ctx context.Context, scopeId string, acct *authAccount, code string) (bool, error) {
+	const op = "password.(Repository).checkTotp"
+	totp, err := r.lookupTotp(ctx, totpTableName, scopeId, acct.PublicId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if totp == nil || !totp.Verified {
+		if acct.MfaRequired {
+			return false, errors.New(ctx, errors.MfaNotEnrolled, op, "account has no verified TOTP enrollment", errors.WithoutEvent())
+		}
+		return true, nil
+	}
+	if code == "" {
+		return false, errors.New(ctx, errors.MfaCodeRequired, op, "missing TOTP code", errors.WithoutEvent())
+	}
+
+	if step, ok := totp.validateCode(code, time.Now()); ok {
+		lastUsedStep := totp.LastUsedStep
+		totp.LastUsedStep = step
+		rowsUpdated, err := r.writer.Update(ctx, totp, []string{"LastUsedStep"}, nil,
+			db.WithWhere("last_used_step = ?", lastUsedStep))
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update last used step"))
+		}
+		if rowsUpdated == 1 {
+			return true, nil
+		}
+		// the code was used by a concurrent authentication
+	} else {
+		oldCtRecoveryCodes := totp.CtRecoveryCodes
+		used, err := totp.useRecoveryCode(ctx, code)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		if used {
+			databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+			if err != nil {
+				return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+			}
+			if err := totp.encrypt(ctx, databaseWrapper); err != nil {
+				return false, errors.Wrap(ctx, err, op)
+			}
+			rowsUpdated, err := r.writer.Update(ctx, totp, []string{"CtSecret", "CtRecoveryCodes", "KeyId"}, nil,
+				db.WithWhere("recovery_codes = ?", oldCtRecoveryCodes))
+			if err != nil {
+				return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove used recovery code"))
+			}
+			if rowsUpdated == 1 {
+				return true, nil
+			}
+			// the recovery codes were changed by a concurrent authentication
+		}
+	}
+
+	if err := r.recordFailedAttempt(ctx, acct); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return false, nil
+}
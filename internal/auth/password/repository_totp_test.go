@@ -0,0 +1,266 @@
+package password
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_EnrollTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+
+	tests := []struct {
+		name      string
+		scopeId   string
+		accountId string
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "missing-account-id",
+			scopeId:   o.GetPublicId(),
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "missing-scope-id",
+			accountId: acct.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "account-not-found",
+			scopeId:   o.GetPublicId(),
+			accountId: "apw_1234567890",
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "valid",
+			scopeId:   o.GetPublicId(),
+			accountId: acct.PublicId,
+		},
+		{
+			name:      "valid-replaces-enrollment",
+			scopeId:   o.GetPublicId(),
+			accountId: acct.PublicId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, uri, err := repo.EnrollTotp(ctx, tt.scopeId, tt.accountId)
+			if tt.wantIsErr != 0 {
+				require.Error(t, err)
+				assert.Truef(t, errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(t, got)
+				assert.Empty(t, uri)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.accountId, got.PublicId)
+			assert.Contains(t, uri, "otpauth://totp/Boundary:alice?")
+
+			totp, err := repo.lookupTotp(ctx, totpTableName, tt.scopeId, tt.accountId)
+			require.NoError(t, err)
+			require.NotNil(t, totp)
+			assert.False(t, totp.Verified)
+			assert.NotEmpty(t, totp.KeyId)
+			u, err := url.Parse(uri)
+			require.NoError(t, err)
+			assert.Equal(t, totpEncoding.EncodeToString(totp.Secret), u.Query().Get("secret"))
+		})
+	}
+}
+
+func TestRepository_VerifyTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+
+	t.Run("not-enrolled", func(t *testing.T) {
+		acct := TestAccount(t, conn, am.GetPublicId(), "not-enrolled")
+		got, codes, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "123456")
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+		assert.Nil(t, got)
+		assert.Nil(t, codes)
+	})
+	t.Run("missing-code", func(t *testing.T) {
+		acct := TestAccount(t, conn, am.GetPublicId(), "missing-code")
+		_, _, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "")
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("invalid-code", func(t *testing.T) {
+		acct := TestAccount(t, conn, am.GetPublicId(), "invalid-code")
+		_, uri, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		_, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, uri, -5))
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.MfaInvalidCode), err), "want err code: %q got: %q", errors.MfaInvalidCode, err)
+	})
+	t.Run("valid", func(t *testing.T) {
+		acct := TestAccount(t, conn, am.GetPublicId(), "valid")
+		_, uri, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		got, codes, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, uri, 0))
+		require.NoError(t, err)
+		assert.Equal(t, acct.PublicId, got.PublicId)
+		assert.Len(t, codes, recoveryCodeCount)
+
+		totp, err := repo.lookupTotp(ctx, totpTableName, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		assert.True(t, totp.Verified)
+
+		_, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, uri, 1))
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+}
+
+func TestRepository_AuthenticateTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, mfaRequired bool, enroll bool) (*AuthMethod, string, []string) {
+		t.Helper()
+		am := TestAuthMethod(t, conn, o.GetPublicId())
+		am.MfaRequired = mfaRequired
+		am.LockoutThreshold = 3
+		am, _, err := repo.UpdateAuthMethod(ctx, am, am.Version, []string{"MfaRequired", "LockoutThreshold"})
+		require.NoError(t, err)
+		acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+		acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "password-1", acct.Version)
+		require.NoError(t, err)
+		if !enroll {
+			return am, "", nil
+		}
+		_, uri, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		_, codes, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, uri, -1))
+		require.NoError(t, err)
+		return am, uri, codes
+	}
+	authenticate := func(am *AuthMethod, pw string, opt ...Option) (*Account, error) {
+		return repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "alice", pw, opt...)
+	}
+
+	t.Run("not-enrolled", func(t *testing.T) {
+		am, _, _ := setup(t, false, false)
+		got, err := authenticate(am, "password-1")
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+	t.Run("not-enrolled-mfa-required", func(t *testing.T) {
+		am, _, _ := setup(t, true, false)
+		got, err := authenticate(am, "password-1")
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.MfaNotEnrolled), err), "want err code: %q got: %q", errors.MfaNotEnrolled, err)
+		assert.Nil(t, got)
+	})
+	t.Run("missing-code", func(t *testing.T) {
+		am, _, _ := setup(t, false, true)
+		got, err := authenticate(am, "password-1")
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.MfaCodeRequired), err), "want err code: %q got: %q", errors.MfaCodeRequired, err)
+		assert.Nil(t, got)
+	})
+	t.Run("wrong-password", func(t *testing.T) {
+		am, uri, _ := setup(t, true, true)
+		got, err := authenticate(am, "wrong-password", WithTotpCode(TestTotpCode(t, uri, 0)))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("valid-code-only-once", func(t *testing.T) {
+		am, uri, _ := setup(t, true, true)
+		code := TestTotpCode(t, uri, 0)
+		got, err := authenticate(am, "password-1", WithTotpCode(code))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		got, err = authenticate(am, "password-1", WithTotpCode(code))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("recovery-code-only-once", func(t *testing.T) {
+		am, _, codes := setup(t, true, true)
+		got, err := authenticate(am, "password-1", WithTotpCode(codes[0]))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		got, err = authenticate(am, "password-1", WithTotpCode(codes[0]))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = authenticate(am, "password-1", WithTotpCode(codes[1]))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+	t.Run("re-enroll-keeps-verified-enrollment", func(t *testing.T) {
+		am, oldUri, _ := setup(t, true, true)
+		acct, err := authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, oldUri, 0)))
+		require.NoError(t, err)
+		require.NotNil(t, acct)
+		_, newUri, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+
+		got, err := authenticate(am, "password-1")
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.MfaCodeRequired), err), "want err code: %q got: %q", errors.MfaCodeRequired, err)
+		assert.Nil(t, got)
+		got, err = authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, newUri, 1)))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		got, err = authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, oldUri, 1)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		_, _, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, newUri, 0))
+		require.NoError(t, err)
+		pending, err := repo.lookupTotp(ctx, pendingTotpTableName, o.GetPublicId(), acct.PublicId)
+		require.NoError(t, err)
+		assert.Nil(t, pending)
+
+		got, err = authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, oldUri, -1)))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+		got, err = authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, newUri, 1)))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+	})
+	t.Run("invalid-codes-lock-account", func(t *testing.T) {
+		am, uri, _ := setup(t, true, true)
+		for i := 0; i < 3; i++ {
+			got, err := authenticate(am, "password-1", WithTotpCode("000000"))
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+		got, err := authenticate(am, "password-1", WithTotpCode(TestTotpCode(t, uri, 1)))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
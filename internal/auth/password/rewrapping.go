@@ -0,0 +1,212 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("auth_password_argon2_cred", argon2CredentialRewrapFn)
+	kms.RegisterTableRewrapFn(historyCredentialTableName, historyCredentialRewrapFn)
+	kms.RegisterTableRewrapFn(totpTableName, totpRewrapFn)
+	kms.RegisterTableRewrapFn(pendingTotpTableName, pendingTotpRewrapFn)
+}
+
+// argon2CredentialRewrapFn re-encrypts the salts of the argon2 credentials which
+// were encrypted with the data key version using the current database wrapper
+// of the scope.
+func argon2CredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.argon2CredentialRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	var creds []*Argon2Credential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range creds {
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt argon2 credential"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt argon2 credential"))
+		}
+		if _, err := writer.Update(ctx, cred, []string{"CtSalt", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update argon2 credential row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// historyCredentialRewrapFn re-encrypts the salts of the argon2 credentials in
+// the password history which were encrypted with the data key version using
+// the current database wrapper of the scope.
+func historyCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.historyCredentialRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	rows, err := reader.Query(ctx, historyCredentialRewrapQuery, []interface{}{sql.Named("key_id", dataKeyVersionId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	defer rows.Close()
+	var creds []*Argon2Credential
+	for rows.Next() {
+		cred := &Argon2Credential{
+			Argon2Credential: &store.Argon2Credential{},
+		}
+		if err := reader.ScanRows(ctx, rows, cred); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to scan row"))
+		}
+		creds = append(creds, cred)
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range creds {
+		cred.SetTableName(historyCredentialTableName)
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt argon2 credential"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt argon2 credential"))
+		}
+		if _, err := writer.Update(ctx, cred, []string{"CtSalt", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update argon2 credential history row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// totpRewrapFn re-encrypts the secrets and recovery codes of the TOTP
+// enrollments which were encrypted with the data key version using the
+// current database wrapper of the scope.
+func totpRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.totpRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	var totps []*Totp
+	if err := reader.SearchWhere(ctx, &totps, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(totps) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, totp := range totps {
+		if err := totp.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt totp enrollment"))
+		}
+		if err := totp.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt totp enrollment"))
+		}
+		if _, err := writer.Update(ctx, totp, []string{"CtSecret", "CtRecoveryCodes", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update totp enrollment row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// pendingTotpRewrapFn re-encrypts the secrets and recovery codes of the
+// pending TOTP enrollments which were encrypted with the data key version
+// using the current database wrapper of the scope.
+func pendingTotpRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "password.pendingTotpRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	rows, err := reader.Query(ctx, pendingTotpRewrapQuery, []interface{}{sql.Named("key_id", dataKeyVersionId)})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	defer rows.Close()
+	var totps []*Totp
+	for rows.Next() {
+		totp := allocTotp()
+		if err := reader.ScanRows(ctx, rows, totp); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to scan row"))
+		}
+		totps = append(totps, totp)
+	}
+	if len(totps) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, totp := range totps {
+		totp.SetTableName(pendingTotpTableName)
+		if err := totp.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt pending totp enrollment"))
+		}
+		if err := totp.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt pending totp enrollment"))
+		}
+		if _, err := writer.Update(ctx, totp, []string{"CtSecret", "CtRecoveryCodes", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update pending totp enrollment row with rewrapped fields"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package password
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrap_argon2CredentialRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := argon2CredentialRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = argon2CredentialRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		authMethod := TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+
+		repo, err := NewRepository(rw, rw, kmsCache)
+		require.NoError(err)
+		acct, err := repo.CreateAccount(ctx, org.GetPublicId(), &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.GetPublicId(),
+				LoginName:    "kazmierczak",
+			},
+		}, WithPassword("12345678"))
+		require.NoError(err)
+
+		orig := &Argon2Credential{Argon2Credential: &store.Argon2Credential{}}
+		require.NoError(rw.LookupWhere(ctx, orig, "password_account_id = ?", []interface{}{acct.GetPublicId()}))
+
+		require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.True(referenced)
+
+		require.NoError(argon2CredentialRewrapFn(ctx, orig.GetKeyId(), org.GetPublicId(), rw, rw, kmsCache))
+
+		got := &Argon2Credential{Argon2Credential: &store.Argon2Credential{PrivateId: orig.GetPrivateId()}}
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtSalt(), got.GetCtSalt())
+		assert.Equal(orig.GetDerivedKey(), got.GetDerivedKey())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		require.NoError(orig.decrypt(ctx, databaseWrapper))
+		assert.Equal(orig.GetSalt(), got.GetSalt())
+
+		// The rewrapped salt must still authenticate the account
+		authAcct, err := repo.Authenticate(ctx, org.GetPublicId(), authMethod.GetPublicId(), "kazmierczak", "12345678")
+		require.NoError(err)
+		assert.Equal(acct.GetPublicId(), authAcct.GetPublicId())
+
+		referenced, err = kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
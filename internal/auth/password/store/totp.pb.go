@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: controller/storage/auth/password/store/v1/totp.proto
+
+// Package store provides protobufs for storing types in the password package.
+
+package store
+
+import (
+	timestamp "github.com/hashicorp/boundary/internal/db/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Totp is a time-based one-time password (TOTP) enrollment of an Account.
+type Totp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: `gorm:"primary_key"`
+	PasswordAccountId string `protobuf:"bytes,1,opt,name=password_account_id,json=passwordAccountId,proto3" json:"password_account_id,omitempty" gorm:"primary_key"`
+	// The create_time is set by the database.
+	// @inject_tag: `gorm:"default:current_timestamp"`
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// The update_time is set by the database.
+	// @inject_tag: `gorm:"default:current_timestamp"`
+	UpdateTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	// ct_secret is the encrypted TOTP secret which is stored in the database.
+	// @inject_tag: `gorm:"column:secret;not_null" wrapping:"ct,totp_secret"`
+	CtSecret []byte `protobuf:"bytes,4,opt,name=ct_secret,json=ctSecret,proto3" json:"ct_secret,omitempty" gorm:"column:secret;not_null" wrapping:"ct,totp_secret"`
+	// secret is the unencrypted TOTP secret which is not stored in the database.
+	// @inject_tag: `gorm:"-" wrapping:"pt,totp_secret"`
+	Secret []byte `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty" gorm:"-" wrapping:"pt,totp_secret"`
+	// ct_recovery_codes is the encrypted JSON array of unused recovery codes
+	// which is stored in the database.
+	// @inject_tag: `gorm:"column:recovery_codes;not_null" wrapping:"ct,totp_recovery_codes"`
+	CtRecoveryCodes []byte `protobuf:"bytes,6,opt,name=ct_recovery_codes,json=ctRecoveryCodes,proto3" json:"ct_recovery_codes,omitempty" gorm:"column:recovery_codes;not_null" wrapping:"ct,totp_recovery_codes"`
+	// recovery_codes is the unencrypted JSON array of unused recovery codes
+	// which is not stored in the database.
+	// @inject_tag: `gorm:"-" wrapping:"pt,totp_recovery_codes"`
+	RecoveryCodes []byte `protobuf:"bytes,7,opt,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty" gorm:"-" wrapping:"pt,totp_recovery_codes"`
+	// key_id is the key ID that was used for the encryption operation. It can be
+	// used to identify a specific version of the key needed to decrypt the value,
+	// which is useful for caching purposes.
+	// @inject_tag: `gorm:"not_null"`
+	KeyId string `protobuf:"bytes,8,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty" gorm:"not_null"`
+	// verified is true once the enrollment has been verified with a TOTP code.
+	// @inject_tag: `gorm:"not_null"`
+	Verified bool `protobuf:"varint,9,opt,name=verified,proto3" json:"verified,omitempty" gorm:"not_null"`
+	// last_used_step is the TOTP time step of the last code used to
+	// authenticate.
+	// @inject_tag: `gorm:"not_null"`
+	LastUsedStep uint64 `protobuf:"varint,10,opt,name=last_used_step,json=lastUsedStep,proto3" json:"last_used_step,omitempty" gorm:"not_null"`
+}
+
+func (x *Totp) Reset() {
+	*x = Totp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_storage_auth_password_store_v1_totp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Totp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Totp) ProtoMessage() {}
+
+func (x *Totp) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_storage_auth_password_store_v1_totp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Totp.ProtoReflect.Descriptor instead.
+func (*Totp) Descriptor() ([]byte, []int) {
+	return file_controller_storage_auth_password_store_v1_totp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Totp) GetPasswordAccountId() string {
+	if x != nil {
+		return x.PasswordAccountId
+	}
+	return ""
+}
+
+func (x *Totp) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Totp) GetUpdateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Totp) GetCtSecret() []byte {
+	if x != nil {
+		return x.CtSecret
+	}
+	return nil
+}
+
+func (x *Totp) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *Totp) GetCtRecoveryCodes() []byte {
+	if x != nil {
+		return x.CtRecoveryCodes
+	}
+	return nil
+}
+
+func (x *Totp) GetRecoveryCodes() []byte {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+func (x *Totp) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Totp) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Totp) GetLastUsedStep() uint64 {
+	if x != nil {
+		return x.LastUsedStep
+	}
+	return 0
+}
+
+var File_controller_storage_auth_password_store_v1_totp_proto protoreflect.FileDescriptor
+
+var file_controller_storage_auth_password_store_v1_totp_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x74, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb1, 0x03, 0x0a, 0x04, 0x54, 0x6f, 0x74, 0x70, 0x12, 0x2e, 0x0a, 0x13, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x3b, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_controller_storage_auth_password_store_v1_totp_proto_rawDescOnce sync.Once
+	file_controller_storage_auth_password_store_v1_totp_proto_rawDescData = file_controller_storage_auth_password_store_v1_totp_proto_rawDesc
+)
+
+func file_controller_storage_auth_password_store_v1_totp_proto_rawDescGZIP() []byte {
+	file_controller_storage_auth_password_store_v1_totp_proto_rawDescOnce.Do(func() {
+		file_controller_storage_auth_password_store_v1_totp_proto_rawDescData = protoimpl.X.CompressGZIP(file_controller_storage_auth_password_store_v1_totp_proto_rawDescData)
+	})
+	return file_controller_storage_auth_password_store_v1_totp_proto_rawDescData
+}
+
+var file_controller_storage_auth_password_store_v1_totp_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_controller_storage_auth_password_store_v1_totp_proto_goTypes = []interface{}{
+	(*Totp)(nil),                // 0: controller.storage.auth.password.store.v1.Totp
+	(*timestamp.Timestamp)(nil), // 1: controller.storage.timestamp.v1.Timestamp
+}
+var file_controller_storage_auth_password_store_v1_totp_proto_depIdxs = []int32{
+	1, // 0: controller.storage.auth.password.store.v1.Totp.create_time:type_name -> controller.storage.timestamp.v1.Timestamp
+	1, // 1: controller.storage.auth.password.store.v1.Totp.update_time:type_name -> controller.storage.timestamp.v1.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_controller_storage_auth_password_store_v1_totp_proto_init() }
+func file_controller_storage_auth_password_store_v1_totp_proto_init() {
+	if File_controller_storage_auth_password_store_v1_totp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_controller_storage_auth_password_store_v1_totp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Totp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_controller_storage_auth_password_store_v1_totp_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_controller_storage_auth_password_store_v1_totp_proto_goTypes,
+		DependencyIndexes: file_controller_storage_auth_password_store_v1_totp_proto_depIdxs,
+		MessageInfos:      file_controller_storage_auth_password_store_v1_totp_proto_msgTypes,
+	}.Build()
+	File_controller_storage_auth_password_store_v1_totp_proto = out.File
+	file_controller_storage_auth_password_store_v1_totp_proto_rawDesc = nil
+	file_controller_storage_auth_password_store_v1_totp_proto_goTypes = nil
+	file_controller_storage_auth_password_store_v1_totp_proto_depIdxs = nil
+}
@@ -3,7 +3,9 @@ package password
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
@@ -95,3 +97,16 @@ func TestAccount(t testing.TB, conn *db.DB, authMethodId, loginName string, opt
 	require.NoError(err2)
 	return cat
 }
+
+// TestTotpCode returns the TOTP code of the enrollment in otpauthUri, as
+// returned by EnrollTotp, for the current time step plus offset.
+func TestTotpCode(t testing.TB, otpauthUri string, offset int) string {
+	t.Helper()
+	require := require.New(t)
+	u, err := url.Parse(otpauthUri)
+	require.NoError(err)
+	secret, err := totpEncoding.DecodeString(u.Query().Get("secret"))
+	require.NoError(err)
+	step := uint64(time.Now().Unix())/uint64(totpPeriod.Seconds()) + uint64(offset)
+	return totpCode(secret, step)
+}
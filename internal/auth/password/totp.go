@@ -0,0 +1,220 @@
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+)
+
+const (
+	totpTableName = "auth_password_account_totp"
+
+	// pendingTotpTableName is the table containing the unverified enrollments
+	// which replace the verified enrollments of accounts once verified.
+	pendingTotpTableName = "auth_password_account_totp_pending"
+
+	// totpIssuer is the issuer of the otpauth URIs of TOTP enrollments.
+	totpIssuer = "Boundary"
+
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30 * time.Second
+
+	// totpSkew is the number of time steps before and after the current time
+	// step for which codes are accepted to allow for clock drift.
+	totpSkew = 1
+
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// A Totp is a time-based one-time password (TOTP) enrollment of an Account as
+// described in RFC 6238. Codes are 6 digits long, use HMAC-SHA1 and a 30
+// second time step.
+type Totp struct {
+	*store.Totp
+	tableName string
+}
+
+func allocTotp() *Totp {
+	return &Totp{
+		Totp: &store.Totp{},
+	}
+}
+
+// newTotp creates an unverified TOTP enrollment with a random secret for
+// accountId.
+func newTotp(ctx context.Context, accountId string) (*Totp, error) {
+	const op = "password.newTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return &Totp{
+		Totp: &store.Totp{
+			PasswordAccountId: accountId,
+			Secret:            secret,
+			RecoveryCodes:     []byte("[]"),
+		},
+	}, nil
+}
+
+// TableName returns the table name.
+func (t *Totp) TableName() string {
+	if t != nil && t.tableName != "" {
+		return t.tableName
+	}
+	return totpTableName
+}
+
+// SetTableName sets the table name.
+func (t *Totp) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *Totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+func (t *Totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// otpauthUri returns the otpauth URI of the enrollment, which can be imported
+// into an authenticator app, labeled with loginName.
+func (t *Totp) otpauthUri(loginName string) string {
+	q := url.Values{}
+	q.Set("secret", totpEncoding.EncodeToString(t.Secret))
+	q.Set("issuer", totpIssuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(totpDigits))
+	q.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + loginName,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+// validateCode returns the time step of code if it is a valid code for the
+// time steps around now which have not been used yet.
+func (t *Totp) validateCode(code string, now time.Time) (uint64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := uint64(now.Unix()) / uint64(totpPeriod.Seconds())
+	for i := -totpSkew; i <= totpSkew; i++ {
+		step := current + uint64(i)
+		if step <= t.LastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(t.Secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode returns the HOTP value of secret for the counter step as described
+// in RFC 4226.
+func totpCode(secret []byte, step uint64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], step)
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// setRecoveryCodes generates new recovery codes for the enrollment and
+// returns them.
+func (t *Totp) setRecoveryCodes(ctx context.Context) ([]string, error) {
+	const op = "password.(Totp).setRecoveryCodes"
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))[:recoveryCodeLength]
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+	}
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	t.RecoveryCodes = encoded
+	return codes, nil
+}
+
+// useRecoveryCode removes code from the unused recovery codes of the
+// enrollment. It returns false if code is not an unused recovery code.
+func (t *Totp) useRecoveryCode(ctx context.Context, code string) (bool, error) {
+	const op = "password.(Totp).useRecoveryCode"
+	var codes []string
+	if err := json.Unmarshal(t.RecoveryCodes, &codes); err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+	}
+	code = normalizeRecoveryCode(code)
+	found := -1
+	for i, c := range codes {
+		if subtle.ConstantTimeCompare([]byte(normalizeRecoveryCode(c)), []byte(code)) == 1 {
+			found = i
+		}
+	}
+	if found < 0 {
+		return false, nil
+	}
+	codes = append(codes[:found], codes[found+1:]...)
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	t.RecoveryCodes = encoded
+	return true, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
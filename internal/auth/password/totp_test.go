@@ -0,0 +1,146 @@
+package password
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// Test vectors from RFC 6238 Appendix B truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			step := uint64(tt.unix) / uint64(totpPeriod.Seconds())
+			assert.Equal(t, tt.want, totpCode(secret, step))
+		})
+	}
+}
+
+func TestTotp_validateCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	current := uint64(now.Unix()) / uint64(totpPeriod.Seconds())
+
+	tests := []struct {
+		name         string
+		code         string
+		lastUsedStep uint64
+		wantStep     uint64
+		wantOk       bool
+	}{
+		{
+			name:     "current-step",
+			code:     totpCode(secret, current),
+			wantStep: current,
+			wantOk:   true,
+		},
+		{
+			name:     "previous-step",
+			code:     totpCode(secret, current-1),
+			wantStep: current - 1,
+			wantOk:   true,
+		},
+		{
+			name:     "next-step",
+			code:     totpCode(secret, current+1),
+			wantStep: current + 1,
+			wantOk:   true,
+		},
+		{
+			name: "too-old",
+			code: totpCode(secret, current-2),
+		},
+		{
+			name:         "already-used",
+			code:         totpCode(secret, current),
+			lastUsedStep: current,
+		},
+		{
+			name: "wrong-length",
+			code: "12345",
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totp := &Totp{
+				Totp: &store.Totp{
+					Secret:       secret,
+					LastUsedStep: tt.lastUsedStep,
+				},
+			}
+			gotStep, gotOk := totp.validateCode(tt.code, now)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.wantStep, gotStep)
+		})
+	}
+}
+
+func TestTotp_otpauthUri(t *testing.T) {
+	totp := &Totp{
+		Totp: &store.Totp{
+			Secret: []byte("12345678901234567890"),
+		},
+	}
+	u, err := url.Parse(totp.otpauthUri("alice"))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Boundary:alice", u.Path)
+	q := u.Query()
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", q.Get("secret"))
+	assert.Equal(t, "Boundary", q.Get("issuer"))
+	assert.Equal(t, "SHA1", q.Get("algorithm"))
+	assert.Equal(t, "6", q.Get("digits"))
+	assert.Equal(t, "30", q.Get("period"))
+}
+
+func TestTotp_recoveryCodes(t *testing.T) {
+	ctx := context.Background()
+	totp, err := newTotp(ctx, "apw_1234567890")
+	require.NoError(t, err)
+	assert.Len(t, totp.Secret, totpSecretLength)
+
+	codes, err := totp.setRecoveryCodes(ctx)
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	for _, c := range codes {
+		assert.Len(t, c, recoveryCodeLength+1)
+	}
+
+	used, err := totp.useRecoveryCode(ctx, "abcde-fghij")
+	require.NoError(t, err)
+	assert.False(t, used)
+
+	used, err = totp.useRecoveryCode(ctx, strings.ToUpper(strings.ReplaceAll(codes[0], "-", "")))
+	require.NoError(t, err)
+	assert.True(t, used)
+
+	used, err = totp.useRecoveryCode(ctx, codes[0])
+	require.NoError(t, err)
+	assert.False(t, used)
+
+	var remaining []string
+	require.NoError(t, json.Unmarshal(totp.RecoveryCodes, &remaining))
+	assert.Equal(t, codes[1:], remaining)
+}
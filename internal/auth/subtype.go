@@ -17,6 +17,7 @@ type AuthMethod interface {
 type Account interface {
 	boundary.Resource
 	GetAuthMethodId() string
+	GetState() string
 }
 
 type ManagedGroup interface {
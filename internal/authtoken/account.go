@@ -1,7 +1,14 @@
 package authtoken
 
 import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/auth"
 	authStore "github.com/hashicorp/boundary/internal/auth/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -44,3 +51,46 @@ func (a *authAccount) TableName() string {
 func (a *authAccount) SetTableName(n string) {
 	a.tableName = n
 }
+
+// authTokenPolicy is the state of an auth account and of its user, and the
+// auth token lifetime of its auth method.  Together they decide whether auth
+// tokens can be issued to the account and how long they are valid for.  It is
+// used to scan the row of the lookupAuthAccountPolicyQuery.
+type authTokenPolicy struct {
+	AccountState                string
+	UserState                   string
+	AuthTokenTimeToLiveSeconds  uint32
+	AuthTokenTimeToStaleSeconds uint32
+}
+
+// userDisabled returns true if the user of the account is disabled.
+func (p *authTokenPolicy) userDisabled() bool {
+	return iam.UserState(p.UserState) == iam.DisabledUserState
+}
+
+// accountDisabled returns true if the account is disabled.
+func (p *authTokenPolicy) accountDisabled() bool {
+	return auth.AccountState(p.AccountState) == auth.DisabledAccountState
+}
+
+// lookupAuthAccountPolicy returns the auth token policy of the auth account
+// with the provided id.  Returns nil, nil if the account is not found.
+func lookupAuthAccountPolicy(ctx context.Context, reader db.Reader, authAccountId string) (*authTokenPolicy, error) {
+	const op = "authtoken.lookupAuthAccountPolicy"
+	rows, err := reader.Query(ctx, lookupAuthAccountPolicyQuery, []interface{}{sql.Named("auth_account_id", authAccountId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var p *authTokenPolicy
+	for rows.Next() {
+		p = &authTokenPolicy{}
+		if err := reader.ScanRows(ctx, rows, p); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return p, nil
+}
@@ -47,6 +47,23 @@ func (atv *authTokenView) toAuthToken() *AuthToken {
 	}
 }
 
+// authTokenPolicyView is used for reading an auth token together with the
+// auth token policy of its account in a single query, the
+// lookupAuthTokenPolicyQuery.
+type authTokenPolicyView struct {
+	*store.AuthToken
+	Policy authTokenPolicy `gorm:"embedded"`
+}
+
+// toAuthToken converts the view type to the type returned to repo callers and
+// the API.
+func (v *authTokenPolicyView) toAuthToken() *AuthToken {
+	cp := proto.Clone(v.AuthToken)
+	return &AuthToken{
+		AuthToken: cp.(*store.AuthToken),
+	}
+}
+
 // A AuthToken contains auth tokens. It is owned by a scope.
 type AuthToken struct {
 	*store.AuthToken
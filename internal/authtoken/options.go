@@ -9,6 +9,7 @@ import (
 var (
 	defaultTokenTimeToLiveDuration  = 7 * 24 * time.Hour
 	defaultTokenTimeToStaleDuration = 24 * time.Hour
+	defaultTokenMaxLifetimeDuration = 30 * 24 * time.Hour
 )
 
 // getOpts - iterate the inbound Options and return a struct
@@ -28,9 +29,13 @@ type options struct {
 	withTokenValue               bool
 	withTokenTimeToLiveDuration  time.Duration
 	withTokenTimeToStaleDuration time.Duration
+	withTokenMaxLifetimeDuration time.Duration
 	withLimit                    int
 	withStatus                   Status
 	withPublicId                 string
+	withDescription              string
+	withAllowedCidrs             []string
+	withClientIp                 string
 }
 
 func getDefaultOptions() options {
@@ -38,6 +43,7 @@ func getDefaultOptions() options {
 		withLimit:                    db.DefaultLimit,
 		withTokenTimeToLiveDuration:  defaultTokenTimeToLiveDuration,
 		withTokenTimeToStaleDuration: defaultTokenTimeToStaleDuration,
+		withTokenMaxLifetimeDuration: defaultTokenMaxLifetimeDuration,
 	}
 }
 
@@ -67,6 +73,17 @@ func WithTokenTimeToStaleDuration(dur time.Duration) Option {
 	}
 }
 
+// WithTokenMaxLifetimeDuration allows setting the maximum lifetime of an auth
+// token, measured from its creation.  Refreshing a token never extends its
+// expiration time beyond it.
+func WithTokenMaxLifetimeDuration(dur time.Duration) Option {
+	return func(o *options) {
+		if dur > 0 {
+			o.withTokenMaxLifetimeDuration = dur
+		}
+	}
+}
+
 // WithLimit provides an option to provide a limit.  Intentionally allowing
 // negative integers.   If WithLimit < 0, then unlimited results are returned.
 // If WithLimit == 0, then default limits are used for results.
@@ -91,3 +108,28 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithDescription allows the setting of the description of a service account
+// token.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithAllowedCidrs allows the setting of the client address ranges a service
+// account token can be used from.
+func WithAllowedCidrs(cidrs []string) Option {
+	return func(o *options) {
+		o.withAllowedCidrs = cidrs
+	}
+}
+
+// WithClientIp provides the ip of the client presenting a token, which is
+// checked against the allowed client address ranges of service account
+// tokens.
+func WithClientIp(ip string) Option {
+	return func(o *options) {
+		o.withClientIp = ip
+	}
+}
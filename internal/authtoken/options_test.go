@@ -35,6 +35,14 @@ func Test_GetOpts(t *testing.T) {
 		assert.Equal(opts, testOpts)
 	})
 
+	t.Run("WithTokenMaxLifetimeDuration", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTokenMaxLifetimeDuration(48 * time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withTokenMaxLifetimeDuration = 48 * time.Hour
+		assert.Equal(opts, testOpts)
+	})
+
 	t.Run("withStatus", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithStatus(IssuedStatus))
@@ -50,4 +58,28 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPublicId = "test-id"
 		assert.Equal(opts, testOpts)
 	})
+
+	t.Run("WithDescription", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDescription("ci"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "ci"
+		assert.Equal(opts, testOpts)
+	})
+
+	t.Run("WithAllowedCidrs", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAllowedCidrs([]string{"10.0.0.0/8"}))
+		testOpts := getDefaultOptions()
+		testOpts.withAllowedCidrs = []string{"10.0.0.0/8"}
+		assert.Equal(opts, testOpts)
+	})
+
+	t.Run("WithClientIp", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithClientIp("10.0.0.1"))
+		testOpts := getDefaultOptions()
+		testOpts.withClientIp = "10.0.0.1"
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -0,0 +1,51 @@
+package authtoken
+
+const (
+	lookupAuthAccountPolicyQuery = `
+select acct.state                           as account_state,
+       usr.state                            as user_state,
+       am.auth_token_time_to_live_seconds,
+       am.auth_token_time_to_stale_seconds
+  from auth_account acct
+  join auth_method am
+    on am.public_id = acct.auth_method_id
+  left join iam_user usr
+    on usr.public_id = acct.iam_user_id
+ where acct.public_id = @auth_account_id;
+`
+
+	lookupAuthTokenPolicyQuery = `
+select at.public_id,
+       at.token,
+       at.auth_account_id,
+       at.create_time,
+       at.update_time,
+       at.approximate_last_access_time,
+       at.expiration_time,
+       at.status,
+       at.key_id,
+       acct.scope_id,
+       acct.iam_user_id,
+       acct.auth_method_id,
+       acct.state                           as account_state,
+       usr.state                            as user_state,
+       am.auth_token_time_to_live_seconds,
+       am.auth_token_time_to_stale_seconds
+  from auth_token at
+  join auth_account acct
+    on acct.public_id = at.auth_account_id
+  join auth_method am
+    on am.public_id = acct.auth_method_id
+  left join iam_user usr
+    on usr.public_id = acct.iam_user_id
+ where at.public_id = @public_id;
+`
+
+	lookupAuthMethodTokenLifetimesQuery = `
+select public_id as auth_method_id,
+       auth_token_time_to_live_seconds,
+       auth_token_time_to_stale_seconds
+  from auth_method
+ where public_id in (@auth_method_ids);
+`
+)
@@ -2,10 +2,13 @@ package authtoken
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/authtoken/store"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -27,6 +30,7 @@ type Repository struct {
 	limit               int
 	timeToLiveDuration  time.Duration
 	timeToStaleDuration time.Duration
+	maxLifetimeDuration time.Duration
 }
 
 // NewRepository creates a new Repository. The returned repository is not safe for concurrent go
@@ -51,13 +55,17 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		limit:               opts.withLimit,
 		timeToLiveDuration:  opts.withTokenTimeToLiveDuration,
 		timeToStaleDuration: opts.withTokenTimeToStaleDuration,
+		maxLifetimeDuration: opts.withTokenMaxLifetimeDuration,
 	}, nil
 }
 
 // CreateAuthToken inserts an Auth Token into the repository and returns a new
 // Auth Token.  The returned auth token contains the auth token value. The
 // provided IAM User ID must be associated to the provided auth account id or an
-// error will be returned.  The Auth Token will have a Status of "issued".
+// error will be returned.  An error with the Unauthorized code is returned if
+// the user or the account is disabled.  The Auth Token expires after the auth
+// token time-to-live of the account's auth method, or the repository's default
+// if the auth method does not set one.  The Auth Token will have a Status of "issued".
 // The WithStatus and WithPublicId options are supported and all other options
 // are ignored.
 func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId string, opt ...Option) (*AuthToken, error) {
@@ -98,14 +106,6 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
 	}
 
-	// We truncate the expiration time to the nearest second to make testing in different platforms with
-	// different time resolutions easier.
-	expiration, err := ptypes.TimestampProto(time.Now().Add(r.timeToLiveDuration).Truncate(time.Second))
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
-	}
-	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
-
 	var newAuthToken *AuthToken
 	_, err = r.writer.DoTx(
 		ctx,
@@ -121,10 +121,30 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 				return errors.New(ctx, errors.InvalidParameter, op,
 					fmt.Sprintf("auth account %q mismatch with iam user %q", withAuthAccountId, withIamUser.GetPublicId()))
 			}
+			policy, err := lookupAuthAccountPolicy(ctx, read, withAuthAccountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch {
+			case policy == nil:
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth account %q not found", withAuthAccountId))
+			case policy.userDisabled():
+				return errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("iam user %q is disabled", acct.GetIamUserId()))
+			case policy.accountDisabled():
+				return errors.New(ctx, errors.Unauthorized, op, fmt.Sprintf("auth account %q is disabled", withAuthAccountId))
+			}
 			at.ScopeId = acct.GetScopeId()
 			at.AuthMethodId = acct.GetAuthMethodId()
 			at.IamUserId = acct.GetIamUserId()
 
+			ttl, _ := r.tokenLifetime(policy)
+			now := time.Now()
+			expiration, err := ptypes.TimestampProto(r.tokenExpiration(now, now, ttl))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
+			}
+			at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+
 			newAuthToken = at.clone()
 			if err := newAuthToken.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -186,7 +206,14 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 // approximate last accessed time may be updated depending on how long it has been since the last time the token
 // was validated.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
 // value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
-// All options are ignored.
+// The auth tokens of disabled users and accounts are not valid. A token is stale when it has not been accessed within
+// the auth token staleness duration of its auth method, or the repository's default if the auth method
+// does not set one.
+//
+// Service account tokens are validated as well; the returned AuthToken then
+// has its ServiceAccountId set instead of an auth method, account and user.
+// The WithClientIp option is supported for checking the allowed client address
+// ranges of service account tokens and all other options are ignored.
 //
 // NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
 func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ...Option) (*AuthToken, error) {
@@ -197,18 +224,22 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	if id == "" {
 		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
 	}
+	if strings.HasPrefix(id, ServiceAccountTokenPrefix+"_") {
+		retAT, err := r.validateServiceAccountToken(ctx, id, token, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return retAT, nil
+	}
 
-	retAT, err := r.LookupAuthToken(ctx, id, withTokenValue())
+	v, err := lookupAuthTokenPolicy(ctx, r.reader, id)
 	if err != nil {
-		retAT = nil
-		if errors.IsNotFoundError(err) {
-			return nil, nil
-		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	if retAT == nil {
+	if v == nil {
 		return nil, nil
 	}
+	retAT := v.toAuthToken()
 
 	// If the token is too old or stale invalidate it and return nothing.
 	exp, err := ptypes.Timestamp(retAT.GetExpirationTime().GetTimestamp())
@@ -220,11 +251,13 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
 	}
 
+	_, timeToStale := r.tokenLifetime(&v.Policy)
+
 	now := time.Now()
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= r.timeToStaleDuration {
+	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= timeToStale {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -245,9 +278,25 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, nil
 	}
 
+	databaseWrapper, err := r.kms.GetWrapper(ctx, retAT.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(retAT.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := retAT.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	retAT.CtToken = nil
+	retAT.KeyId = ""
 	if retAT.GetToken() != token {
 		return nil, nil
 	}
+
+	// Tokens of disabled users and accounts are not valid, but they are kept
+	// so they can be used again if the user or account is enabled before they
+	// expire.
+	if v.Policy.userDisabled() || v.Policy.accountDisabled() {
+		return nil, nil
+	}
 	// retAT.Token set to empty string so the value is not returned as described in the methods' doc.
 	retAT.Token = ""
 
@@ -287,6 +336,118 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
+// RefreshAuthToken extends the expiration time of the issued auth token with
+// the provided id to the auth token time-to-live of its auth method from now,
+// or the repository's default if the auth method does not set one, and marks
+// the token as accessed.  The expiration time is never extended beyond the
+// repository's maximum token lifetime measured from the creation of the token.
+// Tokens which are expired or stale, and tokens of disabled users and accounts,
+// can not be refreshed.  For security reasons, the actual token value is not
+// included in the returned AuthToken.  If no auth token which can be refreshed
+// is found nil, nil is returned.  All options are ignored.
+//
+// Note: no oplog entries are created for auth token operations (this is intentional).
+func (r *Repository) RefreshAuthToken(ctx context.Context, id string, opt ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).RefreshAuthToken"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+
+	var at *AuthToken
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			at = nil
+			v, err := lookupAuthTokenPolicy(ctx, reader, id)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if v == nil || v.GetStatus() != string(IssuedStatus) || v.Policy.userDisabled() || v.Policy.accountDisabled() {
+				return nil
+			}
+
+			createTime, err := ptypes.Timestamp(v.GetCreateTime().GetTimestamp())
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("create time"), errors.WithCode(errors.InvalidTimeStamp))
+			}
+			exp, err := ptypes.Timestamp(v.GetExpirationTime().GetTimestamp())
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("expiration time"), errors.WithCode(errors.InvalidTimeStamp))
+			}
+			lastAccessed, err := ptypes.Timestamp(v.GetApproximateLastAccessTime().GetTimestamp())
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("last accessed time"), errors.WithCode(errors.InvalidTimeStamp))
+			}
+			timeToLive, timeToStale := r.tokenLifetime(&v.Policy)
+			now := time.Now()
+			if now.After(exp.Add(-timeSkew)) || now.Sub(lastAccessed)+timeSkew >= timeToStale {
+				return nil
+			}
+			expiration, err := ptypes.TimestampProto(r.tokenExpiration(createTime, now, timeToLive))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidTimeStamp))
+			}
+
+			updateAT := allocAuthToken()
+			updateAT.PublicId = id
+			updateAT.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+			// Setting the ApproximateLastAccessTime to null through using the null mask allows a defined db's
+			// trigger to set ApproximateLastAccessTime to the commit timestamp.
+			rowsUpdated, err := w.Update(ctx, updateAT, []string{"ExpirationTime"}, []string{"ApproximateLastAccessTime"}, db.WithWhere("status = ?", IssuedStatus))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch {
+			case rowsUpdated == 0:
+				return nil
+			case rowsUpdated > 1:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+			}
+			at, err = txRepo.LookupAuthToken(ctx, id)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(id))
+	}
+	return at, nil
+}
+
+// lookupAuthTokenPolicy returns the auth token with the provided id together
+// with the auth token policy of its account.  The returned auth token includes
+// the encrypted token value.  Returns nil, nil if no auth token is found.
+func lookupAuthTokenPolicy(ctx context.Context, reader db.Reader, id string) (*authTokenPolicyView, error) {
+	const op = "authtoken.lookupAuthTokenPolicy"
+	rows, err := reader.Query(ctx, lookupAuthTokenPolicyQuery, []interface{}{sql.Named("public_id", id)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var v *authTokenPolicyView
+	for rows.Next() {
+		v = &authTokenPolicyView{AuthToken: &store.AuthToken{}}
+		if err := reader.ScanRows(ctx, rows, v); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return v, nil
+}
+
 // ListAuthTokens lists auth tokens in the given scopes and supports the
 // WithLimit option.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
@@ -0,0 +1,285 @@
+package authtoken
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// CreateServiceAccountToken inserts a token for the service account into the
+// repository and returns it. The returned token contains the token value,
+// which cannot be retrieved afterwards. The token expires once timeToLive has
+// passed; unlike auth tokens, it does not expire when it has not been used
+// for a while. The WithDescription and WithAllowedCidrs options are supported
+// and all other options are ignored.
+func (r *Repository) CreateServiceAccountToken(ctx context.Context, serviceAccountId string, timeToLive time.Duration, opt ...Option) (*ServiceAccountToken, error) {
+	const op = "authtoken.(Repository).CreateServiceAccountToken"
+	if serviceAccountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing service account id")
+	}
+	if timeToLive <= 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "time to live must be greater than zero")
+	}
+	opts := getOpts(opt...)
+	cidrs, err := normalizeCidrs(ctx, opts.withAllowedCidrs)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	sa := iam.AllocServiceAccount()
+	sa.PublicId = serviceAccountId
+	if err := r.reader.LookupByPublicId(ctx, &sa); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("service account lookup"))
+	}
+
+	at, err := newServiceAccountToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	id, err := NewServiceAccountTokenId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	at.PublicId = id
+	at.ServiceAccountId = sa.GetPublicId()
+	at.ScopeId = sa.GetScopeId()
+	at.Description = opts.withDescription
+	// We truncate the expiration time to the nearest second to make testing
+	// in different platforms with different time resolutions easier.
+	at.ExpirationTime = timestamp.New(time.Now().Add(timeToLive).Truncate(time.Second))
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	var newToken *ServiceAccountToken
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newToken = at.clone()
+			if err := newToken.encrypt(ctx, databaseWrapper); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			// tokens are not replicated, so they don't need oplog entries.
+			if err := w.Create(ctx, newToken); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(cidrs) > 0 {
+				items := make([]interface{}, 0, len(cidrs))
+				for _, c := range cidrs {
+					tc, err := newServiceAccountTokenCidr(ctx, newToken.GetPublicId(), c)
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					items = append(items, tc)
+				}
+				if err := w.CreateItems(ctx, items); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add allowed cidrs"))
+				}
+			}
+			newToken.AllowedCidrs = cidrs
+			newToken.CtToken = nil
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newToken, nil
+}
+
+// LookupServiceAccountToken returns the ServiceAccountToken for the provided
+// id. Returns nil, nil if no ServiceAccountToken is found for id. For
+// security reasons, the actual token is not included in the returned
+// ServiceAccountToken. All exported options are ignored.
+func (r *Repository) LookupServiceAccountToken(ctx context.Context, id string, opt ...Option) (*ServiceAccountToken, error) {
+	const op = "authtoken.(Repository).LookupServiceAccountToken"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	opts := getOpts(opt...)
+
+	at := allocServiceAccountToken()
+	at.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, at); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if opts.withTokenValue {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, at.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(at.GetKeyId()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := at.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	cidrs, err := r.listServiceAccountTokenCidrs(ctx, []string{id})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	at.AllowedCidrs = cidrs[id]
+
+	at.CtToken = nil
+	at.KeyId = ""
+	return at, nil
+}
+
+// ListServiceAccountTokens lists the tokens of the service account and
+// supports the WithLimit option. The token values are not included in the
+// returned tokens.
+func (r *Repository) ListServiceAccountTokens(ctx context.Context, serviceAccountId string, opt ...Option) ([]*ServiceAccountToken, error) {
+	const op = "authtoken.(Repository).ListServiceAccountTokens"
+	if serviceAccountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing service account id")
+	}
+	opts := getOpts(opt...)
+
+	var tokens []*ServiceAccountToken
+	if err := r.reader.SearchWhere(ctx, &tokens, "service_account_id = ?", []interface{}{serviceAccountId}, db.WithLimit(opts.withLimit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(tokens) == 0 {
+		return tokens, nil
+	}
+	ids := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		ids = append(ids, t.GetPublicId())
+	}
+	cidrs, err := r.listServiceAccountTokenCidrs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, t := range tokens {
+		t.Token = ""
+		t.CtToken = nil
+		t.KeyId = ""
+		t.AllowedCidrs = cidrs[t.GetPublicId()]
+	}
+	return tokens, nil
+}
+
+// DeleteServiceAccountToken deletes the service account token with the
+// provided id from the repository returning a count of the number of records
+// deleted. All options are ignored.
+func (r *Repository) DeleteServiceAccountToken(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "authtoken.(Repository).DeleteServiceAccountToken"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			at := allocServiceAccountToken()
+			at.PublicId = id
+			var err error
+			// tokens are not replicated, so they don't need oplog entries.
+			rowsDeleted, err = w.Delete(ctx, at)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(id))
+	}
+	return rowsDeleted, nil
+}
+
+// validateServiceAccountToken is the ValidateToken implementation for service
+// account tokens. Service account tokens only expire at their expiration
+// time and can be restricted to client address ranges, which are checked
+// against the WithClientIp option.
+func (r *Repository) validateServiceAccountToken(ctx context.Context, id, token string, opt ...Option) (*AuthToken, error) {
+	const op = "authtoken.(Repository).validateServiceAccountToken"
+	opts := getOpts(opt...)
+
+	retAT, err := r.LookupServiceAccountToken(ctx, id, withTokenValue())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if retAT == nil {
+		return nil, nil
+	}
+
+	exp := retAT.GetExpirationTime().AsTime()
+	lastAccessed := retAT.GetApproximateLastAccessTime().AsTime()
+	now := time.Now()
+	if now.After(exp.Add(-timeSkew)) {
+		// Expired tokens are kept until revoked so they remain visible when
+		// listing the tokens of the service account.
+		return nil, nil
+	}
+	if retAT.GetToken() != token {
+		return nil, nil
+	}
+	// retAT.Token set to empty string so the value is not returned.
+	retAT.Token = ""
+	if !retAT.allowsClientIp(opts.withClientIp) {
+		return nil, nil
+	}
+
+	if now.Sub(lastAccessed)+timeSkew >= lastAccessedUpdateDuration {
+		// To save the db from being updated too frequently, we only update the
+		// LastAccessTime if it hasn't been updated within lastAccessedUpdateDuration.
+		_, err = r.writer.DoTx(
+			ctx,
+			db.StdRetryCnt,
+			db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				at := allocServiceAccountToken()
+				at.PublicId = retAT.GetPublicId()
+				// Setting the ApproximateLastAccessTime to null through using
+				// the null mask allows a defined db's trigger to set
+				// ApproximateLastAccessTime to the commit timestamp. Tokens are
+				// not replicated, so they don't need oplog entries.
+				rowsUpdated, err := w.Update(ctx, at, nil, []string{"ApproximateLastAccessTime"})
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(id))
+		}
+	}
+	return retAT.toAuthToken(), nil
+}
+
+// listServiceAccountTokenCidrs returns the allowed cidrs of the tokens keyed
+// by token id.
+func (r *Repository) listServiceAccountTokenCidrs(ctx context.Context, tokenIds []string) (map[string][]string, error) {
+	const op = "authtoken.(Repository).listServiceAccountTokenCidrs"
+	var cidrs []*serviceAccountTokenCidr
+	if err := r.reader.SearchWhere(ctx, &cidrs, "token_id in (?)", []interface{}{tokenIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %d tokens", len(tokenIds))))
+	}
+	ret := make(map[string][]string, len(tokenIds))
+	for _, c := range cidrs {
+		ret[c.GetTokenId()] = append(ret[c.GetTokenId()], c.GetCidr())
+	}
+	return ret, nil
+}
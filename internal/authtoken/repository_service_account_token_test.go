@@ -0,0 +1,235 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateServiceAccountToken(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	sa := iam.TestServiceAccount(t, conn, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		saId      string
+		ttl       time.Duration
+		opt       []Option
+		wantCidrs []string
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "missing-service-account-id",
+			ttl:       time.Hour,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "missing-ttl",
+			saId:      sa.GetPublicId(),
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-cidr",
+			saId:      sa.GetPublicId(),
+			ttl:       time.Hour,
+			opt:       []Option{WithAllowedCidrs([]string{"not-a-cidr"})},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "service-account-not-found",
+			saId:      "sa_1234567890",
+			ttl:       time.Hour,
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name: "valid",
+			saId: sa.GetPublicId(),
+			ttl:  time.Hour,
+		},
+		{
+			name:      "valid-with-options",
+			saId:      sa.GetPublicId(),
+			ttl:       24 * time.Hour,
+			opt:       []Option{WithDescription("ci"), WithAllowedCidrs([]string{"10.0.0.0/8", "192.168.1.0/24"})},
+			wantCidrs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateServiceAccountToken(ctx, tt.saId, tt.ttl, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.GetToken())
+			assert.Nil(got.GetCtToken())
+			assert.Equal(org.GetPublicId(), got.GetScopeId())
+			assert.Equal(sa.GetPublicId(), got.GetServiceAccountId())
+			assert.ElementsMatch(tt.wantCidrs, got.AllowedCidrs)
+			assert.WithinDuration(time.Now().Add(tt.ttl), got.GetExpirationTime().AsTime(), time.Minute)
+
+			found, err := repo.LookupServiceAccountToken(ctx, got.GetPublicId())
+			require.NoError(err)
+			require.NotNil(found)
+			assert.Empty(found.GetToken())
+			assert.Empty(found.GetKeyId())
+			assert.Equal(got.GetDescription(), found.GetDescription())
+			assert.ElementsMatch(tt.wantCidrs, found.AllowedCidrs)
+		})
+	}
+}
+
+func TestRepository_ListServiceAccountTokens(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	sa := iam.TestServiceAccount(t, conn, org.GetPublicId())
+	other := iam.TestServiceAccount(t, conn, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := repo.CreateServiceAccountToken(ctx, sa.GetPublicId(), time.Hour, WithAllowedCidrs([]string{"10.0.0.0/8"}))
+		require.NoError(t, err)
+	}
+	_, err = repo.CreateServiceAccountToken(ctx, other.GetPublicId(), time.Hour)
+	require.NoError(t, err)
+
+	got, err := repo.ListServiceAccountTokens(ctx, sa.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for _, at := range got {
+		assert.Empty(t, at.GetToken())
+		assert.Nil(t, at.GetCtToken())
+		assert.Equal(t, []string{"10.0.0.0/8"}, at.AllowedCidrs)
+	}
+
+	got, err = repo.ListServiceAccountTokens(ctx, sa.GetPublicId(), WithLimit(1))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = repo.ListServiceAccountTokens(ctx, "")
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_DeleteServiceAccountToken(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	at := TestServiceAccountToken(t, conn, kms, org.GetPublicId(), WithAllowedCidrs([]string{"10.0.0.0/8"}))
+	rowsDeleted, err := repo.DeleteServiceAccountToken(ctx, at.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, rowsDeleted)
+
+	found, err := repo.LookupServiceAccountToken(ctx, at.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	rowsDeleted, err = repo.DeleteServiceAccountToken(ctx, at.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 0, rowsDeleted)
+
+	got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestRepository_ValidateToken_serviceAccount(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		at := TestServiceAccountToken(t, conn, kms, org.GetPublicId())
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, at.GetPublicId(), got.GetPublicId())
+		assert.Equal(t, at.GetServiceAccountId(), got.GetServiceAccountId())
+		assert.Equal(t, org.GetPublicId(), got.GetScopeId())
+		assert.Empty(t, got.GetIamUserId())
+		assert.Empty(t, got.GetAuthAccountId())
+		assert.Empty(t, got.GetToken())
+	})
+	t.Run("wrong-token", func(t *testing.T) {
+		at := TestServiceAccountToken(t, conn, kms, org.GetPublicId())
+		other := TestServiceAccountToken(t, conn, kms, org.GetPublicId())
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), other.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("allowed-cidrs", func(t *testing.T) {
+		at := TestServiceAccountToken(t, conn, kms, org.GetPublicId(), WithAllowedCidrs([]string{"10.0.0.0/8"}))
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken(), WithClientIp("10.1.2.3"))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+
+		got, err = repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken(), WithClientIp("192.168.1.1"))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		got, err = repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("expired", func(t *testing.T) {
+		sa := iam.TestServiceAccount(t, conn, org.GetPublicId())
+		at, err := repo.CreateServiceAccountToken(ctx, sa.GetPublicId(), time.Second)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Second)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		// expired tokens are kept until they are revoked
+		found, err := repo.LookupServiceAccountToken(ctx, at.GetPublicId())
+		require.NoError(t, err)
+		assert.NotNil(t, found)
+	})
+	t.Run("deleted-with-service-account", func(t *testing.T) {
+		at := TestServiceAccountToken(t, conn, kms, org.GetPublicId())
+		iamRepo := iam.TestRepo(t, conn, wrapper)
+		_, err := iamRepo.DeleteServiceAccount(ctx, at.GetServiceAccountId())
+		require.NoError(t, err)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
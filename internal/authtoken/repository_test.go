@@ -57,6 +57,7 @@ func TestRepository_New(t *testing.T) {
 				limit:               db.DefaultLimit,
 				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
 				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				maxLifetimeDuration: defaultTokenMaxLifetimeDuration,
 			},
 		},
 		{
@@ -76,6 +77,7 @@ func TestRepository_New(t *testing.T) {
 				limit:               5,
 				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
 				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				maxLifetimeDuration: defaultTokenMaxLifetimeDuration,
 			},
 		},
 		{
@@ -95,6 +97,7 @@ func TestRepository_New(t *testing.T) {
 				limit:               db.DefaultLimit,
 				timeToLiveDuration:  1 * time.Hour,
 				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				maxLifetimeDuration: defaultTokenMaxLifetimeDuration,
 			},
 		},
 		{
@@ -114,9 +117,29 @@ func TestRepository_New(t *testing.T) {
 				limit:               db.DefaultLimit,
 				timeToStaleDuration: 1 * time.Hour,
 				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
+				maxLifetimeDuration: defaultTokenMaxLifetimeDuration,
+			},
+		},
+		{
+			name: "valid token max lifetime",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+				opts: []Option{
+					WithTokenMaxLifetimeDuration(48 * time.Hour),
+				},
+			},
+			want: &Repository{
+				reader:              rw,
+				writer:              rw,
+				kms:                 kmsCache,
+				limit:               db.DefaultLimit,
+				timeToLiveDuration:  defaultTokenTimeToLiveDuration,
+				timeToStaleDuration: defaultTokenTimeToStaleDuration,
+				maxLifetimeDuration: 48 * time.Hour,
 			},
 		},
-
 		{
 			name: "nil-reader",
 			args: args{
@@ -566,6 +589,192 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	}
 }
 
+func TestRepository_ValidateToken_disabled(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	setState := func(t *testing.T, query, state, id string) {
+		t.Helper()
+		_, err := rw.Exec(ctx, query, []interface{}{state, id})
+		require.NoError(t, err)
+	}
+	const (
+		setAccountState = "update auth_password_account set state = ? where public_id = ?"
+		setUserState    = "update iam_user set state = ? where public_id = ?"
+	)
+
+	tests := []struct {
+		name         string
+		accountState string
+		userState    string
+		wantReturned bool
+	}{
+		{
+			name:         "active",
+			accountState: "active",
+			userState:    "active",
+			wantReturned: true,
+		},
+		{
+			name:         "disabled-account",
+			accountState: "disabled",
+			userState:    "active",
+		},
+		{
+			name:         "disabled-user",
+			accountState: "active",
+			userState:    "disabled",
+		},
+		{
+			name:         "re-enabled",
+			accountState: "active",
+			userState:    "active",
+			wantReturned: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			setState(t, setAccountState, tt.accountState, at.GetAuthAccountId())
+			setState(t, setUserState, tt.userState, at.GetIamUserId())
+
+			got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+			require.NoError(err)
+			if !tt.wantReturned {
+				assert.Nil(got)
+				u, _, err := iamRepo.LookupUser(ctx, at.GetIamUserId())
+				require.NoError(err)
+				_, err = repo.CreateAuthToken(ctx, u, at.GetAuthAccountId())
+				assert.Truef(errors.Match(errors.T(errors.Unauthorized), err), "Unexpected error %s", err)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(at.GetPublicId(), got.GetPublicId())
+		})
+	}
+}
+
+func TestRepository_RefreshAuthToken(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	iamUser, _, err := iamRepo.LookupUser(context.Background(), baseAT.GetIamUserId())
+	require.NoError(t, err)
+	require.NotNil(t, iamUser)
+	badId, err := NewAuthTokenId()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		ttl           time.Duration
+		maxLifetime   time.Duration
+		createOpt     []Option
+		id            string
+		disableUser   bool
+		wantRefreshed bool
+		wantIsErr     errors.Code
+	}{
+		{
+			name:          "valid",
+			ttl:           time.Hour,
+			wantRefreshed: true,
+		},
+		{
+			name:          "capped-at-max-lifetime",
+			ttl:           time.Hour,
+			maxLifetime:   90 * time.Minute,
+			wantRefreshed: true,
+		},
+		{
+			name:        "disabled-user",
+			ttl:         time.Hour,
+			disableUser: true,
+		},
+		{
+			name:      "pending",
+			ttl:       time.Hour,
+			createOpt: []Option{WithStatus(PendingStatus)},
+		},
+		{
+			name: "expired",
+			ttl:  1 * time.Millisecond,
+		},
+		{
+			name: "not-found",
+			ttl:  time.Hour,
+			id:   badId,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			timeSkew = 20 * time.Millisecond
+
+			repo, err := NewRepository(rw, rw, kms, WithTokenTimeToLiveDuration(tt.ttl))
+			require.NoError(err)
+			at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId(), tt.createOpt...)
+			require.NoError(err)
+			id := at.GetPublicId()
+			if tt.id != "" {
+				id = tt.id
+			}
+
+			if tt.disableUser {
+				_, err := rw.Exec(ctx, "update iam_user set state = 'disabled' where public_id = ?", []interface{}{iamUser.GetPublicId()})
+				require.NoError(err)
+				t.Cleanup(func() {
+					_, err := rw.Exec(ctx, "update iam_user set state = 'active' where public_id = ?", []interface{}{iamUser.GetPublicId()})
+					require.NoError(err)
+				})
+			}
+
+			// Refreshing uses the time-to-live of the repository doing the refresh.
+			refreshRepo, err := NewRepository(rw, rw, kms, WithTokenTimeToLiveDuration(2*time.Hour), WithTokenMaxLifetimeDuration(tt.maxLifetime))
+			require.NoError(err)
+			got, err := refreshRepo.RefreshAuthToken(ctx, id)
+			require.NoError(err)
+			if !tt.wantRefreshed {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Empty(got.GetToken())
+			assert.True(got.GetExpirationTime().AsTime().After(at.GetExpirationTime().AsTime()))
+			if tt.maxLifetime > 0 {
+				maxExpiration := got.GetCreateTime().AsTime().Add(tt.maxLifetime)
+				assert.False(got.GetExpirationTime().AsTime().After(maxExpiration), "expiration %s exceeds max lifetime %s", got.GetExpirationTime().AsTime(), maxExpiration)
+			}
+
+			validated, err := refreshRepo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+			require.NoError(err)
+			assert.NotNil(validated)
+		})
+	}
+
+	t.Run("missing-public-id", func(t *testing.T) {
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(t, err)
+		_, err = repo.RefreshAuthToken(context.Background(), "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidPublicId), err), "Unexpected error %s", err)
+	})
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -0,0 +1,99 @@
+package authtoken
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// AuthMethodTokenLifetime is the lifetime of the auth tokens issued by an auth
+// method. It overrides the time-to-live and staleness durations the repository
+// was created with. A zero duration means the repository's default is used.
+type AuthMethodTokenLifetime struct {
+	AuthMethodId string
+	TimeToLive   time.Duration
+	TimeToStale  time.Duration
+}
+
+// authMethodTokenLifetime is used to scan the rows of the
+// lookupAuthMethodTokenLifetimesQuery.
+type authMethodTokenLifetime struct {
+	AuthMethodId                string
+	AuthTokenTimeToLiveSeconds  uint32
+	AuthTokenTimeToStaleSeconds uint32
+}
+
+func (l *authMethodTokenLifetime) toAuthMethodTokenLifetime() *AuthMethodTokenLifetime {
+	return &AuthMethodTokenLifetime{
+		AuthMethodId: l.AuthMethodId,
+		TimeToLive:   time.Duration(l.AuthTokenTimeToLiveSeconds) * time.Second,
+		TimeToStale:  time.Duration(l.AuthTokenTimeToStaleSeconds) * time.Second,
+	}
+}
+
+// ListAuthMethodTokenLifetimes returns the auth token lifetimes of the auth
+// methods with the provided ids, keyed by auth method id.  Ids which do not
+// match an auth method are not included in the result.  All options are
+// ignored.
+func (r *Repository) ListAuthMethodTokenLifetimes(ctx context.Context, authMethodIds []string, _ ...Option) (map[string]*AuthMethodTokenLifetime, error) {
+	const op = "authtoken.(Repository).ListAuthMethodTokenLifetimes"
+	if len(authMethodIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method ids")
+	}
+	lifetimes, err := listAuthMethodTokenLifetimes(ctx, r.reader, authMethodIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return lifetimes, nil
+}
+
+// tokenLifetime returns the time-to-live and staleness durations of the auth
+// tokens issued under the provided policy.  The repository's defaults are
+// returned for the durations the auth method does not override.
+func (r *Repository) tokenLifetime(p *authTokenPolicy) (time.Duration, time.Duration) {
+	ttl, stale := r.timeToLiveDuration, r.timeToStaleDuration
+	if p.AuthTokenTimeToLiveSeconds > 0 {
+		ttl = time.Duration(p.AuthTokenTimeToLiveSeconds) * time.Second
+	}
+	if p.AuthTokenTimeToStaleSeconds > 0 {
+		stale = time.Duration(p.AuthTokenTimeToStaleSeconds) * time.Second
+	}
+	return ttl, stale
+}
+
+// tokenExpiration returns the expiration time of an auth token created at
+// createTime which is issued or refreshed at now with the provided
+// time-to-live.  The expiration time never exceeds the repository's maximum
+// lifetime measured from createTime.  It is truncated to the nearest second to
+// make testing in different platforms with different time resolutions easier.
+func (r *Repository) tokenExpiration(createTime, now time.Time, ttl time.Duration) time.Time {
+	exp := now.Add(ttl)
+	if max := createTime.Add(r.maxLifetimeDuration); exp.After(max) {
+		exp = max
+	}
+	return exp.Truncate(time.Second)
+}
+
+func listAuthMethodTokenLifetimes(ctx context.Context, reader db.Reader, authMethodIds []string) (map[string]*AuthMethodTokenLifetime, error) {
+	const op = "authtoken.listAuthMethodTokenLifetimes"
+	rows, err := reader.Query(ctx, lookupAuthMethodTokenLifetimesQuery, []interface{}{sql.Named("auth_method_ids", authMethodIds)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	lifetimes := make(map[string]*AuthMethodTokenLifetime, len(authMethodIds))
+	for rows.Next() {
+		var l authMethodTokenLifetime
+		if err := reader.ScanRows(ctx, rows, &l); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		lifetimes[l.AuthMethodId] = l.toAuthMethodTokenLifetime()
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return lifetimes, nil
+}
@@ -0,0 +1,148 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListAuthMethodTokenLifetimes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	ams := password.TestAuthMethods(t, conn, org.GetPublicId(), 2)
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.ListAuthMethodTokenLifetimes(ctx, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	setAuthMethodTokenLifetime(t, rw, kms, ams[0].GetPublicId(), time.Hour, time.Minute)
+
+	got, err := repo.ListAuthMethodTokenLifetimes(ctx, []string{ams[0].GetPublicId(), ams[1].GetPublicId(), "ampw_doesntexist"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*AuthMethodTokenLifetime{
+		ams[0].GetPublicId(): {AuthMethodId: ams[0].GetPublicId(), TimeToLive: time.Hour, TimeToStale: time.Minute},
+		ams[1].GetPublicId(): {AuthMethodId: ams[1].GetPublicId()},
+	}, got)
+}
+
+func TestRepository_AuthMethodTokenLifetime(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	iamUser, _, err := iamRepo.LookupUser(context.Background(), baseAT.GetIamUserId())
+	require.NoError(t, err)
+	require.NotNil(t, iamUser)
+
+	ctx := context.Background()
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("time-to-live", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		setAuthMethodTokenLifetime(t, rw, kms, baseAT.GetAuthMethodId(), time.Hour, 0)
+
+		before := time.Now().Truncate(time.Second)
+		at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId())
+		require.NoError(err)
+		exp := at.GetExpirationTime().AsTime()
+		assert.False(exp.Before(before.Add(time.Hour)))
+		assert.True(exp.Before(before.Add(defaultTokenTimeToLiveDuration)))
+	})
+
+	t.Run("time-to-stale", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		timeSkew = 20 * time.Millisecond
+		t.Cleanup(func() { timeSkew = 0 })
+
+		at, err := repo.CreateAuthToken(ctx, iamUser, baseAT.GetAuthAccountId())
+		require.NoError(err)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(err)
+		assert.NotNil(got)
+
+		// The staleness override of the auth method applies to existing tokens.
+		setAuthMethodTokenLifetime(t, rw, kms, baseAT.GetAuthMethodId(), 0, time.Second)
+		time.Sleep(time.Second)
+		got, err = repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(err)
+		assert.Nil(got)
+	})
+}
+
+// setAuthMethodTokenLifetime sets the auth token lifetime of the password auth
+// method with the provided id.
+func setAuthMethodTokenLifetime(t *testing.T, rw *db.Db, kms *kms.Kms, authMethodId string, ttl, stale time.Duration) {
+	t.Helper()
+	ctx := context.Background()
+	pwRepo, err := password.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	am, err := pwRepo.LookupAuthMethod(ctx, authMethodId)
+	require.NoError(t, err)
+	require.NotNil(t, am)
+	am.AuthTokenTimeToLiveSeconds = uint32(ttl / time.Second)
+	am.AuthTokenTimeToStaleSeconds = uint32(stale / time.Second)
+	_, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"AuthTokenTimeToLiveSeconds", "AuthTokenTimeToStaleSeconds"})
+	require.NoError(t, err)
+}
+
+func TestRepository_tokenExpiration(t *testing.T) {
+	repo := &Repository{
+		timeToLiveDuration:  time.Hour,
+		timeToStaleDuration: 10 * time.Minute,
+		maxLifetimeDuration: 3 * time.Hour,
+	}
+	created := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy *authTokenPolicy
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			name:   "defaults",
+			policy: &authTokenPolicy{},
+			now:    created,
+			want:   created.Add(time.Hour),
+		},
+		{
+			name:   "auth-method-override",
+			policy: &authTokenPolicy{AuthTokenTimeToLiveSeconds: 7200, AuthTokenTimeToStaleSeconds: 60},
+			now:    created.Add(30 * time.Minute),
+			want:   created.Add(150 * time.Minute),
+		},
+		{
+			name:   "capped-at-max-lifetime",
+			policy: &authTokenPolicy{},
+			now:    created.Add(150 * time.Minute),
+			want:   created.Add(3 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, _ := repo.tokenLifetime(tt.policy)
+			assert.Equal(t, tt.want, repo.tokenExpiration(created, tt.now, ttl))
+		})
+	}
+}
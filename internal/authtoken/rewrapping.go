@@ -0,0 +1,99 @@
+package authtoken
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn(defaultAuthTokenTableName, authTokenRewrapFn)
+	kms.RegisterTableRewrapFn(defaultServiceAccountTokenTableName, serviceAccountTokenRewrapFn)
+}
+
+// authTokenRewrapFn re-encrypts the auth tokens which were encrypted with
+// the data key version using the current database wrapper of the scope.
+func authTokenRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "authtoken.authTokenRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	var tokens []*AuthToken
+	if err := reader.SearchWhere(ctx, &tokens, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, token := range tokens {
+		if err := token.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt auth token"))
+		}
+		if err := token.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt auth token"))
+		}
+		if _, err := writer.Update(ctx, token, []string{"CtToken", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update auth token row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// serviceAccountTokenRewrapFn re-encrypts the service account tokens which
+// were encrypted with the data key version using the current database wrapper
+// of the scope.
+func serviceAccountTokenRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "authtoken.serviceAccountTokenRewrapFn"
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	var tokens []*ServiceAccountToken
+	if err := reader.SearchWhere(ctx, &tokens, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, token := range tokens {
+		if err := token.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt service account token"))
+		}
+		if err := token.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt service account token"))
+		}
+		if _, err := writer.Update(ctx, token, []string{"CtToken", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update service account token row with rewrapped fields"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrap_authTokenRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := authTokenRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = authTokenRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+		at := TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+		orig := allocAuthToken()
+		orig.PublicId = at.GetPublicId()
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.True(referenced)
+
+		require.NoError(authTokenRewrapFn(ctx, orig.GetKeyId(), org.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocAuthToken()
+		got.PublicId = at.GetPublicId()
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtToken(), got.GetCtToken())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		require.NoError(orig.decrypt(ctx, databaseWrapper))
+		assert.Equal(orig.GetToken(), got.GetToken())
+
+		referenced, err = kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
+
+func TestRewrap_serviceAccountTokenRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := serviceAccountTokenRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = serviceAccountTokenRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+		at := TestServiceAccountToken(t, conn, kmsCache, org.GetPublicId())
+		orig := allocServiceAccountToken()
+		orig.PublicId = at.GetPublicId()
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.True(referenced)
+
+		require.NoError(serviceAccountTokenRewrapFn(ctx, orig.GetKeyId(), org.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocServiceAccountToken()
+		got.PublicId = at.GetPublicId()
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtToken(), got.GetCtToken())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal(at.GetToken(), got.GetToken())
+
+		referenced, err = kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
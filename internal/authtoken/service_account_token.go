@@ -0,0 +1,226 @@
+package authtoken
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/boundary/internal/authtoken/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ServiceAccountTokenPrefix is the prefix of the ids of service account
+	// tokens. It differs from AuthTokenPrefix so the kind of a token can be
+	// determined from its id alone.
+	ServiceAccountTokenPrefix = "atsa"
+
+	// defaultServiceAccountTokenTableName is the table where service account
+	// tokens are stored.
+	defaultServiceAccountTokenTableName = "auth_service_account_token"
+
+	// defaultServiceAccountTokenCidrTableName is the table where the allowed
+	// client address ranges of service account tokens are stored.
+	defaultServiceAccountTokenCidrTableName = "auth_service_account_token_cidr"
+)
+
+// A ServiceAccountToken is a long-lived token of an iam service account. It
+// is owned by the scope of the service account.
+type ServiceAccountToken struct {
+	*store.ServiceAccountToken
+
+	// AllowedCidrs are the client address ranges the token can be used from.
+	// If empty, the token can be used from any address.
+	AllowedCidrs []string `gorm:"-"`
+
+	tableName string `gorm:"-"`
+}
+
+// allocServiceAccountToken is just easier/better than leaking the underlying
+// type bits to the repo, since the repo needs to alloc this type quite often.
+func allocServiceAccountToken() *ServiceAccountToken {
+	return &ServiceAccountToken{
+		ServiceAccountToken: &store.ServiceAccountToken{},
+	}
+}
+
+func (t *ServiceAccountToken) clone() *ServiceAccountToken {
+	cp := proto.Clone(t.ServiceAccountToken)
+	var cidrs []string
+	if t.AllowedCidrs != nil {
+		cidrs = make([]string, len(t.AllowedCidrs))
+		copy(cidrs, t.AllowedCidrs)
+	}
+	return &ServiceAccountToken{
+		ServiceAccountToken: cp.(*store.ServiceAccountToken),
+		AllowedCidrs:        cidrs,
+	}
+}
+
+// NewServiceAccountTokenId creates a new id for a service account token.
+func NewServiceAccountTokenId() (string, error) {
+	const op = "authtoken.NewServiceAccountTokenId"
+	id, err := db.NewPublicId(ServiceAccountTokenPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
+
+// newServiceAccountToken generates a new in-memory service account token
+// with a random token value.
+func newServiceAccountToken(ctx context.Context) (*ServiceAccountToken, error) {
+	const op = "authtoken.newServiceAccountToken"
+	token, err := base62.Random(tokenLength)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return &ServiceAccountToken{
+		ServiceAccountToken: &store.ServiceAccountToken{
+			Token: fmt.Sprintf("%s%s", TokenValueVersionPrefix, token),
+		},
+	}, nil
+}
+
+// encrypt the token's value using the provided cipher (wrapping.Wrapper)
+func (t *ServiceAccountToken) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "authtoken.(ServiceAccountToken).encrypt"
+	// structwrapping doesn't support embedding, so we'll pass in the store struct directly
+	if err := structwrapping.WrapStruct(ctx, cipher, t.ServiceAccountToken, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+// decrypt will decrypt the token's value using the provided cipher (wrapping.Wrapper)
+func (t *ServiceAccountToken) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "authtoken.(ServiceAccountToken).decrypt"
+	// structwrapping doesn't support embedding, so we'll pass in the store struct directly
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.ServiceAccountToken, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// allowsClientIp reports whether the token can be used from the client ip.
+func (t *ServiceAccountToken) allowsClientIp(clientIp string) bool {
+	if len(t.AllowedCidrs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(clientIp)
+	if ip == nil {
+		return false
+	}
+	for _, c := range t.AllowedCidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// toAuthToken converts the service account token to the type returned by
+// ValidateToken, so callers can handle both kinds of tokens the same way.
+func (t *ServiceAccountToken) toAuthToken() *AuthToken {
+	return &AuthToken{
+		AuthToken: &store.AuthToken{
+			PublicId:                  t.GetPublicId(),
+			CreateTime:                t.GetCreateTime(),
+			UpdateTime:                t.GetUpdateTime(),
+			ApproximateLastAccessTime: t.GetApproximateLastAccessTime(),
+			ExpirationTime:            t.GetExpirationTime(),
+			ScopeId:                   t.GetScopeId(),
+			ServiceAccountId:          t.GetServiceAccountId(),
+			Status:                    string(IssuedStatus),
+		},
+	}
+}
+
+// TableName returns the table name for the service account token.
+func (t *ServiceAccountToken) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultServiceAccountTokenTableName
+}
+
+// SetTableName sets the table name. If the caller attempts to set the name to
+// "" the name will be reset to the default name.
+func (t *ServiceAccountToken) SetTableName(n string) {
+	t.tableName = n
+}
+
+// serviceAccountTokenCidr is an allowed client address range of a service
+// account token.
+type serviceAccountTokenCidr struct {
+	*store.ServiceAccountTokenCidr
+	tableName string `gorm:"-"`
+}
+
+func newServiceAccountTokenCidr(ctx context.Context, tokenId, cidr string) (*serviceAccountTokenCidr, error) {
+	const op = "authtoken.newServiceAccountTokenCidr"
+	if tokenId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token id")
+	}
+	if cidr == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing cidr")
+	}
+	return &serviceAccountTokenCidr{
+		ServiceAccountTokenCidr: &store.ServiceAccountTokenCidr{
+			TokenId: tokenId,
+			Cidr:    cidr,
+		},
+	}, nil
+}
+
+// TableName returns the table name for the service account token cidr.
+func (c *serviceAccountTokenCidr) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return defaultServiceAccountTokenCidrTableName
+}
+
+// SetTableName sets the table name. If the caller attempts to set the name to
+// "" the name will be reset to the default name.
+func (c *serviceAccountTokenCidr) SetTableName(n string) {
+	c.tableName = n
+}
+
+// normalizeCidrs validates the cidrs and returns them in their canonical
+// form, without duplicates.
+func normalizeCidrs(ctx context.Context, cidrs []string) ([]string, error) {
+	const op = "authtoken.normalizeCidrs"
+	if len(cidrs) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]struct{}, len(cidrs))
+	ret := make([]string, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid cidr %q", c))
+		}
+		n := ipNet.String()
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		ret = append(ret, n)
+	}
+	return ret, nil
+}
@@ -0,0 +1,83 @@
+package authtoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountToken_allowsClientIp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		cidrs    []string
+		clientIp string
+		want     bool
+	}{
+		{
+			name:     "no-cidrs",
+			clientIp: "192.168.1.1",
+			want:     true,
+		},
+		{
+			name: "no-cidrs-no-ip",
+			want: true,
+		},
+		{
+			name:     "in-range",
+			cidrs:    []string{"10.0.0.0/8", "192.168.1.0/24"},
+			clientIp: "192.168.1.20",
+			want:     true,
+		},
+		{
+			name:     "ipv6-in-range",
+			cidrs:    []string{"2001:db8::/32"},
+			clientIp: "2001:db8::1",
+			want:     true,
+		},
+		{
+			name:     "out-of-range",
+			cidrs:    []string{"10.0.0.0/8"},
+			clientIp: "192.168.1.20",
+		},
+		{
+			name:  "missing-ip",
+			cidrs: []string{"10.0.0.0/8"},
+		},
+		{
+			name:     "invalid-ip",
+			cidrs:    []string{"10.0.0.0/8"},
+			clientIp: "10.0.0.1:9200",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := &ServiceAccountToken{
+				ServiceAccountToken: &store.ServiceAccountToken{},
+				AllowedCidrs:        tt.cidrs,
+			}
+			assert.Equal(t, tt.want, at.allowsClientIp(tt.clientIp))
+		})
+	}
+}
+
+func Test_normalizeCidrs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	got, err := normalizeCidrs(ctx, nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = normalizeCidrs(ctx, []string{"10.1.2.3/8", "10.0.0.0/8", "2001:db8::1/32"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8", "2001:db8::/32"}, got)
+
+	_, err = normalizeCidrs(ctx, []string{"10.0.0.1"})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
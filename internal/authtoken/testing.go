@@ -3,6 +3,7 @@ package authtoken
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/db"
@@ -32,3 +33,18 @@ func TestAuthToken(t testing.TB, conn *db.DB, kms *kms.Kms, scopeId string, opt
 	require.NoError(t, err)
 	return at
 }
+
+// TestServiceAccountToken creates a service account in the scope and a token
+// for it which expires in an hour.
+func TestServiceAccountToken(t testing.TB, conn *db.DB, kms *kms.Kms, scopeId string, opt ...Option) *ServiceAccountToken {
+	t.Helper()
+	sa := iam.TestServiceAccount(t, conn, scopeId)
+
+	rw := db.New(conn)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	at, err := repo.CreateServiceAccountToken(context.Background(), sa.GetPublicId(), time.Hour, opt...)
+	require.NoError(t, err)
+	return at
+}
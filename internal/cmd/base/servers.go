@@ -675,6 +675,13 @@ func (b *Server) RunShutdownFuncs() error {
 			mErr = multierror.Append(mErr, err)
 		}
 	}
+	// The eventer is closed last, so the events sent while shutting down are
+	// not dropped.
+	if b.Eventer != nil {
+		if err := b.Eventer.Close(context.Background()); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
 	return mErr.ErrorOrNil()
 }
 
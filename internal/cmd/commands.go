@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/rolescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/scopescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/server"
+	"github.com/hashicorp/boundary/internal/cmd/commands/serviceaccountscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/sessionscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/targetscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/userscmd"
@@ -71,6 +72,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"authenticate ldap": func() (cli.Command, error) {
+			return &authenticate.LdapCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"authenticate jwt": func() (cli.Command, error) {
+			return &authenticate.JwtCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"accounts": func() (cli.Command, error) {
 			return &accountscmd.Command{
@@ -107,6 +118,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}, nil
 		},
+		"accounts unlock": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "unlock",
+			}, nil
+		},
+		"accounts enroll-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enroll-totp",
+			}, nil
+		},
+		"accounts verify-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "verify-totp",
+			}, nil
+		},
 		"accounts create": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -125,6 +154,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"accounts create ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"accounts create jwt": func() (cli.Command, error) {
+			return &accountscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"accounts update": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -143,6 +184,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"accounts update ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"accounts update jwt": func() (cli.Command, error) {
+			return &accountscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
@@ -185,6 +238,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"auth-methods create ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"auth-methods create jwt": func() (cli.Command, error) {
+			return &authmethodscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"auth-methods update": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui),
@@ -203,6 +268,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"auth-methods update ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"auth-methods update jwt": func() (cli.Command, error) {
+			return &authmethodscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"auth-methods change-state oidc": func() (cli.Command, error) {
 			return &authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui),
@@ -233,6 +310,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"auth-tokens refresh": func() (cli.Command, error) {
+			return &authtokenscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "refresh",
+			}, nil
+		},
 
 		"config": func() (cli.Command, error) {
 			return &config.Command{
@@ -778,6 +861,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"managed-groups create ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"managed-groups create jwt": func() (cli.Command, error) {
+			return &managedgroupscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"managed-groups update": func() (cli.Command, error) {
 			return &managedgroupscmd.Command{
 				Command: base.NewCommand(ui),
@@ -790,6 +885,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"managed-groups update ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"managed-groups update jwt": func() (cli.Command, error) {
+			return &managedgroupscmd.JwtCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"roles": func() (cli.Command, error) {
 			return &rolescmd.Command{
@@ -862,6 +969,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-grants",
 			}, nil
 		},
+		"roles explain": func() (cli.Command, error) {
+			return &rolescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "explain",
+			}, nil
+		},
 
 		"scopes": func() (cli.Command, error) {
 			return &scopescmd.Command{
@@ -898,6 +1011,78 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"scopes rotate-keys": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "rotate-keys",
+			}, nil
+		},
+		"scopes list-keys": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list-keys",
+			}, nil
+		},
+		"scopes destroy-key-version": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "destroy-key-version",
+			}, nil
+		},
+
+		"service-accounts": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"service-accounts create": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"service-accounts read": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"service-accounts update": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"service-accounts delete": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"service-accounts list": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"service-accounts create-token": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create-token",
+			}, nil
+		},
+		"service-accounts list-tokens": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list-tokens",
+			}, nil
+		},
+		"service-accounts revoke-token": func() (cli.Command, error) {
+			return &serviceaccountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "revoke-token",
+			}, nil
+		},
 
 		"sessions": func() (cli.Command, error) {
 			return &sessionscmd.Command{
@@ -922,6 +1107,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions list-recordings": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list-recordings",
+			}, nil
+		},
+		"sessions download-recording": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "download-recording",
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targetscmd.Command{
@@ -970,6 +1167,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"targets create http": func() (cli.Command, error) {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"targets create postgres": func() (cli.Command, error) {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"targets update": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -988,6 +1197,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"targets update http": func() (cli.Command, error) {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"targets update postgres": func() (cli.Command, error) {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"targets add-host-sets": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
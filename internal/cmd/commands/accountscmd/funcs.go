@@ -2,6 +2,7 @@ package accountscmd
 
 import (
 	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"strings"
@@ -15,24 +16,35 @@ import (
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 )
 
+const (
+	disabledFlagName = "disabled"
+)
+
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
 	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
+	enrollTotpResult    *accounts.TotpEnrollResult
+	verifyTotpResult    *accounts.TotpVerifyResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"unlock":          {"id"},
+		"enroll-totp":     {"id"},
+		"verify-totp":     {"id", "code"},
 	}
 }
 
@@ -44,6 +56,15 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "unlock":
+		return "Unlock an account locked after too many failed authentication attempts"
+
+	case "enroll-totp":
+		return "Enroll an account in TOTP multi-factor authentication"
+
+	case "verify-totp":
+		return "Verify the TOTP enrollment of an account"
+
 	default:
 		return ""
 	}
@@ -88,6 +109,42 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command allows unlocking a password-type account which has been locked after too many failed authentication attempts. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command allows enrolling a password-type account in TOTP multi-factor authentication. The returned otpauth URI can be imported into an authenticator app. The enrollment must be verified with \"boundary accounts verify-totp\" before it is used for authentication, and any existing verified enrollment stays in use until then. Example:",
+			"",
+			"    Enroll a password-type account in TOTP:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "verify-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts verify-totp [options] [args]",
+			"",
+			"  This command allows verifying the TOTP enrollment of a password-type account with a code generated by an authenticator app. The returned recovery codes can each be used once in place of a TOTP code when authenticating. Example:",
+			"",
+			"    Verify the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts verify-totp -id acctpw_1234567890 -code 123456`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -113,6 +170,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "The TOTP code generated by the authenticator app.",
+			})
 		}
 	}
 }
@@ -221,6 +284,11 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		}
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		c.UI.Error("Code must be passed in via -code")
+		return false
+	}
+
 	return true
 }
 
@@ -238,10 +306,63 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *accou
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "unlock":
+		result, err := accountClient.Unlock(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	case "enroll-totp":
+		var err error
+		c.enrollTotpResult, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "verify-totp":
+		var err error
+		c.verifyTotpResult, err = accountClient.VerifyTotp(c.Context, c.FlagId, c.flagCode, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printItemTable(c.enrollTotpResult.GetItem(), c.enrollTotpResult.GetResponse()))
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"  TOTP Enrollment:",
+				fmt.Sprintf("    otpauth URI:  %s", c.enrollTotpResult.OtpauthUri),
+			}))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.enrollTotpResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	case "verify-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printItemTable(c.verifyTotpResult.GetItem(), c.verifyTotpResult.GetResponse()))
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"  Recovery Codes:",
+				base.WrapSlice(4, c.verifyTotpResult.RecoveryCodes),
+			}))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.verifyTotpResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
@@ -284,6 +405,11 @@ func (c *Command) printListTable(items []*accounts.Account) string {
 				fmt.Sprintf("    Description:         %s", item.Description),
 			)
 		}
+		if item.State != "" {
+			output = append(output,
+				fmt.Sprintf("    State:               %s", item.State),
+			)
+		}
 		if len(item.AuthorizedActions) > 0 {
 			output = append(output,
 				"    Authorized Actions:",
@@ -321,6 +447,9 @@ func printItemTable(item *accounts.Account, resp *api.Response) string {
 	if item.Description != "" {
 		nonAttributeMap["Description"] = item.Description
 	}
+	if item.State != "" {
+		nonAttributeMap["State"] = item.State
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -368,3 +497,28 @@ func printItemTable(item *accounts.Account, resp *api.Response) string {
 var keySubstMap = map[string]string{
 	"login_name": "Login Name",
 }
+
+// disabledFlag adds the -disabled flag shared by the create and update
+// commands of every account type.
+func disabledFlag(f *base.FlagSet, target *bool) {
+	f.BoolVar(&base.BoolVar{
+		Name:   disabledFlagName,
+		Target: target,
+		Usage:  "If set, the account is disabled: it cannot authenticate, the auth tokens issued to it are rejected and the sessions created with them are canceled. Use -disabled=false to re-enable the account.",
+	})
+}
+
+// handleDisabledFlag appends the state option if the -disabled flag was
+// explicitly given, so that -disabled=false can re-enable an account.
+func handleDisabledFlag(set *base.FlagSets, disabled bool, opts *[]accounts.Option) {
+	set.Visit(func(fl *flag.Flag) {
+		if fl.Name != disabledFlagName {
+			return
+		}
+		if disabled {
+			*opts = append(*opts, accounts.WithState("disabled"))
+		} else {
+			*opts = append(*opts, accounts.WithState("active"))
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package accountscmd
+
+import (
+	"github.com/hashicorp/boundary/api/accounts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraJwtActionsFlagsMapFunc = extraJwtActionsFlagsMapFuncImpl
+	extraJwtFlagsFunc = extraJwtFlagsFuncImpl
+	extraJwtFlagsHandlingFunc = extraJwtFlagsHandlingFuncImpl
+}
+
+func extraJwtActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {subjectFlagName, disabledFlagName},
+		"update": {disabledFlagName},
+	}
+}
+
+type extraJwtCmdVars struct {
+	flagSubject  string
+	flagDisabled bool
+}
+
+func (c *JwtCommand) extraJwtHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts create jwt [options] [args]",
+			"",
+			"  Create a jwt-type account. Example:",
+			"",
+			`    $ boundary accounts create jwt -subject "system:serviceaccount:ci:runner" -description "Jwt account for the CI runner"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts update jwt [options] [args]",
+			"",
+			"  Update a jwt-type account given its ID. Example:",
+			"",
+			`    $ boundary accounts update jwt -id acctjwt_1234567890 -name "runner" -description "Jwt account for the CI runner"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraJwtFlagsFuncImpl(c *JwtCommand, set *base.FlagSets, f *base.FlagSet) {
+	f = set.NewFlagSet("Jwt Account Options")
+
+	for _, name := range flagsJwtMap[c.Func] {
+		switch name {
+		case subjectFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   subjectFlagName,
+				Target: &c.flagSubject,
+				Usage:  "The subject claim of the tokens which authenticate as this account.",
+			})
+		case disabledFlagName:
+			disabledFlag(f, &c.flagDisabled)
+		}
+	}
+}
+
+func extraJwtFlagsHandlingFuncImpl(c *JwtCommand, set *base.FlagSets, opts *[]accounts.Option) bool {
+	switch c.flagSubject {
+	case "null", "":
+		if c.Func == "create" {
+			c.UI.Error("Subject must be passed in via -subject")
+			return false
+		}
+	default:
+		if c.Func != "create" {
+			c.UI.Error("-subject can only be set when creating a jwt account")
+			return false
+		}
+		*opts = append(*opts, accounts.WithJwtAccountSubject(c.flagSubject))
+	}
+	handleDisabledFlag(set, c.flagDisabled, opts)
+	return true
+}
@@ -18,13 +18,15 @@ func init() {
 
 func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {subjectFlagName, issuerFlagName},
+		"create": {subjectFlagName, issuerFlagName, disabledFlagName},
+		"update": {disabledFlagName},
 	}
 }
 
 type extraOidcCmdVars struct {
-	flagIssuer  string
-	flagSubject string
+	flagIssuer   string
+	flagSubject  string
+	flagDisabled bool
 }
 
 func (c *OidcCommand) extraOidcHelpFunc(helpMap map[string]func() string) string {
@@ -72,11 +74,13 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, f *base.FlagSet)
 				Target: &c.flagSubject,
 				Usage:  "The subject for this account on the OIDC provider.",
 			})
+		case disabledFlagName:
+			disabledFlag(f, &c.flagDisabled)
 		}
 	}
 }
 
-func extraOidcFlagsHandlingFuncImpl(c *OidcCommand, _ *base.FlagSets, opts *[]accounts.Option) bool {
+func extraOidcFlagsHandlingFuncImpl(c *OidcCommand, set *base.FlagSets, opts *[]accounts.Option) bool {
 	switch c.flagSubject {
 	case "null", "":
 		if c.Func == "create" {
@@ -101,5 +105,6 @@ func extraOidcFlagsHandlingFuncImpl(c *OidcCommand, _ *base.FlagSets, opts *[]ac
 		}
 		*opts = append(*opts, accounts.WithOidcAccountIssuer(c.flagIssuer))
 	}
+	handleDisabledFlag(set, c.flagDisabled, opts)
 	return true
 }
@@ -21,14 +21,15 @@ func init() {
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"login-name", "password"},
-		"update": {"login-name"},
+		"create": {"login-name", "password", disabledFlagName},
+		"update": {"login-name", disabledFlagName},
 	}
 }
 
 type extraPasswordCmdVars struct {
 	flagLoginName string
 	flagPassword  string
+	flagDisabled  bool
 }
 
 func (c *PasswordCommand) extraPasswordHelpFunc(helpMap map[string]func() string) string {
@@ -76,11 +77,13 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagPassword,
 				Usage:  "The password for the account. If blank, the command will prompt for the password to be entered interactively in a non-echoing way. Otherwise, this can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
 			})
+		case disabledFlagName:
+			disabledFlag(f, &c.flagDisabled)
 		}
 	}
 }
 
-func extraPasswordFlagsHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opts *[]accounts.Option) bool {
+func extraPasswordFlagsHandlingFuncImpl(c *PasswordCommand, set *base.FlagSets, opts *[]accounts.Option) bool {
 	if c.Func == "create" && c.flagLoginName == "" {
 		c.UI.Error("Login Name must be passed in via -login-name")
 		return false
@@ -132,5 +135,6 @@ func extraPasswordFlagsHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, op
 		}
 	}
 
+	handleDisabledFlag(set, c.flagDisabled, opts)
 	return true
 }
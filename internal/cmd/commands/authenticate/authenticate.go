@@ -30,6 +30,14 @@ func (c *Command) Help() string {
 		"",
 		"      $ boundary authenticate oidc -auth-method-id amoidc_1234567890",
 		"",
+		"    Authenticate with an LDAP auth method:",
+		"",
+		"      $ boundary authenticate ldap -auth-method-id amldap_1234567890 -login-name foo",
+		"",
+		"    Authenticate with a JWT auth method:",
+		"",
+		"      $ boundary authenticate jwt -auth-method-id amjwt_1234567890 -token env://CI_JOB_JWT",
+		"",
 		"  Please see the auth method subcommand help for detailed usage information.",
 	})
 }
@@ -0,0 +1,127 @@
+package authenticate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*JwtCommand)(nil)
+	_ cli.CommandAutocomplete = (*JwtCommand)(nil)
+)
+
+var envJwtToken = "BOUNDARY_AUTHENTICATE_JWT_TOKEN"
+
+type JwtCommand struct {
+	*base.Command
+
+	flagToken string
+}
+
+func (c *JwtCommand) Synopsis() string {
+	return wordwrap.WrapString("Invoke the JWT auth method to authenticate with Boundary", base.TermWidth)
+}
+
+func (c *JwtCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary authenticate jwt [options] [args]",
+		"",
+		"  Invoke the JWT auth method to authenticate the Boundary CLI using a signed token issued by a trusted platform. Example:",
+		"",
+		`    $ boundary authenticate jwt -auth-method-id amjwt_1234567890 -token file:///var/run/secrets/tokens/boundary`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *JwtCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "token",
+		Target: &c.flagToken,
+		EnvVar: envJwtToken,
+		Usage:  "The signed JWT to authenticate with. This can refer to a file on disk (file://) from which the token will be read or an env var (env://) from which the token will be read.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "auth-method-id",
+		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
+		Target: &c.FlagAuthMethodId,
+		Usage:  "The auth-method resource to use for the operation.",
+	})
+
+	return set
+}
+
+func (c *JwtCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *JwtCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *JwtCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.flagToken == "":
+		c.PrintCliError(errors.New("Token must be provided via -token"))
+		return base.CommandUserError
+	case c.FlagAuthMethodId == "":
+		c.PrintCliError(errors.New("Auth method ID must be provided via -auth-method-id"))
+		return base.CommandUserError
+	}
+
+	token, err := parseutil.ParsePath(c.flagToken)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.UI.Error(fmt.Sprintf("Error parsing token flag: %v", err))
+		return base.CommandUserError
+	}
+	c.flagToken = strings.TrimSpace(token)
+
+	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	aClient := authmethods.NewClient(client)
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
+		map[string]interface{}{
+			"token": c.flagToken,
+		})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing authentication")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to perform authentication: %w", err))
+		return base.CommandCliError
+	}
+
+	return saveAndOrPrintToken(c.Command, result)
+}
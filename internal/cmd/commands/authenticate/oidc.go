@@ -24,6 +24,8 @@ var (
 
 type OidcCommand struct {
 	*base.Command
+
+	flagUseDeviceCode bool
 }
 
 func (c *OidcCommand) Synopsis() string {
@@ -38,6 +40,10 @@ func (c *OidcCommand) Help() string {
 		"",
 		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
 		"",
+		"  On hosts without a browser, use -use-device-code to authorize the CLI from another device instead. Example:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890 -use-device-code`,
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -53,6 +59,13 @@ func (c *OidcCommand) Flags() *base.FlagSets {
 		Usage:  "The auth-method resource to use for the operation",
 	})
 
+	f.BoolVar(&base.BoolVar{
+		Name:   "use-device-code",
+		EnvVar: "BOUNDARY_AUTHENTICATE_OIDC_USE_DEVICE_CODE",
+		Target: &c.flagUseDeviceCode,
+		Usage:  "If set, instead of opening a browser, print a URL and code which can be used to authorize the CLI from another device. The provider must support the device authorization grant.",
+	})
+
 	return set
 }
 
@@ -92,6 +105,14 @@ func (c *OidcCommand) Run(args []string) int {
 	}
 
 	aClient := authmethods.NewClient(client)
+	if c.flagUseDeviceCode {
+		result, retCode := c.authenticateDevice(aClient)
+		if retCode != 0 {
+			return retCode
+		}
+		return saveAndOrPrintToken(c.Command, result)
+	}
+
 	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "start", nil)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
@@ -163,3 +184,69 @@ func (c *OidcCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// authenticateDevice performs the device authorization flow: it prints the
+// verification URL and user code returned by the device-start command, then
+// polls the device-token command until the user has authorized the request.
+func (c *OidcCommand) authenticateDevice(aClient *authmethods.Client) (*authmethods.AuthenticateResult, int) {
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "device-start", nil)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing device authentication start")
+			return nil, base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to perform device authentication start: %w", err))
+		return nil, base.CommandCliError
+	}
+
+	startResp := new(authmethods.OidcAuthMethodAuthenticateDeviceStartResponse)
+	if err := json.Unmarshal(result.GetRawAttributes(), startResp); err != nil {
+		c.PrintCliError(fmt.Errorf("Error trying to decode device authentication start response: %w", err))
+		return nil, base.CommandCliError
+	}
+
+	msg := fmt.Sprintf("To authenticate, visit %s and enter the code: %s", startResp.VerificationUri, startResp.UserCode)
+	if startResp.VerificationUriComplete != "" {
+		msg = fmt.Sprintf("%s\nOr visit %s", msg, startResp.VerificationUriComplete)
+	}
+	if base.Format(c.UI) == "table" {
+		c.UI.Output(msg)
+	} else {
+		// Keep stdout parseable for other formats
+		c.UI.Warn(msg)
+	}
+
+	interval := time.Duration(startResp.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	for {
+		select {
+		case <-c.Context.Done():
+			c.PrintCliError(errors.New("Command canceled."))
+			return nil, base.CommandCliError
+
+		case <-time.After(interval):
+			result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "device-token", map[string]interface{}{
+				"token_id": startResp.TokenId,
+			})
+			if err != nil {
+				if apiErr := api.AsServerError(err); apiErr != nil {
+					c.PrintApiError(apiErr, "Error from controller when performing device authentication token fetch")
+					return nil, base.CommandApiError
+				}
+				c.PrintCliError(fmt.Errorf("Error trying to perform device authentication token fetch: %w", err))
+				return nil, base.CommandCliError
+			}
+			if result.GetResponse().StatusCode() == http.StatusAccepted {
+				// Nothing yet -- circle around, more slowly if the provider
+				// asked for it.
+				if result.Attributes["status"] == "slow_down" {
+					interval += 5 * time.Second
+				}
+				continue
+			}
+			return result, 0
+		}
+	}
+}
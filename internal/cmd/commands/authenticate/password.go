@@ -14,6 +14,7 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
+	"google.golang.org/grpc/codes"
 )
 
 var (
@@ -22,15 +23,23 @@ var (
 )
 
 var (
-	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
-	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envPassword    = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
+	envNewPassword = "BOUNDARY_AUTHENTICATE_PASSWORD_NEW_PASSWORD"
+	envLoginName   = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode    = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
 )
 
+// totpCodeField is the request field named in the error returned when a TOTP
+// code is required to authenticate.
+const totpCodeField = "attributes.totp_code"
+
 type PasswordCommand struct {
 	*base.Command
 
-	flagLoginName string
-	flagPassword  string
+	flagLoginName   string
+	flagPassword    string
+	flagNewPassword string
+	flagTotpCode    string
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -45,6 +54,10 @@ func (c *PasswordCommand) Help() string {
 		"",
 		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo`,
 		"",
+		"  If the password has expired, a new password must be provided. When the password is entered interactively, the command will prompt for the new password.",
+		"",
+		"  If the account is enrolled in TOTP multi-factor authentication and no code is provided with -totp-code, the command will prompt for a TOTP code or a recovery code.",
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -67,6 +80,20 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name. If blank, the command will prompt for the password to be entered interactively in a non-echoing way. Otherwise, this can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "new-password",
+		Target: &c.flagNewPassword,
+		EnvVar: envNewPassword,
+		Usage:  "A new password for the account, used to change the password if it has expired. This can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "A TOTP code or an unused recovery code for accounts enrolled in TOTP multi-factor authentication. If blank and a code is required, the command will prompt for the code.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -102,16 +129,14 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandUserError
 	}
 
+	interactive := c.flagPassword == ""
 	switch c.flagPassword {
 	case "":
-		fmt.Print("Please enter the password (it will be hidden): ")
-		value, err := password.Read(os.Stdin)
-		fmt.Print("\n")
-		if err != nil {
-			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the password. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+		value, ok := c.readHidden("Please enter the password (it will be hidden): ", "password")
+		if !ok {
 			return base.CommandUserError
 		}
-		c.flagPassword = strings.TrimSpace(value)
+		c.flagPassword = value
 
 	default:
 		password, err := parseutil.MustParsePath(c.flagPassword)
@@ -127,6 +152,20 @@ func (c *PasswordCommand) Run(args []string) int {
 		c.flagPassword = password
 	}
 
+	if c.flagNewPassword != "" {
+		newPassword, err := parseutil.MustParsePath(c.flagNewPassword)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("New password flag must be used with env:// or file:// syntax")
+			return base.CommandUserError
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing new password flag: %v", err))
+			return base.CommandUserError
+		}
+		c.flagNewPassword = newPassword
+	}
+
 	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
 	if c.WrapperCleanupFunc != nil {
 		defer func() {
@@ -141,11 +180,43 @@ func (c *PasswordCommand) Run(args []string) int {
 	}
 
 	aClient := authmethods.NewClient(client)
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]interface{}{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	attrs := map[string]interface{}{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagNewPassword != "" {
+		attrs["new_password"] = c.flagNewPassword
+	}
+	if c.flagTotpCode != "" {
+		attrs["totp_code"] = c.flagTotpCode
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+retry:
+	for {
+		apiErr := api.AsServerError(err)
+		if apiErr == nil {
+			break
+		}
+		switch {
+		case isTotpCodeRequired(apiErr) && attrs["totp_code"] == nil:
+			// The account is enrolled in TOTP, so prompt for a code and try again.
+			value, ok := c.readHidden("Please enter the TOTP code or a recovery code (it will be hidden): ", "TOTP code")
+			if !ok {
+				return base.CommandUserError
+			}
+			attrs["totp_code"] = value
+		case apiErr.Kind == codes.FailedPrecondition.String() && interactive && attrs["new_password"] == nil:
+			// The password has expired, so prompt for a new one and try again.
+			value, ok := c.readHidden("The password has expired. Please enter a new password (it will be hidden): ", "password")
+			if !ok {
+				return base.CommandUserError
+			}
+			attrs["new_password"] = value
+		default:
+			break retry
+		}
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -157,3 +228,31 @@ func (c *PasswordCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// readHidden prompts for a value, such as a password, to be entered
+// interactively in a non-echoing way. It returns false if the value could not
+// be read.
+func (c *PasswordCommand) readHidden(prompt, name string) (string, bool) {
+	fmt.Print(prompt)
+	value, err := password.Read(os.Stdin)
+	fmt.Print("\n")
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("An error occurred attempting to read the %s. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", name, err.Error()))
+		return "", false
+	}
+	return strings.TrimSpace(value), true
+}
+
+// isTotpCodeRequired returns true if apiErr indicates that a TOTP code must be
+// provided to authenticate.
+func isTotpCodeRequired(apiErr *api.Error) bool {
+	if apiErr.Kind != codes.InvalidArgument.String() || apiErr.Details == nil {
+		return false
+	}
+	for _, f := range apiErr.Details.RequestFields {
+		if f.Name == totpCodeField {
+			return true
+		}
+	}
+	return false
+}
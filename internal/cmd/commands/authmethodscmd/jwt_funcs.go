@@ -0,0 +1,205 @@
+package authmethodscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraJwtActionsFlagsMapFunc = extraJwtActionsFlagsMapFuncImpl
+	extraJwtFlagsFunc = extraJwtFlagsFuncImpl
+	extraJwtFlagsHandlingFunc = extraJwtFlagHandlingFuncImpl
+}
+
+type extraJwtCmdVars struct {
+	flagBoundIssuer       string
+	flagJwksUrl           string
+	flagJwksCaCert        string
+	flagPublicKeys        []string
+	flagBoundAudiences    []string
+	flagSigningAlgorithms []string
+	flagBoundClaims       []string
+	flagAccountClaimMaps  []string
+}
+
+const (
+	boundIssuerFlagName   = "bound-issuer"
+	jwksUrlFlagName       = "jwks-url"
+	jwksCaCertFlagName    = "jwks-ca-cert"
+	publicKeyFlagName     = "public-key"
+	boundAudienceFlagName = "bound-audience"
+	boundClaimFlagName    = "bound-claim"
+)
+
+func extraJwtActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			boundIssuerFlagName,
+			jwksUrlFlagName,
+			jwksCaCertFlagName,
+			publicKeyFlagName,
+			boundAudienceFlagName,
+			signingAlgorithmFlagName,
+			boundClaimFlagName,
+			accountClaimMaps,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraJwtFlagsFuncImpl(c *JwtCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("JWT Auth Method Options")
+
+	for _, name := range flagsJwtMap[c.Func] {
+		switch name {
+		case boundIssuerFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   boundIssuerFlagName,
+				Target: &c.flagBoundIssuer,
+				Usage:  `The required issuer ("iss") claim of the tokens.`,
+			})
+		case jwksUrlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   jwksUrlFlagName,
+				Target: &c.flagJwksUrl,
+				Usage:  "The URL of the JSON Web Key Set used to verify token signatures. Cannot be used along with -public-key.",
+			})
+		case jwksCaCertFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   jwksCaCertFlagName,
+				Target: &c.flagJwksCaCert,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as a trust anchor when fetching the JSON Web Key Set. This can refer to a file on disk (file://) or an env var (env://).",
+			})
+		case publicKeyFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   publicKeyFlagName,
+				Target: &c.flagPublicKeys,
+				Usage:  "A PEM-encoded public key used to verify token signatures. This can refer to a file on disk (file://) or an env var (env://). May be specified multiple times.",
+			})
+		case boundAudienceFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   boundAudienceFlagName,
+				Target: &c.flagBoundAudiences,
+				Usage:  `An acceptable audience ("aud") claim of the tokens. May be specified multiple times.`,
+			})
+		case signingAlgorithmFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   signingAlgorithmFlagName,
+				Target: &c.flagSigningAlgorithms,
+				Usage:  "The allowed signing algorithm. May be specified multiple times for multiple values.",
+			})
+		case boundClaimFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   boundClaimFlagName,
+				Target: &c.flagBoundClaims,
+				Usage:  `A claim constraint represented as claim=value, for example "namespace=ci". Tokens must match at least one value of each bound claim. May be specified multiple times.`,
+			})
+		case accountClaimMaps:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   accountClaimMaps,
+				Target: &c.flagAccountClaimMaps,
+				Usage:  `The optional account claim maps from custom claims to the standard claims of sub, name and email.  These maps are represented as key=value where the key equals the token from-claim and the value equals the Boundary to-claim.  For example "runner_id=sub". May be specified multiple times for different to-claims.`,
+			})
+		}
+	}
+}
+
+func (c *JwtCommand) extraJwtHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create jwt [options] [args]",
+			"",
+			"  Create a jwt-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create jwt -name k8s -jwks-url "https://kubernetes.example.com/openid/v1/jwks" -bound-audience boundary -signing-algorithm RS256 -description "Jwt auth-method for cluster workloads"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update jwt [options] [args]",
+			"",
+			"  Update a jwt-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update jwt -id amjwt_1234567890 -name "ci" -description "Jwt auth-method for CI runners"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraJwtFlagHandlingFuncImpl(c *JwtCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	for _, f := range []struct {
+		val       string
+		withFn    func(string) authmethods.Option
+		defaultFn func() authmethods.Option
+	}{
+		{c.flagBoundIssuer, authmethods.WithJwtAuthMethodBoundIssuer, authmethods.DefaultJwtAuthMethodBoundIssuer},
+		{c.flagJwksUrl, authmethods.WithJwtAuthMethodJwksUrl, authmethods.DefaultJwtAuthMethodJwksUrl},
+	} {
+		switch f.val {
+		case "":
+		case "null":
+			*opts = append(*opts, f.defaultFn())
+		default:
+			*opts = append(*opts, f.withFn(f.val))
+		}
+	}
+	switch c.flagJwksCaCert {
+	case "":
+	case "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodJwksCaCert())
+	default:
+		cert, err := parseutil.ParsePath(c.flagJwksCaCert)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing %s flag: %v", jwksCaCertFlagName, err))
+			return false
+		}
+		*opts = append(*opts, authmethods.WithJwtAuthMethodJwksCaCert(cert))
+	}
+	switch {
+	case len(c.flagPublicKeys) == 0:
+	case len(c.flagPublicKeys) == 1 && c.flagPublicKeys[0] == "null":
+		*opts = append(*opts, authmethods.DefaultJwtAuthMethodPublicKeys())
+	default:
+		keys := make([]string, 0, len(c.flagPublicKeys))
+		for _, k := range c.flagPublicKeys {
+			key, err := parseutil.ParsePath(k)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				c.UI.Error(fmt.Sprintf("Error parsing %s flag: %v", publicKeyFlagName, err))
+				return false
+			}
+			keys = append(keys, key)
+		}
+		*opts = append(*opts, authmethods.WithJwtAuthMethodPublicKeys(keys))
+	}
+	for _, f := range []struct {
+		vals      []string
+		withFn    func([]string) authmethods.Option
+		defaultFn func() authmethods.Option
+	}{
+		{c.flagBoundAudiences, authmethods.WithJwtAuthMethodBoundAudiences, authmethods.DefaultJwtAuthMethodBoundAudiences},
+		{c.flagSigningAlgorithms, authmethods.WithJwtAuthMethodSigningAlgorithms, authmethods.DefaultJwtAuthMethodSigningAlgorithms},
+		{c.flagBoundClaims, authmethods.WithJwtAuthMethodBoundClaims, authmethods.DefaultJwtAuthMethodBoundClaims},
+		{c.flagAccountClaimMaps, authmethods.WithJwtAuthMethodAccountClaimMaps, authmethods.DefaultJwtAuthMethodAccountClaimMaps},
+	} {
+		switch {
+		case len(f.vals) == 0:
+		case len(f.vals) == 1 && f.vals[0] == "null":
+			*opts = append(*opts, f.defaultFn())
+		default:
+			*opts = append(*opts, f.withFn(f.vals))
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,265 @@
+package authmethodscmd
+
+import (
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagHandlingFuncImpl
+}
+
+type extraLdapCmdVars struct {
+	flagUrls            []string
+	flagCertificates    []string
+	flagStartTls        bool
+	flagInsecureTls     bool
+	flagDiscoverDn      bool
+	flagAnonGroupSearch bool
+	flagEnableGroups    bool
+	flagUpnDomain       string
+	flagUserDn          string
+	flagUserAttr        string
+	flagUserFilter      string
+	flagGroupDn         string
+	flagGroupAttr       string
+	flagGroupFilter     string
+	flagBindDn          string
+	flagBindPassword    string
+}
+
+const (
+	urlsFlagName            = "urls"
+	certificateFlagName     = "certificate"
+	startTlsFlagName        = "start-tls"
+	insecureTlsFlagName     = "insecure-tls"
+	discoverDnFlagName      = "discover-dn"
+	anonGroupSearchFlagName = "anon-group-search"
+	enableGroupsFlagName    = "enable-groups"
+	upnDomainFlagName       = "upn-domain"
+	userDnFlagName          = "user-dn"
+	userAttrFlagName        = "user-attr"
+	userFilterFlagName      = "user-filter"
+	groupDnFlagName         = "group-dn"
+	groupAttrFlagName       = "group-attr"
+	groupFilterFlagName     = "group-filter"
+	bindDnFlagName          = "bind-dn"
+	bindPasswordFlagName    = "bind-password"
+)
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			urlsFlagName,
+			certificateFlagName,
+			startTlsFlagName,
+			insecureTlsFlagName,
+			discoverDnFlagName,
+			anonGroupSearchFlagName,
+			enableGroupsFlagName,
+			upnDomainFlagName,
+			userDnFlagName,
+			userAttrFlagName,
+			userFilterFlagName,
+			groupDnFlagName,
+			groupAttrFlagName,
+			groupFilterFlagName,
+			bindDnFlagName,
+			bindPasswordFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("LDAP Auth Method Options")
+
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case urlsFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   urlsFlagName,
+				Target: &c.flagUrls,
+				Usage:  `The LDAP server URL, using either the "ldap" or "ldaps" scheme. May be specified multiple times; the servers are tried in the given order.`,
+			})
+		case certificateFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificates,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as a trust anchor when connecting to the LDAP server. May be specified multiple times.",
+			})
+		case startTlsFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   startTlsFlagName,
+				Target: &c.flagStartTls,
+				Usage:  `Issue the StartTLS command after connecting to an "ldap" URL.`,
+			})
+		case insecureTlsFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   insecureTlsFlagName,
+				Target: &c.flagInsecureTls,
+				Usage:  "Skip verification of the LDAP server's TLS certificate.",
+			})
+		case discoverDnFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   discoverDnFlagName,
+				Target: &c.flagDiscoverDn,
+				Usage:  "Use anonymous bind to discover the bind DN of a user.",
+			})
+		case anonGroupSearchFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   anonGroupSearchFlagName,
+				Target: &c.flagAnonGroupSearch,
+				Usage:  "Use anonymous bind when performing LDAP group searches.",
+			})
+		case enableGroupsFlagName:
+			f.BoolVar(&base.BoolVar{
+				Name:   enableGroupsFlagName,
+				Target: &c.flagEnableGroups,
+				Usage:  "Find the groups an account is a member of at login time, which determines its managed group memberships.",
+			})
+		case upnDomainFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   upnDomainFlagName,
+				Target: &c.flagUpnDomain,
+				Usage:  "The userPrincipalDomain used to construct the UPN string for the authenticating user.",
+			})
+		case userDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userDnFlagName,
+				Target: &c.flagUserDn,
+				Usage:  "The base DN under which to perform user search.",
+			})
+		case userAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userAttrFlagName,
+				Target: &c.flagUserAttr,
+				Usage:  `The attribute on a user's entry matching the login name, for example "uid" or "cn".`,
+			})
+		case userFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userFilterFlagName,
+				Target: &c.flagUserFilter,
+				Usage:  "A go template used to construct an LDAP user search filter.",
+			})
+		case groupDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupDnFlagName,
+				Target: &c.flagGroupDn,
+				Usage:  "The base DN under which to perform group search.",
+			})
+		case groupAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupAttrFlagName,
+				Target: &c.flagGroupAttr,
+				Usage:  `The attribute on a group's entry which contains the group's name, for example "cn".`,
+			})
+		case groupFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupFilterFlagName,
+				Target: &c.flagGroupFilter,
+				Usage:  "A go template used to construct an LDAP group search filter.",
+			})
+		case bindDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindDnFlagName,
+				Target: &c.flagBindDn,
+				Usage:  "The distinguished name of the entry to bind as when performing user and group search.",
+			})
+		case bindPasswordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindPasswordFlagName,
+				Target: &c.flagBindPassword,
+				Usage:  "The password to use along with the bind DN when performing user and group search.",
+			})
+		}
+	}
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create ldap [options] [args]",
+			"",
+			"  Create an ldap-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create ldap -name prodops -urls "ldaps://ldap.example.org" -user-dn "ou=people,dc=example,dc=org" -description "Ldap auth-method for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update ldap [options] [args]",
+			"",
+			"  Update an ldap-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update ldap -id amldap_1234567890 -name "devops" -description "Ldap auth-method for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch {
+	case len(c.flagUrls) == 0:
+	case len(c.flagUrls) == 1 && c.flagUrls[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodUrls())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodUrls(c.flagUrls))
+	}
+	switch {
+	case len(c.flagCertificates) == 0:
+	case len(c.flagCertificates) == 1 && c.flagCertificates[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodCertificates())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodCertificates(c.flagCertificates))
+	}
+	if c.flagStartTls {
+		*opts = append(*opts, authmethods.WithLdapAuthMethodStartTls(c.flagStartTls))
+	}
+	if c.flagInsecureTls {
+		*opts = append(*opts, authmethods.WithLdapAuthMethodInsecureTls(c.flagInsecureTls))
+	}
+	if c.flagDiscoverDn {
+		*opts = append(*opts, authmethods.WithLdapAuthMethodDiscoverDn(c.flagDiscoverDn))
+	}
+	if c.flagAnonGroupSearch {
+		*opts = append(*opts, authmethods.WithLdapAuthMethodAnonGroupSearch(c.flagAnonGroupSearch))
+	}
+	if c.flagEnableGroups {
+		*opts = append(*opts, authmethods.WithLdapAuthMethodEnableGroups(c.flagEnableGroups))
+	}
+	for _, f := range []struct {
+		val       string
+		withFn    func(string) authmethods.Option
+		defaultFn func() authmethods.Option
+	}{
+		{c.flagUpnDomain, authmethods.WithLdapAuthMethodUpnDomain, authmethods.DefaultLdapAuthMethodUpnDomain},
+		{c.flagUserDn, authmethods.WithLdapAuthMethodUserDn, authmethods.DefaultLdapAuthMethodUserDn},
+		{c.flagUserAttr, authmethods.WithLdapAuthMethodUserAttr, authmethods.DefaultLdapAuthMethodUserAttr},
+		{c.flagUserFilter, authmethods.WithLdapAuthMethodUserFilter, authmethods.DefaultLdapAuthMethodUserFilter},
+		{c.flagGroupDn, authmethods.WithLdapAuthMethodGroupDn, authmethods.DefaultLdapAuthMethodGroupDn},
+		{c.flagGroupAttr, authmethods.WithLdapAuthMethodGroupAttr, authmethods.DefaultLdapAuthMethodGroupAttr},
+		{c.flagGroupFilter, authmethods.WithLdapAuthMethodGroupFilter, authmethods.DefaultLdapAuthMethodGroupFilter},
+		{c.flagBindDn, authmethods.WithLdapAuthMethodBindDn, authmethods.DefaultLdapAuthMethodBindDn},
+		{c.flagBindPassword, authmethods.WithLdapAuthMethodBindPassword, authmethods.DefaultLdapAuthMethodBindPassword},
+	} {
+		switch f.val {
+		case "":
+		case "null":
+			*opts = append(*opts, f.defaultFn())
+		default:
+			*opts = append(*opts, f.withFn(f.val))
+		}
+	}
+
+	return true
+}
@@ -15,15 +15,41 @@ func init() {
 }
 
 type extraPasswordCmdVars struct {
-	flagMinLoginNameLength string
-	flagMinPasswordLength  string
+	flagMinLoginNameLength     string
+	flagMinPasswordLength      string
+	flagRequireUppercase       string
+	flagRequireLowercase       string
+	flagRequireDigit           string
+	flagRequireSpecial         string
+	flagDisallowLoginName      string
+	flagDisallowedWords        []string
+	flagPasswordHistoryCount   string
+	flagMaxPasswordAgeSeconds  string
+	flagLockoutThreshold       string
+	flagLockoutDurationSeconds string
+	flagMfaRequired            string
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
-	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+	flags := map[string][]string{
+		"create": {
+			"min-login-name-length",
+			"min-password-length",
+			"require-uppercase",
+			"require-lowercase",
+			"require-digit",
+			"require-special",
+			"disallow-login-name",
+			"disallowed-word",
+			"password-history-count",
+			"max-password-age-seconds",
+			"lockout-threshold",
+			"lockout-duration-seconds",
+			"mfa-required",
+		},
 	}
+	flags["update"] = flags["create"]
+	return flags
 }
 
 func (c *PasswordCommand) extraPasswordHelpFunc(helpMap map[string]func() string) string {
@@ -71,6 +97,72 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "require-uppercase":
+			f.StringVar(&base.StringVar{
+				Name:   "require-uppercase",
+				Target: &c.flagRequireUppercase,
+				Usage:  "Whether passwords must contain an uppercase letter. Can be true or false.",
+			})
+		case "require-lowercase":
+			f.StringVar(&base.StringVar{
+				Name:   "require-lowercase",
+				Target: &c.flagRequireLowercase,
+				Usage:  "Whether passwords must contain a lowercase letter. Can be true or false.",
+			})
+		case "require-digit":
+			f.StringVar(&base.StringVar{
+				Name:   "require-digit",
+				Target: &c.flagRequireDigit,
+				Usage:  "Whether passwords must contain a digit. Can be true or false.",
+			})
+		case "require-special":
+			f.StringVar(&base.StringVar{
+				Name:   "require-special",
+				Target: &c.flagRequireSpecial,
+				Usage:  "Whether passwords must contain a character which is not a letter or a digit. Can be true or false.",
+			})
+		case "disallow-login-name":
+			f.StringVar(&base.StringVar{
+				Name:   "disallow-login-name",
+				Target: &c.flagDisallowLoginName,
+				Usage:  "Whether passwords must not contain the login name of the account. Can be true or false.",
+			})
+		case "disallowed-word":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "disallowed-word",
+				Target: &c.flagDisallowedWords,
+				Usage:  "A word which passwords must not contain, compared case insensitively. May be specified multiple times.",
+			})
+		case "password-history-count":
+			f.StringVar(&base.StringVar{
+				Name:   "password-history-count",
+				Target: &c.flagPasswordHistoryCount,
+				Usage:  "The number of previous passwords of an account which cannot be reused",
+			})
+		case "max-password-age-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "max-password-age-seconds",
+				Target: &c.flagMaxPasswordAgeSeconds,
+				Usage:  "The number of seconds after which a password expires and must be changed when authenticating. If 0, passwords never expire.",
+			})
+		case "lockout-threshold":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-threshold",
+				Target: &c.flagLockoutThreshold,
+				Usage:  "The number of consecutive failed authentication attempts after which an account is locked. If 0, accounts are never locked.",
+			})
+		case "lockout-duration-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-duration-seconds",
+				Target: &c.flagLockoutDurationSeconds,
+				Usage:  "The number of seconds a locked account stays locked. If 0, a locked account stays locked until it is unlocked with \"boundary accounts unlock\".",
+			})
+		case "mfa-required":
+			f.StringVar(&base.StringVar{
+				Name:   "mfa-required",
+				Target: &c.flagMfaRequired,
+				Usage:  "Whether accounts must be enrolled in TOTP and provide a TOTP code when authenticating. Can be true or false.",
+			})
 		}
 	}
 }
@@ -109,6 +201,62 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	for _, f := range []struct {
+		val  string
+		name string
+	}{
+		{c.flagRequireUppercase, "require_uppercase"},
+		{c.flagRequireLowercase, "require_lowercase"},
+		{c.flagRequireDigit, "require_digit"},
+		{c.flagRequireSpecial, "require_special"},
+		{c.flagDisallowLoginName, "disallow_login_name"},
+		{c.flagMfaRequired, "mfa_required"},
+	} {
+		switch f.val {
+		case "":
+		case "null":
+			addAttribute(f.name, nil)
+		default:
+			b, err := strconv.ParseBool(f.val)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", f.val, err))
+				return false
+			}
+			addAttribute(f.name, b)
+		}
+	}
+
+	switch {
+	case len(c.flagDisallowedWords) == 0:
+	case len(c.flagDisallowedWords) == 1 && c.flagDisallowedWords[0] == "null":
+		addAttribute("disallowed_words", nil)
+	default:
+		addAttribute("disallowed_words", c.flagDisallowedWords)
+	}
+
+	for _, f := range []struct {
+		val  string
+		name string
+	}{
+		{c.flagPasswordHistoryCount, "password_history_count"},
+		{c.flagMaxPasswordAgeSeconds, "max_password_age_seconds"},
+		{c.flagLockoutThreshold, "lockout_threshold"},
+		{c.flagLockoutDurationSeconds, "lockout_duration_seconds"},
+	} {
+		switch f.val {
+		case "":
+		case "null":
+			addAttribute(f.name, nil)
+		default:
+			n, err := strconv.ParseUint(f.val, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", f.val, err))
+				return false
+			}
+			addAttribute(f.name, uint32(n))
+		}
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
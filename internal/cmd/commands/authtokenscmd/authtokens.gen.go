@@ -76,7 +76,7 @@ func (c *Command) Help() string {
 
 	default:
 
-		helpStr = helpMap["base"]()
+		helpStr = c.extraHelpFunc(helpMap)
 
 	}
 
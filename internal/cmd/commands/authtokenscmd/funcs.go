@@ -14,11 +14,51 @@ import (
 const selfFlag = "self"
 
 func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"refresh": {"id"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "refresh":
+		return "Extend the expiration time of an auth token"
+
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "refresh":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-tokens refresh [options] [args]",
+			"",
+			"  This command allows extending the expiration time of an auth token using the time-to-live of the auth method which issued it. Expired and stale auth tokens cannot be refreshed. Example:",
+			"",
+			"    Refresh the stored auth token:",
+			"",
+			`      $ boundary auth-tokens refresh -id self`,
+			"",
+			"",
+		})
+	default:
+		helpStr = helpMap["base"]()
+	}
+	return helpStr + c.Flags().Help()
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Option) bool {
-	if c.Func != "delete" && c.Func != "read" {
+	if c.Func != "delete" && c.Func != "read" && c.Func != "refresh" {
 		if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
 			c.PrintCliError(errors.New("ID is required but not passed in via -id"))
 			return false
@@ -56,6 +96,18 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]authtokens.Op
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *authtokens.AuthToken, origItems []*authtokens.AuthToken, origError error, authtokenClient *authtokens.Client, _ uint32, opts []authtokens.Option) (*api.Response, *authtokens.AuthToken, []*authtokens.AuthToken, error) {
+	switch c.Func {
+	case "refresh":
+		result, err := authtokenClient.Refresh(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
 func (c *Command) printListTable(items []*authtokens.AuthToken) string {
 	if len(items) == 0 {
 		return "No auth tokens found"
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/posener/complete"
 )
 
@@ -52,10 +53,9 @@ func httpOptions(c *Command, set *base.FlagSets) {
 	f.StringVar(&base.StringVar{
 		Name:       "scheme",
 		Target:     &c.flagHttpScheme,
-		Default:    "https",
 		EnvVar:     "BOUNDARY_CONNECT_HTTP_SCHEME",
 		Completion: complete.PredictNothing,
-		Usage:      `Specifies the scheme to use.`,
+		Usage:      `Specifies the scheme to use. Defaults to "http" for http targets, since the worker proxies their requests, and to "https" otherwise.`,
 	})
 }
 
@@ -82,6 +82,13 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 		}
 		host = u.Hostname()
 	}
+	scheme := h.flagHttpScheme
+	if scheme == "" {
+		scheme = "https"
+		if target.SubtypeFromId(c.sessionAuthzData.TargetId) == "http" {
+			scheme = "http"
+		}
+	}
 	switch h.flagHttpStyle {
 	case "curl":
 		if h.flagHttpMethod != "" {
@@ -92,9 +99,9 @@ func (h *httpFlags) buildArgs(c *Command, port, ip, addr string) ([]string, erro
 			host = strings.TrimSuffix(host, "/")
 			args = append(args, "-H", fmt.Sprintf("Host: %s", host))
 			args = append(args, "--resolve", fmt.Sprintf("%s:%s:%s", host, port, ip))
-			uri = fmt.Sprintf("%s://%s:%s", h.flagHttpScheme, host, port)
+			uri = fmt.Sprintf("%s://%s:%s", scheme, host, port)
 		} else {
-			uri = fmt.Sprintf("%s://%s", h.flagHttpScheme, addr)
+			uri = fmt.Sprintf("%s://%s", scheme, addr)
 		}
 		if h.flagHttpPath != "" {
 			uri = fmt.Sprintf("%s/%s", uri, strings.TrimPrefix(h.flagHttpPath, "/"))
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/target"
 	"github.com/posener/complete"
 )
 
@@ -103,6 +104,13 @@ func (p *postgresFlags) buildArgs(c *Command, port, ip, addr string, creds crede
 				c.UI.Warn("Credentials are being brokered but no -dbname parameter provided. psql may misinterpret another parameter as the database name.")
 			}
 		}
+
+		// The worker authenticates to the endpoint of postgres targets with the
+		// injected credentials, replacing the user sent by psql but not the
+		// database, which psql defaults to the local username.
+		if target.SubtypeFromId(c.sessionAuthzData.TargetId) == "postgres" && c.flagDbname == "" {
+			c.UI.Warn("Connecting to a postgres target but no -dbname parameter provided. psql will use the local username as the database name.")
+		}
 	}
 	return
 }
@@ -0,0 +1,87 @@
+package managedgroupscmd
+
+import (
+	"github.com/hashicorp/boundary/api/managedgroups"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+const (
+	groupNamesFlagName = "group-names"
+)
+
+func init() {
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagsHandlingFuncImpl
+}
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {groupNamesFlagName},
+		"update": {groupNamesFlagName},
+	}
+}
+
+type extraLdapCmdVars struct {
+	flagGroupNames []string
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups create ldap [options] [args]",
+			"",
+			"  Create a ldap-type managed group. Example:",
+			"",
+			`    $ boundary managed-groups create ldap -group-names "admins" -group-names "prodops" -description "Ldap managed group for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary managed-groups update ldap [options] [args]",
+			"",
+			"  Update a ldap-type managed group given its ID. Example:",
+			"",
+			`    $ boundary managed-groups update ldap -id mgldap_1234567890 -name "devops" -description "Ldap managed group for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case groupNamesFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   groupNamesFlagName,
+				Target: &c.flagGroupNames,
+				Usage:  "The name of an LDAP group whose members are members of this managed group. May be specified multiple times.",
+			})
+		}
+	}
+}
+
+func extraLdapFlagsHandlingFuncImpl(c *LdapCommand, _ *base.FlagSets, opts *[]managedgroups.Option) bool {
+	switch {
+	case len(c.flagGroupNames) == 1 && c.flagGroupNames[0] == "null":
+		c.UI.Error("Group names must be defined, and cannot be cleared.")
+		return false
+
+	case len(c.flagGroupNames) == 0:
+		if c.Func == "create" {
+			c.UI.Error("The -group-names flag must be provided when creating a managed group.")
+			return false
+		}
+
+	default:
+		*opts = append(*opts, managedgroups.WithLdapManagedGroupGroupNames(c.flagGroupNames))
+	}
+
+	return true
+}
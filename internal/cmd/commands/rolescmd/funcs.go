@@ -19,24 +19,33 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagGrantScopeId string
 	flagPrincipals   []string
 	flagGrants       []string
+	flagUserId       string
+	flagResourceId   string
+	flagResourceType string
+	flagPinId        string
+	flagAction       string
+	flagExpiresIn    time.Duration
+	explainResult    *roles.ExplainResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create":            {"grant-scope-id"},
 		"update":            {"grant-scope-id"},
-		"add-principals":    {"id", "principal", "version"},
-		"set-principals":    {"id", "principal", "version"},
+		"add-principals":    {"id", "principal", "version", "expires-in"},
+		"set-principals":    {"id", "principal", "version", "expires-in"},
 		"remove-principals": {"id", "principal", "version"},
 		"add-grants":        {"id", "grant", "version"},
 		"set-grants":        {"id", "grant", "version"},
 		"remove-grants":     {"id", "grant", "version"},
+		"explain":           {"scope-id", "user-id", "grant", "resource-id", "resource-type", "pin-id", "action"},
 	}
 }
 
@@ -46,6 +55,8 @@ func extraSynopsisFuncImpl(c *Command) string {
 		return c.principalsGrantsSynopsisFunc(c.Func, true)
 	case "add-grants", "set-grants", "remove-grants":
 		return c.principalsGrantsSynopsisFunc(c.Func, false)
+	case "explain":
+		return "Explain whether an action is allowed on a resource"
 	}
 
 	return ""
@@ -79,6 +90,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890`,
 			"",
+			`  The "expires-in" flag grants the role to the principals temporarily; their assignment expires after the given duration and they are removed from the role. Example:`,
+			"",
+			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890 -expires-in 8h`,
+			"",
 			"",
 		})
 
@@ -137,6 +152,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "explain":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary roles explain [options] [args]",
+			"",
+			`  Explains whether an action is allowed on a resource within a scope, listing the grants applying to the scope and whether each of them allows the action. The grants of the user given by "user-id" are evaluated, or else the hypothetical grants given by the "grant" flag, which can be specified multiple times. Example:`,
+			"",
+			`    $ boundary roles explain -scope-id p_1234567890 -user-id u_1234567890 -resource-type target -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -164,6 +190,42 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagGrants,
 				Usage:  "The grants to add, remove, or set. May be specified multiple times. Can be in compact string format or JSON (be sure to escape JSON properly).",
 			})
+		case "user-id":
+			f.StringVar(&base.StringVar{
+				Name:   "user-id",
+				Target: &c.flagUserId,
+				Usage:  "The ID of the user whose grants are evaluated",
+			})
+		case "resource-id":
+			f.StringVar(&base.StringVar{
+				Name:   "resource-id",
+				Target: &c.flagResourceId,
+				Usage:  "The ID of the resource. Leave empty for actions on a collection, such as create or list.",
+			})
+		case "resource-type":
+			f.StringVar(&base.StringVar{
+				Name:   "resource-type",
+				Target: &c.flagResourceType,
+				Usage:  `The type of the resource, e.g. "target"`,
+			})
+		case "pin-id":
+			f.StringVar(&base.StringVar{
+				Name:   "pin-id",
+				Target: &c.flagPinId,
+				Usage:  "The ID of the resource containing the resource, if any, e.g. the host catalog of a host",
+			})
+		case "action":
+			f.StringVar(&base.StringVar{
+				Name:   "action",
+				Target: &c.flagAction,
+				Usage:  `The action to evaluate, e.g. "authorize-session"`,
+			})
+		case "expires-in":
+			f.DurationVar(&base.DurationVar{
+				Name:   "expires-in",
+				Target: &c.flagExpiresIn,
+				Usage:  "The duration after which the assignment of the principals to the role expires, for instance 8h. If not specified, the assignment does not expire.",
+			})
 		}
 	}
 }
@@ -177,6 +239,15 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 		*opts = append(*opts, roles.WithGrantScopeId(c.flagGrantScopeId))
 	}
 
+	switch {
+	case c.flagExpiresIn == 0:
+	case c.flagExpiresIn < time.Second:
+		c.UI.Error("The duration supplied via -expires-in must be at least one second")
+		return false
+	default:
+		*opts = append(*opts, roles.WithExpiresInSeconds(uint32(c.flagExpiresIn/time.Second)))
+	}
+
 	switch c.Func {
 	case "add-principals", "remove-principals":
 		if len(c.flagPrincipals) == 0 {
@@ -211,6 +282,37 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 				c.flagGrants = nil
 			}
 		}
+
+	case "explain":
+		switch {
+		case c.FlagScopeId == "":
+			c.UI.Error("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID")
+			return false
+		case c.flagResourceType == "":
+			c.UI.Error("No resource type supplied via -resource-type")
+			return false
+		case c.flagAction == "":
+			c.UI.Error("No action supplied via -action")
+			return false
+		case c.flagUserId == "" && len(c.flagGrants) == 0:
+			c.UI.Error("Either a user ID must be supplied via -user-id or grants via -grant")
+			return false
+		case c.flagUserId != "" && len(c.flagGrants) > 0:
+			c.UI.Error("A user ID and grants cannot both be supplied")
+			return false
+		}
+		if c.flagUserId != "" {
+			*opts = append(*opts, roles.WithUserId(c.flagUserId))
+		}
+		if len(c.flagGrants) > 0 {
+			*opts = append(*opts, roles.WithGrantStrings(c.flagGrants))
+		}
+		if c.flagResourceId != "" {
+			*opts = append(*opts, roles.WithResourceId(c.flagResourceId))
+		}
+		if c.flagPinId != "" {
+			*opts = append(*opts, roles.WithPinId(c.flagPinId))
+		}
 	}
 
 	if len(c.flagGrants) > 0 {
@@ -264,10 +366,31 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *roles
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "explain":
+		var err error
+		c.explainResult, err = roleClient.Explain(c.Context, c.FlagScopeId, c.flagResourceType, c.flagAction, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "explain":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printExplanationTable(c.explainResult.GetItem()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.explainResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*roles.Role) string {
 	if len(items) == 0 {
 		return "No roles found"
@@ -382,6 +505,11 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 			fmt.Sprintf("      Type:         %s", principal.Type),
 			fmt.Sprintf("      Scope ID:     %s", principal.ScopeId),
 		)
+		if !principal.ExpirationTime.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Expires:      %s", principal.ExpirationTime.Local().Format(time.RFC1123)),
+			)
+		}
 	}
 	if len(item.Grants) > 0 {
 		ret = append(ret,
@@ -397,3 +525,52 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printExplanationTable(item *roles.Explanation) string {
+	nonAttributeMap := map[string]interface{}{
+		"Scope ID":      item.ScopeId,
+		"Resource Type": item.ResourceType,
+		"Action":        item.Action,
+		"Authorized":    item.Authorized,
+	}
+	if item.UserId != "" {
+		nonAttributeMap["User ID"] = item.UserId
+	}
+	if item.ResourceId != "" {
+		nonAttributeMap["Resource ID"] = item.ResourceId
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Explanation:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(item.Grants) == 0 {
+		ret = append(ret,
+			"",
+			"  No grants apply to the scope",
+		)
+	} else {
+		ret = append(ret,
+			"",
+			fmt.Sprintf("  Grants:           %s", ""),
+		)
+	}
+	for _, grant := range item.Grants {
+		roleId := grant.RoleId
+		if roleId == "" {
+			roleId = "(provided)"
+		}
+		ret = append(ret,
+			fmt.Sprintf("    %s", grant.Grant),
+			fmt.Sprintf("      Role ID:      %s", roleId),
+			fmt.Sprintf("      Authorized:   %t", grant.Authorized),
+			fmt.Sprintf("      Denied:       %t", grant.Denied),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -1,6 +1,7 @@
 package scopescmd
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -14,18 +15,25 @@ const (
 	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
 	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
 	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
+	flagKeyVersionIdName            = "key-version-id"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
-		"update": {flagPrimaryAuthMethodIdName},
+		"create":              {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
+		"update":              {flagPrimaryAuthMethodIdName},
+		"rotate-keys":         {"scope-id"},
+		"list-keys":           {"scope-id"},
+		"destroy-key-version": {"scope-id", flagKeyVersionIdName},
 	}
 }
 
@@ -33,6 +41,65 @@ type extraCmdVars struct {
 	flagSkipAdminRoleCreation   bool
 	flagSkipDefaultRoleCreation bool
 	flagPrimaryAuthMethodId     string
+	flagKeyVersionId            string
+	rotateKeysResult            *scopes.ScopeRotateKeysResult
+	listKeysResult              *scopes.KeyListResult
+	destroyKeyVersionResult     *scopes.KeyVersionDestroyResult
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "rotate-keys":
+		return "Rotate the keys of a scope"
+	case "list-keys":
+		return "List the keys of a scope and their versions"
+	case "destroy-key-version":
+		return "Destroy a version of a key of a scope"
+	}
+	return ""
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "rotate-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes rotate-keys [options] [args]",
+			"",
+			"  Rotate the root key and data encryption keys of the scope specified by ID. New data is encrypted with the new key versions, while data encrypted with the previous versions can still be decrypted. Example:",
+			"",
+			`    $ boundary scopes rotate-keys -scope-id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "list-keys":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes list-keys [options] [args]",
+			"",
+			"  List the root key and data encryption keys of the scope specified by ID, along with the versions of each key and when they were created. Example:",
+			"",
+			`    $ boundary scopes list-keys -scope-id o_1234567890`,
+			"",
+			"",
+		})
+
+	case "destroy-key-version":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes destroy-key-version [options] [args]",
+			"",
+			"  Destroy a version of a key of the scope specified by ID. The latest version of a key, and versions which still protect data, can not be destroyed. Example:",
+			"",
+			`    $ boundary scopes destroy-key-version -scope-id o_1234567890 -key-version-id kdkv_1234567890`,
+			"",
+			"",
+		})
+
+	default:
+		return helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -56,6 +123,12 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrimaryAuthMethodId,
 				Usage:  "If set, the primary auth method id for the scope.  A primary auth method is allowed to create users on first login and is also used as a source for account full name and email for a scope's users",
 			})
+		case flagKeyVersionIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagKeyVersionIdName,
+				Target: &c.flagKeyVersionId,
+				Usage:  "The ID of the key version to destroy",
+			})
 		}
 	}
 }
@@ -70,10 +143,82 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]scopes.Opt
 	if c.flagPrimaryAuthMethodId != "" {
 		*opts = append(*opts, scopes.WithPrimaryAuthMethodId(c.flagPrimaryAuthMethodId))
 	}
+	switch c.Func {
+	case "rotate-keys", "list-keys", "destroy-key-version":
+		if c.FlagScopeId == "" {
+			c.PrintCliError(errors.New("Scope ID must be passed in via -scope-id or BOUNDARY_SCOPE_ID"))
+			return false
+		}
+	}
+	if c.Func == "destroy-key-version" && c.flagKeyVersionId == "" {
+		c.PrintCliError(errors.New("Key version ID must be passed in via -key-version-id"))
+		return false
+	}
 
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *scopes.Scope, origItems []*scopes.Scope, origError error, scopeClient *scopes.Client, version uint32, opts []scopes.Option) (*api.Response, *scopes.Scope, []*scopes.Scope, error) {
+	switch c.Func {
+	case "rotate-keys":
+		var err error
+		c.plural = "keys of scope"
+		c.rotateKeysResult, err = scopeClient.RotateKeys(c.Context, c.FlagScopeId, opts...)
+		return nil, nil, nil, err
+	case "list-keys":
+		var err error
+		c.plural = "keys of scope"
+		c.listKeysResult, err = scopeClient.ListKeys(c.Context, c.FlagScopeId, opts...)
+		return nil, nil, nil, err
+	case "destroy-key-version":
+		var err error
+		c.plural = "key version"
+		c.destroyKeyVersionResult, err = scopeClient.DestroyKeyVersion(c.Context, c.FlagScopeId, c.flagKeyVersionId, opts...)
+		return nil, nil, nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "rotate-keys":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The rotate-keys operation completed successfully.")
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.rotateKeysResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	case "list-keys":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printKeysTable(c.listKeysResult.GetItems()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItems(c.listKeysResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	case "destroy-key-version":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The destroy-key-version operation completed successfully.")
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.destroyKeyVersionResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*scopes.Scope) string {
 	if len(items) == 0 {
 		return "No child scopes found"
@@ -128,6 +273,46 @@ func (c *Command) printListTable(items []*scopes.Scope) string {
 	return base.WrapForHelpText(output)
 }
 
+func printKeysTable(items []*scopes.Key) string {
+	if len(items) == 0 {
+		return "No keys found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Key information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                    %s", item.Id),
+			fmt.Sprintf("    Type:                %s", item.Type),
+			fmt.Sprintf("    Purpose:             %s", item.Purpose),
+		)
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.Versions) > 0 {
+			output = append(output,
+				"    Versions:",
+			)
+		}
+		for _, v := range item.Versions {
+			output = append(output,
+				fmt.Sprintf("      ID:                %s", v.Id),
+				fmt.Sprintf("        Version:         %d", v.Version),
+				fmt.Sprintf("        Created Time:    %s", v.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func printItemTable(item *scopes.Scope, resp *api.Response) string {
 	nonAttributeMap := map[string]interface{}{}
 	if item.Id != "" {
@@ -0,0 +1,339 @@
+package serviceaccountscmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/serviceaccounts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagTtl          time.Duration
+	flagAllowedCidrs []string
+	flagTokenId      string
+	createResult     *serviceaccounts.TokenCreateResult
+	listResult       *serviceaccounts.TokenListResult
+	revokeResult     *serviceaccounts.TokenRevokeResult
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create-token": {"id", "description", "ttl", "allowed-cidr"},
+		"list-tokens":  {"id"},
+		"revoke-token": {"id", "token-id"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "create-token":
+		return "Create a token for a service account"
+
+	case "list-tokens":
+		return "List the tokens of a service account"
+
+	case "revoke-token":
+		return "Revoke a token of a service account"
+
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return helpMap["base"]()
+
+	case "create-token":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary service-accounts create-token [options] [args]",
+			"",
+			`  Creates a token for a service account given its ID. The token expires once the "ttl" duration has passed. The "allowed-cidr" flag can be specified multiple times to only accept the token from clients within the given address ranges. The token value is only shown once. Example:`,
+			"",
+			`    $ boundary service-accounts create-token -id sa_1234567890 -ttl 720h -allowed-cidr 10.0.0.0/8`,
+			"",
+			"",
+		})
+
+	case "list-tokens":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary service-accounts list-tokens [options] [args]",
+			"",
+			"  Lists the tokens of a service account given its ID. Token values are not included. Example:",
+			"",
+			`    $ boundary service-accounts list-tokens -id sa_1234567890`,
+			"",
+			"",
+		})
+
+	case "revoke-token":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary service-accounts revoke-token [options] [args]",
+			"",
+			"  Revokes a token of a service account given the IDs of both. Example:",
+			"",
+			`    $ boundary service-accounts revoke-token -id sa_1234567890 -token-id atsa_1234567890`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "ttl":
+			f.DurationVar(&base.DurationVar{
+				Name:   "ttl",
+				Target: &c.flagTtl,
+				Usage:  "The duration after which the token expires, for instance 720h.",
+			})
+		case "allowed-cidr":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "allowed-cidr",
+				Target: &c.flagAllowedCidrs,
+				Usage:  "A client address range, in CIDR notation, the token can be used from. May be specified multiple times. If not specified, the token can be used from any address.",
+			})
+		case "token-id":
+			f.StringVar(&base.StringVar{
+				Name:   "token-id",
+				Target: &c.flagTokenId,
+				Usage:  "The ID of the token to revoke.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]serviceaccounts.Option) bool {
+	if strutil.StrListContains(flagsMap[c.Func], "ttl") && c.flagTtl < time.Second {
+		c.UI.Error("A time to live of at least one second must be passed in via -ttl")
+		return false
+	}
+	if strutil.StrListContains(flagsMap[c.Func], "token-id") && c.flagTokenId == "" {
+		c.UI.Error("Token ID must be passed in via -token-id")
+		return false
+	}
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *serviceaccounts.ServiceAccount, origItems []*serviceaccounts.ServiceAccount, origError error, serviceAccountClient *serviceaccounts.Client, _ uint32, opts []serviceaccounts.Option) (*api.Response, *serviceaccounts.ServiceAccount, []*serviceaccounts.ServiceAccount, error) {
+	var err error
+	switch c.Func {
+	case "create-token":
+		c.createResult, err = serviceAccountClient.CreateToken(c.Context, c.FlagId, uint32(c.flagTtl/time.Second), c.flagAllowedCidrs, opts...)
+		return nil, nil, nil, err
+	case "list-tokens":
+		c.listResult, err = serviceAccountClient.ListTokens(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "revoke-token":
+		c.revokeResult, err = serviceAccountClient.RevokeToken(c.Context, c.FlagId, c.flagTokenId, opts...)
+		return nil, nil, nil, err
+	}
+	return origResp, origItem, origItems, origError
+}
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "create-token":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printTokenTable(c.createResult.GetItem()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.createResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	case "list-tokens":
+		switch base.Format(c.UI) {
+		case "table":
+			items := c.listResult.GetItems()
+			if len(items) == 0 {
+				c.UI.Output("No service account tokens found")
+				return true, nil
+			}
+			for _, item := range items {
+				c.UI.Output(printTokenTable(item))
+			}
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItems(c.listResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	case "revoke-token":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The revoke operation completed successfully.")
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.revokeResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *Command) printListTable(items []*serviceaccounts.ServiceAccount) string {
+	if len(items) == 0 {
+		return "No service accounts found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Service Account information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Version > 0 {
+			output = append(output,
+				fmt.Sprintf("    Version:             %d", item.Version),
+			)
+		}
+		if item.Name != "" {
+			output = append(output,
+				fmt.Sprintf("    Name:                %s", item.Name),
+			)
+		}
+		if item.Description != "" {
+			output = append(output,
+				fmt.Sprintf("    Description:         %s", item.Description),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(item *serviceaccounts.ServiceAccount, resp *api.Response) string {
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if item.Name != "" {
+		nonAttributeMap["Name"] = item.Name
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Service Account information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
+
+func printTokenTable(item *serviceaccounts.ServiceAccountToken) string {
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.ServiceAccountId != "" {
+		nonAttributeMap["Service Account ID"] = item.ServiceAccountId
+	}
+	if item.Description != "" {
+		nonAttributeMap["Description"] = item.Description
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.ApproximateLastUsedTime.IsZero() {
+		nonAttributeMap["Approximate Last Used Time"] = item.ApproximateLastUsedTime.Local().Format(time.RFC1123)
+	}
+	if !item.ExpirationTime.IsZero() {
+		nonAttributeMap["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
+	}
+	if item.Token != "" {
+		nonAttributeMap["Token"] = item.Token
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Service Account Token information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(item.AllowedCidrs) > 0 {
+		ret = append(ret,
+			"",
+			"  Allowed CIDRs:",
+			base.WrapSlice(4, item.AllowedCidrs),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -1,35 +1,58 @@
 package sessionscmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/sessions"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/posener/complete"
 )
 
 const (
 	flagIncludeTerminated = "include-terminated"
+	flagConnectionId      = "connection-id"
+	flagOutput            = "output"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":             {"id"},
+		"list":               {flagIncludeTerminated},
+		"list-recordings":    {"id"},
+		"download-recording": {"id", flagConnectionId, flagOutput},
 	}
 }
 
 type extraCmdVars struct {
 	flagIncludeTerminated bool
+	flagConnectionId      string
+	flagOutput            string
+	listRecordingsResult  *sessions.ConnectionRecordingListResult
+	recordingResult       *sessions.ConnectionRecordingReadResult
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "list-recordings":
+		return "List the connection recordings of a session"
+	case "download-recording":
+		return "Download the recording of a session connection"
+	}
+	return ""
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -41,11 +64,29 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIncludeTerminated,
 				Usage:  "If set, terminated sessions will be included in the results.",
 			})
+		case flagConnectionId:
+			f.StringVar(&base.StringVar{
+				Name:       flagConnectionId,
+				Target:     &c.flagConnectionId,
+				Completion: complete.PredictAnything,
+				Usage:      "The ID of the session connection whose recording should be downloaded.",
+			})
+		case flagOutput:
+			f.StringVar(&base.StringVar{
+				Name:       flagOutput,
+				Target:     &c.flagOutput,
+				Completion: complete.PredictFiles("*"),
+				Usage:      "If set, the recording is written to the given file instead of being printed.",
+			})
 		}
 	}
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]sessions.Option) bool {
+	if c.Func == "download-recording" && c.flagConnectionId == "" {
+		c.PrintCliError(errors.New("Connection ID is required but not passed in via -connection-id"))
+		return false
+	}
 	if c.flagIncludeTerminated {
 		*opts = append(*opts, sessions.WithIncludeTerminated(c.flagIncludeTerminated))
 	}
@@ -79,6 +120,28 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "list-recordings":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions list-recordings [options] [args]",
+			"",
+			"  List the recordings of the connections of the session specified by ID. Example:",
+			"",
+			`    $ boundary sessions list-recordings -id s_1234567890`,
+			"",
+			"",
+		})
+
+	case "download-recording":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions download-recording [options] [args]",
+			"",
+			"  Download the recording of a connection of the session specified by ID. Example:",
+			"",
+			`    $ boundary sessions download-recording -id s_1234567890 -connection-id sc_1234567890 -output sc_1234567890.rec`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -94,10 +157,98 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "list-recordings":
+		var err error
+		c.plural = "recordings of session"
+		c.listRecordingsResult, err = sessionClient.ListRecordings(c.Context, c.FlagId, opts...)
+		return nil, nil, nil, err
+	case "download-recording":
+		var err error
+		c.plural = "recording of session connection"
+		c.recordingResult, err = sessionClient.DownloadRecording(c.Context, c.FlagId, c.flagConnectionId, opts...)
+		return nil, nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "list-recordings":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printRecordingListTable(c.listRecordingsResult.GetItems()))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItems(c.listRecordingsResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "download-recording":
+		item := c.recordingResult.GetItem()
+		if c.flagOutput != "" {
+			if err := os.WriteFile(c.flagOutput, item.Data, 0o600); err != nil {
+				return false, fmt.Errorf("Error writing recording to %q: %w", c.flagOutput, err)
+			}
+			if base.Format(c.UI) == "table" {
+				c.UI.Output(fmt.Sprintf("Recording of connection %s written to %s", item.ConnectionId, c.flagOutput))
+			}
+			return true, nil
+		}
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(strings.TrimSuffix(string(item.Data), "\n"))
+			return true, nil
+		case "json":
+			if ok := c.PrintJsonItem(c.recordingResult.GetResponse()); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func printRecordingListTable(items []*sessions.ConnectionRecording) string {
+	if len(items) == 0 {
+		return "No recordings found"
+	}
+	output := []string{
+		"",
+		"Recording information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Connection ID:         %s", item.ConnectionId),
+		)
+		if item.WorkerId != "" {
+			output = append(output,
+				fmt.Sprintf("    Worker ID:           %s", item.WorkerId),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Size:                %d", item.Size),
+		)
+		if !item.StartTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Start Time:          %s", item.StartTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.EndTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    End Time:            %s", item.EndTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
 func (c *Command) printListTable(items []*sessions.Session) string {
 	if len(items) == 0 {
 		return "No sessions found"
@@ -230,6 +381,31 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 		}
 	}
 
+	var connectionsMaps []map[string]interface{}
+	if len(item.Connections) > 0 {
+		for _, conn := range item.Connections {
+			m := map[string]interface{}{
+				"Bytes Up":   conn.BytesUp,
+				"Bytes Down": conn.BytesDown,
+			}
+			if conn.ClientTcpAddress != "" {
+				m["Client TCP Address"] = conn.ClientTcpAddress
+				m["Client TCP Port"] = conn.ClientTcpPort
+			}
+			if conn.EndpointTcpAddress != "" {
+				m["Endpoint TCP Address"] = conn.EndpointTcpAddress
+				m["Endpoint TCP Port"] = conn.EndpointTcpPort
+			}
+			if conn.ClosedReason != "" {
+				m["Closed Reason"] = conn.ClosedReason
+			}
+			connectionsMaps = append(connectionsMaps, m)
+		}
+		if l := len("Endpoint TCP Address"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	ret := []string{
 		"",
 		"Session information:",
@@ -265,5 +441,18 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 		}
 	}
 
+	if len(item.Connections) > 0 {
+		ret = append(ret,
+			"",
+			"  Connections:",
+		)
+		for _, m := range connectionsMaps {
+			ret = append(ret,
+				base.WrapMap(4, maxLength, m),
+				"",
+			)
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
@@ -611,6 +611,12 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.WorkerFilter != "" {
 		nonAttributeMap["Worker Filter"] = item.WorkerFilter
 	}
+	if item.Address != "" {
+		nonAttributeMap["Address"] = item.Address
+	}
+	if item.EnableSessionRecording {
+		nonAttributeMap["Enable Session Recording"] = item.EnableSessionRecording
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
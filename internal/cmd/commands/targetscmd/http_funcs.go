@@ -0,0 +1,234 @@
+package targetscmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+)
+
+func init() {
+	extraHttpActionsFlagsMapFunc = extraHttpActionsFlagsMapFuncImpl
+	extraHttpFlagsFunc = extraHttpFlagsFuncImpl
+	extraHttpFlagsHandlingFunc = extraHttpFlagsHandlingFuncImpl
+}
+
+func extraHttpActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "enable-tls", "auth-scheme"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "enable-tls", "auth-scheme"},
+	}
+}
+
+type extraHttpCmdVars struct {
+	flagDefaultPort            string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagAddress                string
+	flagEnableSessionRecording string
+	flagEnableTls              string
+	flagAuthScheme             string
+}
+
+func (c *HttpCommand) extraHttpHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create http [options] [args]",
+			"",
+			"  Create a http-type target. Example:",
+			"",
+			`    $ boundary targets create http -name grafana -description "Http target for Grafana"`,
+			"",
+			"  The worker acts as a reverse proxy to the endpoint and sets the",
+			"  Authorization header of each request using the injected application",
+			"  credentials of the target, which are never returned to the user. The",
+			"  default port is 80 unless -default-port is provided.",
+			"",
+			"  Create a http-type target that connects to an address using TLS and",
+			"  sends the injected password as a bearer token. Example:",
+			"",
+			`    $ boundary targets create http -name grafana -address grafana.example.com -default-port 443 -enable-tls true -auth-scheme bearer`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update http [options] [args]",
+			"",
+			"  Update a http-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update http -id thttp_1234567890 -name "grafana" -description "Http target for Grafana"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraHttpFlagsFuncImpl(c *HttpCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("HTTP Target Options")
+
+	for _, name := range flagsHttpMap[c.Func] {
+		switch name {
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
+			})
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "The network address to connect to for sessions of this target. Cannot be used with host sources.",
+			})
+		case "enable-session-recording":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  "Whether the connections of sessions of this target are recorded by the worker. Can be true or false.",
+			})
+		case "enable-tls":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-tls",
+				Target: &c.flagEnableTls,
+				Usage:  "Whether the worker connects to the endpoint of the target using TLS. Can be true or false.",
+			})
+		case "auth-scheme":
+			fs.StringVar(&base.StringVar{
+				Name:   "auth-scheme",
+				Target: &c.flagAuthScheme,
+				Usage:  `The scheme of the Authorization header set by the worker using the injected application credentials. Can be "basic" or "bearer".`,
+			})
+		}
+	}
+}
+
+func extraHttpFlagsHandlingFuncImpl(c *HttpCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHttpTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithHttpTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnableSessionRecording())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableSessionRecording)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableSessionRecording, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEnableSessionRecording(enable))
+	}
+
+	switch c.flagEnableTls {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHttpTargetEnableTls())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableTls)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableTls, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithHttpTargetEnableTls(enable))
+	}
+
+	switch c.flagAuthScheme {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHttpTargetAuthScheme())
+	default:
+		*opts = append(*opts, targets.WithHttpTargetAuthScheme(c.flagAuthScheme))
+	}
+
+	return true
+}
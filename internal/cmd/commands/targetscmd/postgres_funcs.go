@@ -0,0 +1,239 @@
+package targetscmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraPostgresActionsFlagsMapFunc = extraPostgresActionsFlagsMapFuncImpl
+	extraPostgresFlagsFunc = extraPostgresFlagsFuncImpl
+	extraPostgresFlagsHandlingFunc = extraPostgresFlagsHandlingFuncImpl
+}
+
+func extraPostgresActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "ssl-mode", "ssl-root-cert"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "ssl-mode", "ssl-root-cert"},
+	}
+}
+
+type extraPostgresCmdVars struct {
+	flagDefaultPort            string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagAddress                string
+	flagEnableSessionRecording string
+	flagSslMode                string
+	flagSslRootCert            string
+}
+
+func (c *PostgresCommand) extraPostgresHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create postgres [options] [args]",
+			"",
+			"  Create a postgres-type target. Example:",
+			"",
+			`    $ boundary targets create postgres -name prodops -description "Postgres target for ProdOps"`,
+			"",
+			"  The worker authenticates to the endpoint with the injected application",
+			"  username_password credential of the target, which is never returned to",
+			"  the user. The default port is 5432 unless -default-port is provided.",
+			"",
+			"  The worker connects to the endpoint using TLS and verifies its certificate",
+			"  and address unless -ssl-mode is provided. It only answers cleartext and",
+			"  MD5 password requests of the endpoint over TLS.",
+			"",
+			"  Create a postgres-type target that connects directly to an address. Example:",
+			"",
+			`    $ boundary targets create postgres -name prodops-db -address db.prodops.example.com -ssl-root-cert file:///etc/ssl/prodops-ca.pem`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update postgres [options] [args]",
+			"",
+			"  Update a postgres-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update postgres -id tpg_1234567890 -name "devops" -description "Postgres target for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraPostgresFlagsFuncImpl(c *PostgresCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("PostgreSQL Target Options")
+
+	for _, name := range flagsPostgresMap[c.Func] {
+		switch name {
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
+			})
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "The network address to connect to for sessions of this target. Cannot be used with host sources.",
+			})
+		case "enable-session-recording":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  "Whether the connections of sessions of this target are recorded by the worker. Can be true or false.",
+			})
+		case "ssl-mode":
+			fs.StringVar(&base.StringVar{
+				Name:   "ssl-mode",
+				Target: &c.flagSslMode,
+				Usage:  `How the worker secures its connection to the endpoint. Can be "disable", "require", "verify-ca" or "verify-full".`,
+			})
+		case "ssl-root-cert":
+			fs.StringVar(&base.StringVar{
+				Name:   "ssl-root-cert",
+				Target: &c.flagSslRootCert,
+				Usage:  "The PEM encoded certificate authorities the certificate of the endpoint is verified against. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraPostgresFlagsHandlingFuncImpl(c *PostgresCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnableSessionRecording())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableSessionRecording)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableSessionRecording, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEnableSessionRecording(enable))
+	}
+
+	switch c.flagSslMode {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetSslMode())
+	default:
+		*opts = append(*opts, targets.WithPostgresTargetSslMode(c.flagSslMode))
+	}
+
+	switch c.flagSslRootCert {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetSslRootCert())
+	default:
+		rootCert, err := parseutil.ParsePath(c.flagSslRootCert)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing ssl root cert flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetSslRootCert(strings.TrimSpace(rootCert)))
+	}
+
+	return true
+}
@@ -1,13 +1,16 @@
 package targetscmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 func init() {
@@ -18,8 +21,8 @@ func init() {
 
 func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
-		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "host-key"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "host-key"},
 	}
 }
 
@@ -28,6 +31,9 @@ type extraSshCmdVars struct {
 	flagSessionMaxSeconds      string
 	flagSessionConnectionLimit string
 	flagWorkerFilter           string
+	flagAddress                string
+	flagEnableSessionRecording string
+	flagHostKey                string
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -41,6 +47,16 @@ func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary targets create ssh -name prodops -description "Ssh target for ProdOps"`,
 			"",
+			"  The worker authenticates to the endpoint with the injected application",
+			"  credentials of the target, which are never returned to the user. The",
+			"  default port is 22 unless -default-port is provided. The endpoint must",
+			"  present the public key given by -host-key, the worker refuses to connect",
+			"  to the endpoints of targets without one.",
+			"",
+			"  Create a ssh-type target that connects directly to an address. Example:",
+			"",
+			`    $ boundary targets create ssh -name prodops-bastion -address bastion.prodops.example.com -host-key file:///etc/ssh/bastion_host_ed25519_key.pub`,
+			"",
 			"",
 		})
 
@@ -87,6 +103,24 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagWorkerFilter,
 				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
 			})
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "The network address to connect to for sessions of this target. Cannot be used with host sources.",
+			})
+		case "enable-session-recording":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  "Whether the connections of sessions of this target are recorded by the worker. Can be true or false.",
+			})
+		case "host-key":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-key",
+				Target: &c.flagHostKey,
+				Usage:  "The public key, in authorized_keys format, the endpoint must present. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
 		}
 	}
 }
@@ -150,5 +184,39 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
 	}
 
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnableSessionRecording())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableSessionRecording)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableSessionRecording, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEnableSessionRecording(enable))
+	}
+
+	switch c.flagHostKey {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetHostKey())
+	default:
+		hostKey, err := parseutil.ParsePath(c.flagHostKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing host key flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetHostKey(strings.TrimSpace(hostKey)))
+	}
+
 	return true
 }
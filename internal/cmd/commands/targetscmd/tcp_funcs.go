@@ -18,8 +18,8 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
-		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "idle-timeout-seconds"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "address", "enable-session-recording", "idle-timeout-seconds"},
 	}
 }
 
@@ -28,6 +28,9 @@ type extraTcpCmdVars struct {
 	flagSessionMaxSeconds      string
 	flagSessionConnectionLimit string
 	flagWorkerFilter           string
+	flagAddress                string
+	flagEnableSessionRecording string
+	flagIdleTimeoutSeconds     string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -41,6 +44,10 @@ func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary targets create tcp -name prodops -description "Tcp target for ProdOps"`,
 			"",
+			"  Create a tcp-type target that connects directly to an address. Example:",
+			"",
+			`    $ boundary targets create tcp -name prodops-db -default-port 5432 -address db.prodops.example.com`,
+			"",
 			"",
 		})
 
@@ -87,6 +94,24 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagWorkerFilter,
 				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
 			})
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "The network address to connect to for sessions of this target. Cannot be used with host sources.",
+			})
+		case "enable-session-recording":
+			fs.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  "Whether the connections of sessions of this target are recorded by the worker. Can be true or false.",
+			})
+		case "idle-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "idle-timeout-seconds",
+				Target: &c.flagIdleTimeoutSeconds,
+				Usage:  `The time after which a connection carrying no traffic is closed by the worker. Can be specified as an integer number of seconds or a duration string. 0 means connections are never closed for being idle.`,
+			})
 		}
 	}
 }
@@ -150,5 +175,46 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
 	}
 
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEnableSessionRecording())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableSessionRecording)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableSessionRecording, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEnableSessionRecording(enable))
+	}
+
+	switch c.flagIdleTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultTcpTargetIdleTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagIdleTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagIdleTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagIdleTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithTcpTargetIdleTimeoutSeconds(final))
+	}
+
 	return true
 }
@@ -1,6 +1,7 @@
 package userscmd
 
 import (
+	"flag"
 	"fmt"
 	"strings"
 	"time"
@@ -21,6 +22,7 @@ func init() {
 
 type extraCmdVars struct {
 	flagAccounts []string
+	flagDisabled bool
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +30,8 @@ func extraActionsFlagsMapFuncImpl() map[string][]string {
 		"add-accounts":    {"id", "account", "version"},
 		"set-accounts":    {"id", "account", "version"},
 		"remove-accounts": {"id", "account", "version"},
+		"create":          {"disabled"},
+		"update":          {"disabled"},
 	}
 }
 
@@ -100,12 +104,32 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagAccounts,
 				Usage:  "The accounts to add, remove, or set. May be specified multiple times.",
 			})
+		case "disabled":
+			f.BoolVar(&base.BoolVar{
+				Name:   "disabled",
+				Target: &c.flagDisabled,
+				Usage:  "If set, the user is disabled: it cannot authenticate, its existing auth tokens are rejected and its active sessions are canceled. Use -disabled=false to re-enable the user.",
+			})
 		}
 	}
 }
 
-func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]users.Option) bool {
+func extraFlagsHandlingFuncImpl(c *Command, f *base.FlagSets, opts *[]users.Option) bool {
 	switch c.Func {
+	case "create", "update":
+		// Only send the state if the flag was explicitly given, so that
+		// -disabled=false can re-enable a user.
+		f.Visit(func(fl *flag.Flag) {
+			if fl.Name != "disabled" {
+				return
+			}
+			if c.flagDisabled {
+				*opts = append(*opts, users.WithState("disabled"))
+			} else {
+				*opts = append(*opts, users.WithState("active"))
+			}
+		})
+
 	case "add-accounts", "remove-accounts":
 		if len(c.flagAccounts) == 0 {
 			c.UI.Error("No accounts supplied via -account")
@@ -195,6 +219,11 @@ func (c *Command) printListTable(items []*users.User) string {
 				fmt.Sprintf("    Description:         %s", item.Description),
 			)
 		}
+		if item.State != "" {
+			output = append(output,
+				fmt.Sprintf("    State:               %s", item.State),
+			)
+		}
 		if item.PrimaryAccountId != "" {
 			output = append(output,
 				fmt.Sprintf("    Primary Account ID:  %s", item.PrimaryAccountId),
@@ -247,6 +276,9 @@ func printItemTable(item *users.User, resp *api.Response) string {
 	if item.Description != "" {
 		nonAttributeMap["Description"] = item.Description
 	}
+	if item.State != "" {
+		nonAttributeMap["State"] = item.State
+	}
 	if item.PrimaryAccountId != "" {
 		nonAttributeMap["Primary Account Id"] = item.PrimaryAccountId
 	}
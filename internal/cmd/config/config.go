@@ -144,12 +144,22 @@ type Controller struct {
 	AuthTokenTimeToStale         interface{} `hcl:"auth_token_time_to_stale"`
 	AuthTokenTimeToStaleDuration time.Duration
 
+	// AuthTokenMaxLifetime is the total time a token can be valid for,
+	// including refreshes, denoted by time.Duration
+	AuthTokenMaxLifetime         interface{} `hcl:"auth_token_max_lifetime"`
+	AuthTokenMaxLifetimeDuration time.Duration
+
 	// GracefulShutdownWait is the amount of time that we'll wait before actually
 	// starting the Controller shutdown. This allows the health endpoint to
 	// return a status code to indicate that the instance is shutting down.
 	GracefulShutdownWait         interface{} `hcl:"graceful_shutdown_wait_duration"`
 	GracefulShutdownWaitDuration time.Duration
 
+	// RecordingStoragePath represents the location a controller stores the
+	// recordings of session connections uploaded by workers. Uploads of
+	// recordings are refused if it is not set.
+	RecordingStoragePath string `hcl:"recording_storage_path"`
+
 	// StatusGracePeriod represents the period of time (as a duration) that the
 	// controller will wait before marking connections from a disconnected worker
 	// as invalid.
@@ -213,6 +223,22 @@ type Worker struct {
 	// AuthStoragePath represents the location a worker stores its node credentials, if set
 	AuthStoragePath string `hcl:"auth_storage_path"`
 
+	// RecordingStoragePath represents the location a worker stores the
+	// recordings of session connections for targets with session recording
+	// enabled. Connections of such sessions are refused if it is not set.
+	RecordingStoragePath string `hcl:"recording_storage_path"`
+
+	// SshForwardedChannelTypes lists the ssh channel types, such as
+	// direct-tcpip, which the worker forwards between the client and the
+	// endpoint of ssh targets in addition to the session channels opened by
+	// the client. Other channels are rejected.
+	SshForwardedChannelTypes []string `hcl:"ssh_forwarded_channel_types"`
+
+	// SshForwardedGlobalRequests lists the ssh global request types, such as
+	// tcpip-forward, which the worker forwards between the client and the
+	// endpoint of ssh targets. Other global requests are refused.
+	SshForwardedGlobalRequests []string `hcl:"ssh_forwarded_global_requests"`
+
 	// ControllerGeneratedActivationToken is a controller-generated activation
 	// token used to register this worker to the cluster. It can be a path, env
 	// var, or direct value.
@@ -371,6 +397,14 @@ func Parse(d string) (*Config, error) {
 			result.Controller.AuthTokenTimeToStaleDuration = t
 		}
 
+		if result.Controller.AuthTokenMaxLifetime != "" {
+			t, err := parseutil.ParseDurationSecond(result.Controller.AuthTokenMaxLifetime)
+			if err != nil {
+				return result, err
+			}
+			result.Controller.AuthTokenMaxLifetimeDuration = t
+		}
+
 		if result.Controller.GracefulShutdownWait != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.GracefulShutdownWait)
 			if err != nil {
@@ -719,6 +753,12 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.KafkaConfig != nil:
+				s.Type = event.KafkaSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
+			case s.HttpConfig != nil:
+				s.Type = event.HttpSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -740,6 +780,45 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// parse the timeout string specified in a kafka config into a time.Duration
+		if s.KafkaConfig != nil && s.KafkaConfig.TimeoutHCL != "" {
+			var err error
+			s.KafkaConfig.Timeout, err = parseutil.ParseDurationSecond(s.KafkaConfig.TimeoutHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse kafka timeout %s", s.KafkaConfig.TimeoutHCL)
+			}
+		}
+
+		// parse the timeout string specified in a syslog config into a time.Duration
+		if s.SyslogConfig != nil && s.SyslogConfig.TimeoutHCL != "" {
+			var err error
+			s.SyslogConfig.Timeout, err = parseutil.ParseDurationSecond(s.SyslogConfig.TimeoutHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse syslog timeout %s", s.SyslogConfig.TimeoutHCL)
+			}
+		}
+
+		// parse the durations and bearer token specified in an http config
+		if s.HttpConfig != nil {
+			var err error
+			if s.HttpConfig.BatchIntervalHCL != "" {
+				s.HttpConfig.BatchInterval, err = parseutil.ParseDurationSecond(s.HttpConfig.BatchIntervalHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse http batch interval %s", s.HttpConfig.BatchIntervalHCL)
+				}
+			}
+			if s.HttpConfig.TimeoutHCL != "" {
+				s.HttpConfig.Timeout, err = parseutil.ParseDurationSecond(s.HttpConfig.TimeoutHCL)
+				if err != nil {
+					return nil, fmt.Errorf("can't parse http timeout %s", s.HttpConfig.TimeoutHCL)
+				}
+			}
+			s.HttpConfig.BearerToken, err = parseutil.ParsePath(s.HttpConfig.BearerToken)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				return nil, fmt.Errorf("Error parsing http bearer token: %w", err)
+			}
+		}
+
 		// parse map into event types
 		if s.AuditConfig != nil && s.AuditConfig.FilterOverridesHCL != nil {
 			s.AuditConfig.FilterOverrides = make(map[event.DataClassification]event.FilterOperation, len(s.AuditConfig.FilterOverridesHCL))
@@ -477,6 +477,54 @@ func TestDevWorkerCredentialStorageDir(t *testing.T) {
 	}
 }
 
+func TestWorkerRecordingStoragePath(t *testing.T) {
+	t.Parallel()
+	parsed, err := Parse(devConfig + `
+	listener "tcp" {
+		purpose = "proxy"
+	}
+
+	worker {
+		name = "w_1234567890"
+		initial_upstreams = ["127.0.0.1"]
+		recording_storage_path = "/var/lib/boundary/recordings"
+	}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, "/var/lib/boundary/recordings", parsed.Worker.RecordingStoragePath)
+}
+
+func TestWorkerSshForwarding(t *testing.T) {
+	t.Parallel()
+	parsed, err := Parse(devConfig + `
+	listener "tcp" {
+		purpose = "proxy"
+	}
+
+	worker {
+		name = "w_1234567890"
+		initial_upstreams = ["127.0.0.1"]
+		ssh_forwarded_channel_types = ["direct-tcpip"]
+		ssh_forwarded_global_requests = ["tcpip-forward", "cancel-tcpip-forward"]
+	}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"direct-tcpip"}, parsed.Worker.SshForwardedChannelTypes)
+	require.Equal(t, []string{"tcpip-forward", "cancel-tcpip-forward"}, parsed.Worker.SshForwardedGlobalRequests)
+}
+
+func TestControllerRecordingStoragePath(t *testing.T) {
+	t.Parallel()
+	parsed, err := Parse(devConfig + `
+	controller {
+		name = "c_1234567890"
+		recording_storage_path = "/var/lib/boundary/recordings"
+	}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, "/var/lib/boundary/recordings", parsed.Controller.RecordingStoragePath)
+}
+
 func TestDevKeyGeneration(t *testing.T) {
 	t.Parallel()
 	dk := DevKeyGeneration()
@@ -1015,6 +1063,182 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "kafka-sink",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink "kafka" {
+						name = "kafka-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						kafka {
+							brokers = ["kafka-1:9093", "kafka-2:9093"]
+							topic = "boundary-audit"
+							required_acks = "leader"
+							delivery_guarantee = "enforced"
+							timeout = "5s"
+							tls_ca_file = "/etc/kafka/ca.pem"
+						}
+					}
+				}`,
+				`events {
+					audit_enabled = true
+					sink {
+						name = "kafka-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						kafka {
+							brokers = ["kafka-1:9093", "kafka-2:9093"]
+							topic = "boundary-audit"
+							required_acks = "leader"
+							delivery_guarantee = "enforced"
+							timeout = "5s"
+							tls_ca_file = "/etc/kafka/ca.pem"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "kafka",
+						Name:       "kafka-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						KafkaConfig: &event.KafkaSinkTypeConfig{
+							Brokers:           []string{"kafka-1:9093", "kafka-2:9093"},
+							Topic:             "boundary-audit",
+							RequiredAcks:      event.KafkaLeaderAcks,
+							DeliveryGuarantee: event.Enforced,
+							TimeoutHCL:        "5s",
+							Timeout:           5 * time.Second,
+							TlsCaFile:         "/etc/kafka/ca.pem",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "syslog-sink",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink "syslog" {
+						name = "syslog-sink"
+						format = "syslog"
+						event_types = ["audit", "error"]
+						syslog {
+							network = "tls"
+							address = "syslog.example.com:6514"
+							timeout = "5s"
+							tls_ca_file = "/etc/syslog/ca.pem"
+						}
+					}
+				}`,
+				`events {
+					audit_enabled = true
+					sink {
+						name = "syslog-sink"
+						format = "syslog"
+						event_types = ["audit", "error"]
+						syslog {
+							network = "tls"
+							address = "syslog.example.com:6514"
+							timeout = "5s"
+							tls_ca_file = "/etc/syslog/ca.pem"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "syslog",
+						Name:       "syslog-sink",
+						Format:     "syslog",
+						EventTypes: []event.Type{"audit", "error"},
+						SyslogConfig: &event.SyslogSinkTypeConfig{
+							Network:    event.SyslogTlsNetwork,
+							Address:    "syslog.example.com:6514",
+							TimeoutHCL: "5s",
+							Timeout:    5 * time.Second,
+							TlsCaFile:  "/etc/syslog/ca.pem",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "http-sink",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink "http" {
+						name = "http-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						http {
+							url = "https://siem.example.com/events"
+							headers = {
+								X-Source = "boundary"
+							}
+							gzip = true
+							bearer_token = "test-token"
+							delivery_guarantee = "enforced"
+							batch_size = 50
+							batch_interval = "2s"
+							timeout = "5s"
+						}
+					}
+				}`,
+				`events {
+					audit_enabled = true
+					sink {
+						name = "http-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						http {
+							url = "https://siem.example.com/events"
+							headers = {
+								X-Source = "boundary"
+							}
+							gzip = true
+							bearer_token = "test-token"
+							delivery_guarantee = "enforced"
+							batch_size = 50
+							batch_interval = "2s"
+							timeout = "5s"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "http",
+						Name:       "http-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						HttpConfig: &event.HttpSinkTypeConfig{
+							Url:               "https://siem.example.com/events",
+							Headers:           map[string]string{"X-Source": "boundary"},
+							Gzip:              true,
+							BearerToken:       "test-token",
+							DeliveryGuarantee: event.Enforced,
+							BatchSize:         50,
+							BatchIntervalHCL:  "2s",
+							BatchInterval:     2 * time.Second,
+							TimeoutHCL:        "5s",
+							Timeout:           5 * time.Second,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "audit_config",
 			config: []string{
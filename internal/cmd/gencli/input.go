@@ -123,6 +123,34 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
+		{
+			ResourceType:        resource.Account.String(),
+			Pkg:                 "accounts",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "ldap",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
+		{
+			ResourceType:        resource.Account.String(),
+			Pkg:                 "accounts",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "jwt",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
 	},
 	"authmethods": {
 		{
@@ -163,13 +191,44 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update", "change-state"},
 			NeedsSubtypeInCreate: true,
 		},
+		{
+			ResourceType:         resource.AuthMethod.String(),
+			Pkg:                  "authmethods",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ldap",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
+		{
+			ResourceType:         resource.AuthMethod.String(),
+			Pkg:                  "authmethods",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "jwt",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"authtokens": {
 		{
-			ResourceType: resource.AuthToken.String(),
-			Pkg:          "authtokens",
-			StdActions:   []string{"read", "delete", "list"},
-			Container:    "Scope",
+			ResourceType:     resource.AuthToken.String(),
+			Pkg:              "authtokens",
+			StdActions:       []string{"read", "delete", "list"},
+			HasExtraHelpFunc: true,
+			Container:        "Scope",
 		},
 	},
 	"credentialstores": {
@@ -427,6 +486,34 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
+		{
+			ResourceType:        resource.ManagedGroup.String(),
+			Pkg:                 "managedgroups",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "ldap",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
+		{
+			ResourceType:        resource.ManagedGroup.String(),
+			Pkg:                 "managedgroups",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "jwt",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
 	},
 	"roles": {
 		{
@@ -448,6 +535,21 @@ var inputStructs = map[string][]*cmdInfo{
 			Pkg:                 "scopes",
 			StdActions:          []string{"create", "read", "update", "delete", "list"},
 			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			Container:           "Scope",
+			HasName:             true,
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
+	},
+	"serviceaccounts": {
+		{
+			ResourceType:        resource.ServiceAccount.String(),
+			Pkg:                 "serviceaccounts",
+			StdActions:          []string{"create", "read", "update", "delete", "list"},
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
 			HasName:             true,
@@ -510,6 +612,36 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
+		{
+			ResourceType:         resource.Target.String(),
+			Pkg:                  "targets",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "http",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			Container:            "Scope",
+			HasDescription:       true,
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
+		{
+			ResourceType:         resource.Target.String(),
+			Pkg:                  "targets",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "postgres",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			Container:            "Scope",
+			HasDescription:       true,
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"users": {
 		{
@@ -0,0 +1,102 @@
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn("credential_static_username_password_credential", credStaticUsernamePasswordRewrapFn)
+	kms.RegisterTableRewrapFn("credential_static_ssh_private_key_credential", credStaticSshPrivKeyRewrapFn)
+}
+
+func rewrapParameterChecks(ctx context.Context, op errors.Op, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	return nil
+}
+
+// credStaticUsernamePasswordRewrapFn re-encrypts the passwords of the
+// username password credentials which were encrypted with the data key
+// version using the current database wrapper of the scope.
+func credStaticUsernamePasswordRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "static.credStaticUsernamePasswordRewrapFn"
+	if err := rewrapParameterChecks(ctx, op, dataKeyVersionId, scopeId, reader, writer, kmsCache); err != nil {
+		return err
+	}
+
+	var creds []*UsernamePasswordCredential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range creds {
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt username password credential"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt username password credential"))
+		}
+		if _, err := writer.Update(ctx, cred, []string{"CtPassword", "PasswordHmac", "KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update username password credential row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// credStaticSshPrivKeyRewrapFn re-encrypts the private keys and private key
+// passphrases of the ssh private key credentials which were encrypted with the
+// data key version using the current database wrapper of the scope.
+func credStaticSshPrivKeyRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "static.credStaticSshPrivKeyRewrapFn"
+	if err := rewrapParameterChecks(ctx, op, dataKeyVersionId, scopeId, reader, writer, kmsCache); err != nil {
+		return err
+	}
+
+	var creds []*SshPrivateKeyCredential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range creds {
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt ssh private key credential"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt ssh private key credential"))
+		}
+		fieldMask := []string{"PrivateKeyEncrypted", "PrivateKeyHmac", "KeyId"}
+		if len(cred.PrivateKeyPassphrase) > 0 {
+			fieldMask = append(fieldMask, "PrivateKeyPassphraseEncrypted", "PrivateKeyPassphraseHmac")
+		}
+		if _, err := writer.Update(ctx, cred, fieldMask, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update ssh private key credential row with rewrapped fields"))
+		}
+	}
+	return nil
+}
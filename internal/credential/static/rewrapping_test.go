@@ -0,0 +1,108 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh/testdata"
+)
+
+func TestRewrap_credStaticUsernamePasswordRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := credStaticUsernamePasswordRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = credStaticUsernamePasswordRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "username", "password", cs.GetPublicId(), prj.GetPublicId())
+
+		orig := allocUsernamePasswordCredential()
+		orig.PublicId = cred.GetPublicId()
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId()))
+
+		require.NoError(credStaticUsernamePasswordRewrapFn(ctx, orig.GetKeyId(), prj.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocUsernamePasswordCredential()
+		got.PublicId = cred.GetPublicId()
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtPassword(), got.GetCtPassword())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal("password", string(got.GetPassword()))
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
+
+func TestRewrap_credStaticSshPrivKeyRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := credStaticSshPrivKeyRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = credStaticSshPrivKeyRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+		cred := TestSshPrivateKeyCredential(t, conn, wrapper, "username", string(testdata.PEMEncryptedKeys[0].PEMBytes),
+			cs.GetPublicId(), prj.GetPublicId(), WithPrivateKeyPassphrase([]byte(testdata.PEMEncryptedKeys[0].EncryptionKey)))
+
+		orig := allocSshPrivateKeyCredential()
+		orig.PublicId = cred.GetPublicId()
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId()))
+
+		require.NoError(credStaticSshPrivKeyRewrapFn(ctx, orig.GetKeyId(), prj.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocSshPrivateKeyCredential()
+		got.PublicId = cred.GetPublicId()
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetPrivateKeyEncrypted(), got.GetPrivateKeyEncrypted())
+		assert.NotEqual(orig.GetPrivateKeyPassphraseEncrypted(), got.GetPrivateKeyPassphraseEncrypted())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal(testdata.PEMEncryptedKeys[0].PEMBytes, got.GetPrivateKey())
+		assert.Equal([]byte(testdata.PEMEncryptedKeys[0].EncryptionKey), got.GetPrivateKeyPassphrase())
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
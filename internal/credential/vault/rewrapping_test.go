@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrap_credVaultTokenRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := credVaultTokenRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = credVaultTokenRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), "https://vault.consul.service", "token", "accessor")
+
+		orig := allocToken()
+		require.NoError(rw.LookupWhere(ctx, orig, "store_id = ?", []interface{}{cs.GetPublicId()}))
+
+		require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId()))
+
+		require.NoError(credVaultTokenRewrapFn(ctx, orig.GetKeyId(), prj.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocToken()
+		require.NoError(rw.LookupWhere(ctx, got, "store_id = ?", []interface{}{cs.GetPublicId()}))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.Equal(orig.GetTokenHmac(), got.GetTokenHmac())
+		assert.NotEqual(orig.GetCtToken(), got.GetCtToken())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal("token", string(got.GetToken()))
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
+
+func TestRewrap_credVaultClientCertificateRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := credVaultClientCertificateRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = credVaultClientCertificateRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		clientCert := testClientCert(t, testCaCert(t)).Cert.ClientCertificate(t)
+		cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), "https://vault.consul.service", "token", "accessor", WithClientCert(clientCert))
+
+		orig := allocClientCertificate()
+		orig.StoreId = cs.GetPublicId()
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId()))
+
+		require.NoError(credVaultClientCertificateRewrapFn(ctx, orig.GetKeyId(), prj.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocClientCertificate()
+		got.StoreId = cs.GetPublicId()
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtCertificateKey(), got.GetCtCertificateKey())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Equal(clientCert.GetCertificateKey(), got.GetCertificateKey())
+	})
+}
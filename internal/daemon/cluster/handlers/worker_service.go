@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	berrors "github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
@@ -154,6 +156,11 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 				case pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
 					pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED:
 					sr.ConnectionIds = append(sr.ConnectionIds, conn.GetConnectionId())
+					sr.ConnectionBytes = append(sr.ConnectionBytes, session.ConnectionBytes{
+						ConnectionId: conn.GetConnectionId(),
+						BytesUp:      conn.GetBytesUp(),
+						BytesDown:    conn.GetBytesDown(),
+					})
 				}
 			}
 			stateReport = append(stateReport, sr)
@@ -318,18 +325,20 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 			SessionId:   sessionInfo.GetPublicId(),
 			Certificate: sessionInfo.Certificate,
 		},
-		Status:          sessionInfo.States[0].Status.ProtoVal(),
-		Version:         sessionInfo.Version,
-		TofuToken:       string(sessionInfo.TofuToken),
-		Endpoint:        sessionInfo.Endpoint,
-		Expiration:      sessionInfo.ExpirationTime.Timestamp,
-		ConnectionLimit: sessionInfo.ConnectionLimit,
-		ConnectionsLeft: authzSummary.ConnectionLimit,
-		HostId:          sessionInfo.HostId,
-		HostSetId:       sessionInfo.HostSetId,
-		TargetId:        sessionInfo.TargetId,
-		UserId:          sessionInfo.UserId,
-		Credentials:     workerCreds,
+		Status:                 sessionInfo.States[0].Status.ProtoVal(),
+		Version:                sessionInfo.Version,
+		TofuToken:              string(sessionInfo.TofuToken),
+		Endpoint:               sessionInfo.Endpoint,
+		Expiration:             sessionInfo.ExpirationTime.Timestamp,
+		ConnectionLimit:        sessionInfo.ConnectionLimit,
+		ConnectionsLeft:        authzSummary.ConnectionLimit,
+		HostId:                 sessionInfo.HostId,
+		HostSetId:              sessionInfo.HostSetId,
+		TargetId:               sessionInfo.TargetId,
+		UserId:                 sessionInfo.UserId,
+		Credentials:            workerCreds,
+		EnableSessionRecording: sessionInfo.EnableSessionRecording,
+		IdleTimeoutSeconds:     sessionInfo.IdleTimeoutSeconds,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
@@ -528,3 +537,99 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 
 	return ret, nil
 }
+
+func (ws *workerServiceServer) UploadConnectionRecording(ctx context.Context, req *pbs.UploadConnectionRecordingRequest) (*pbs.UploadConnectionRecordingResponse, error) {
+	const op = "workers.(workerServiceServer).UploadConnectionRecording"
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+
+	recording, err := session.NewConnectionRecording(
+		ctx,
+		req.GetSessionId(),
+		req.GetConnectionId(),
+		req.GetWorkerId(),
+		&timestamp.Timestamp{Timestamp: req.GetStartTime()},
+		&timestamp.Timestamp{Timestamp: req.GetEndTime()},
+		req.GetSize(),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid connection recording: %v", err)
+	}
+	recording, err = sessRepo.AddConnectionRecording(ctx, recording)
+	if err != nil {
+		return nil, connectionRecordingStatus(err, "error indexing connection recording")
+	}
+
+	return &pbs.UploadConnectionRecordingResponse{
+		UploadedChunks: recording.UploadedChunks,
+		UploadedSize:   recording.UploadedSize,
+	}, nil
+}
+
+func (ws *workerServiceServer) UploadConnectionRecordingChunk(ctx context.Context, req *pbs.UploadConnectionRecordingChunkRequest) (*pbs.UploadConnectionRecordingChunkResponse, error) {
+	const op = "workers.(workerServiceServer).UploadConnectionRecordingChunk"
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+
+	if _, err := sessRepo.AddConnectionRecordingChunk(ctx, req.GetSessionId(), req.GetConnectionId(), req.GetWorkerId(), req.GetChunkNumber(), req.GetData()); err != nil {
+		return nil, connectionRecordingStatus(err, fmt.Sprintf("error adding chunk %d of connection recording", req.GetChunkNumber()))
+	}
+
+	return &pbs.UploadConnectionRecordingChunkResponse{}, nil
+}
+
+// connectionRecordingStatus converts an error of the session repository while
+// uploading a connection recording to a status the worker uses to decide
+// whether to retry the upload.
+func connectionRecordingStatus(err error, msg string) error {
+	switch {
+	case berrors.Match(berrors.T(berrors.InvalidParameter), err):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case berrors.Match(berrors.T(berrors.RecordNotFound), err):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case berrors.Match(berrors.T(berrors.Forbidden), err):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case berrors.Match(berrors.T(berrors.NotUnique), err):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+func (ws *workerServiceServer) AddConnectionHttpRequests(ctx context.Context, req *pbs.AddConnectionHttpRequestsRequest) (*pbs.AddConnectionHttpRequestsResponse, error) {
+	const op = "workers.(workerServiceServer).AddConnectionHttpRequests"
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+
+	requests := make([]*session.ConnectionHttpRequest, 0, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		var requestTime *timestamp.Timestamp
+		if r.GetRequestTime() != nil {
+			requestTime = &timestamp.Timestamp{Timestamp: r.GetRequestTime()}
+		}
+		httpReq, err := session.NewConnectionHttpRequest(
+			ctx,
+			req.GetConnectionId(),
+			r.GetSequence(),
+			r.GetMethod(),
+			r.GetPath(),
+			r.GetStatusCode(),
+			requestTime,
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid connection http request %d: %v", i, err)
+		}
+		requests = append(requests, httpReq)
+	}
+	if _, err := sessRepo.AddConnectionHttpRequests(ctx, req.GetSessionId(), requests); err != nil {
+		return nil, err
+	}
+
+	return &pbs.AddConnectionHttpRequestsResponse{}, nil
+}
@@ -10,12 +10,14 @@ import (
 
 	"github.com/hashicorp/boundary/api/recovery"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
 
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -326,19 +328,39 @@ func (v *verifier) decryptToken(ctx context.Context) {
 			return
 		}
 
-		at, err := tokenRepo.LookupAuthToken(v.ctx, v.requestInfo.PublicId)
-		if err != nil {
-			event.WriteError(ctx, op, err, event.WithInfoMsg("failed to look up auth token by public ID"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
-		}
-		if at == nil {
-			event.WriteError(ctx, op, stderrors.New("nil result from looking up auth token by public ID"))
-			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
-			return
+		// Service account tokens are stored apart from auth tokens, and
+		// their ids tell them apart.
+		var tokenScopeId string
+		switch {
+		case strings.HasPrefix(v.requestInfo.PublicId, authtoken.ServiceAccountTokenPrefix+"_"):
+			at, err := tokenRepo.LookupServiceAccountToken(v.ctx, v.requestInfo.PublicId)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("failed to look up service account token by public ID"))
+				v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
+				return
+			}
+			if at == nil {
+				event.WriteError(ctx, op, stderrors.New("nil result from looking up service account token by public ID"))
+				v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
+				return
+			}
+			tokenScopeId = at.GetScopeId()
+		default:
+			at, err := tokenRepo.LookupAuthToken(v.ctx, v.requestInfo.PublicId)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("failed to look up auth token by public ID"))
+				v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
+				return
+			}
+			if at == nil {
+				event.WriteError(ctx, op, stderrors.New("nil result from looking up auth token by public ID"))
+				v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
+				return
+			}
+			tokenScopeId = at.GetScopeId()
 		}
 
-		tokenWrapper, err := v.kms.GetWrapper(v.ctx, at.GetScopeId(), kms.KeyPurposeTokens)
+		tokenWrapper, err := v.kms.GetWrapper(v.ctx, tokenScopeId, kms.KeyPurposeTokens)
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to get wrapper for tokens; continuing as anonymous user"))
 			v.requestInfo.TokenFormat = uint32(AuthTokenTypeUnknown)
@@ -470,14 +492,19 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 			retErr = errors.Wrap(ctx, err, op)
 			return
 		}
-		at, err := tokenRepo.ValidateToken(v.ctx, v.requestInfo.PublicId, v.requestInfo.Token)
+		at, err := tokenRepo.ValidateToken(v.ctx, v.requestInfo.PublicId, v.requestInfo.Token, authtoken.WithClientIp(v.requestInfo.ClientIp))
 		if err != nil {
 			// Continue as the anonymous user as maybe this token is expired but
 			// we can still perform the action
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error validating token; continuing as anonymous user"))
 			break
 		}
-		if at != nil {
+		switch {
+		case at != nil && at.GetServiceAccountId() != "":
+			// Service accounts are principals of their own and have neither
+			// an account nor a user.
+			userId = at.GetServiceAccountId()
+		case at != nil:
 			accountId = at.GetAuthAccountId()
 			userId = at.GetIamUserId()
 			if userId == "" {
@@ -494,13 +521,27 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		return
 	}
 
-	u, _, err := iamRepo.LookupUser(ctx, userId)
-	if err != nil {
-		retErr = errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup user"))
-		return
+	switch {
+	case strings.HasPrefix(userId, iam.ServiceAccountPrefix+"_"):
+		sa, err := iamRepo.LookupServiceAccount(ctx, userId)
+		if err != nil {
+			retErr = errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup service account"))
+			return
+		}
+		if sa == nil {
+			retErr = errors.New(ctx, errors.RecordNotFound, op, "service account not found")
+			return
+		}
+		userName = sa.Name
+	default:
+		u, _, err := iamRepo.LookupUser(ctx, userId)
+		if err != nil {
+			retErr = errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup user"))
+			return
+		}
+		userEmail = u.Email
+		userName = u.FullName
 	}
-	userEmail = u.Email
-	userName = u.FullName
 
 	// Look up scope details to return. We can skip a lookup when using the
 	// global scope
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
@@ -21,6 +23,8 @@ type (
 	IamRepoFactory               func() (*iam.Repository, error)
 	OidcAuthRepoFactory          = oidc.OidcRepoFactory
 	PasswordAuthRepoFactory      func() (*password.Repository, error)
+	LdapAuthRepoFactory          func() (*ldap.Repository, error)
+	JwtAuthRepoFactory           func() (*jwt.Repository, error)
 	ServersRepoFactory           func() (*server.Repository, error)
 	StaticRepoFactory            func() (*static.Repository, error)
 	PluginHostRepoFactory        func() (*pluginhost.Repository, error)
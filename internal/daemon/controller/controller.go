@@ -7,6 +7,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -22,7 +24,9 @@ import (
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	iamjob "github.com/hashicorp/boundary/internal/iam/job"
 	"github.com/hashicorp/boundary/internal/kms"
+	kmsjob "github.com/hashicorp/boundary/internal/kms/job"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/plugin/host"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
@@ -109,6 +113,8 @@ type Controller struct {
 	IamRepoFn               common.IamRepoFactory
 	OidcRepoFn              common.OidcAuthRepoFactory
 	PasswordAuthRepoFn      common.PasswordAuthRepoFactory
+	LdapRepoFn              common.LdapAuthRepoFactory
+	JwtRepoFn               common.JwtAuthRepoFactory
 	ServersRepoFn           common.ServersRepoFactory
 	SessionRepoFn           common.SessionRepoFactory
 	ConnectionRepoFn        common.ConnectionRepoFactory
@@ -316,7 +322,8 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(dbase, dbase, c.kms,
 			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
-			authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration))
+			authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration),
+			authtoken.WithTokenMaxLifetimeDuration(c.conf.RawConfig.Controller.AuthTokenMaxLifetimeDuration))
 	}
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
 		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
@@ -333,11 +340,17 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(dbase, dbase, c.kms)
 	}
+	c.LdapRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.JwtRepoFn = func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 	c.TargetRepoFn = func() (*target.Repository, error) {
 		return target.NewRepository(dbase, dbase, c.kms)
 	}
 	c.SessionRepoFn = func() (*session.Repository, error) {
-		return session.NewRepository(dbase, dbase, c.kms)
+		return session.NewRepository(dbase, dbase, c.kms, session.WithRecordingStoragePath(c.conf.RawConfig.Controller.RecordingStoragePath))
 	}
 	c.ConnectionRepoFn = func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, dbase, dbase, c.kms)
@@ -474,6 +487,12 @@ func (c *Controller) registerJobs() error {
 	if err := serversjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
 		return err
 	}
+	if err := kmsjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
+	if err := iamjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/scopes"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/serviceaccounts"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
@@ -132,14 +133,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterHostServiceServer(s, hs)
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn)
+		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.JwtRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create account handler service: %w", err)
 		}
 		services.RegisterAccountServiceServer(s, accts)
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.JwtRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
@@ -153,7 +154,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterAuthTokenServiceServer(s, authtoks)
 	}
 	if _, ok := currentServices[services.ScopeService_ServiceDesc.ServiceName]; !ok {
-		os, err := scopes.NewService(c.IamRepoFn)
+		os, err := scopes.NewService(c.IamRepoFn, c.kms)
 		if err != nil {
 			return fmt.Errorf("failed to create scope handler service: %w", err)
 		}
@@ -205,7 +206,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterSessionServiceServer(s, ss)
 	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.OidcRepoFn)
+		mgs, err := managed_groups.NewService(c.OidcRepoFn, c.LdapRepoFn, c.JwtRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -239,6 +240,13 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		}
 		services.RegisterCredentialServiceServer(s, c)
 	}
+	if _, ok := currentServices[services.ServiceAccountService_ServiceDesc.ServiceName]; !ok {
+		sas, err := serviceaccounts.NewService(c.IamRepoFn, c.AuthTokenRepoFn, c.kms)
+		if err != nil {
+			return fmt.Errorf("failed to create service account handler service: %w", err)
+		}
+		services.RegisterServiceAccountServiceServer(s, sas)
+	}
 	if _, ok := s.GetServiceInfo()[opsservices.HealthService_ServiceDesc.ServiceName]; !ok {
 		hs := health.NewService()
 		opsservices.RegisterHealthServiceServer(s, hs)
@@ -302,6 +310,9 @@ func registerGrpcGatewayEndpoints(ctx context.Context, gwMux *runtime.ServeMux,
 	if err := services.RegisterCredentialServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
 		return fmt.Errorf("failed to register credential service handler: %w", err)
 	}
+	if err := services.RegisterServiceAccountServiceHandlerFromEndpoint(ctx, gwMux, gatewayTarget, dialOptions); err != nil {
+		return fmt.Errorf("failed to register service account service handler: %w", err)
+	}
 
 	return nil
 }
@@ -9,6 +9,10 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	jwtstore "github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
@@ -43,6 +47,7 @@ const (
 	loginNameKey         = "login_name"
 	newPasswordField     = "new_password"
 	currentPasswordField = "current_password"
+	codeField            = "code"
 
 	// oidc field names
 	issuerField     = "attributes.issuer"
@@ -50,12 +55,22 @@ const (
 	nameClaimField  = "attributes.full_name"
 	emailClaimField = "attributes.email"
 
+	// ldap field names
+	loginNameField      = "attributes.login_name"
+	dnField             = "attributes.dn"
+	memberOfGroupsField = "attributes.member_of_groups"
+
+	// jwt field names
+	tokenClaimsField = "attributes.token_claims"
+
 	domain = "auth"
 )
 
 var (
 	pwMaskManager   handlers.MaskManager
 	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
+	jwtMaskManager  handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -67,6 +82,9 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.Unlock,
+			action.EnrollTotp,
+			action.VerifyTotp,
 		},
 		oidc.Subtype: {
 			action.NoOp,
@@ -74,6 +92,18 @@ var (
 			action.Update,
 			action.Delete,
 		},
+		ldap.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
+		jwt.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -92,6 +122,12 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&oidcstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.OidcAccountAttributes{}}); err != nil {
 		panic(err)
 	}
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.LdapAccountAttributes{}}); err != nil {
+		panic(err)
+	}
+	if jwtMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&jwtstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.JwtAccountAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.AccountServiceServer interface.
@@ -100,12 +136,14 @@ type Service struct {
 
 	pwRepoFn   common.PasswordAuthRepoFactory
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	jwtRepoFn  common.JwtAuthRepoFactory
 }
 
 var _ pbs.AccountServiceServer = (*Service)(nil)
 
 // NewService returns a account service which handles account related requests to boundary.
-func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory) (Service, error) {
+func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, jwtRepo common.JwtAuthRepoFactory) (Service, error) {
 	const op = "accounts.NewService"
 	if pwRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing password repository")
@@ -113,7 +151,13 @@ func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthR
 	if oidcRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidc repository provided")
 	}
-	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo}, nil
+	if ldapRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository provided")
+	}
+	if jwtRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing jwt repository provided")
+	}
+	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, jwtRepoFn: jwtRepo}, nil
 }
 
 // ListAccounts implements the interface pbs.AccountServiceServer.
@@ -393,6 +437,123 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: item}, nil
 }
 
+// UnlockAccount implements the interface pbs.AccountServiceServer.
+func (s Service) UnlockAccount(ctx context.Context, req *pbs.UnlockAccountRequest) (*pbs.UnlockAccountResponse, error) {
+	const op = "accounts.(Service).UnlockAccount"
+
+	if err := validateUnlockAccountRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Unlock)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, err := s.unlockInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UnlockAccountResponse{Item: item}, nil
+}
+
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	const op = "accounts.(Service).EnrollTotp"
+
+	if err := validateEnrollTotpRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, uri, err := s.enrollTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.EnrollTotpResponse{Item: item, OtpauthUri: uri}, nil
+}
+
+// VerifyTotp implements the interface pbs.AccountServiceServer.
+func (s Service) VerifyTotp(ctx context.Context, req *pbs.VerifyTotpRequest) (*pbs.VerifyTotpResponse, error) {
+	const op = "accounts.(Service).VerifyTotp"
+
+	if err := validateVerifyTotpRequest(req); err != nil {
+		return nil, err
+	}
+
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.VerifyTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	acct, recoveryCodes, err := s.verifyTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[subtypes.SubtypeFromId(domain, acct.GetPublicId())]).Strings()))
+	}
+
+	item, err := toProto(ctx, acct, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.VerifyTotpResponse{Item: item, RecoveryCodes: recoveryCodes}, nil
+}
+
 // getFromRepo returns the account and, if available, managed groups the account
 // belongs to within the auth method
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []string, error) {
@@ -432,6 +593,52 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			mgIds = append(mgIds, mg.GetManagedGroupId())
 		}
 		acct = a
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		if a == nil {
+			return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		if a == nil {
+			return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -455,6 +662,9 @@ func (s Service) createPwInRepo(ctx context.Context, am auth.AuthMethod, item *p
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
 	}
+	if item.GetState() != "" {
+		a.State = item.GetState()
+	}
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
@@ -466,6 +676,17 @@ func (s Service) createPwInRepo(ctx context.Context, am auth.AuthMethod, item *p
 	}
 	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a, createOpts...)
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.PasswordTooShort), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.password": "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.password": "Password does not contain the character classes required by the password policy."})
+		case errors.Match(errors.T(errors.PasswordDisallowed), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.password": "Password contains a word disallowed by the password policy."})
+		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if out == nil {
@@ -498,6 +719,9 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
 	}
+	if item.GetState() != "" {
+		a.State = item.GetState()
+	}
 	repo, err := s.oidcRepoFn()
 	if err != nil {
 		return nil, err
@@ -513,6 +737,74 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	a, err := ldap.NewAccount(ctx, am.GetPublicId(), item.GetLdapAccountAttributes().GetLoginName(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	if item.GetState() != "" {
+		a.State = item.GetState()
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) createJwtInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*jwt.Account, error) {
+	const op = "accounts.(Service).createJwtInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []jwt.Option
+	if item.GetName() != nil {
+		opts = append(opts, jwt.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, jwt.WithDescription(item.GetDescription().GetValue()))
+	}
+	a, err := jwt.NewAccount(ctx, am.GetPublicId(), item.GetJwtAccountAttributes().GetSubject(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	if item.GetState() != "" {
+		a.State = item.GetState()
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (auth.Account, error) {
 	const op = "accounts.(Service).createInRepo"
 	if item == nil {
@@ -538,6 +830,24 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
+	case jwt.Subtype:
+		am, err := s.createJwtInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -588,6 +898,7 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	if item.GetDescription() != nil {
 		u.Description = item.GetDescription().GetValue()
 	}
+	u.State = item.GetState()
 
 	version := item.GetVersion()
 
@@ -609,35 +920,123 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
-func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateAccountRequest) (auth.Account, error) {
-	const op = "accounts.(Service).updateInRepo"
-	var out auth.Account
-	switch subtypes.SubtypeFromId(domain, req.GetId()) {
-	case password.Subtype:
-		a, err := s.updatePwInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		if a == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
-		}
-		out = a
-	case oidc.Subtype:
-		a, err := s.updateOidcInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
-		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
-		}
-		if a == nil {
-			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
-		}
-		out = a
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).updateLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
 	}
-	return out, nil
-}
-
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	const op = "accounts.(Service).deleteFromRepo"
-	var rows int
+	u := ldap.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+	u.State = item.GetState()
+
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) updateJwtInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Account) (*jwt.Account, error) {
+	const op = "accounts.(Service).updateJwtInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := jwt.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+	u.State = item.GetState()
+
+	version := item.GetVersion()
+
+	dbMask := jwtMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateAccountRequest) (auth.Account, error) {
+	const op = "accounts.(Service).updateInRepo"
+	var out auth.Account
+	switch subtypes.SubtypeFromId(domain, req.GetId()) {
+	case password.Subtype:
+		a, err := s.updatePwInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case oidc.Subtype:
+		a, err := s.updateOidcInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case ldap.Subtype:
+		a, err := s.updateLdapInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case jwt.Subtype:
+		a, err := s.updateJwtInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	const op = "accounts.(Service).deleteFromRepo"
+	var rows int
 	var err error
 	switch subtypes.SubtypeFromId(domain, id) {
 	case password.Subtype:
@@ -652,6 +1051,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case jwt.Subtype:
+		repo, iErr := s.jwtRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -691,6 +1102,30 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		for _, a := range oidcl {
 			outUl = append(outUl, a)
 		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ldapl, err := ldapRepo.ListAccounts(ctx, authMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range ldapl {
+			outUl = append(outUl, a)
+		}
+	case jwt.Subtype:
+		jwtRepo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		jwtl, err := jwtRepo.ListAccounts(ctx, authMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range jwtl {
+			outUl = append(outUl, a)
+		}
 	}
 	return outUl, nil
 }
@@ -712,6 +1147,15 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 		case errors.Match(errors.T(errors.PasswordsEqual), err):
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"new_password": "New password equal to current password."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": "Password does not contain the character classes required by the password policy."})
+		case errors.Match(errors.T(errors.PasswordDisallowed), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": "Password contains a word disallowed by the password policy."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": "Password matches a previous password."})
 		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -736,12 +1180,78 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 		case errors.Match(errors.T(errors.PasswordTooShort), err):
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"password": "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": "Password does not contain the character classes required by the password policy."})
+		case errors.Match(errors.T(errors.PasswordDisallowed), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": "Password contains a word disallowed by the password policy."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": "Password matches a previous password."})
 		}
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	return out, nil
 }
 
+func (s Service) unlockInRepo(ctx context.Context, id string) (auth.Account, error) {
+	const op = "accounts.(Service).unlockInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.UnlockAccount(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return out, nil
+}
+
+func (s Service) enrollTotpInRepo(ctx context.Context, scopeId, id string) (auth.Account, string, error) {
+	const op = "accounts.(Service).enrollTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	out, uri, err := repo.EnrollTotp(ctx, scopeId, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, "", handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, "", errors.Wrap(ctx, err, op)
+	}
+	return out, uri, nil
+}
+
+func (s Service) verifyTotpInRepo(ctx context.Context, scopeId, id, code string) (auth.Account, []string, error) {
+	const op = "accounts.(Service).verifyTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	out, recoveryCodes, err := repo.VerifyTotp(ctx, scopeId, id, code)
+	if err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, nil, handlers.NotFoundErrorf("Account not found or account has no TOTP enrollment.")
+		case errors.Match(errors.T(errors.MfaInvalidCode), err):
+			return nil, nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{codeField: "Invalid TOTP code."})
+		case errors.Match(errors.T(errors.InvalidParameter), err):
+			return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The TOTP enrollment of the account has already been verified.")
+		}
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return out, recoveryCodes, nil
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, requestauth.VerifyResults) {
 	res := requestauth.VerifyResults{}
 	pwRepo, err := s.pwRepoFn()
@@ -754,6 +1264,16 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.Account), requestauth.WithAction(a)}
@@ -784,6 +1304,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case ldap.Subtype:
+			acct, err := ldapRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
+		case jwt.Subtype:
+			acct, err := jwtRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
 		}
 		opts = append(opts, requestauth.WithId(id))
 	}
@@ -812,6 +1354,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		authMeth = am
+	case ldap.Subtype:
+		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+	case jwt.Subtype:
+		am, err := jwtRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
 	}
 	opts = append(opts, requestauth.WithScopeId(authMeth.GetScopeId()), requestauth.WithPin(parentId))
 	return authMeth, requestauth.Verify(ctx, opts...)
@@ -848,6 +1412,9 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 	if outputFields.Has(globals.VersionField) {
 		out.Version = in.GetVersion()
 	}
+	if outputFields.Has(globals.StateField) {
+		out.State = in.GetState()
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -906,6 +1473,51 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			}
 		}
 		out.Attrs = attrs
+	case *ldap.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		groups, err := i.GetMemberOfGroupNames(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out.Attrs = &pb.Account_LdapAccountAttributes{
+			LdapAccountAttributes: &pb.LdapAccountAttributes{
+				LoginName:      i.GetLoginName(),
+				FullName:       i.GetFullName(),
+				Email:          i.GetEmail(),
+				Dn:             i.GetDn(),
+				MemberOfGroups: groups,
+			},
+		}
+	case *jwt.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = jwt.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.Account_JwtAccountAttributes{
+			JwtAccountAttributes: &pb.JwtAccountAttributes{
+				Subject:  i.GetSubject(),
+				FullName: i.GetFullName(),
+				Email:    i.GetEmail(),
+			},
+		}
+		if s := i.GetTokenClaims(); s != "" {
+			m := make(map[string]interface{})
+			var err error
+			if err = json.Unmarshal([]byte(s), &m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored token claims"))
+			}
+			if attrs.JwtAccountAttributes.TokenClaims, err = structpb.NewStruct(m); err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error converting stored token claims to protobuf struct"))
+			}
+		}
+		out.Attrs = attrs
 	}
 	return &out, nil
 }
@@ -927,6 +1539,8 @@ func toStoragePwAccount(amId string, item *pb.Account) (*password.Account, error
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
 	}
 
+	u.State = item.GetState()
+
 	attrs := item.GetPasswordAccountAttributes()
 	if attrs.GetLoginName() != "" {
 		u.LoginName = attrs.GetLoginName()
@@ -944,7 +1558,7 @@ func validateGetRequest(req *pbs.GetAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix, jwt.AccountPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateAccountRequest) error {
@@ -957,6 +1571,9 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 		if req.GetItem().GetAuthMethodId() == "" {
 			badFields[authMethodIdField] = "This field is required."
 		}
+		if st := req.GetItem().GetState(); st != "" && !auth.ValidAccountState(st) {
+			badFields[globals.StateField] = fmt.Sprintf("Only %q and %q are valid states.", auth.ActiveAccountState, auth.DisabledAccountState)
+		}
 		switch subtypes.SubtypeFromId(domain, req.GetItem().GetAuthMethodId()) {
 		case password.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != password.Subtype.String() {
@@ -999,6 +1616,53 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 					badFields[emailClaimField] = "This is a read only field."
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetLdapAccountAttributes()
+			switch {
+			case attrs == nil:
+				badFields["attributes"] = "This is a required field."
+			default:
+				if attrs.GetLoginName() == "" {
+					badFields[loginNameField] = "This is a required field for this type."
+				}
+				if attrs.GetFullName() != "" {
+					badFields[nameClaimField] = "This is a read only field."
+				}
+				if attrs.GetEmail() != "" {
+					badFields[emailClaimField] = "This is a read only field."
+				}
+				if attrs.GetDn() != "" {
+					badFields[dnField] = "This is a read only field."
+				}
+				if len(attrs.GetMemberOfGroups()) > 0 {
+					badFields[memberOfGroupsField] = "This is a read only field."
+				}
+			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetJwtAccountAttributes()
+			switch {
+			case attrs == nil:
+				badFields["attributes"] = "This is a required field."
+			default:
+				if attrs.GetSubject() == "" {
+					badFields[subjectField] = "This is a required field for this type."
+				}
+				if attrs.GetFullName() != "" {
+					badFields[nameClaimField] = "This is a read only field."
+				}
+				if attrs.GetEmail() != "" {
+					badFields[emailClaimField] = "This is a read only field."
+				}
+				if attrs.GetTokenClaims() != nil {
+					badFields[tokenClaimsField] = "This is a read only field."
+				}
+			}
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -1013,6 +1677,9 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.StateField) && !auth.ValidAccountState(req.GetItem().GetState()) {
+			badFields[globals.StateField] = fmt.Sprintf("Only %q and %q are valid states.", auth.ActiveAccountState, auth.DisabledAccountState)
+		}
 		switch subtypes.SubtypeFromId(domain, req.GetId()) {
 		case password.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != password.Subtype.String() {
@@ -1034,9 +1701,33 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), nameClaimField) {
 				badFields[nameClaimField] = "Field is read only."
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), loginNameField) {
+				badFields[loginNameField] = "Field cannot be updated."
+			}
+			for _, f := range []string{emailClaimField, nameClaimField, dnField, memberOfGroupsField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), subjectField) {
+				badFields[subjectField] = "Field cannot be updated."
+			}
+			for _, f := range []string{emailClaimField, nameClaimField, tokenClaimsField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
 		}
 		return badFields
-	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix, jwt.AccountPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
@@ -1044,7 +1735,7 @@ func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix, jwt.AccountPrefix)
 }
 
 func validateListRequest(req *pbs.ListAccountsRequest) error {
@@ -1053,7 +1744,7 @@ func validateListRequest(req *pbs.ListAccountsRequest) error {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix) {
 		badFields[authMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -1106,3 +1797,51 @@ func validateSetPasswordRequest(req *pbs.SetPasswordRequest) error {
 	}
 	return nil
 }
+
+func validateUnlockAccountRequest(req *pbs.UnlockAccountRequest) error {
+	const op = "accounts.validateUnlockAccountRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateEnrollTotpRequest(req *pbs.EnrollTotpRequest) error {
+	const op = "accounts.validateEnrollTotpRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateVerifyTotpRequest(req *pbs.VerifyTotpRequest) error {
+	const op = "accounts.validateVerifyTotpRequest"
+	if req == nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
+	}
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetCode() == "" {
+		badFields[codeField] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -46,6 +48,9 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.Unlock.String(),
+		action.EnrollTotp.String(),
+		action.VerifyTotp.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -67,11 +72,19 @@ func TestNewService(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name     string
 		pwRepo   common.PasswordAuthRepoFactory
 		oidcRepo common.OidcAuthRepoFactory
+		ldapRepo common.LdapAuthRepoFactory
+		jwtRepo  common.JwtAuthRepoFactory
 		wantErr  bool
 	}{
 		{
@@ -81,22 +94,42 @@ func TestNewService(t *testing.T) {
 		{
 			name:     "nil-pw-repo",
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			jwtRepo:  jwtRepoFn,
 			wantErr:  true,
 		},
 		{
-			name:    "nil-oidc-repo",
-			pwRepo:  pwRepoFn,
-			wantErr: true,
+			name:     "nil-oidc-repo",
+			pwRepo:   pwRepoFn,
+			ldapRepo: ldapRepoFn,
+			jwtRepo:  jwtRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-ldap-repo",
+			pwRepo:   pwRepoFn,
+			oidcRepo: oidcRepoFn,
+			jwtRepo:  jwtRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-jwt-repo",
+			pwRepo:   pwRepoFn,
+			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			wantErr:  true,
 		},
 		{
 			name:     "success",
 			pwRepo:   pwRepoFn,
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			jwtRepo:  jwtRepoFn,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo)
+			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo, tc.ldapRepo, tc.jwtRepo)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -118,11 +151,17 @@ func TestGet(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -142,6 +181,7 @@ func TestGet(t *testing.T) {
 			&pb.PasswordAccountAttributes{LoginName: pwA.GetLoginName()},
 		},
 		AuthorizedActions: pwAuthorizedActions,
+		State:             "active",
 	}
 
 	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
@@ -172,6 +212,7 @@ func TestGet(t *testing.T) {
 			},
 		},
 		AuthorizedActions: oidcAuthorizedActions,
+		State:             "active",
 		ManagedGroupIds:   []string{mg.GetPublicId()},
 	}
 
@@ -261,6 +302,12 @@ func TestListPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -283,6 +330,7 @@ func TestListPassword(t *testing.T) {
 				PasswordAccountAttributes: &pb.PasswordAccountAttributes{LoginName: aa.GetLoginName()},
 			},
 			AuthorizedActions: pwAuthorizedActions,
+			State:             "active",
 		})
 	}
 
@@ -300,6 +348,7 @@ func TestListPassword(t *testing.T) {
 				&pb.PasswordAccountAttributes{LoginName: aa.GetLoginName()},
 			},
 			AuthorizedActions: pwAuthorizedActions,
+			State:             "active",
 		})
 	}
 
@@ -356,7 +405,7 @@ func TestListPassword(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			// Test non-anon first
@@ -399,6 +448,12 @@ func TestListOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -432,6 +487,7 @@ func TestListOidc(t *testing.T) {
 				},
 			},
 			AuthorizedActions: oidcAuthorizedActions,
+			State:             "active",
 		})
 	}
 
@@ -454,6 +510,7 @@ func TestListOidc(t *testing.T) {
 				},
 			},
 			AuthorizedActions: oidcAuthorizedActions,
+			State:             "active",
 		})
 	}
 
@@ -510,7 +567,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -556,6 +613,12 @@ func TestDelete(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -575,7 +638,7 @@ func TestDelete(t *testing.T) {
 	)
 	oidcA := oidc.TestAccount(t, conn, oidcAm, "test-subject")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -652,6 +715,12 @@ func TestDelete_twice(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -660,7 +729,7 @@ func TestDelete_twice(t *testing.T) {
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccount(t, conn, am.GetPublicId(), "name1")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAccountRequest{
 		Id: ac.GetPublicId(),
@@ -684,11 +753,17 @@ func TestCreatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -735,6 +810,7 @@ func TestCreatePassword(t *testing.T) {
 						},
 					},
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -765,6 +841,7 @@ func TestCreatePassword(t *testing.T) {
 						},
 					},
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -799,6 +876,7 @@ func TestCreatePassword(t *testing.T) {
 						},
 					},
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -928,11 +1006,17 @@ func TestCreateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -983,6 +1067,7 @@ func TestCreateOidc(t *testing.T) {
 						},
 					},
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1012,6 +1097,7 @@ func TestCreateOidc(t *testing.T) {
 						},
 					},
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1045,6 +1131,7 @@ func TestCreateOidc(t *testing.T) {
 						},
 					},
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1162,13 +1249,19 @@ func TestUpdatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new accounts service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1234,6 +1327,7 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1258,6 +1352,7 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1328,6 +1423,7 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1352,6 +1448,7 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1376,6 +1473,7 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1400,8 +1498,44 @@ func TestUpdatePassword(t *testing.T) {
 					Attrs:             modifiedAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: pwAuthorizedActions,
+					State:             "active",
+				},
+			},
+		},
+		{
+			name: "Disable an Existing Account",
+			req: &pbs.UpdateAccountRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{globals.StateField},
+				},
+				Item: &pb.Account{
+					State: "disabled",
+				},
+			},
+			res: &pbs.UpdateAccountResponse{
+				Item: &pb.Account{
+					AuthMethodId:      am.GetPublicId(),
+					Name:              &wrapperspb.StringValue{Value: "default"},
+					Description:       &wrapperspb.StringValue{Value: "default"},
+					Type:              "password",
+					Attrs:             defaultAttributes,
+					Scope:             defaultScopeInfo,
+					AuthorizedActions: pwAuthorizedActions,
+					State:             "disabled",
+				},
+			},
+		},
+		{
+			name: "Invalid State",
+			req: &pbs.UpdateAccountRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{globals.StateField},
+				},
+				Item: &pb.Account{
+					State: "locked",
 				},
 			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
 		{
 			name: "Update a Non Existing Old ID Account",
@@ -1549,6 +1683,12 @@ func TestUpdateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -1564,7 +1704,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1621,6 +1761,7 @@ func TestUpdateOidc(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1645,6 +1786,7 @@ func TestUpdateOidc(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1715,6 +1857,7 @@ func TestUpdateOidc(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1739,6 +1882,7 @@ func TestUpdateOidc(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1763,6 +1907,7 @@ func TestUpdateOidc(t *testing.T) {
 					Attrs:             defaultAttributes,
 					Scope:             defaultScopeInfo,
 					AuthorizedActions: oidcAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -1936,12 +2081,18 @@ func TestSetPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -2063,6 +2214,192 @@ func TestSetPassword(t *testing.T) {
 	}
 }
 
+func TestUnlockAccount(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.LockoutThreshold = 2
+	am, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"LockoutThreshold"})
+	require.NoError(t, err)
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "testusername")
+	_, err = pwRepo.SetPassword(ctx, o.GetPublicId(), acct.GetPublicId(), "originalpassword", acct.GetVersion())
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		got, err := pwRepo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "testusername", "wrongpassword")
+		require.NoError(t, err)
+		require.Nil(t, got)
+	}
+	got, err := pwRepo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "testusername", "originalpassword")
+	require.NoError(t, err)
+	require.Nil(t, got, "locked account must not authenticate")
+
+	unlockResp, err := tested.UnlockAccount(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.UnlockAccountRequest{
+		Id: acct.GetPublicId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, acct.GetPublicId(), unlockResp.GetItem().GetId())
+
+	got, err = pwRepo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "testusername", "originalpassword")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	badRequestCases := []struct {
+		name      string
+		accountId string
+	}{
+		{
+			name:      "empty account id",
+			accountId: "",
+		},
+		{
+			name:      "notfound old account id",
+			accountId: intglobals.OldPasswordAccountPrefix + "_DoesntExis",
+		},
+		{
+			name:      "notfound new account id",
+			accountId: intglobals.NewPasswordAccountPrefix + "_DoesntExis",
+		},
+	}
+
+	for _, tt := range badRequestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			unlockResp, err := tested.UnlockAccount(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.UnlockAccountRequest{
+				Id: tt.accountId,
+			})
+			assert.Error(err)
+			assert.Nil(unlockResp)
+		})
+	}
+}
+
+func TestEnrollAndVerifyTotp(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "testusername")
+	_, err = pwRepo.SetPassword(ctx, o.GetPublicId(), acct.GetPublicId(), "originalpassword", acct.GetVersion())
+	require.NoError(t, err)
+
+	_, err = tested.VerifyTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.VerifyTotpRequest{
+		Id:   acct.GetPublicId(),
+		Code: "123456",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "Got %v, wanted not found error", err)
+
+	enrollResp, err := tested.EnrollTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.EnrollTotpRequest{
+		Id: acct.GetPublicId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, acct.GetPublicId(), enrollResp.GetItem().GetId())
+	require.NotEmpty(t, enrollResp.GetOtpauthUri())
+
+	_, err = tested.VerifyTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.VerifyTotpRequest{
+		Id:   acct.GetPublicId(),
+		Code: password.TestTotpCode(t, enrollResp.GetOtpauthUri(), -5),
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "Got %v, wanted invalid argument error", err)
+
+	verifyResp, err := tested.VerifyTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.VerifyTotpRequest{
+		Id:   acct.GetPublicId(),
+		Code: password.TestTotpCode(t, enrollResp.GetOtpauthUri(), 0),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, acct.GetPublicId(), verifyResp.GetItem().GetId())
+	assert.NotEmpty(t, verifyResp.GetRecoveryCodes())
+
+	_, err = pwRepo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "testusername", "originalpassword")
+	require.Error(t, err, "authentication without a TOTP code must fail once the enrollment is verified")
+
+	badRequestCases := []struct {
+		name      string
+		accountId string
+		code      string
+	}{
+		{
+			name:      "empty account id",
+			accountId: "",
+			code:      "123456",
+		},
+		{
+			name:      "empty code",
+			accountId: acct.GetPublicId(),
+		},
+		{
+			name:      "notfound new account id",
+			accountId: intglobals.NewPasswordAccountPrefix + "_DoesntExis",
+			code:      "123456",
+		},
+	}
+
+	for _, tt := range badRequestCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			verifyResp, err := tested.VerifyTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.VerifyTotpRequest{
+				Id:   tt.accountId,
+				Code: tt.code,
+			})
+			assert.Error(err)
+			assert.Nil(verifyResp)
+		})
+	}
+}
+
 func TestChangePassword(t *testing.T) {
 	ctx := context.TODO()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -2075,12 +2412,18 @@ func TestChangePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -49,6 +50,15 @@ func TestValidateCreateRequest(t *testing.T) {
 			},
 			errContains: fieldError(typeField, "Doesn't match the parent resource's type."),
 		},
+		{
+			name: "invalid state",
+			item: &pb.Account{
+				Type:         password.Subtype.String(),
+				AuthMethodId: password.AuthMethodPrefix + "_1234567890",
+				State:        "locked",
+			},
+			errContains: fieldError(globals.StateField, `Only "active" and "disabled" are valid states.`),
+		},
 		{
 			name: "missing oidc attributes",
 			item: &pb.Account{
@@ -185,6 +195,17 @@ func TestValidateUpdateRequest(t *testing.T) {
 			},
 			errContains: fieldError(typeField, "Cannot modify the resource type."),
 		},
+		{
+			name: "invalid state",
+			req: &pbs.UpdateAccountRequest{
+				Id:         oidc.AccountPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{globals.StateField}},
+				Item: &pb.Account{
+					State: "locked",
+				},
+			},
+			errContains: fieldError(globals.StateField, `Only "active" and "disabled" are valid states.`),
+		},
 		{
 			name: "no error",
 			req: &pbs.UpdateAccountRequest{
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -53,6 +56,9 @@ const (
 	tokenTypeField    = "type"
 	isPrimaryField    = "is_primary"
 
+	authTokenTimeToLiveSecondsField  = "auth_token_time_to_live_seconds"
+	authTokenTimeToStaleSecondsField = "auth_token_time_to_stale_seconds"
+
 	domain = "auth"
 )
 
@@ -81,6 +87,8 @@ type Service struct {
 	kms        *kms.Kms
 	pwRepoFn   common.PasswordAuthRepoFactory
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	jwtRepoFn  common.JwtAuthRepoFactory
 	iamRepoFn  common.IamRepoFactory
 	atRepoFn   common.AuthTokenRepoFactory
 }
@@ -88,7 +96,7 @@ type Service struct {
 var _ pbs.AuthMethodServiceServer = (*Service)(nil)
 
 // NewService returns a auth method service which handles auth method related requests to boundary.
-func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, opt ...handlers.Option) (Service, error) {
+func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, jwtRepoFn common.JwtAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, opt ...handlers.Option) (Service, error) {
 	const op = "authmethods.NewService"
 	if kms == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
@@ -99,13 +107,19 @@ func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoF
 	if oidcRepoFn == nil {
 		return Service{}, fmt.Errorf("nil oidc repository provided")
 	}
+	if ldapRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository")
+	}
+	if jwtRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing jwt repository")
+	}
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
 	if atRepoFn == nil {
 		return Service{}, fmt.Errorf("nil auth token repository provided")
 	}
-	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}
+	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, ldapRepoFn: ldapRepoFn, jwtRepoFn: jwtRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}
 
 	return s, nil
 }
@@ -151,6 +165,14 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 	if err != nil {
 		return nil, err
 	}
+	ids := make([]string, 0, len(ul))
+	for _, am := range ul {
+		ids = append(ids, am.GetPublicId())
+	}
+	lifetimes, err := s.tokenLifetimesFromRepo(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
 	finalItems := make([]*pb.AuthMethod, 0, len(ul))
 	res := perms.Resource{
 		Type: resource.AuthMethod,
@@ -179,6 +201,7 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 			}
 			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 		}
+		outputOpts = append(outputOpts, tokenLifetimeOpts(lifetimes[am.GetPublicId()])...)
 
 		item, err := toAuthMethodProto(ctx, am, outputOpts...)
 		if err != nil {
@@ -234,6 +257,11 @@ func (s Service) GetAuthMethod(ctx context.Context, req *pbs.GetAuthMethodReques
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	lifetimes, err := s.tokenLifetimesFromRepo(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	outputOpts = append(outputOpts, tokenLifetimeOpts(lifetimes[am.GetPublicId()])...)
 
 	item, err := toAuthMethodProto(ctx, am, outputOpts...)
 	if err != nil {
@@ -279,6 +307,11 @@ func (s Service) CreateAuthMethod(ctx context.Context, req *pbs.CreateAuthMethod
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	lifetimes, err := s.tokenLifetimesFromRepo(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	outputOpts = append(outputOpts, tokenLifetimeOpts(lifetimes[am.GetPublicId()])...)
 
 	item, err := toAuthMethodProto(ctx, am, outputOpts...)
 	if err != nil {
@@ -329,6 +362,11 @@ func (s Service) UpdateAuthMethod(ctx context.Context, req *pbs.UpdateAuthMethod
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	lifetimes, err := s.tokenLifetimesFromRepo(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	outputOpts = append(outputOpts, tokenLifetimeOpts(lifetimes[am.GetPublicId()])...)
 
 	item, err := toAuthMethodProto(ctx, am, outputOpts...)
 	if err != nil {
@@ -383,6 +421,11 @@ func (s Service) ChangeState(ctx context.Context, req *pbs.ChangeStateRequest) (
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	lifetimes, err := s.tokenLifetimesFromRepo(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	outputOpts = append(outputOpts, tokenLifetimeOpts(lifetimes[am.GetPublicId()])...)
 
 	item, err := toAuthMethodProto(ctx, am, outputOpts...)
 	if err != nil {
@@ -424,6 +467,14 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		if err := validateAuthenticateOidcRequest(req); err != nil {
 			return nil, err
 		}
+	case ldap.Subtype:
+		if err := validateAuthenticateLdapRequest(req); err != nil {
+			return nil, err
+		}
+	case jwt.Subtype:
+		if err := validateAuthenticateJwtRequest(req); err != nil {
+			return nil, err
+		}
 	}
 
 	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
@@ -437,6 +488,12 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 
 	case oidc.Subtype:
 		return s.authenticateOidc(ctx, req, &authResults)
+
+	case ldap.Subtype:
+		return s.authenticateLdap(ctx, req, &authResults)
+
+	case jwt.Subtype:
+		return s.authenticateJwt(ctx, req, &authResults)
 	}
 	return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
 }
@@ -459,6 +516,20 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, e
 		}
 		am, lookupErr = repo.LookupAuthMethod(ctx, id)
 
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, lookupErr = repo.LookupAuthMethod(ctx, id)
+
 	default:
 		return nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -508,6 +579,30 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string, authResult
 	for _, item := range pl {
 		outUl = append(outUl, item)
 	}
+
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ll, err := ldapRepo.ListAuthMethods(ctx, scopeIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, item := range ll {
+		outUl = append(outUl, item)
+	}
+
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	jl, err := jwtRepo.ListAuthMethods(ctx, scopeIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, item := range jl {
+		outUl = append(outUl, item)
+	}
 	return outUl, nil
 }
 
@@ -533,6 +628,24 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
+	case jwt.Subtype:
+		am, err := s.createJwtInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -564,6 +677,26 @@ func (s Service) updateInRepo(ctx context.Context, scopeId string, req *pbs.Upda
 		}
 		am = oam
 		dryRun = dr
+
+	case ldap.Subtype:
+		lam, err := s.updateLdapInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, false, errors.Wrap(ctx, err, op)
+		}
+		if lam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = lam
+
+	case jwt.Subtype:
+		jam, err := s.updateJwtInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, false, errors.Wrap(ctx, err, op)
+		}
+		if jam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = jam
 	}
 
 	return am, dryRun, nil
@@ -587,6 +720,20 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, errors.Wrap(ctx, err, op)
 		}
 		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
 	}
 
 	if dErr != nil {
@@ -694,6 +841,38 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) reque
 				return res
 			}
 			authMeth = am
+		case ldap.Subtype:
+			repo, err := s.ldapRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
+		case jwt.Subtype:
+			repo, err := s.jwtRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method type")
 			return res
@@ -705,6 +884,31 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) reque
 	return requestauth.Verify(ctx, opts...)
 }
 
+// tokenLifetimesFromRepo returns the auth token lifetimes of the auth methods
+// with the provided ids, keyed by auth method id.
+func (s Service) tokenLifetimesFromRepo(ctx context.Context, ids ...string) (map[string]*authtoken.AuthMethodTokenLifetime, error) {
+	const op = "authmethods.(Service).tokenLifetimesFromRepo"
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	lifetimes, err := repo.ListAuthMethodTokenLifetimes(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return lifetimes, nil
+}
+
+func tokenLifetimeOpts(l *authtoken.AuthMethodTokenLifetime) []handlers.Option {
+	if l == nil {
+		return nil
+	}
+	return []handlers.Option{
+		handlers.WithAuthTokenTimeToLive(l.TimeToLive),
+		handlers.WithAuthTokenTimeToStale(l.TimeToStale),
+	}
+}
+
 func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.Option) (*pb.AuthMethod, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
@@ -722,6 +926,12 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 	if outputFields.Has(globals.IsPrimaryField) {
 		out.IsPrimary = in.GetIsPrimaryAuthMethod()
 	}
+	if outputFields.Has(globals.AuthTokenTimeToLiveSecondsField) && opts.WithAuthTokenTimeToLive > 0 {
+		out.AuthTokenTimeToLiveSeconds = wrapperspb.UInt32(uint32(opts.WithAuthTokenTimeToLive / time.Second))
+	}
+	if outputFields.Has(globals.AuthTokenTimeToStaleSecondsField) && opts.WithAuthTokenTimeToStale > 0 {
+		out.AuthTokenTimeToStaleSeconds = wrapperspb.UInt32(uint32(opts.WithAuthTokenTimeToStale / time.Second))
+	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
 		out.Description = wrapperspb.String(in.GetDescription())
 	}
@@ -754,10 +964,25 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		if !outputFields.Has(globals.AttributesField) {
 			break
 		}
+		words, err := i.GetDisallowedWordsSlice(ctx)
+		if err != nil {
+			return nil, err
+		}
 		out.Attrs = &pb.AuthMethod_PasswordAuthMethodAttributes{
 			PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
-				MinLoginNameLength: i.GetMinLoginNameLength(),
-				MinPasswordLength:  i.GetMinPasswordLength(),
+				MinLoginNameLength:     i.GetMinLoginNameLength(),
+				MinPasswordLength:      i.GetMinPasswordLength(),
+				RequireUppercase:       i.GetRequireUppercase(),
+				RequireLowercase:       i.GetRequireLowercase(),
+				RequireDigit:           i.GetRequireDigit(),
+				RequireSpecial:         i.GetRequireSpecial(),
+				DisallowLoginName:      i.GetDisallowLoginName(),
+				DisallowedWords:        words,
+				PasswordHistoryCount:   i.GetPasswordHistoryCount(),
+				MaxPasswordAgeSeconds:  i.GetMaxPasswordAgeSeconds(),
+				LockoutThreshold:       i.GetLockoutThreshold(),
+				LockoutDurationSeconds: i.GetLockoutDurationSeconds(),
+				MfaRequired:            i.GetMfaRequired(),
 			},
 		}
 	case *oidc.AuthMethod:
@@ -798,6 +1023,26 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		out.Attrs = &pb.AuthMethod_OidcAuthMethodsAttributes{
 			OidcAuthMethodsAttributes: attrs,
 		}
+	case *ldap.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		out.Attrs = &pb.AuthMethod_LdapAuthMethodsAttributes{
+			LdapAuthMethodsAttributes: toLdapAuthMethodAttributesProto(i),
+		}
+	case *jwt.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = jwt.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		out.Attrs = &pb.AuthMethod_JwtAuthMethodsAttributes{
+			JwtAuthMethodsAttributes: toJwtAuthMethodAttributesProto(i),
+		}
 	}
 	return &out, nil
 }
@@ -826,7 +1071,7 @@ func validateGetRequest(req *pbs.GetAuthMethodRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest) error {
@@ -843,9 +1088,11 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 		if req.GetItem().GetIsPrimary() {
 			badFields[isPrimaryField] = "This field is read only."
 		}
+		validateAuthTokenLifetime(req.GetItem(), nil, badFields)
 		switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
 		case password.Subtype:
 			// Password attributes are not required when creating a password auth method.
+			validatePasswordAttributes(req.GetItem().GetPasswordAuthMethodAttributes(), badFields)
 		case oidc.Subtype:
 			attrs := req.GetItem().GetOidcAuthMethodsAttributes()
 			if attrs == nil {
@@ -922,6 +1169,20 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 					}
 				}
 			}
+		case ldap.Subtype:
+			attrs := req.GetItem().GetLdapAuthMethodsAttributes()
+			if attrs == nil {
+				badFields[attributesField] = "Attributes are required for creating an LDAP auth method."
+			} else {
+				validateLdapAttributes(ctx, attrs, nil, badFields)
+			}
+		case jwt.Subtype:
+			attrs := req.GetItem().GetJwtAuthMethodsAttributes()
+			if attrs == nil {
+				badFields[attributesField] = "Attributes are required for creating a JWT auth method."
+			} else {
+				validateJwtAttributes(ctx, attrs, nil, badFields)
+			}
 		default:
 			badFields[typeField] = fmt.Sprintf("This is a required field and must be %q.", password.Subtype.String())
 		}
@@ -939,11 +1200,13 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), isPrimaryField) {
 			badFields[isPrimaryField] = "This field is read only."
 		}
+		validateAuthTokenLifetime(req.GetItem(), req.GetUpdateMask().GetPaths(), badFields)
 		switch subtypes.SubtypeFromId(domain, req.GetId()) {
 		case password.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != password.Subtype {
 				badFields[typeField] = "Cannot modify the resource type."
 			}
+			validatePasswordAttributes(req.GetItem().GetPasswordAuthMethodAttributes(), badFields)
 		case oidc.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != oidc.Subtype {
 				badFields[typeField] = "Cannot modify the resource type."
@@ -1048,11 +1311,25 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 					}
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != ldap.Subtype {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if attrs := req.GetItem().GetLdapAuthMethodsAttributes(); attrs != nil {
+				validateLdapAttributes(ctx, attrs, req.GetUpdateMask().GetPaths(), badFields)
+			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != jwt.Subtype {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			if attrs := req.GetItem().GetJwtAuthMethodsAttributes(); attrs != nil {
+				validateJwtAttributes(ctx, attrs, req.GetUpdateMask().GetPaths(), badFields)
+			}
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
 		}
 		return badFields
-	}, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	}, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
@@ -1060,7 +1337,7 @@ func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix)
 }
 
 func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
@@ -1124,7 +1401,7 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	} else {
 		st := subtypes.SubtypeFromId(domain, req.GetAuthMethodId())
 		switch st {
-		case password.Subtype, oidc.Subtype:
+		case password.Subtype, oidc.Subtype, ldap.Subtype, jwt.Subtype:
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type."
 		}
@@ -1235,6 +1512,22 @@ func transformAuthenticateRequestAttributes(msg proto.Message) error {
 		authRequest.Attrs = &pbs.AuthenticateRequest_PasswordLoginAttributes{
 			PasswordLoginAttributes: newAttrs,
 		}
+	case ldap.Subtype:
+		newAttrs := &pbs.LdapLoginAttributes{}
+		if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+			return err
+		}
+		authRequest.Attrs = &pbs.AuthenticateRequest_LdapLoginAttributes{
+			LdapLoginAttributes: newAttrs,
+		}
+	case jwt.Subtype:
+		newAttrs := &pbs.JwtLoginAttributes{}
+		if err := handlers.StructToProto(attrs, newAttrs); err != nil {
+			return err
+		}
+		authRequest.Attrs = &pbs.AuthenticateRequest_JwtLoginAttributes{
+			JwtLoginAttributes: newAttrs,
+		}
 	case oidc.Subtype:
 		switch authRequest.GetCommand() {
 		case startCommand:
@@ -1253,7 +1546,10 @@ func transformAuthenticateRequestAttributes(msg proto.Message) error {
 			authRequest.Attrs = &pbs.AuthenticateRequest_OidcAuthMethodAuthenticateCallbackRequest{
 				OidcAuthMethodAuthenticateCallbackRequest: newAttrs,
 			}
-		case tokenCommand:
+		case deviceStartCommand:
+			// device-start doesn't take any attributes
+			authRequest.Attrs = nil
+		case tokenCommand, deviceTokenCommand:
 			newAttrs := &pb.OidcAuthMethodAuthenticateTokenRequest{}
 			if err := handlers.StructToProto(attrs, newAttrs); err != nil {
 				return err
@@ -1308,6 +1604,11 @@ func transformAuthenticateResponseAttributes(msg proto.Message) error {
 		if err != nil {
 			return err
 		}
+	case *pbs.AuthenticateResponse_OidcAuthMethodAuthenticateDeviceStartResponse:
+		newAttrs, err = handlers.ProtoToStruct(attrs.OidcAuthMethodAuthenticateDeviceStartResponse)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%s: unknown attributes type %T", op, attrs)
 	}
@@ -1316,3 +1617,26 @@ func transformAuthenticateResponseAttributes(msg proto.Message) error {
 	}
 	return nil
 }
+
+// validateAuthTokenLifetime validates the auth token lifetime fields of the
+// auth method. If a mask is provided only the fields it contains are validated.
+func validateAuthTokenLifetime(item *pb.AuthMethod, mask []string, badFields map[string]string) {
+	ttl, stale := item.GetAuthTokenTimeToLiveSeconds(), item.GetAuthTokenTimeToStaleSeconds()
+	if mask != nil {
+		if !handlers.MaskContains(mask, authTokenTimeToLiveSecondsField) {
+			ttl = nil
+		}
+		if !handlers.MaskContains(mask, authTokenTimeToStaleSecondsField) {
+			stale = nil
+		}
+	}
+	if ttl != nil && ttl.GetValue() == 0 {
+		badFields[authTokenTimeToLiveSecondsField] = "This field must be greater than 0. To use the controller's default, unset it."
+	}
+	if stale != nil && stale.GetValue() == 0 {
+		badFields[authTokenTimeToStaleSecondsField] = "This field must be greater than 0. To use the controller's default, unset it."
+	}
+	if ttl.GetValue() > 0 && stale.GetValue() > ttl.GetValue() {
+		badFields[authTokenTimeToStaleSecondsField] = fmt.Sprintf("This field cannot exceed %s.", authTokenTimeToLiveSecondsField)
+	}
+}
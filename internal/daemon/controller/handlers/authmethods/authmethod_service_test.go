@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -83,6 +86,12 @@ func TestGet(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -194,7 +203,7 @@ func TestGet(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.GetAuthMethod(requestauth.DisabledAuthTestContext(iamRepoFn, tc.scopeId), tc.req)
@@ -224,6 +233,12 @@ func TestList(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -365,7 +380,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			// First check with non-anonymous user
@@ -410,6 +425,12 @@ func TestDelete(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -426,7 +447,7 @@ func TestDelete(t *testing.T) {
 	oidcam := oidc.TestAuthMethod(t, conn, databaseWrapper, o.GetPublicId(), oidc.InactiveState, "alice_rp", "my-dogs-name",
 		oidc.WithIssuer(oidc.TestConvertToUrls(t, "https://alice.com")[0]), oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://api.com")[0]))
 
-	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	cases := []struct {
@@ -490,6 +511,12 @@ func TestDelete_twice(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
@@ -501,7 +528,7 @@ func TestDelete_twice(t *testing.T) {
 	o, _ := iam.TestScopes(t, iamRepo)
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 
-	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(err, "Error when getting new auth_method service.")
 
 	req := &pbs.DeleteAuthMethodRequest{
@@ -529,6 +556,12 @@ func TestCreate(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -956,7 +989,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err, "Error when getting new auth_method service.")
 
 			got, gErr := s.CreateAuthMethod(requestauth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
@@ -1004,3 +1037,122 @@ func TestCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthTokenLifetime(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+	authCtx := requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	t.Run("create-get-update", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		created, err := s.CreateAuthMethod(authCtx, &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+			ScopeId:                     o.GetPublicId(),
+			Type:                        "password",
+			AuthTokenTimeToLiveSeconds:  wrapperspb.UInt32(3600),
+			AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(600),
+		}})
+		require.NoError(err)
+		assert.Equal(uint32(3600), created.GetItem().GetAuthTokenTimeToLiveSeconds().GetValue())
+		assert.Equal(uint32(600), created.GetItem().GetAuthTokenTimeToStaleSeconds().GetValue())
+
+		got, err := s.GetAuthMethod(authCtx, &pbs.GetAuthMethodRequest{Id: created.GetItem().GetId()})
+		require.NoError(err)
+		assert.Equal(uint32(3600), got.GetItem().GetAuthTokenTimeToLiveSeconds().GetValue())
+		assert.Equal(uint32(600), got.GetItem().GetAuthTokenTimeToStaleSeconds().GetValue())
+
+		// Updating only the lifetime bumps the version of the auth method.
+		updated, err := s.UpdateAuthMethod(authCtx, &pbs.UpdateAuthMethodRequest{
+			Id:         created.GetItem().GetId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"auth_token_time_to_stale_seconds"}},
+			Item: &pb.AuthMethod{
+				Version:                     created.GetItem().GetVersion(),
+				AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(60),
+			},
+		})
+		require.NoError(err)
+		assert.Equal(created.GetItem().GetVersion()+1, updated.GetItem().GetVersion())
+		assert.Equal(uint32(3600), updated.GetItem().GetAuthTokenTimeToLiveSeconds().GetValue())
+		assert.Equal(uint32(60), updated.GetItem().GetAuthTokenTimeToStaleSeconds().GetValue())
+
+		// Clearing the time-to-live along with a regular field.
+		updated, err = s.UpdateAuthMethod(authCtx, &pbs.UpdateAuthMethodRequest{
+			Id:         created.GetItem().GetId(),
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "auth_token_time_to_live_seconds"}},
+			Item: &pb.AuthMethod{
+				Version: updated.GetItem().GetVersion(),
+				Name:    wrapperspb.String("updated"),
+			},
+		})
+		require.NoError(err)
+		assert.Equal(created.GetItem().GetVersion()+2, updated.GetItem().GetVersion())
+		assert.Equal("updated", updated.GetItem().GetName().GetValue())
+		assert.Nil(updated.GetItem().GetAuthTokenTimeToLiveSeconds())
+		assert.Equal(uint32(60), updated.GetItem().GetAuthTokenTimeToStaleSeconds().GetValue())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			name string
+			item *pb.AuthMethod
+		}{
+			{
+				name: "zero-ttl",
+				item: &pb.AuthMethod{
+					ScopeId:                    o.GetPublicId(),
+					Type:                       "password",
+					AuthTokenTimeToLiveSeconds: wrapperspb.UInt32(0),
+				},
+			},
+			{
+				name: "zero-stale",
+				item: &pb.AuthMethod{
+					ScopeId:                     o.GetPublicId(),
+					Type:                        "password",
+					AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(0),
+				},
+			},
+			{
+				name: "stale-exceeds-ttl",
+				item: &pb.AuthMethod{
+					ScopeId:                     o.GetPublicId(),
+					Type:                        "password",
+					AuthTokenTimeToLiveSeconds:  wrapperspb.UInt32(60),
+					AuthTokenTimeToStaleSeconds: wrapperspb.UInt32(3600),
+				},
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := s.CreateAuthMethod(authCtx, &pbs.CreateAuthMethodRequest{Item: tc.item})
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+			})
+		}
+	})
+}
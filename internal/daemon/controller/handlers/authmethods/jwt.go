@@ -0,0 +1,271 @@
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	jwtstore "github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	capjwt "github.com/hashicorp/cap/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// jwt field names
+	jwksUrlField     = "attributes.jwks_url"
+	jwksCaCertField  = "attributes.jwks_ca_cert"
+	publicKeysField  = "attributes.public_keys"
+	boundClaimsField = "attributes.bound_claims"
+	jwtTokenField    = "attributes.token"
+)
+
+var jwtMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if jwtMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&jwtstore.AuthMethod{}}, handlers.MaskSource{&pb.AuthMethod{}, &pb.JwtAuthMethodAttributes{}}); err != nil {
+		panic(err)
+	}
+
+	IdActions[jwt.Subtype] = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+	}
+}
+
+// createJwtInRepo creates a jwt auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createJwtInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	u, err := toStorageJwtAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateJwtInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	u, err := toStorageJwtAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := jwtMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) authenticateJwt(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetJwtLoginAttributes()
+	tok, err := s.authenticateWithJwtRepo(ctx, req.GetAuthMethodId(), reqAttrs.Token)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+func (s Service) authenticateWithJwtRepo(ctx context.Context, authMethodId, token string) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := jwtRepo.Authenticate(ctx, authMethodId, token)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+
+	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		tok,
+	)
+}
+
+func validateAuthenticateJwtRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	attrs := req.GetJwtLoginAttributes()
+	switch {
+	case attrs == nil:
+		badFields["attributes"] = "This is a required field."
+	default:
+		if attrs.Token == "" {
+			badFields[jwtTokenField] = "This is a required field."
+		}
+		if req.GetCommand() == "" {
+			req.Command = loginCommand
+		}
+		if req.Command != loginCommand {
+			badFields[commandField] = "Invalid command for this auth method type."
+		}
+		tType := strings.ToLower(strings.TrimSpace(req.GetType()))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+// validateJwtAttributes adds the invalid jwt attributes to badFields. When
+// mask is nil, the attributes are validated for creating an auth method and
+// otherwise only the fields in the mask are validated.
+func validateJwtAttributes(ctx context.Context, attrs *pb.JwtAuthMethodAttributes, mask []string, badFields map[string]string) {
+	inMask := func(f string) bool {
+		return mask == nil || handlers.MaskContains(mask, f)
+	}
+	if mask == nil {
+		switch {
+		case attrs.GetJwksUrl().GetValue() == "" && len(attrs.GetPublicKeys()) == 0:
+			badFields[jwksUrlField] = "Either a JWKS URL or public keys are required."
+		case attrs.GetJwksUrl().GetValue() != "" && len(attrs.GetPublicKeys()) > 0:
+			badFields[publicKeysField] = "Public keys cannot be set along with a JWKS URL."
+		}
+		if attrs.GetJwksCaCert().GetValue() != "" && attrs.GetJwksUrl().GetValue() == "" {
+			badFields[jwksCaCertField] = "This field requires a JWKS URL."
+		}
+	}
+	if inMask(jwksUrlField) && attrs.GetJwksUrl().GetValue() != "" {
+		if pu, err := url.Parse(attrs.GetJwksUrl().GetValue()); err != nil || (pu.Scheme != "http" && pu.Scheme != "https") || pu.Host == "" {
+			badFields[jwksUrlField] = fmt.Sprintf("%q is not a valid http or https url.", attrs.GetJwksUrl().GetValue())
+		}
+	}
+	for _, k := range attrs.GetPublicKeys() {
+		if _, err := capjwt.ParsePublicKeyPEM([]byte(k)); err != nil {
+			badFields[publicKeysField] = fmt.Sprintf("Cannot parse public key. %v", err.Error())
+			break
+		}
+	}
+	for _, sa := range attrs.GetSigningAlgorithms() {
+		if err := capjwt.SupportedSigningAlgorithm(capjwt.Alg(sa)); err != nil {
+			badFields[signingAlgorithmField] = fmt.Sprintf("Contains unsupported algorithm %q", sa)
+			break
+		}
+	}
+	if _, err := jwt.ParseBoundClaims(ctx, attrs.GetBoundClaims()...); err != nil {
+		badFields[boundClaimsField] = "Bound claims must be in the format of claim=value."
+	}
+	if len(attrs.GetAccountClaimMaps()) > 0 {
+		acms, err := oidc.ParseAccountClaimMaps(ctx, attrs.GetAccountClaimMaps()...)
+		if err != nil {
+			badFields[accountClaimMapsField] = fmt.Sprintf("Contains invalid map %q", err.Error())
+		}
+		for _, m := range acms {
+			if _, err := oidc.ConvertToAccountToClaim(ctx, m.To); err != nil {
+				badFields[accountClaimMapsField] = fmt.Sprintf("%q is not a valid to claim, it must be one of sub, name or email", m.To)
+				break
+			}
+		}
+	}
+}
+
+// toStorageJwtAuthMethod converts the api auth method to a storage auth
+// method. The result is not validated since an update request only contains
+// the fields being updated; the repository validates the auth method when it
+// is created or after an update is applied.
+func toStorageJwtAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*jwt.AuthMethod, error) {
+	const op = "authmethod_service.toStorageJwtAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetJwtAuthMethodsAttributes()
+	u := jwt.AllocAuthMethod()
+	u.ScopeId = scopeId
+	u.Name = in.GetName().GetValue()
+	u.Description = in.GetDescription().GetValue()
+	u.BoundIssuer = attrs.GetBoundIssuer().GetValue()
+	u.JwksUrl = attrs.GetJwksUrl().GetValue()
+	u.JwksCaCert = attrs.GetJwksCaCert().GetValue()
+	u.PublicKeys = attrs.GetPublicKeys()
+	u.BoundAudiences = attrs.GetBoundAudiences()
+	u.SigningAlgorithms = attrs.GetSigningAlgorithms()
+	u.BoundClaims = attrs.GetBoundClaims()
+	u.AccountClaimMaps = attrs.GetAccountClaimMaps()
+	u.AuthTokenTimeToLiveSeconds = in.GetAuthTokenTimeToLiveSeconds().GetValue()
+	u.AuthTokenTimeToStaleSeconds = in.GetAuthTokenTimeToStaleSeconds().GetValue()
+	return &u, nil
+}
+
+func toJwtAuthMethodAttributesProto(in *jwt.AuthMethod) *pb.JwtAuthMethodAttributes {
+	attrs := &pb.JwtAuthMethodAttributes{
+		PublicKeys:        in.GetPublicKeys(),
+		BoundAudiences:    in.GetBoundAudiences(),
+		SigningAlgorithms: in.GetSigningAlgorithms(),
+		BoundClaims:       in.GetBoundClaims(),
+		AccountClaimMaps:  in.GetAccountClaimMaps(),
+	}
+	for _, f := range []struct {
+		from string
+		to   **wrapperspb.StringValue
+	}{
+		{in.GetBoundIssuer(), &attrs.BoundIssuer},
+		{in.GetJwksUrl(), &attrs.JwksUrl},
+		{in.GetJwksCaCert(), &attrs.JwksCaCert},
+	} {
+		if f.from != "" {
+			*f.to = wrapperspb.String(f.from)
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,173 @@
+package authmethods_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authmethods"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	capoidc "github.com/hashicorp/cap/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAuthenticate_Jwt(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+
+	priv := jwt.TestGenerateKey(t)
+	jwks := jwt.TestJwksServer(t, &priv.PublicKey)
+	jwksUrl, err := url.Parse(jwks.URL)
+	require.NoError(t, err)
+	am := jwt.TestAuthMethod(t, conn, o.GetPublicId(),
+		jwt.WithJwksUrl(jwksUrl),
+		jwt.WithSigningAlgs("ES256"),
+		jwt.WithBoundAudiences("boundary"),
+	)
+	iam.TestSetPrimaryAuthMethod(t, iam.TestRepo(t, conn, wrapper), o, am.PublicId)
+
+	signToken := func(aud string) string {
+		now := time.Now()
+		return capoidc.TestSignJWT(t, priv, "ES256", map[string]interface{}{
+			"sub": testLoginName,
+			"aud": []string{aud},
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+		}, nil)
+	}
+
+	cases := []struct {
+		name            string
+		request         *pbs.AuthenticateRequest
+		wantType        string
+		wantErr         error
+		wantErrContains string
+	}{
+		{
+			name: "basic",
+			request: &pbs.AuthenticateRequest{
+				AuthMethodId: am.GetPublicId(),
+				TokenType:    "token",
+				Attrs: &pbs.AuthenticateRequest_JwtLoginAttributes{
+					JwtLoginAttributes: &pbs.JwtLoginAttributes{
+						Token: signToken("boundary"),
+					},
+				},
+			},
+			wantType: "token",
+		},
+		{
+			name: "cookie-type",
+			request: &pbs.AuthenticateRequest{
+				AuthMethodId: am.GetPublicId(),
+				TokenType:    "cookie",
+				Attrs: &pbs.AuthenticateRequest_JwtLoginAttributes{
+					JwtLoginAttributes: &pbs.JwtLoginAttributes{
+						Token: signToken("boundary"),
+					},
+				},
+			},
+			wantType: "cookie",
+		},
+		{
+			name: "wrong-audience",
+			request: &pbs.AuthenticateRequest{
+				AuthMethodId: am.GetPublicId(),
+				TokenType:    "token",
+				Attrs: &pbs.AuthenticateRequest_JwtLoginAttributes{
+					JwtLoginAttributes: &pbs.JwtLoginAttributes{
+						Token: signToken("vault"),
+					},
+				},
+			},
+			wantErr: handlers.ApiErrorWithCode(codes.Unauthenticated),
+		},
+		{
+			name: "not-a-jwt",
+			request: &pbs.AuthenticateRequest{
+				AuthMethodId: am.GetPublicId(),
+				TokenType:    "token",
+				Attrs: &pbs.AuthenticateRequest_JwtLoginAttributes{
+					JwtLoginAttributes: &pbs.JwtLoginAttributes{
+						Token: "not-a-jwt",
+					},
+				},
+			},
+			wantErr: handlers.ApiErrorWithCode(codes.Unauthenticated),
+		},
+		{
+			name: "no-attributes",
+			request: &pbs.AuthenticateRequest{
+				AuthMethodId: am.GetPublicId(),
+				TokenType:    "token",
+				Attrs:        &pbs.AuthenticateRequest_JwtLoginAttributes{},
+			},
+			wantErr:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			wantErrContains: `Details: {{name: "attributes", desc: "This is a required field."}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
+			require.NoError(err)
+
+			resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.request)
+			if tc.wantErr != nil {
+				assert.Error(err)
+				assert.Truef(errors.Is(err, tc.wantErr), "Got %#v, wanted %#v", err, tc.wantErr)
+				if tc.wantErrContains != "" {
+					assert.Contains(err.Error(), tc.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+
+			aToken := resp.GetAuthTokenResponse()
+			assert.NotEmpty(aToken.GetId())
+			assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
+			assert.Equal(am.GetPublicId(), aToken.GetAuthMethodId())
+			assert.True(strings.HasPrefix(aToken.GetAccountId(), jwt.AccountPrefix))
+			assert.Equal(tc.wantType, resp.GetType())
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package authmethods
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// ldap field names
+	urlsField             = "attributes.urls"
+	certificatesField     = "attributes.certificates"
+	groupDnField          = "attributes.group_dn"
+	bindDnField           = "attributes.bind_dn"
+	bindPasswordHmacField = "attributes.bind_password_hmac"
+)
+
+var ldapMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.AuthMethod{}}, handlers.MaskSource{&pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}}); err != nil {
+		panic(err)
+	}
+
+	IdActions[ldap.Subtype] = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+	}
+}
+
+// createLdapInRepo creates an ldap auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+
+	version := item.GetVersion()
+	u.PublicId = id
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetLdapLoginAttributes()
+	tok, err := s.authenticateWithLdapRepo(ctx, req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+func (s Service) authenticateWithLdapRepo(ctx context.Context, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := ldapRepo.Authenticate(ctx, authMethodId, loginName, pw)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+
+	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		tok,
+	)
+}
+
+func validateAuthenticateLdapRequest(req *pbs.AuthenticateRequest) error {
+	badFields := make(map[string]string)
+
+	attrs := req.GetLdapLoginAttributes()
+	switch {
+	case attrs == nil:
+		badFields["attributes"] = "This is a required field."
+	default:
+		if attrs.LoginName == "" {
+			badFields["attributes.login_name"] = "This is a required field."
+		}
+		if attrs.Password == "" {
+			badFields["attributes.password"] = "This is a required field."
+		}
+		if req.GetCommand() == "" {
+			req.Command = loginCommand
+		}
+		if req.Command != loginCommand {
+			badFields[commandField] = "Invalid command for this auth method type."
+		}
+		tType := strings.ToLower(strings.TrimSpace(req.GetType()))
+		if tType != "" && tType != "token" && tType != "cookie" {
+			badFields[tokenTypeField] = `The only accepted types are "token" and "cookie".`
+		}
+	}
+
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+// validateLdapAttributes adds the invalid ldap attributes to badFields. When
+// mask is nil, the attributes are validated for creating an auth method and
+// otherwise only the fields in the mask are validated.
+func validateLdapAttributes(ctx context.Context, attrs *pb.LdapAuthMethodAttributes, mask []string, badFields map[string]string) {
+	inMask := func(f string) bool {
+		return mask == nil || handlers.MaskContains(mask, f)
+	}
+	if inMask(urlsField) {
+		if len(attrs.GetUrls()) == 0 {
+			badFields[urlsField] = "At least one URL is required."
+		}
+		for _, u := range attrs.GetUrls() {
+			if pu, err := url.Parse(u); err != nil || (pu.Scheme != "ldap" && pu.Scheme != "ldaps") || pu.Host == "" {
+				badFields[urlsField] = fmt.Sprintf("%q is not a valid ldap or ldaps url.", u)
+				break
+			}
+		}
+	}
+	if len(attrs.GetCertificates()) > 0 {
+		if _, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...); err != nil {
+			badFields[certificatesField] = fmt.Sprintf("Cannot parse CA certificates. %v", err.Error())
+		}
+	}
+	if mask == nil && attrs.GetEnableGroups() && attrs.GetGroupDn().GetValue() == "" {
+		badFields[groupDnField] = "This field is required when groups are enabled."
+	}
+	if mask == nil && attrs.GetBindPassword().GetValue() != "" && attrs.GetBindDn().GetValue() == "" {
+		badFields[bindDnField] = "This field is required when a bind password is provided."
+	}
+	if attrs.GetBindPasswordHmac() != "" {
+		badFields[bindPasswordHmacField] = "Field is read only."
+	}
+}
+
+// toStorageLdapAuthMethod converts the api auth method to a storage auth
+// method. The result is not validated since an update request only contains
+// the fields being updated; the repository validates the auth method when it
+// is created or after an update is applied.
+func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	const op = "authmethod_service.toStorageLdapAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := in.GetLdapAuthMethodsAttributes()
+	u := ldap.AllocAuthMethod()
+	u.ScopeId = scopeId
+	u.Name = in.GetName().GetValue()
+	u.Description = in.GetDescription().GetValue()
+	u.StartTls = attrs.GetStartTls()
+	u.InsecureTls = attrs.GetInsecureTls()
+	u.DiscoverDn = attrs.GetDiscoverDn()
+	u.AnonGroupSearch = attrs.GetAnonGroupSearch()
+	u.UpnDomain = attrs.GetUpnDomain().GetValue()
+	u.Urls = attrs.GetUrls()
+	u.UserDn = attrs.GetUserDn().GetValue()
+	u.UserAttr = attrs.GetUserAttr().GetValue()
+	u.UserFilter = attrs.GetUserFilter().GetValue()
+	u.EnableGroups = attrs.GetEnableGroups()
+	u.GroupDn = attrs.GetGroupDn().GetValue()
+	u.GroupAttr = attrs.GetGroupAttr().GetValue()
+	u.GroupFilter = attrs.GetGroupFilter().GetValue()
+	u.BindDn = attrs.GetBindDn().GetValue()
+	u.BindPassword = attrs.GetBindPassword().GetValue()
+	u.AuthTokenTimeToLiveSeconds = in.GetAuthTokenTimeToLiveSeconds().GetValue()
+	u.AuthTokenTimeToStaleSeconds = in.GetAuthTokenTimeToStaleSeconds().GetValue()
+	if len(attrs.GetCertificates()) > 0 {
+		certs, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if u.Certificates, err = ldap.EncodeCertificates(ctx, certs...); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return &u, nil
+}
+
+func toLdapAuthMethodAttributesProto(in *ldap.AuthMethod) *pb.LdapAuthMethodAttributes {
+	attrs := &pb.LdapAuthMethodAttributes{
+		StartTls:         in.GetStartTls(),
+		InsecureTls:      in.GetInsecureTls(),
+		DiscoverDn:       in.GetDiscoverDn(),
+		AnonGroupSearch:  in.GetAnonGroupSearch(),
+		Urls:             in.GetUrls(),
+		EnableGroups:     in.GetEnableGroups(),
+		Certificates:     in.GetCertificates(),
+		BindPasswordHmac: in.GetBindPasswordHmac(),
+	}
+	for _, f := range []struct {
+		from string
+		to   **wrapperspb.StringValue
+	}{
+		{in.GetUpnDomain(), &attrs.UpnDomain},
+		{in.GetUserDn(), &attrs.UserDn},
+		{in.GetUserAttr(), &attrs.UserAttr},
+		{in.GetUserFilter(), &attrs.UserFilter},
+		{in.GetGroupDn(), &attrs.GroupDn},
+		{in.GetGroupAttr(), &attrs.GroupAttr},
+		{in.GetGroupFilter(), &attrs.GroupFilter},
+		{in.GetBindDn(), &attrs.BindDn},
+	} {
+		if f.from != "" {
+			*f.to = wrapperspb.String(f.from)
+		}
+	}
+	return attrs
+}
@@ -21,13 +21,19 @@ import (
 
 const (
 	// commands
-	startCommand    = "start"
-	callbackCommand = "callback"
-	tokenCommand    = "token"
+	startCommand       = "start"
+	callbackCommand    = "callback"
+	tokenCommand       = "token"
+	deviceStartCommand = "device-start"
+	deviceTokenCommand = "device-token"
 
 	// token request/response fields
 	statusField = "status"
 
+	// token response statuses
+	unknownStatus  = "unknown"
+	slowDownStatus = "slow_down"
+
 	// field names
 	issuerField                            = "attributes.issuer"
 	clientSecretField                      = "attributes.client_secret"
@@ -156,6 +162,10 @@ func (s Service) authenticateOidc(ctx context.Context, req *pbs.AuthenticateRequ
 		return s.authenticateOidcCallback(ctx, req)
 	case tokenCommand:
 		return s.authenticateOidcToken(ctx, req, authResults)
+	case deviceStartCommand:
+		return s.authenticateOidcDeviceStart(ctx, req)
+	case deviceTokenCommand:
+		return s.authenticateOidcDeviceToken(ctx, req, authResults)
 	}
 
 	return &pbs.AuthenticateResponse{Command: req.GetCommand()}, nil
@@ -310,7 +320,98 @@ func (s Service) authenticateOidcToken(ctx context.Context, req *pbs.Authenticat
 			Command: req.Command,
 			Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateTokenResponse{
 				OidcAuthMethodAuthenticateTokenResponse: &pb.OidcAuthMethodAuthenticateTokenResponse{
-					Status: "unknown",
+					Status: unknownStatus,
+				},
+			},
+		}, nil
+	}
+
+	responseToken, err := s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		token,
+	)
+	if err != nil {
+		return nil, errors.New(ctx, errors.Internal, op, "Error converting response to proper format.", errors.WithWrap(err))
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, responseToken)
+}
+
+func (s Service) authenticateOidcDeviceStart(ctx context.Context, req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcDeviceStart"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+
+	da, err := oidc.StartDeviceAuth(ctx, s.oidcRepoFn, req.GetAuthMethodId())
+	if err != nil {
+		// this event.WriteError(...) may cause a dup error to be emitted...
+		// it should be removed if that's the case.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error starting the oidc device authorization flow"))
+		return nil, errors.New(ctx, errors.Internal, op, "Error starting the OIDC device authorization flow. See the controller's log for more information.")
+	}
+
+	return &pbs.AuthenticateResponse{
+		Command: req.GetCommand(),
+		Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateDeviceStartResponse{
+			OidcAuthMethodAuthenticateDeviceStartResponse: &pb.OidcAuthMethodAuthenticateDeviceStartResponse{
+				VerificationUri:         da.VerificationUri,
+				VerificationUriComplete: da.VerificationUriComplete,
+				UserCode:                da.UserCode,
+				Interval:                uint32(da.Interval.Seconds()),
+				ExpiresIn:               uint32(da.ExpiresIn.Seconds()),
+				TokenId:                 da.TokenId,
+			},
+		},
+	}, nil
+}
+
+func (s Service) authenticateOidcDeviceToken(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	const op = "authmethod_service.(Service).authenticateOidcDeviceToken"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request.")
+	}
+	if authResults == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil auth results.")
+	}
+	if req.GetOidcAuthMethodAuthenticateTokenRequest() == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Nil request attributes.")
+	}
+
+	attrs := req.GetOidcAuthMethodAuthenticateTokenRequest()
+	if attrs.TokenId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "Empty token ID in request attributes.")
+	}
+
+	token, slowDown, err := oidc.DeviceTokenRequest(
+		ctx,
+		s.oidcRepoFn,
+		oidc.IamRepoFactory(s.iamRepoFn),
+		s.atRepoFn,
+		req.GetAuthMethodId(),
+		attrs.TokenId)
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.Forbidden), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		case errors.Match(errors.T(errors.AuthAttemptExpired), err):
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Forbidden."))
+		default:
+			// this event.WriteError(...) may cause a dup error to be emitted...
+			// it should be removed if that's the case.
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error processing device token request"))
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Error processing device token request. See the controller's log for more information."))
+		}
+	}
+	if token == nil {
+		status := unknownStatus
+		if slowDown {
+			status = slowDownStatus
+		}
+		return &pbs.AuthenticateResponse{
+			Command: req.Command,
+			Attrs: &pbs.AuthenticateResponse_OidcAuthMethodAuthenticateTokenResponse{
+				OidcAuthMethodAuthenticateTokenResponse: &pb.OidcAuthMethodAuthenticateTokenResponse{
+					Status: status,
 				},
 			},
 		}, nil
@@ -371,7 +472,10 @@ func validateAuthenticateOidcRequest(req *pbs.AuthenticateRequest) error {
 			}
 		}
 
-	case tokenCommand:
+	case deviceStartCommand:
+		// device-start doesn't take any attributes
+
+	case tokenCommand, deviceTokenCommand:
 		tokenType := req.GetType()
 		if tokenType == "" {
 			// Fall back to deprecated field if type is not set
@@ -484,5 +588,7 @@ func toStorageOidcAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMet
 	if err != nil {
 		return nil, false, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method: %v.", err)
 	}
+	u.AuthTokenTimeToLiveSeconds = in.GetAuthTokenTimeToLiveSeconds().GetValue()
+	u.AuthTokenTimeToStaleSeconds = in.GetAuthTokenTimeToStaleSeconds().GetValue()
 	return u, attrs.GetDryRun(), attrs.GetDisableDiscoveredConfigValidation(), nil
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -54,6 +56,8 @@ type setup struct {
 	iamRepo                     *iam.Repository
 	iamRepoFn                   common.IamRepoFactory
 	oidcRepoFn                  common.OidcAuthRepoFactory
+	ldapRepoFn                  common.LdapAuthRepoFactory
+	jwtRepoFn                   common.JwtAuthRepoFactory
 	pwRepoFn                    common.PasswordAuthRepoFactory
 	atRepoFn                    common.AuthTokenRepoFactory
 	org                         *iam.Scope
@@ -88,6 +92,12 @@ func getSetup(t *testing.T) setup {
 	ret.oidcRepoFn = func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
 	}
+	ret.ldapRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
+	}
+	ret.jwtRepoFn = func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, ret.rw, ret.rw, ret.kmsCache)
+	}
 	ret.pwRepoFn = func() (*password.Repository, error) {
 		return password.NewRepository(ret.rw, ret.rw, ret.kmsCache)
 	}
@@ -99,7 +109,7 @@ func getSetup(t *testing.T) setup {
 	ret.databaseWrapper, err = ret.kmsCache.GetWrapper(ret.ctx, ret.org.PublicId, kms.KeyPurposeDatabase)
 	require.NoError(err)
 
-	ret.authMethodService, err = authmethods.NewService(ret.kmsCache, ret.pwRepoFn, ret.oidcRepoFn, ret.iamRepoFn, ret.atRepoFn)
+	ret.authMethodService, err = authmethods.NewService(ret.kmsCache, ret.pwRepoFn, ret.oidcRepoFn, ret.ldapRepoFn, ret.jwtRepoFn, ret.iamRepoFn, ret.atRepoFn)
 	require.NoError(err)
 
 	ret.testProvider = capoidc.StartTestProvider(t)
@@ -145,6 +155,12 @@ func TestList_FilterNonPublic(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -181,7 +197,7 @@ func TestList_FilterNonPublic(t *testing.T) {
 			oidc.WithIssuer(oidc.TestConvertToUrls(t, fmt.Sprintf("https://alice%d.com", i))[0]), oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://api.com")[0]))
 	}
 
-	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Couldn't create new auth_method service.")
 
 	req := &pbs.ListAuthMethodsRequest{
@@ -247,6 +263,12 @@ func TestUpdate_OIDC(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
@@ -256,7 +278,7 @@ func TestUpdate_OIDC(t *testing.T) {
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1055,6 +1077,12 @@ func TestUpdate_OIDCDryRun(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -1114,7 +1142,7 @@ func TestUpdate_OIDCDryRun(t *testing.T) {
 		},
 	}
 
-	tested, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	tested, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 	cases := []struct {
 		name    string
@@ -1231,6 +1259,12 @@ func TestChangeState_OIDC(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kmsCache)
 	}
@@ -1260,7 +1294,7 @@ func TestChangeState_OIDC(t *testing.T) {
 	mismatchedAM := oidc.TestAuthMethod(t, conn, databaseWrapper, o.PublicId, "inactive", "different_client_id", oidc.ClientSecret(tpClientSecret),
 		oidc.WithIssuer(oidc.TestConvertToUrls(t, tp.Addr())[0]), oidc.WithSigningAlgs(oidc.EdDSA), oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://example.callback:58")[0]), oidc.WithCertificates(tpCert...))
 
-	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, jwtRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	wantTemplate := &pb.AuthMethod{
@@ -1691,6 +1725,100 @@ func TestAuthenticate_OIDC_Token(t *testing.T) {
 	}
 }
 
+func TestAuthenticate_OIDC_Device(t *testing.T) {
+	s := getSetup(t)
+	s.testProvider.SetClientCreds("test-rp", "fido")
+	dp := oidc.StartTestDeviceProvider(t, s.testProvider)
+
+	testAuthMethod := oidc.TestAuthMethod(t, s.conn, s.databaseWrapper, s.org.PublicId, oidc.ActivePublicState,
+		"test-rp", "fido",
+		oidc.WithIssuer(oidc.TestConvertToUrls(t, dp.Addr())[0]),
+		oidc.WithApiUrl(oidc.TestConvertToUrls(t, s.testController.URL)[0]),
+		oidc.WithSigningAlgs(oidc.Alg(s.testProviderAlg)),
+		oidc.WithCertificates(dp.Certificate()),
+	)
+	// set this as the primary so users will be created on first login
+	iam.TestSetPrimaryAuthMethod(t, s.iamRepo, s.org, testAuthMethod.PublicId)
+
+	authenticate := func(req *pbs.AuthenticateRequest) (*pbs.AuthenticateResponse, error) {
+		return s.authMethodService.Authenticate(auth.DisabledAuthTestContext(s.iamRepoFn, s.org.GetPublicId()), req)
+	}
+	deviceToken := func(tokenId string) *pbs.AuthenticateRequest {
+		return &pbs.AuthenticateRequest{
+			Command:      "device-token",
+			AuthMethodId: testAuthMethod.GetPublicId(),
+			Attrs: &pbs.AuthenticateRequest_OidcAuthMethodAuthenticateTokenRequest{
+				OidcAuthMethodAuthenticateTokenRequest: &pb.OidcAuthMethodAuthenticateTokenRequest{
+					TokenId: tokenId,
+				},
+			},
+		}
+	}
+	deviceStart := func(t *testing.T) *pb.OidcAuthMethodAuthenticateDeviceStartResponse {
+		t.Helper()
+		dp.SetAuthorized(false)
+		dp.SetDenied(false)
+		dp.SetSlowDown(false)
+		got, err := authenticate(&pbs.AuthenticateRequest{
+			Command:      "device-start",
+			AuthMethodId: testAuthMethod.GetPublicId(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "device-start", got.GetCommand())
+		require.NotNil(t, got.GetOidcAuthMethodAuthenticateDeviceStartResponse())
+		return got.GetOidcAuthMethodAuthenticateDeviceStartResponse()
+	}
+
+	t.Run("start-unsupported-provider", func(t *testing.T) {
+		_, err := authenticate(&pbs.AuthenticateRequest{
+			Command:      "device-start",
+			AuthMethodId: s.authMethod.GetPublicId(),
+		})
+		require.Error(t, err)
+	})
+	t.Run("token-no-attributes", func(t *testing.T) {
+		_, err := authenticate(&pbs.AuthenticateRequest{
+			Command:      "device-token",
+			AuthMethodId: testAuthMethod.GetPublicId(),
+		})
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Got %#v, wanted %#v", err, errors.InvalidParameter)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		started := deviceStart(t)
+		assert.Equal(dp.UserCode(), started.GetUserCode())
+		assert.NotEmpty(started.GetVerificationUri())
+		assert.NotEmpty(started.GetVerificationUriComplete())
+		assert.Equal(uint32(1), started.GetInterval())
+		assert.Equal(uint32(300), started.GetExpiresIn())
+		require.NotEmpty(started.GetTokenId())
+
+		got, err := authenticate(deviceToken(started.GetTokenId()))
+		require.NoError(err)
+		assert.Equal("unknown", got.GetOidcAuthMethodAuthenticateTokenResponse().GetStatus())
+
+		dp.SetSlowDown(true)
+		got, err = authenticate(deviceToken(started.GetTokenId()))
+		require.NoError(err)
+		assert.Equal("slow_down", got.GetOidcAuthMethodAuthenticateTokenResponse().GetStatus())
+
+		dp.SetAuthorized(true)
+		got, err = authenticate(deviceToken(started.GetTokenId()))
+		require.NoError(err)
+		require.Equal("device-token", got.GetCommand())
+		require.NotNil(got.GetAuthTokenResponse())
+		assert.NotEmpty(got.GetAuthTokenResponse().GetToken())
+	})
+	t.Run("denied", func(t *testing.T) {
+		started := deviceStart(t)
+		dp.SetDenied(true)
+		_, err := authenticate(deviceToken(started.GetTokenId()))
+		require.Error(t, err)
+		assert.Truef(t, errors.Match(errors.T(errors.Forbidden), err), "Got %#v, wanted %#v", err, errors.Forbidden)
+	})
+}
+
 func TestAuthenticate_OIDC_Callback_ErrorRedirect(t *testing.T) {
 	s := getSetup(t)
 
@@ -19,9 +19,14 @@ import (
 
 const (
 	// password field names
-	loginNameField = "login_name"
-	passwordField  = "password"
-	loginCommand   = "login"
+	loginNameField            = "login_name"
+	passwordField             = "password"
+	loginCommand              = "login"
+	newPasswordField          = "attributes.new_password"
+	totpCodeField             = "attributes.totp_code"
+	disallowedWordsField      = "attributes.disallowed_words"
+	passwordHistoryCountField = "attributes.password_history_count"
+	maxPasswordHistoryCount   = 24
 )
 
 var pwMaskManager handlers.MaskManager
@@ -44,7 +49,7 @@ func init() {
 // createPwInRepo creates a password auth method in a repo and returns the result.
 // This method should never return a nil AuthMethod without returning an error.
 func (s Service) createPwInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*password.AuthMethod, error) {
-	u, err := toStoragePwAuthMethod(scopeId, item)
+	u, err := toStoragePwAuthMethod(ctx, scopeId, item)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +65,7 @@ func (s Service) createPwInRepo(ctx context.Context, scopeId string, item *pb.Au
 }
 
 func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*password.AuthMethod, error) {
-	u, err := toStoragePwAuthMethod(scopeId, item)
+	u, err := toStoragePwAuthMethod(ctx, scopeId, item)
 	if err != nil {
 		return nil, err
 	}
@@ -89,14 +94,14 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetPasswordLoginAttributes()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password)
+	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password, reqAttrs.NewPassword, reqAttrs.TotpCode)
 	if err != nil {
 		return nil, err
 	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw, newPw, totpCode string) (*pba.AuthToken, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
@@ -110,8 +115,39 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 		return nil, err
 	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+	var opts []password.Option
+	if newPw != "" {
+		opts = append(opts, password.WithNewPassword(newPw))
+	}
+	if totpCode != "" {
+		opts = append(opts, password.WithTotpCode(totpCode))
+	}
+	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw, opts...)
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.MfaCodeRequired), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{totpCodeField: "A TOTP code is required for this account."})
+		case errors.Match(errors.T(errors.MfaNotEnrolled), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Multi-factor authentication is required; the account must be enrolled in TOTP before authenticating.")
+		case errors.Match(errors.T(errors.PasswordExpired), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Password has expired; a new password must be provided in %q.", newPasswordField)
+		case errors.Match(errors.T(errors.PasswordsEqual), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{newPasswordField: "New password equal to current password."})
+		case errors.Match(errors.T(errors.PasswordTooShort), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{newPasswordField: "Password is too short."})
+		case errors.Match(errors.T(errors.PasswordTooWeak), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{newPasswordField: "Password does not contain the character classes required by the password policy."})
+		case errors.Match(errors.T(errors.PasswordDisallowed), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{newPasswordField: "Password contains a word disallowed by the password policy."})
+		case errors.Match(errors.T(errors.PasswordReused), err):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{newPasswordField: "Password matches a previous password."})
+		}
 		return nil, err
 	}
 	if acct == nil {
@@ -171,7 +207,7 @@ func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	return nil
 }
 
-func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthMethod, error) {
+func toStoragePwAuthMethod(ctx context.Context, scopeId string, item *pb.AuthMethod) (*password.AuthMethod, error) {
 	const op = "authmethod_service.toStoragePwAuthMethod"
 	if item == nil {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "nil auth method.")
@@ -195,5 +231,35 @@ func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthM
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.RequireUppercase = pwAttrs.GetRequireUppercase()
+	u.RequireLowercase = pwAttrs.GetRequireLowercase()
+	u.RequireDigit = pwAttrs.GetRequireDigit()
+	u.RequireSpecial = pwAttrs.GetRequireSpecial()
+	u.DisallowLoginName = pwAttrs.GetDisallowLoginName()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeSeconds = pwAttrs.GetMaxPasswordAgeSeconds()
+	u.LockoutThreshold = pwAttrs.GetLockoutThreshold()
+	u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
+	u.MfaRequired = pwAttrs.GetMfaRequired()
+	u.AuthTokenTimeToLiveSeconds = item.GetAuthTokenTimeToLiveSeconds().GetValue()
+	u.AuthTokenTimeToStaleSeconds = item.GetAuthTokenTimeToStaleSeconds().GetValue()
+	if err := u.SetDisallowedWords(ctx, pwAttrs.GetDisallowedWords()); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{disallowedWordsField: "Must not contain empty words."})
+	}
 	return u, nil
 }
+
+// validatePasswordAttributes adds the invalid password attributes to
+// badFields.
+func validatePasswordAttributes(attrs *pb.PasswordAuthMethodAttributes, badFields map[string]string) {
+	if attrs.GetPasswordHistoryCount() > maxPasswordHistoryCount {
+		badFields[passwordHistoryCountField] = fmt.Sprintf("Must not be greater than %d.", maxPasswordHistoryCount)
+	}
+	for _, w := range attrs.GetDisallowedWords() {
+		if strings.TrimSpace(w) == "" {
+			badFields[disallowedWordsField] = "Must not contain empty words."
+			break
+		}
+	}
+}
@@ -30,6 +30,8 @@ var (
 		action.ReadSelf,
 		action.Delete,
 		action.DeleteSelf,
+		action.Refresh,
+		action.RefreshSelf,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -226,6 +228,59 @@ func (s Service) DeleteAuthToken(ctx context.Context, req *pbs.DeleteAuthTokenRe
 	return nil, nil
 }
 
+// RefreshAuthToken implements the interface pbs.AuthTokenServiceServer.
+func (s Service) RefreshAuthToken(ctx context.Context, req *pbs.RefreshAuthTokenRequest) (*pbs.RefreshAuthTokenResponse, error) {
+	const op = "authtokens.(Service).RefreshAuthToken"
+
+	if err := validateRefreshRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RefreshSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	at, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	authorizedActions := authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions)
+
+	// Check to see if we need to verify Refresh vs. just RefreshSelf
+	if at.GetIamUserId() != authResults.UserId {
+		if !authorizedActions.HasAction(action.Refresh) {
+			return nil, handlers.ForbiddenError()
+		}
+	}
+
+	at, err = s.refreshInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, at, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RefreshAuthTokenResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*authtoken.AuthToken, error) {
 	const op = "authtokens.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -258,6 +313,22 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
+func (s Service) refreshInRepo(ctx context.Context, id string) (*authtoken.AuthToken, error) {
+	const op = "authtokens.(Service).refreshInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	at, err := repo.RefreshAuthToken(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to refresh auth token"))
+	}
+	if at == nil {
+		return nil, handlers.NotFoundErrorf("AuthToken %q doesn't exist or can no longer be refreshed.", id)
+	}
+	return at, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*authtoken.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
@@ -373,6 +444,10 @@ func validateDeleteRequest(req *pbs.DeleteAuthTokenRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, authtoken.AuthTokenPrefix)
 }
 
+func validateRefreshRequest(req *pbs.RefreshAuthTokenRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, authtoken.AuthTokenPrefix)
+}
+
 func validateListRequest(req *pbs.ListAuthTokensRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
@@ -594,3 +594,68 @@ func TestDelete_twice(t *testing.T) {
 	assert.Error(gErr, "Second attempt")
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected permission denied for the second delete.")
 }
+
+func TestRefresh(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
+	repoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	s, err := authtokens.NewService(repoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new auth token service.")
+
+	cases := []struct {
+		name  string
+		scope string
+		req   *pbs.RefreshAuthTokenRequest
+		err   error
+	}{
+		{
+			name:  "Refresh an existing token",
+			scope: org.GetPublicId(),
+			req: &pbs.RefreshAuthTokenRequest{
+				Id: at.GetPublicId(),
+			},
+		},
+		{
+			name:  "Refresh bad token id",
+			scope: org.GetPublicId(),
+			req: &pbs.RefreshAuthTokenRequest{
+				Id: authtoken.AuthTokenPrefix + "_doesntexis",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:  "Bad token id formatting",
+			scope: org.GetPublicId(),
+			req: &pbs.RefreshAuthTokenRequest{
+				Id: "bad_format",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RefreshAuthToken(auth.DisabledAuthTestContext(iamRepoFn, tc.scope), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RefreshAuthToken(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(at.GetPublicId(), got.GetItem().GetId())
+			assert.False(got.GetItem().GetExpirationTime().AsTime().Before(at.GetExpirationTime().AsTime()))
+		})
+	}
+}
@@ -180,6 +180,14 @@ func backendErrorToApiError(inErr error) *ApiError {
 		return NotFoundErrorf(genericNotFoundMsg)
 	case errors.Match(errors.T(errors.AccountAlreadyAssociated), inErr):
 		return InvalidArgumentErrorf(inErr.Error(), nil)
+	case errors.Match(errors.T(errors.Unauthorized), inErr):
+		return &ApiError{
+			Status: http.StatusUnauthorized,
+			Inner: &pb.Error{
+				Kind:    codes.Unauthenticated.String(),
+				Message: "Unable to authenticate.",
+			},
+		}
 	case errors.Match(errors.T(errors.InvalidFieldMask), inErr), errors.Match(errors.T(errors.EmptyFieldMask), inErr):
 		return InvalidArgumentErrorf("Error in provided request", map[string]string{"update_mask": "Invalid update mask provided."})
 	case errors.IsUniqueError(inErr):
@@ -194,6 +194,17 @@ func TestApiErrorHandler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Domain error unauthorized",
+			err:  errors.E(ctx, errors.WithCode(errors.Unauthorized), errors.WithMsg("test msg")),
+			expected: ApiError{
+				Status: http.StatusUnauthorized,
+				Inner: &pb.Error{
+					Kind:    "Unauthenticated",
+					Message: "Unable to authenticate.",
+				},
+			},
+		},
 		{
 			name: "Wrapped domain error",
 			err:  errors.E(ctx, errors.WithCode(errors.InvalidAddress), errors.WithMsg("test msg"), errors.WithWrap(errors.E(ctx, errors.WithCode(errors.NotNull), errors.WithMsg("inner msg")))),
@@ -6,6 +6,10 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	jwtstore "github.com/hashicorp/boundary/internal/auth/jwt/store"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	requestauth "github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -26,14 +30,19 @@ import (
 )
 
 const (
-	// oidc field names
+	// oidc and jwt field names
 	attrFilterField = "attributes.filter"
 
+	// ldap field names
+	attrGroupNamesField = "attributes.group_names"
+
 	domain = "auth"
 )
 
 var (
 	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
+	jwtMaskManager  handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -44,6 +53,18 @@ var (
 			action.Update,
 			action.Delete,
 		},
+		ldap.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
+		jwt.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -59,6 +80,12 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&oidcstore.ManagedGroup{}}, handlers.MaskSource{&pb.ManagedGroup{}, &pb.OidcManagedGroupAttributes{}}); err != nil {
 		panic(err)
 	}
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.ManagedGroup{}}, handlers.MaskSource{&pb.ManagedGroup{}, &pb.LdapManagedGroupAttributes{}}); err != nil {
+		panic(err)
+	}
+	if jwtMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&jwtstore.ManagedGroup{}}, handlers.MaskSource{&pb.ManagedGroup{}, &pb.JwtManagedGroupAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.ManagedGroupServiceServer interface.
@@ -66,17 +93,25 @@ type Service struct {
 	pbs.UnsafeManagedGroupServiceServer
 
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	jwtRepoFn  common.JwtAuthRepoFactory
 }
 
 var _ pbs.ManagedGroupServiceServer = (*Service)(nil)
 
 // NewService returns a managed group service which handles managed group related requests to boundary.
-func NewService(oidcRepo common.OidcAuthRepoFactory) (Service, error) {
+func NewService(oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, jwtRepo common.JwtAuthRepoFactory) (Service, error) {
 	const op = "managed_groups.NewService"
 	if oidcRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidc repository provided")
 	}
-	return Service{oidcRepoFn: oidcRepo}, nil
+	if ldapRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository provided")
+	}
+	if jwtRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing jwt repository provided")
+	}
+	return Service{oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, jwtRepoFn: jwtRepo}, nil
 }
 
 // ListManagedGroups implements the interface pbs.ManagedGroupsServiceServer.
@@ -305,6 +340,58 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.ManagedGroup,
 			}
 		}
 		out = mg
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		if mg == nil {
+			return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+		out = mg
+	case jwt.Subtype:
+		repo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+			}
+			return nil, nil, err
+		}
+		if mg == nil {
+			return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+		out = mg
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -343,6 +430,70 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetLdapManagedGroupAttributes()
+	mg, err := ldap.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetGroupNames(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) createJwtInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*jwt.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createJwtInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []jwt.Option
+	if item.GetName() != nil {
+		opts = append(opts, jwt.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, jwt.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetJwtManagedGroupAttributes()
+	mg, err := jwt.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetFilter(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).createInRepo"
 	if item == nil {
@@ -359,6 +510,24 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+		}
+		out = am
+	case jwt.Subtype:
+		am, err := s.createJwtInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -399,6 +568,80 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := ldap.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	// Set this regardless; it'll only take effect if the masks contain the value
+	if err := mg.SetGroupNames(ctx, item.GetLdapManagedGroupAttributes().GetGroupNames()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) updateJwtInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.ManagedGroup) (*jwt.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateJwtInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := jwt.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	// Set this regardless; it'll only take effect if the masks contain the value
+	mg.Filter = item.GetJwtManagedGroupAttributes().GetFilter()
+
+	version := item.GetVersion()
+
+	dbMask := jwtMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.jwtRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateManagedGroupRequest) (auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).updateInRepo"
 	var out auth.ManagedGroup
@@ -412,6 +655,24 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
 		}
 		out = mg
+	case ldap.Subtype:
+		mg, err := s.updateLdapInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
+	case jwt.Subtype:
+		mg, err := s.updateJwtInRepo(ctx, scopeId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
 	}
 	return out, nil
 }
@@ -427,6 +688,18 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
+	case jwt.Subtype:
+		repo, iErr := s.jwtRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -454,6 +727,30 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		for _, a := range oidcl {
 			outUl = append(outUl, a)
 		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ldapl, err := ldapRepo.ListManagedGroups(ctx, authMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range ldapl {
+			outUl = append(outUl, a)
+		}
+	case jwt.Subtype:
+		jwtRepo, err := s.jwtRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		jwtl, err := jwtRepo.ListManagedGroups(ctx, authMethodId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range jwtl {
+			outUl = append(outUl, a)
+		}
 	}
 	return outUl, nil
 }
@@ -466,6 +763,16 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
+	jwtRepo, err := s.jwtRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.ManagedGroup), requestauth.WithAction(a)}
@@ -485,6 +792,28 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case ldap.Subtype:
+			mg, err := ldapRepo.LookupManagedGroup(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if mg == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = mg.GetAuthMethodId()
+		case jwt.Subtype:
+			mg, err := jwtRepo.LookupManagedGroup(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if mg == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = mg.GetAuthMethodId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
 			return nil, res
@@ -506,6 +835,30 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		}
 		authMeth = am
 		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
+	case ldap.Subtype:
+		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
+	case jwt.Subtype:
+		am, err := jwtRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
 		return nil, res
@@ -566,6 +919,34 @@ func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option)
 		out.Attrs = &pb.ManagedGroup_OidcManagedGroupAttributes{
 			OidcManagedGroupAttributes: attrs,
 		}
+	case *ldap.ManagedGroup:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		names, err := i.GetGroupNamesSlice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out.Attrs = &pb.ManagedGroup_LdapManagedGroupAttributes{
+			LdapManagedGroupAttributes: &pb.LdapManagedGroupAttributes{
+				GroupNames: names,
+			},
+		}
+	case *jwt.ManagedGroup:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = jwt.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		out.Attrs = &pb.ManagedGroup_JwtManagedGroupAttributes{
+			JwtManagedGroupAttributes: &pb.JwtManagedGroupAttributes{
+				Filter: i.GetFilter(),
+			},
+		}
 	}
 	return &out, nil
 }
@@ -580,7 +961,7 @@ func validateGetRequest(req *pbs.GetManagedGroupRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateManagedGroupRequest) error {
@@ -610,6 +991,32 @@ func validateCreateRequest(req *pbs.CreateManagedGroupRequest) error {
 					}
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetLdapManagedGroupAttributes()
+			switch {
+			case attrs == nil:
+				badFields[globals.AttributesField] = "Attribute fields is required."
+			case len(attrs.GetGroupNames()) == 0:
+				badFields[attrGroupNamesField] = "This field is required."
+			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := req.GetItem().GetJwtManagedGroupAttributes()
+			switch {
+			case attrs == nil:
+				badFields[globals.AttributesField] = "Attribute fields is required."
+			case attrs.GetFilter() == "":
+				badFields[attrFilterField] = "This field is required."
+			default:
+				if _, err := bexpr.CreateEvaluator(attrs.GetFilter()); err != nil {
+					badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
+				}
+			}
 		default:
 			badFields[globals.AuthMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -644,11 +1051,36 @@ func validateUpdateRequest(req *pbs.UpdateManagedGroupRequest) error {
 					}
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrGroupNamesField) &&
+				len(req.GetItem().GetLdapManagedGroupAttributes().GetGroupNames()) == 0 {
+				badFields[attrGroupNamesField] = "Field cannot be empty."
+			}
+		case jwt.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != jwt.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrFilterField) {
+				attrs := req.GetItem().GetJwtManagedGroupAttributes()
+				switch {
+				case attrs == nil:
+					badFields["attributes"] = "Attributes field not supplied request"
+				case attrs.GetFilter() == "":
+					badFields[attrFilterField] = "Field cannot be empty."
+				default:
+					if _, err := bexpr.CreateEvaluator(attrs.GetFilter()); err != nil {
+						badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
+					}
+				}
+			}
 		default:
 			badFields[globals.IdField] = "Unrecognized resource type."
 		}
 		return badFields
-	}, intglobals.OidcManagedGroupPrefix)
+	}, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteManagedGroupRequest) error {
@@ -656,7 +1088,7 @@ func validateDeleteRequest(req *pbs.DeleteManagedGroupRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix)
 }
 
 func validateListRequest(req *pbs.ListManagedGroupsRequest) error {
@@ -665,7 +1097,7 @@ func validateListRequest(req *pbs.ListManagedGroupsRequest) error {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), oidc.AuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix) {
 		badFields[globals.AuthMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
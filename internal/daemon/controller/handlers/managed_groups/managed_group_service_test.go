@@ -11,6 +11,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -52,24 +54,48 @@ func TestNewService(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	cases := []struct {
 		name     string
 		oidcRepo common.OidcAuthRepoFactory
+		ldapRepo common.LdapAuthRepoFactory
+		jwtRepo  common.JwtAuthRepoFactory
 		wantErr  bool
 	}{
 		{
-			name:    "nil-oidc-repo",
-			wantErr: true,
+			name:     "nil-oidc-repo",
+			ldapRepo: ldapRepoFn,
+			jwtRepo:  jwtRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-ldap-repo",
+			oidcRepo: oidcRepoFn,
+			jwtRepo:  jwtRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-jwt-repo",
+			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			wantErr:  true,
 		},
 		{
 			name:     "success",
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			jwtRepo:  jwtRepoFn,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := managed_groups.NewService(tc.oidcRepo)
+			_, err := managed_groups.NewService(tc.oidcRepo, tc.ldapRepo, tc.jwtRepo)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -88,11 +114,17 @@ func TestGet(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(oidcRepoFn)
+	s, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Couldn't create new managed groups service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -193,6 +225,12 @@ func TestListOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -302,7 +340,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := managed_groups.NewService(oidcRepoFn)
+			s, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 			require.NoError(err, "Couldn't create new managed group service.")
 
 			got, gErr := s.ListManagedGroups(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -345,6 +383,12 @@ func TestDelete(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -362,7 +406,7 @@ func TestDelete(t *testing.T) {
 	)
 	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
 
-	s, err := managed_groups.NewService(oidcRepoFn)
+	s, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -417,6 +461,12 @@ func TestDelete_twice(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -435,7 +485,7 @@ func TestDelete_twice(t *testing.T) {
 	)
 	oidcMg := oidc.TestManagedGroup(t, conn, oidcAm, oidc.TestFakeManagedGroupFilter)
 
-	s, err := managed_groups.NewService(oidcRepoFn)
+	s, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteManagedGroupRequest{
 		Id: oidcMg.GetPublicId(),
@@ -456,11 +506,17 @@ func TestCreateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := managed_groups.NewService(oidcRepoFn)
+	s, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new managed group service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -658,6 +714,12 @@ func TestUpdateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	jwtRepoFn := func() (*jwt.Repository, error) {
+		return jwt.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -673,7 +735,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := managed_groups.NewService(oidcRepoFn)
+	tested, err := managed_groups.NewService(oidcRepoFn, ldapRepoFn, jwtRepoFn)
 	require.NoError(t, err, "Error when getting new managed_groups service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/stretchr/testify/assert"
@@ -86,4 +87,22 @@ func Test_GetOpts(t *testing.T) {
 		opts = GetOpts(WithHostSetIds(out))
 		require.Equal(out, opts.WithHostSetIds)
 	})
+	t.Run("WithAuthTokenTimeToLive", func(t *testing.T) {
+		assert := assert.New(t)
+
+		opts := GetOpts()
+		assert.Zero(opts.WithAuthTokenTimeToLive)
+
+		opts = GetOpts(WithAuthTokenTimeToLive(time.Hour))
+		assert.Equal(time.Hour, opts.WithAuthTokenTimeToLive)
+	})
+	t.Run("WithAuthTokenTimeToStale", func(t *testing.T) {
+		assert := assert.New(t)
+
+		opts := GetOpts()
+		assert.Zero(opts.WithAuthTokenTimeToStale)
+
+		opts = GetOpts(WithAuthTokenTimeToStale(time.Minute))
+		assert.Equal(time.Minute, opts.WithAuthTokenTimeToStale)
+	})
 }
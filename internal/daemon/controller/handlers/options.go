@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"time"
+
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
@@ -33,6 +35,8 @@ type options struct {
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithAuthTokenTimeToLive         time.Duration
+	WithAuthTokenTimeToStale        time.Duration
 }
 
 func getDefaultOptions() options {
@@ -119,3 +123,19 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithAuthTokenTimeToLive provides an option when creating responses to
+// include the given auth token time-to-live of an auth method if allowed
+func WithAuthTokenTimeToLive(d time.Duration) Option {
+	return func(o *options) {
+		o.WithAuthTokenTimeToLive = d
+	}
+}
+
+// WithAuthTokenTimeToStale provides an option when creating responses to
+// include the given auth token staleness duration of an auth method if allowed
+func WithAuthTokenTimeToStale(d time.Duration) Option {
+	return func(o *options) {
+		o.WithAuthTokenTimeToStale = d
+	}
+}
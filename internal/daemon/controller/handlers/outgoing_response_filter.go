@@ -72,11 +72,10 @@ func OutgoingResponseFilter(ctx context.Context, w http.ResponseWriter, m proto.
 			return nil
 		}
 		fields := m.GetAttributes().GetFields()
-		if m.GetCommand() == "token" {
+		if m.GetCommand() == "token" || m.GetCommand() == "device-token" {
 			if _, ok := fields[statusField]; ok {
-				// For now at least status will never be anything useful so
-				// don't need to check on it; the mere presence is enough to
-				// know what to do
+				// The status tells the client how to keep polling, but the
+				// mere presence is enough to know no token is available yet
 				w.WriteHeader(http.StatusAccepted)
 				return nil
 			}
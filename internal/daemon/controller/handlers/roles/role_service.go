@@ -3,6 +3,8 @@ package roles
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -48,6 +50,7 @@ var (
 	CollectionActions = action.ActionSet{
 		action.Create,
 		action.List,
+		action.Explain,
 	}
 )
 
@@ -292,7 +295,7 @@ func (s Service) AddRolePrincipals(ctx context.Context, req *pbs.AddRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), req.GetExpiresInSeconds())
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +333,7 @@ func (s Service) SetRolePrincipals(ctx context.Context, req *pbs.SetRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), req.GetExpiresInSeconds())
 	if err != nil {
 		return nil, err
 	}
@@ -509,6 +512,22 @@ func (s Service) RemoveRoleGrants(ctx context.Context, req *pbs.RemoveRoleGrants
 	return &pbs.RemoveRoleGrantsResponse{Item: item}, nil
 }
 
+// ExplainGrants implements the interface pbs.RoleServiceServer.
+func (s Service) ExplainGrants(ctx context.Context, req *pbs.ExplainGrantsRequest) (*pbs.ExplainGrantsResponse, error) {
+	if err := validateExplainGrantsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.Explain)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	item, err := s.explainInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ExplainGrantsResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -622,13 +641,13 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Ro
 	return rl, nil
 }
 
-func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version, expiresInSeconds uint32) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).addPrincpleInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), principalRoleOpts(expiresInSeconds)...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add principals to role: %v.", err)
@@ -643,13 +662,13 @@ func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, princip
 	return out, pr, roleGrants, nil
 }
 
-func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version, expiresInSeconds uint32) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).setPrinciplesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), principalRoleOpts(expiresInSeconds)...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set principals on role: %v.", err)
@@ -664,6 +683,16 @@ func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, princip
 	return out, pr, roleGrants, nil
 }
 
+// principalRoleOpts returns the options used when adding principals to a role.
+// A non zero expiresInSeconds sets when the assignment of the principals
+// expires.
+func principalRoleOpts(expiresInSeconds uint32) []iam.Option {
+	if expiresInSeconds == 0 {
+		return nil
+	}
+	return []iam.Option{iam.WithExpirationTime(time.Now().Add(time.Duration(expiresInSeconds) * time.Second))}
+}
+
 func (s Service) removePrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).removePrinciplesInRepo"
 	repo, err := s.repoFn()
@@ -752,6 +781,118 @@ func (s Service) removeGrantsInRepo(ctx context.Context, roleId string, grants [
 	return out, pr, roleGrants, nil
 }
 
+// explainInRepo evaluates the action on the resource described in the request
+// against either the grants of the requested user or the hypothetical grants in
+// the request.
+func (s Service) explainInRepo(ctx context.Context, req *pbs.ExplainGrantsRequest) (*pb.Explanation, error) {
+	const op = "roles.(Service).explainInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var grants []perms.Grant
+	var aclOpts []perms.Option
+	switch userId := req.GetUserId(); userId {
+	case "":
+		for _, g := range req.GetGrantStrings() {
+			parsed, err := perms.Parse(req.GetScopeId(), g)
+			if err != nil {
+				return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{"grant_strings": fmt.Sprintf("Improperly formatted grant %q.", g)})
+			}
+			grants = append(grants, parsed)
+		}
+		// Hypothetical grants don't belong to any user, so they are evaluated
+		// as if an authenticated user held them.
+		aclOpts = append(aclOpts, perms.WithSkipAnonymousUserRestrictions(true))
+	default:
+		u, accountIds, err := repo.LookupUser(ctx, userId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if u == nil {
+			return nil, handlers.NotFoundErrorf("User %q doesn't exist.", userId)
+		}
+		grantTuples, err := repo.GrantsForUser(ctx, userId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		// As when verifying requests, templates are expanded with the user's
+		// ids and validation is skipped so that formats that have since been
+		// restricted simply have no effect. The account the user authenticates
+		// with isn't known here, so a grant templated with the account id is
+		// expanded once for each of the user's accounts.
+		for _, t := range grantTuples {
+			templateAccountIds := []string{""}
+			if len(accountIds) > 0 && strings.Contains(t.Grant, "account.id") {
+				templateAccountIds = accountIds
+			}
+			for _, accountId := range templateAccountIds {
+				parsed, err := perms.Parse(
+					t.ScopeId,
+					t.Grant,
+					perms.WithUserId(userId),
+					perms.WithAccountId(accountId),
+					perms.WithRoleId(t.RoleId),
+					perms.WithSkipFinalValidation(true))
+				if err != nil {
+					return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", t.Grant)))
+				}
+				grants = append(grants, parsed)
+			}
+		}
+	}
+
+	res := perms.Resource{
+		ScopeId: req.GetScopeId(),
+		Id:      req.GetResourceId(),
+		Type:    resource.Map[req.GetResourceType()],
+		Pin:     req.GetPinId(),
+	}
+	if id := req.GetResourceId(); id != "" {
+		// The scope, type and pin in the request must match those of the
+		// resource, so the grants on a resource outside of the scope the
+		// request was authorized in cannot be explained.
+		ri, err := repo.LookupResource(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if ri == nil || ri.ScopeId != req.GetScopeId() {
+			return nil, handlers.NotFoundErrorf("Resource %q doesn't exist in scope %q.", id, req.GetScopeId())
+		}
+		badFields := map[string]string{}
+		if ri.Type != res.Type {
+			badFields["resource_type"] = fmt.Sprintf("Does not match the type of the resource, %q.", ri.Type.String())
+		}
+		if req.GetPinId() != "" && ri.PinId != req.GetPinId() {
+			badFields["pin_id"] = "Does not match the resource containing the resource."
+		}
+		if len(badFields) > 0 {
+			return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+		}
+		res.Pin = ri.PinId
+	}
+	results, explanations := perms.NewACL(grants...).Explain(res, action.Map[req.GetAction()], req.GetUserId(), aclOpts...)
+
+	out := &pb.Explanation{
+		ScopeId:      req.GetScopeId(),
+		UserId:       req.GetUserId(),
+		ResourceId:   req.GetResourceId(),
+		ResourceType: req.GetResourceType(),
+		Action:       req.GetAction(),
+		Authorized:   results.Authorized,
+	}
+	for _, e := range explanations {
+		out.Grants = append(out.Grants, &pb.GrantExplanation{
+			RoleId:     e.Grant.RoleId(),
+			Grant:      e.Grant.CanonicalString(),
+			Authorized: e.Authorized,
+			Denied:     e.Denied,
+		})
+	}
+	return out, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -763,7 +904,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Role), auth.WithAction(a)}
 	switch a {
-	case action.List, action.Create:
+	case action.List, action.Create, action.Explain:
 		parentId = id
 		scp, err := repo.LookupScope(ctx, parentId)
 		if err != nil {
@@ -841,6 +982,9 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 				Type:    p.GetType(),
 				ScopeId: p.GetPrincipalScopeId(),
 			}
+			if p.GetExpirationTime() != nil {
+				principal.ExpirationTime = p.GetExpirationTime().GetTimestamp()
+			}
 			out.Principals = append(out.Principals, principal)
 		}
 	}
@@ -869,6 +1013,7 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 						Id:      parsed.Id(),
 						Type:    parsed.Type().String(),
 						Actions: actions,
+						Deny:    parsed.Deny(),
 					},
 				})
 			}
@@ -971,8 +1116,9 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
-			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), iam.ServiceAccountPrefix) {
+			badFields["principal_ids"] = "Must only have valid user, group, managed group, and/or service account ids."
 			break
 		}
 		if id == "u_recovery" {
@@ -997,8 +1143,9 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
-			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), iam.ServiceAccountPrefix) {
+			badFields["principal_ids"] = "Must only have valid user, group, managed group, and/or service account ids."
 			break
 		}
 		if id == "u_recovery" {
@@ -1026,8 +1173,9 @@ func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) e
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
-			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix, intglobals.JwtManagedGroupPrefix) &&
+			!handlers.ValidId(handlers.Id(id), iam.ServiceAccountPrefix) {
+			badFields["principal_ids"] = "Must only have valid user, group, managed group, and/or service account ids."
 			break
 		}
 	}
@@ -1114,3 +1262,44 @@ func validateRemoveRoleGrantsRequest(req *pbs.RemoveRoleGrantsRequest) error {
 	}
 	return nil
 }
+
+func validateExplainGrantsRequest(req *pbs.ExplainGrantsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+		!handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		req.GetScopeId() != scope.Global.String() {
+		badFields["scope_id"] = "Improperly formatted field."
+	}
+	switch {
+	case req.GetUserId() == "" && len(req.GetGrantStrings()) == 0:
+		badFields["user_id"] = "Either this field or grant_strings must be provided."
+	case req.GetUserId() != "" && len(req.GetGrantStrings()) > 0:
+		badFields["grant_strings"] = "This field cannot be used with user_id."
+	case req.GetUserId() != "":
+		if !handlers.ValidId(handlers.Id(req.GetUserId()), iam.UserPrefix) {
+			badFields["user_id"] = "Improperly formatted identifier."
+		}
+	}
+	for _, v := range req.GetGrantStrings() {
+		if len(v) == 0 {
+			badFields["grant_strings"] = "Grant strings must not be empty."
+			break
+		}
+		if _, err := perms.Parse("p_anything", v); err != nil {
+			badFields["grant_strings"] = fmt.Sprintf("Improperly formatted grant %q.", v)
+			break
+		}
+	}
+	switch typ := resource.Map[req.GetResourceType()]; typ {
+	case resource.Unknown, resource.All:
+		badFields["resource_type"] = "Must be a known resource type."
+	}
+	switch act := action.Map[req.GetAction()]; act {
+	case action.Unknown, action.All:
+		badFields["action"] = "Must be a known action."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
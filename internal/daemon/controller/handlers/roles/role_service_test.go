@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
@@ -17,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
@@ -1156,6 +1160,24 @@ func TestAddPrincipal(t *testing.T) {
 		}
 	}
 
+	t.Run("Add user with expiration", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := iam.TestRole(t, conn, p.GetPublicId())
+		before := time.Now().Truncate(time.Second)
+		req := &pbs.AddRolePrincipalsRequest{
+			Id:               role.GetPublicId(),
+			Version:          role.GetVersion(),
+			PrincipalIds:     []string{users[0].GetPublicId()},
+			ExpiresInSeconds: 3600,
+		}
+		got, err := s.AddRolePrincipals(auth.DisabledAuthTestContext(repoFn, p.GetPublicId()), req)
+		require.NoError(err)
+		require.Len(got.GetItem().GetPrincipals(), 1)
+		exp := got.GetItem().GetPrincipals()[0].GetExpirationTime().AsTime()
+		assert.False(exp.Before(before.Add(time.Hour)))
+		assert.True(exp.Before(before.Add(time.Hour + time.Minute)))
+	})
+
 	role := iam.TestRole(t, conn, p.GetPublicId())
 
 	failCases := []struct {
@@ -2030,3 +2052,272 @@ func TestRemoveGrants(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainGrants(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := roles.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new role service.")
+
+	// Skip the default roles so only the grants of the role below apply to
+	// the project.
+	o, p := iam.TestScopes(t, iamRepo, iam.WithSkipDefaultRoleCreation(true))
+	am := password.TestAuthMethod(t, conn, o.GetPublicId())
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "name")
+	u := iam.TestUser(t, iamRepo, o.GetPublicId(), iam.WithAccountIds(acct.GetPublicId()))
+	role := iam.TestRole(t, conn, p.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, role.GetPublicId(), "id=*;type=target;actions=authorize-session")
+	_ = iam.TestRoleGrant(t, conn, role.GetPublicId(), "id=*;type=host-catalog;actions=read")
+	_ = iam.TestUserRole(t, conn, role.GetPublicId(), u.GetPublicId())
+	orgRole := iam.TestRole(t, conn, o.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, orgRole.GetPublicId(), "id={{user.id}};actions=read")
+	_ = iam.TestRoleGrant(t, conn, orgRole.GetPublicId(), "id={{account.id}};actions=read")
+	_ = iam.TestUserRole(t, conn, orgRole.GetPublicId(), u.GetPublicId())
+	sat := authtoken.TestServiceAccountToken(t, conn, kms.TestKms(t, conn, wrap), o.GetPublicId())
+	tar := tcp.TestTarget(context.Background(), t, conn, p.GetPublicId(), "test")
+
+	cases := []struct {
+		name           string
+		req            *pbs.ExplainGrantsRequest
+		wantAuthorized bool
+		wantGrants     []*pb.GrantExplanation
+		err            error
+	}{
+		{
+			name: "User Authorized",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				Action:       "authorize-session",
+			},
+			wantAuthorized: true,
+			wantGrants: []*pb.GrantExplanation{
+				{RoleId: role.GetPublicId(), Grant: "id=*;type=target;actions=authorize-session", Authorized: true},
+				{RoleId: role.GetPublicId(), Grant: "id=*;type=host-catalog;actions=read"},
+			},
+		},
+		{
+			name: "User Not Authorized",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				Action:       "delete",
+			},
+			wantGrants: []*pb.GrantExplanation{
+				{RoleId: role.GetPublicId(), Grant: "id=*;type=target;actions=authorize-session"},
+				{RoleId: role.GetPublicId(), Grant: "id=*;type=host-catalog;actions=read"},
+			},
+		},
+		{
+			name: "User Templated Grant",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      o.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   u.GetPublicId(),
+				ResourceType: "user",
+				Action:       "read",
+			},
+			wantAuthorized: true,
+			wantGrants: []*pb.GrantExplanation{
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + u.GetPublicId() + ";actions=read", Authorized: true},
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + acct.GetPublicId() + ";actions=read"},
+			},
+		},
+		{
+			name: "Account Templated Grant",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      o.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   acct.GetPublicId(),
+				ResourceType: "account",
+				Action:       "read",
+			},
+			wantAuthorized: true,
+			wantGrants: []*pb.GrantExplanation{
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + u.GetPublicId() + ";actions=read"},
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + acct.GetPublicId() + ";actions=read", Authorized: true},
+			},
+		},
+		{
+			name: "Service Account Token",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      o.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   sat.GetPublicId(),
+				ResourceType: "auth-token",
+				Action:       "read",
+			},
+			wantGrants: []*pb.GrantExplanation{
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + u.GetPublicId() + ";actions=read"},
+				{RoleId: orgRole.GetPublicId(), Grant: "id=" + acct.GetPublicId() + ";actions=read"},
+			},
+		},
+		{
+			name: "Hypothetical Grants",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				GrantStrings: []string{"id=*;type=target;actions=delete"},
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				Action:       "delete",
+			},
+			wantAuthorized: true,
+			wantGrants: []*pb.GrantExplanation{
+				{Grant: "id=*;type=target;actions=delete", Authorized: true},
+			},
+		},
+		{
+			name: "Hypothetical Deny Grant",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				GrantStrings: []string{"id=*;type=target;actions=*", "id=" + tar.GetPublicId() + ";actions=delete;deny=true"},
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				Action:       "delete",
+			},
+			wantGrants: []*pb.GrantExplanation{
+				{Grant: "id=*;type=target;actions=*", Authorized: true},
+				{Grant: "id=" + tar.GetPublicId() + ";actions=delete;deny=true", Denied: true},
+			},
+		},
+		{
+			name: "Pin Mismatch",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				PinId:        "hcst_1234567890",
+				Action:       "authorize-session",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Resource Type Mismatch",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "host-catalog",
+				Action:       "read",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Resource In Another Scope",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      o.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   tar.GetPublicId(),
+				ResourceType: "target",
+				Action:       "authorize-session",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Nonexistent Resource",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceId:   "ttcp_doesntexist",
+				ResourceType: "target",
+				Action:       "authorize-session",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Nonexistent User",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       "u_doesntexist",
+				ResourceType: "target",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Nonexistent Scope",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      "p_doesntexist",
+				UserId:       u.GetPublicId(),
+				ResourceType: "target",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "User And Grants",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				GrantStrings: []string{"id=*;type=target;actions=delete"},
+				ResourceType: "target",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing User And Grants",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				ResourceType: "target",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unparseable Grant",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				GrantStrings: []string{"unparseable"},
+				ResourceType: "target",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown Resource Type",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceType: "unknown",
+				Action:       "list",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown Action",
+			req: &pbs.ExplainGrantsRequest{
+				ScopeId:      p.GetPublicId(),
+				UserId:       u.GetPublicId(),
+				ResourceType: "target",
+				Action:       "fly",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ExplainGrants(auth.DisabledAuthTestContext(repoFn, p.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ExplainGrants(%+v) got error %#v, wanted %#v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.wantAuthorized, got.GetItem().GetAuthorized())
+			assert.Empty(cmp.Diff(tc.wantGrants, got.GetItem().GetGrants(), protocmp.Transform(), protocmp.SortRepeated(func(x, y *pb.GrantExplanation) bool {
+				return x.GetGrant() < y.GetGrant()
+			})))
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/serviceaccounts"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/users"
@@ -27,6 +30,7 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -34,6 +38,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -47,6 +52,9 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.RotateKeys,
+		action.ListKeys,
+		action.DestroyKeyVersion,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -58,22 +66,24 @@ var (
 
 	scopeCollectionTypeMapMap = map[string]map[resource.Type]action.ActionSet{
 		scope.Global.String(): {
-			resource.AuthMethod: authmethods.CollectionActions,
-			resource.AuthToken:  authtokens.CollectionActions,
-			resource.Group:      groups.CollectionActions,
-			resource.Role:       roles.CollectionActions,
-			resource.Scope:      CollectionActions,
-			resource.User:       users.CollectionActions,
-			resource.Worker:     workers.CollectionActions,
+			resource.AuthMethod:     authmethods.CollectionActions,
+			resource.AuthToken:      authtokens.CollectionActions,
+			resource.Group:          groups.CollectionActions,
+			resource.Role:           roles.CollectionActions,
+			resource.Scope:          CollectionActions,
+			resource.ServiceAccount: serviceaccounts.CollectionActions,
+			resource.User:           users.CollectionActions,
+			resource.Worker:         workers.CollectionActions,
 		},
 
 		scope.Org.String(): {
-			resource.AuthMethod: authmethods.CollectionActions,
-			resource.AuthToken:  authtokens.CollectionActions,
-			resource.Group:      groups.CollectionActions,
-			resource.Role:       roles.CollectionActions,
-			resource.Scope:      CollectionActions,
-			resource.User:       users.CollectionActions,
+			resource.AuthMethod:     authmethods.CollectionActions,
+			resource.AuthToken:      authtokens.CollectionActions,
+			resource.Group:          groups.CollectionActions,
+			resource.Role:           roles.CollectionActions,
+			resource.Scope:          CollectionActions,
+			resource.ServiceAccount: serviceaccounts.CollectionActions,
+			resource.User:           users.CollectionActions,
 		},
 
 		scope.Project.String(): {
@@ -99,17 +109,21 @@ type Service struct {
 	pbs.UnsafeScopeServiceServer
 
 	repoFn common.IamRepoFactory
+	kms    *kms.Kms
 }
 
 var _ pbs.ScopeServiceServer = (*Service)(nil)
 
 // NewService returns a project service which handles project related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.IamRepoFactory, kms *kms.Kms) (Service, error) {
 	const op = "scopes.(Service).NewService"
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo}, nil
+	if kms == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+	return Service{repoFn: repo, kms: kms}, nil
 }
 
 // ListScopes implements the interface pbs.ScopeServiceServer.
@@ -228,7 +242,12 @@ func (s Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.G
 	act := IdActions
 	// Can't delete global so elide it
 	if p.GetPublicId() == scope.Global.String() {
-		act = act[0:3]
+		act = make(action.ActionSet, 0, len(IdActions))
+		for _, a := range IdActions {
+			if a != action.Delete {
+				act = append(act, a)
+			}
+		}
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), act).Strings()))
@@ -355,6 +374,80 @@ func (s Service) DeleteScope(ctx context.Context, req *pbs.DeleteScopeRequest) (
 	return nil, nil
 }
 
+// RotateScopeKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) RotateScopeKeys(ctx context.Context, req *pbs.RotateScopeKeysRequest) (*pbs.RotateScopeKeysResponse, error) {
+	const op = "scopes.(Service).RotateScopeKeys"
+
+	if err := validateRotateKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kms.RotateKeys(ctx, req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to rotate keys"))
+	}
+	return nil, nil
+}
+
+// ListKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) ListKeys(ctx context.Context, req *pbs.ListKeysRequest) (*pbs.ListKeysResponse, error) {
+	const op = "scopes.(Service).ListKeys"
+
+	if err := validateListKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sc, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.kms.ListKeys(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to list keys"))
+	}
+	scopeInfo := &pb.ScopeInfo{
+		Id:            sc.GetPublicId(),
+		Type:          sc.GetType(),
+		Name:          sc.GetName(),
+		Description:   sc.GetDescription(),
+		ParentScopeId: sc.GetParentId(),
+	}
+	items := make([]*pb.Key, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, toKeyProto(k, scopeInfo))
+	}
+	return &pbs.ListKeysResponse{Items: items}, nil
+}
+
+// DestroyKeyVersion implements the interface pbs.ScopeServiceServer.
+func (s Service) DestroyKeyVersion(ctx context.Context, req *pbs.DestroyKeyVersionRequest) (*pbs.DestroyKeyVersionResponse, error) {
+	const op = "scopes.(Service).DestroyKeyVersion"
+
+	if err := validateDestroyKeyVersionRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DestroyKeyVersion)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kms.DestroyKeyVersion(ctx, req.GetId(), req.GetKeyVersionId()); err != nil {
+		switch {
+		case errors.IsNotFoundError(err):
+			return nil, handlers.NotFoundErrorf("Key version %q not found.", req.GetKeyVersionId())
+		case errors.Match(errors.T(errors.KeyVersionInUse), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+				"Key version %q is the latest version of its key or still protects data.", req.GetKeyVersionId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to destroy key version"))
+	}
+	return nil, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -422,7 +515,7 @@ func (s Service) updateInRepo(ctx context.Context, parentScope *pb.ScopeInfo, sc
 		opts = append(opts, iam.WithName(scopeName))
 	}
 	if primaryAuthMethodId := item.GetPrimaryAuthMethodId(); primaryAuthMethodId != nil {
-		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+		if !handlers.ValidId(handlers.Id(primaryAuthMethodId.GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix) {
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"primary_auth_method_id": "Improperly formatted identifier"})
 		}
 		scopePrimaryAuthMethodId = primaryAuthMethodId.GetValue()
@@ -699,7 +792,7 @@ func validateUpdateRequest(req *pbs.UpdateScopeRequest) error {
 	if item.GetUpdatedTime() != nil {
 		badFields["updated_time"] = "This is a read only field and cannot be specified in an update request."
 	}
-	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if item.GetPrimaryAuthMethodId().GetValue() != "" && !handlers.ValidId(handlers.Id(item.GetPrimaryAuthMethodId().GetValue()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix, jwt.AuthMethodPrefix) {
 		badFields["primary_auth_method_id"] = "Improperly formatted identifier."
 	}
 	if len(badFields) > 0 {
@@ -732,6 +825,54 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateRotateKeysRequest(req *pbs.RotateScopeKeysRequest) error {
+	badFields := map[string]string{}
+	if !validScopeId(req.GetId()) {
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateListKeysRequest(req *pbs.ListKeysRequest) error {
+	badFields := map[string]string{}
+	if !validScopeId(req.GetId()) {
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateDestroyKeyVersionRequest(req *pbs.DestroyKeyVersionRequest) error {
+	badFields := map[string]string{}
+	if !validScopeId(req.GetId()) {
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetKeyVersionId()), "krkv", "kdkv") {
+		badFields["key_version_id"] = "Invalidly formatted key version id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validScopeId(id string) bool {
+	switch {
+	case id == scope.Global.String():
+		return true
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		return handlers.ValidId(handlers.Id(id), scope.Org.Prefix())
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		return handlers.ValidId(handlers.Id(id), scope.Project.Prefix())
+	}
+	return false
+}
+
 func validateListRequest(req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
@@ -745,3 +886,21 @@ func validateListRequest(req *pbs.ListScopesRequest) error {
 	}
 	return nil
 }
+
+func toKeyProto(in *kms.Key, scopeInfo *pb.ScopeInfo) *pb.Key {
+	out := &pb.Key{
+		Id:          in.Id,
+		Scope:       scopeInfo,
+		Purpose:     in.Purpose,
+		CreatedTime: timestamppb.New(in.CreateTime),
+		Type:        in.Type,
+	}
+	for _, v := range in.Versions {
+		out.Versions = append(out.Versions, &pb.KeyVersion{
+			Id:          v.Id,
+			Version:     v.Version,
+			CreatedTime: timestamppb.New(v.CreateTime),
+		})
+	}
+	return out
+}
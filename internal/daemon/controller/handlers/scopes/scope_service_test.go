@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
@@ -30,12 +31,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "rotate-keys", "list-keys", "destroy-key-version"}
 
-func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error)) {
+func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
@@ -56,7 +58,7 @@ func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*
 	require.NoError(t, err)
 	pRes, _, err = repo.UpdateScope(context.Background(), pRes, 1, []string{"Name", "Description"})
 	require.NoError(t, err)
-	return oRes, pRes, repoFn
+	return oRes, pRes, repoFn, kmsCache
 }
 
 var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
@@ -81,6 +83,7 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("explain"),
 		},
 	},
 	"scopes": {
@@ -89,6 +92,12 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"service-accounts": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
+			structpb.NewStringValue("list"),
+		},
+	},
 	"users": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -126,6 +135,7 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("explain"),
 		},
 	},
 	"scopes": {
@@ -134,6 +144,12 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("list"),
 		},
 	},
+	"service-accounts": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
+			structpb.NewStringValue("list"),
+		},
+	},
 	"users": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -165,6 +181,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("explain"),
 		},
 	},
 	"sessions": {
@@ -181,7 +198,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 }
 
 func TestGet(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	toMerge := &pbs.GetScopeRequest{
 		Id: proj.GetPublicId(),
 	}
@@ -269,7 +286,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetScopeRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new project service.")
 
 			got, gErr := s.GetScope(auth.DisabledAuthTestContext(repoFn, tc.scopeId), req)
@@ -285,6 +302,7 @@ func TestGet(t *testing.T) {
 func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
 	iamRepo := iam.TestRepo(t, conn, wrap)
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
@@ -350,7 +368,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -464,7 +482,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test with non-anonymous listing first
@@ -491,9 +509,9 @@ func TestList(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	cases := []struct {
@@ -565,9 +583,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repoFn)
+	s, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(err, "Error when getting new scopes service")
 	ctx := auth.DisabledAuthTestContext(repoFn, org.GetPublicId())
 	req := &pbs.DeleteScopeRequest{
@@ -590,9 +608,250 @@ func TestDelete_twice(t *testing.T) {
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected not found for the second delete.")
 }
 
+func TestRotateKeys(t *testing.T) {
+	ctx := context.Background()
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+
+	s, err := scopes.NewService(repoFn, kmsCache)
+	require.NoError(t, err, "Error when getting new scopes service.")
+
+	cases := []struct {
+		name    string
+		scopeId string
+		req     *pbs.RotateScopeKeysRequest
+		err     error
+	}{
+		{
+			name:    "Rotate global keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateScopeKeysRequest{Id: scope.Global.String()},
+		},
+		{
+			name:    "Rotate org keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateScopeKeysRequest{Id: org.GetPublicId()},
+		},
+		{
+			name:    "Rotate project keys",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.RotateScopeKeysRequest{Id: proj.GetPublicId()},
+		},
+		{
+			name:    "Rotate non existing org keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateScopeKeysRequest{Id: "o_doesntexis"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Bad Id formatting",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateScopeKeysRequest{Id: "bad_format"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RotateScopeKeys(auth.DisabledAuthTestContext(repoFn, tc.scopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RotateScopeKeys(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Nil(got)
+		})
+	}
+
+	t.Run("new key versions are used", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		w, err := kmsCache.GetWrapper(ctx, proj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		prevKeyId, err := w.KeyId(ctx)
+		require.NoError(err)
+		blob, err := w.Encrypt(ctx, []byte("secret"))
+		require.NoError(err)
+
+		_, err = s.RotateScopeKeys(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.RotateScopeKeysRequest{Id: proj.GetPublicId()})
+		require.NoError(err)
+
+		w, err = kmsCache.GetWrapper(ctx, proj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		keyId, err := w.KeyId(ctx)
+		require.NoError(err)
+		assert.NotEqual(prevKeyId, keyId)
+
+		w, err = kmsCache.GetWrapper(ctx, proj.GetPublicId(), kms.KeyPurposeDatabase, kms.WithKeyId(prevKeyId))
+		require.NoError(err)
+		pt, err := w.Decrypt(ctx, blob)
+		require.NoError(err)
+		assert.Equal("secret", string(pt))
+	})
+}
+
+func TestListKeys(t *testing.T) {
+	ctx := context.Background()
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+
+	s, err := scopes.NewService(repoFn, kmsCache)
+	require.NoError(t, err, "Error when getting new scopes service.")
+
+	require.NoError(t, kmsCache.RotateKeys(ctx, proj.GetPublicId()))
+	keys, err := kmsCache.ListKeys(ctx, proj.GetPublicId())
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		scopeId  string
+		req      *pbs.ListKeysRequest
+		wantLen  int
+		versions int
+		err      error
+	}{
+		{
+			name:     "List org keys",
+			scopeId:  scope.Global.String(),
+			req:      &pbs.ListKeysRequest{Id: org.GetPublicId()},
+			wantLen:  len(keys),
+			versions: 1,
+		},
+		{
+			name:     "List rotated project keys",
+			scopeId:  org.GetPublicId(),
+			req:      &pbs.ListKeysRequest{Id: proj.GetPublicId()},
+			wantLen:  len(keys),
+			versions: 2,
+		},
+		{
+			name:    "List non existing org keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.ListKeysRequest{Id: "o_doesntexis"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Bad Id formatting",
+			scopeId: scope.Global.String(),
+			req:     &pbs.ListKeysRequest{Id: "bad_format"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ListKeys(auth.DisabledAuthTestContext(repoFn, tc.scopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListKeys(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.Len(got.GetItems(), tc.wantLen)
+			assert.Equal("kek", got.GetItems()[0].GetType())
+			for _, k := range got.GetItems() {
+				assert.NotEmpty(k.GetId())
+				assert.NotEmpty(k.GetPurpose())
+				assert.NotNil(k.GetCreatedTime())
+				assert.Equal(tc.req.GetId(), k.GetScope().GetId())
+				require.Len(k.GetVersions(), tc.versions)
+				for i, v := range k.GetVersions() {
+					assert.NotEmpty(v.GetId())
+					assert.Equal(uint32(i+1), v.GetVersion())
+					assert.NotNil(v.GetCreatedTime())
+				}
+			}
+		})
+	}
+}
+
+func TestDestroyKeyVersion(t *testing.T) {
+	ctx := context.Background()
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+
+	s, err := scopes.NewService(repoFn, kmsCache)
+	require.NoError(t, err, "Error when getting new scopes service.")
+
+	require.NoError(t, kmsCache.RotateKeys(ctx, proj.GetPublicId()))
+	keys, err := kmsCache.ListKeys(ctx, proj.GetPublicId())
+	require.NoError(t, err)
+	previousVersions := map[string]string{}
+	latestVersions := map[string]string{}
+	for _, k := range keys {
+		previousVersions[k.Purpose] = k.Versions[0].Id
+		latestVersions[k.Purpose] = k.Versions[1].Id
+	}
+
+	cases := []struct {
+		name    string
+		scopeId string
+		req     *pbs.DestroyKeyVersionRequest
+		err     error
+	}{
+		{
+			name:    "Destroy previous sessions key version",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: previousVersions[kms.KeyPurposeSessions.String()]},
+		},
+		{
+			name:    "Destroy already destroyed key version",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: previousVersions[kms.KeyPurposeSessions.String()]},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Destroy latest key version",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: latestVersions[kms.KeyPurposeSessions.String()]},
+			err:     handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:    "Destroy previous oplog key version",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: previousVersions[kms.KeyPurposeOplog.String()]},
+			err:     handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:    "Destroy key version of other scope",
+			scopeId: scope.Global.String(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: org.GetPublicId(), KeyVersionId: previousVersions[kms.KeyPurposeDatabase.String()]},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Destroy key version of non existing org",
+			scopeId: scope.Global.String(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: "o_doesntexis", KeyVersionId: previousVersions[kms.KeyPurposeDatabase.String()]},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Bad Id formatting",
+			scopeId: scope.Global.String(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: "bad_format", KeyVersionId: previousVersions[kms.KeyPurposeDatabase.String()]},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:    "Bad key version id formatting",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.DestroyKeyVersionRequest{Id: proj.GetPublicId(), KeyVersionId: "bad_format"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.DestroyKeyVersion(auth.DisabledAuthTestContext(repoFn, tc.scopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DestroyKeyVersion(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Nil(got)
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
-	defaultOrg, defaultProj, repoFn := createDefaultScopesAndRepo(t)
+	defaultOrg, defaultProj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
 	defaultProjCreated := defaultProj.GetCreateTime().GetTimestamp().AsTime()
 	toMerge := &pbs.CreateScopeRequest{}
 
@@ -775,7 +1034,7 @@ func TestCreate(t *testing.T) {
 				req := proto.Clone(toMerge).(*pbs.CreateScopeRequest)
 				proto.Merge(req, tc.req)
 
-				s, err := scopes.NewService(repoFn)
+				s, err := scopes.NewService(repoFn, kmsCache)
 				require.NoError(err, "Error when getting new project service.")
 
 				if name != "" {
@@ -849,8 +1108,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
-	tested, err := scopes.NewService(repoFn)
+	org, proj, repoFn, kmsCache := createDefaultScopesAndRepo(t)
+	tested, err := scopes.NewService(repoFn, kmsCache)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	iamRepo, err := repoFn()
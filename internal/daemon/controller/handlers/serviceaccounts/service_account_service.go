@@ -0,0 +1,667 @@
+package serviceaccounts
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/serviceaccounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	maskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.CreateToken,
+		action.ListTokens,
+		action.RevokeToken,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.Create,
+		action.List,
+	}
+)
+
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.ServiceAccount{}}, handlers.MaskSource{&pb.ServiceAccount{}}); err != nil {
+		panic(err)
+	}
+}
+
+// Service handles request as described by the pbs.ServiceAccountServiceServer interface.
+type Service struct {
+	pbs.UnsafeServiceAccountServiceServer
+
+	repoFn   common.IamRepoFactory
+	atRepoFn common.AuthTokenRepoFactory
+	kms      *kms.Kms
+}
+
+var _ pbs.ServiceAccountServiceServer = (*Service)(nil)
+
+// NewService returns a service account service which handles service account
+// related requests to boundary.
+func NewService(repo common.IamRepoFactory, atRepo common.AuthTokenRepoFactory, kms *kms.Kms) (Service, error) {
+	const op = "serviceaccounts.NewService"
+	if repo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	if atRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing auth token repository")
+	}
+	if kms == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+	return Service{repoFn: repo, atRepoFn: atRepo, kms: kms}, nil
+}
+
+// ListServiceAccounts implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) ListServiceAccounts(ctx context.Context, req *pbs.ListServiceAccountsRequest) (*pbs.ListServiceAccountsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.repoFn, authResults, req.GetScopeId(), resource.ServiceAccount, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListServiceAccountsResponse{}, nil
+	}
+
+	sl, err := s.listFromRepo(ctx, scopeIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(sl) == 0 {
+		return &pbs.ListServiceAccountsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	finalItems := make([]*pb.ServiceAccount, 0, len(sl))
+	res := perms.Resource{
+		Type: resource.ServiceAccount,
+	}
+	for _, item := range sl {
+		res.Id = item.GetPublicId()
+		res.ScopeId = item.GetScopeId()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		if len(authorizedActions) == 0 {
+			continue
+		}
+
+		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+		outputOpts := make([]handlers.Option, 0, 3)
+		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+		if outputFields.Has(globals.ScopeField) {
+			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+		}
+		if outputFields.Has(globals.AuthorizedActionsField) {
+			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		}
+
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if filter.Match(item) {
+			finalItems = append(finalItems, item)
+		}
+	}
+	return &pbs.ListServiceAccountsResponse{Items: finalItems}, nil
+}
+
+// GetServiceAccount implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) GetServiceAccount(ctx context.Context, req *pbs.GetServiceAccountRequest) (*pbs.GetServiceAccountResponse, error) {
+	const op = "serviceaccounts.(Service).GetServiceAccount"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sa, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, sa.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, sa, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetServiceAccountResponse{Item: item}, nil
+}
+
+// CreateServiceAccount implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) CreateServiceAccount(ctx context.Context, req *pbs.CreateServiceAccountRequest) (*pbs.CreateServiceAccountResponse, error) {
+	const op = "serviceaccounts.(Service).CreateServiceAccount"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sa, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, sa.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, sa, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateServiceAccountResponse{Item: item, Uri: fmt.Sprintf("service-accounts/%s", item.GetId())}, nil
+}
+
+// UpdateServiceAccount implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) UpdateServiceAccount(ctx context.Context, req *pbs.UpdateServiceAccountRequest) (*pbs.UpdateServiceAccountResponse, error) {
+	const op = "serviceaccounts.(Service).UpdateServiceAccount"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sa, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, sa.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, sa, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateServiceAccountResponse{Item: item}, nil
+}
+
+// DeleteServiceAccount implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) DeleteServiceAccount(ctx context.Context, req *pbs.DeleteServiceAccountRequest) (*pbs.DeleteServiceAccountResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// CreateServiceAccountToken implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) CreateServiceAccountToken(ctx context.Context, req *pbs.CreateServiceAccountTokenRequest) (*pbs.CreateServiceAccountTokenResponse, error) {
+	const op = "serviceaccounts.(Service).CreateServiceAccountToken"
+
+	if err := validateCreateTokenRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CreateToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	opts := []authtoken.Option{authtoken.WithAllowedCidrs(req.GetAllowedCidrs())}
+	if req.GetDescription() != "" {
+		opts = append(opts, authtoken.WithDescription(req.GetDescription()))
+	}
+	ttl := time.Duration(req.GetTimeToLiveSeconds()) * time.Second
+	tok, err := repo.CreateServiceAccountToken(ctx, req.GetId(), ttl, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if tok.GetToken() == "" {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create service account token but no token value returned from repository.")
+	}
+	encToken, err := authtoken.EncryptToken(ctx, s.kms, tok.GetScopeId(), tok.GetPublicId(), tok.GetToken())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item := tokenToProto(tok)
+	item.Token = tok.GetPublicId() + "_" + encToken
+	return &pbs.CreateServiceAccountTokenResponse{Item: item}, nil
+}
+
+// ListServiceAccountTokens implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) ListServiceAccountTokens(ctx context.Context, req *pbs.ListServiceAccountTokensRequest) (*pbs.ListServiceAccountTokensResponse, error) {
+	const op = "serviceaccounts.(Service).ListServiceAccountTokens"
+
+	if err := validateListTokensRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListTokens)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tl, err := repo.ListServiceAccountTokens(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	items := make([]*pb.ServiceAccountToken, 0, len(tl))
+	for _, t := range tl {
+		items = append(items, tokenToProto(t))
+	}
+	return &pbs.ListServiceAccountTokensResponse{Items: items}, nil
+}
+
+// RevokeServiceAccountToken implements the interface pbs.ServiceAccountServiceServer.
+func (s Service) RevokeServiceAccountToken(ctx context.Context, req *pbs.RevokeServiceAccountTokenRequest) (*pbs.RevokeServiceAccountTokenResponse, error) {
+	const op = "serviceaccounts.(Service).RevokeServiceAccountToken"
+
+	if err := validateRevokeTokenRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RevokeToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.atRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	tok, err := repo.LookupServiceAccountToken(ctx, req.GetTokenId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// The caller is only authorized against the service account in the path,
+	// so the token must belong to it.
+	if tok == nil || tok.GetServiceAccountId() != req.GetId() {
+		return nil, handlers.NotFoundErrorf("Token %q not found for service account %q.", req.GetTokenId(), req.GetId())
+	}
+	if _, err := repo.DeleteServiceAccountToken(ctx, tok.GetPublicId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke token"))
+	}
+	return nil, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*iam.ServiceAccount, error) {
+	const op = "serviceaccounts.(Service).getFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sa, err := repo.LookupServiceAccount(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if sa == nil {
+		return nil, handlers.NotFoundErrorf("Service account %q doesn't exist.", id)
+	}
+	return sa, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.ServiceAccount) (*iam.ServiceAccount, error) {
+	const op = "serviceaccounts.(Service).createInRepo"
+	var opts []iam.Option
+	if item.GetName() != nil {
+		opts = append(opts, iam.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, iam.WithDescription(item.GetDescription().GetValue()))
+	}
+	sa, err := iam.NewServiceAccount(ctx, scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build service account for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateServiceAccount(ctx, sa)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create service account but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.ServiceAccount) (*iam.ServiceAccount, error) {
+	const op = "serviceaccounts.(Service).updateInRepo"
+	var opts []iam.Option
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, iam.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, iam.WithName(name.GetValue()))
+	}
+	version := item.GetVersion()
+	sa, err := iam.NewServiceAccount(ctx, scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build service account for update: %v.", err)
+	}
+	sa.PublicId = id
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateServiceAccount(ctx, sa, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Service account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "serviceaccounts.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteServiceAccount(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete service account"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.ServiceAccount, error) {
+	const op = "serviceaccounts.(Service).listFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sl, err := repo.ListServiceAccounts(ctx, scopeIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return sl, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	repo, err := s.repoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.ServiceAccount), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		scp, err := repo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		sa, err := repo.LookupServiceAccount(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if sa == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = sa.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *iam.ServiceAccount, opt ...handlers.Option) (*pb.ServiceAccount, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building service account proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.ServiceAccount{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// tokenToProto converts the token without its value, which is only returned
+// when the token is created.
+func tokenToProto(in *authtoken.ServiceAccountToken) *pb.ServiceAccountToken {
+	return &pb.ServiceAccountToken{
+		Id:                      in.GetPublicId(),
+		ServiceAccountId:        in.GetServiceAccountId(),
+		ScopeId:                 in.GetScopeId(),
+		Description:             in.GetDescription(),
+		AllowedCidrs:            in.AllowedCidrs,
+		CreatedTime:             in.GetCreateTime().GetTimestamp(),
+		ApproximateLastUsedTime: in.GetApproximateLastAccessTime().GetTimestamp(),
+		ExpirationTime:          in.GetExpirationTime().GetTimestamp(),
+	}
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetServiceAccountRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, iam.ServiceAccountPrefix)
+}
+
+func validateCreateRequest(req *pbs.CreateServiceAccountRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if !handlers.ValidId(handlers.Id(req.GetItem().GetScopeId()), scope.Org.Prefix()) &&
+			scope.Global.String() != req.GetItem().GetScopeId() {
+			badFields["scope_id"] = "This field is missing or improperly formatted."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateServiceAccountRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), handlers.NoopValidatorFn, iam.ServiceAccountPrefix)
+}
+
+func validateDeleteRequest(req *pbs.DeleteServiceAccountRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, iam.ServiceAccountPrefix)
+}
+
+func validateListRequest(req *pbs.ListServiceAccountsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+		req.GetScopeId() != scope.Global.String() {
+		badFields["scope_id"] = "Incorrectly formatted identifier."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateCreateTokenRequest(req *pbs.CreateServiceAccountTokenRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), iam.ServiceAccountPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetTimeToLiveSeconds() == 0 {
+		badFields["time_to_live_seconds"] = "This field is required and must be greater than zero."
+	}
+	for _, c := range req.GetAllowedCidrs() {
+		if _, _, err := net.ParseCIDR(c); err != nil {
+			badFields["allowed_cidrs"] = fmt.Sprintf("Must only contain valid CIDR blocks but found %q.", c)
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateListTokensRequest(req *pbs.ListServiceAccountTokensRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), iam.ServiceAccountPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateRevokeTokenRequest(req *pbs.RevokeServiceAccountTokenRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), iam.ServiceAccountPrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetTokenId()), authtoken.ServiceAccountTokenPrefix) {
+		badFields["token_id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
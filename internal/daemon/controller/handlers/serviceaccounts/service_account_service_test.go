@@ -0,0 +1,214 @@
+package serviceaccounts_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/serviceaccounts"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/serviceaccounts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "create-token", "list-tokens", "revoke-token"}
+
+func createDefaultServiceAccountAndRepos(t *testing.T) (*iam.ServiceAccount, func() (*iam.Repository, error), func() (*authtoken.Repository, error), *kms.Kms) {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	sa := iam.TestServiceAccount(t, conn, o.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"))
+	return sa, repoFn, atRepoFn, kmsCache
+}
+
+func TestGet(t *testing.T) {
+	sa, repoFn, atRepoFn, kmsCache := createDefaultServiceAccountAndRepos(t)
+
+	want := &pb.ServiceAccount{
+		Id:                sa.GetPublicId(),
+		ScopeId:           sa.GetScopeId(),
+		Scope:             &scopes.ScopeInfo{Id: sa.GetScopeId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+		Name:              &wrapperspb.StringValue{Value: sa.GetName()},
+		Description:       &wrapperspb.StringValue{Value: sa.GetDescription()},
+		CreatedTime:       sa.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       sa.GetUpdateTime().GetTimestamp(),
+		Version:           1,
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetServiceAccountRequest
+		res  *pbs.GetServiceAccountResponse
+		err  error
+	}{
+		{
+			name: "Get an existing service account",
+			req:  &pbs.GetServiceAccountRequest{Id: sa.GetPublicId()},
+			res:  &pbs.GetServiceAccountResponse{Item: want},
+		},
+		{
+			name: "Get a non existent service account",
+			req:  &pbs.GetServiceAccountRequest{Id: iam.ServiceAccountPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetServiceAccountRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := serviceaccounts.NewService(repoFn, atRepoFn, kmsCache)
+			require.NoError(err)
+
+			got, gErr := s.GetServiceAccount(auth.DisabledAuthTestContext(repoFn, sa.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetServiceAccount(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+			}
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()))
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	sa, repoFn, atRepoFn, kmsCache := createDefaultServiceAccountAndRepos(t)
+
+	cases := []struct {
+		name string
+		req  *pbs.CreateServiceAccountRequest
+		err  error
+	}{
+		{
+			name: "Create a valid service account",
+			req: &pbs.CreateServiceAccountRequest{Item: &pb.ServiceAccount{
+				ScopeId:     sa.GetScopeId(),
+				Name:        &wrapperspb.StringValue{Value: "name"},
+				Description: &wrapperspb.StringValue{Value: "desc"},
+			}},
+		},
+		{
+			name: "Create in a project scope",
+			req: &pbs.CreateServiceAccountRequest{Item: &pb.ServiceAccount{
+				ScopeId: "p_1234567890",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Can't specify id",
+			req: &pbs.CreateServiceAccountRequest{Item: &pb.ServiceAccount{
+				ScopeId: sa.GetScopeId(),
+				Id:      iam.ServiceAccountPrefix + "_notallowed",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := serviceaccounts.NewService(repoFn, atRepoFn, kmsCache)
+			require.NoError(err)
+
+			got, gErr := s.CreateServiceAccount(auth.DisabledAuthTestContext(repoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateServiceAccount(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), iam.ServiceAccountPrefix+"_"))
+			assert.Equal("service-accounts/"+got.GetItem().GetId(), got.GetUri())
+			assert.Equal(tc.req.GetItem().GetName().GetValue(), got.GetItem().GetName().GetValue())
+			assert.Equal(testAuthorizedActions, got.GetItem().GetAuthorizedActions())
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	sa, repoFn, atRepoFn, kmsCache := createDefaultServiceAccountAndRepos(t)
+	s, err := serviceaccounts.NewService(repoFn, atRepoFn, kmsCache)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(repoFn, sa.GetScopeId())
+
+	_, err = s.DeleteServiceAccount(ctx, &pbs.DeleteServiceAccountRequest{Id: sa.GetPublicId()})
+	require.NoError(t, err)
+	_, err = s.DeleteServiceAccount(ctx, &pbs.DeleteServiceAccountRequest{Id: sa.GetPublicId()})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+}
+
+func TestTokens(t *testing.T) {
+	sa, repoFn, atRepoFn, kmsCache := createDefaultServiceAccountAndRepos(t)
+	s, err := serviceaccounts.NewService(repoFn, atRepoFn, kmsCache)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(repoFn, sa.GetScopeId())
+
+	t.Run("invalid-requests", func(t *testing.T) {
+		_, err := s.CreateServiceAccountToken(ctx, &pbs.CreateServiceAccountTokenRequest{Id: sa.GetPublicId()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "missing ttl: got error %v", err)
+		_, err = s.CreateServiceAccountToken(ctx, &pbs.CreateServiceAccountTokenRequest{Id: sa.GetPublicId(), TimeToLiveSeconds: 60, AllowedCidrs: []string{"10.0.0.1"}})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "invalid cidr: got error %v", err)
+		_, err = s.RevokeServiceAccountToken(ctx, &pbs.RevokeServiceAccountTokenRequest{Id: sa.GetPublicId(), TokenId: "at_1234567890"})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "wrong token prefix: got error %v", err)
+	})
+
+	created, err := s.CreateServiceAccountToken(ctx, &pbs.CreateServiceAccountTokenRequest{
+		Id:                sa.GetPublicId(),
+		TimeToLiveSeconds: 3600,
+		AllowedCidrs:      []string{"10.0.0.0/8"},
+		Description:       "ci",
+	})
+	require.NoError(t, err)
+	tok := created.GetItem()
+	assert.True(t, strings.HasPrefix(tok.GetId(), authtoken.ServiceAccountTokenPrefix+"_"))
+	assert.True(t, strings.HasPrefix(tok.GetToken(), tok.GetId()+"_"))
+	assert.Equal(t, sa.GetPublicId(), tok.GetServiceAccountId())
+	assert.Equal(t, []string{"10.0.0.0/8"}, tok.GetAllowedCidrs())
+	assert.Equal(t, "ci", tok.GetDescription())
+
+	listed, err := s.ListServiceAccountTokens(ctx, &pbs.ListServiceAccountTokensRequest{Id: sa.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, listed.GetItems(), 1)
+	assert.Equal(t, tok.GetId(), listed.GetItems()[0].GetId())
+	assert.Empty(t, listed.GetItems()[0].GetToken())
+
+	t.Run("revoke-other-service-account", func(t *testing.T) {
+		other, err := s.CreateServiceAccount(ctx, &pbs.CreateServiceAccountRequest{Item: &pb.ServiceAccount{ScopeId: sa.GetScopeId()}})
+		require.NoError(t, err)
+		_, err = s.RevokeServiceAccountToken(ctx, &pbs.RevokeServiceAccountTokenRequest{Id: other.GetItem().GetId(), TokenId: tok.GetId()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+	})
+
+	_, err = s.RevokeServiceAccountToken(ctx, &pbs.RevokeServiceAccountTokenRequest{Id: sa.GetPublicId(), TokenId: tok.GetId()})
+	require.NoError(t, err)
+	listed, err = s.ListServiceAccountTokens(ctx, &pbs.ListServiceAccountTokensRequest{Id: sa.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, listed.GetItems())
+}
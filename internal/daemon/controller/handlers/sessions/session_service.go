@@ -32,6 +32,8 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.ListRecordings,
+		action.DownloadRecording,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -277,6 +279,68 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ListSessionRecordings implements the interface pbs.SessionServiceServer.
+func (s Service) ListSessionRecordings(ctx context.Context, req *pbs.ListSessionRecordingsRequest) (*pbs.ListSessionRecordingsResponse, error) {
+	const op = "sessions.(Service).ListSessionRecordings"
+
+	if err := validateListRecordingsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListRecordings)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	recordings, err := repo.ListConnectionRecordings(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	items := make([]*pb.ConnectionRecording, 0, len(recordings))
+	for _, r := range recordings {
+		items = append(items, toRecordingProto(r))
+	}
+	return &pbs.ListSessionRecordingsResponse{Items: items}, nil
+}
+
+// DownloadSessionRecording implements the interface pbs.SessionServiceServer.
+func (s Service) DownloadSessionRecording(ctx context.Context, req *pbs.DownloadSessionRecordingRequest) (*pbs.DownloadSessionRecordingResponse, error) {
+	const op = "sessions.(Service).DownloadSessionRecording"
+
+	if err := validateDownloadRecordingRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DownloadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	recording, err := repo.LookupConnectionRecording(ctx, req.GetId(), req.GetConnectionId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if recording == nil {
+		return nil, handlers.NotFoundErrorf("Recording of connection %q in session %q doesn't exist.", req.GetConnectionId(), req.GetId())
+	}
+
+	data, err := repo.ReadConnectionRecording(ctx, recording)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	item := toRecordingProto(recording)
+	item.Data = data
+	return &pbs.DownloadSessionRecordingResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -342,7 +406,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.ListRecordings, action.DownloadRecording:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -454,6 +518,15 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 		if outputFields.Has(globals.ConnectionsField) {
 			connections := make([]*pb.Connection, 0, len(in.Connections))
 			for _, c := range in.Connections {
+				var httpRequests []*pb.HttpRequest
+				for _, r := range c.HttpRequests {
+					httpRequests = append(httpRequests, &pb.HttpRequest{
+						Method:      r.Method,
+						Path:        r.Path,
+						StatusCode:  r.StatusCode,
+						RequestTime: r.RequestTime.GetTimestamp(),
+					})
+				}
 				connections = append(connections, &pb.Connection{
 					ClientTcpAddress:   c.ClientTcpAddress,
 					ClientTcpPort:      c.ClientTcpPort,
@@ -462,6 +535,7 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 					BytesUp:            c.BytesUp,
 					BytesDown:          c.BytesDown,
 					ClosedReason:       c.ClosedReason,
+					HttpRequests:       httpRequests,
 				})
 			}
 			out.Connections = append(out.Connections, connections...)
@@ -471,6 +545,20 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	return &out, nil
 }
 
+// toRecordingProto converts the recording metadata. The recorded data is only
+// set by the caller when downloading a recording.
+func toRecordingProto(in *session.ConnectionRecording) *pb.ConnectionRecording {
+	return &pb.ConnectionRecording{
+		ConnectionId: in.ConnectionId,
+		SessionId:    in.SessionId,
+		WorkerId:     in.WorkerId,
+		Size:         in.Size,
+		StartTime:    in.StartTime.GetTimestamp(),
+		EndTime:      in.EndTime.GetTimestamp(),
+		CreatedTime:  in.CreateTime.GetTimestamp(),
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -508,3 +596,28 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateListRecordingsRequest(req *pbs.ListSessionRecordingsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDownloadRecordingRequest(req *pbs.DownloadSessionRecordingRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetConnectionId()), session.ConnectionPrefix) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -29,10 +30,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self"}
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self", "list-recordings", "download-recording"}
 
 func TestGetSession(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -665,3 +667,148 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionRecordings(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms, session.WithRecordingStoragePath(t.TempDir()))
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	worker := server.TestKmsWorker(t, conn, wrap)
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	newConnection := func() *session.Connection {
+		c := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		_, err := rw.Exec(ctx, "update session_connection set worker_id = ? where public_id = ?", []interface{}{worker.GetPublicId(), c.GetPublicId()})
+		require.NoError(t, err)
+		return c
+	}
+	c := newConnection()
+	notRecorded := newConnection()
+	incomplete := newConnection()
+	addRecording := func(connectionId string, start time.Time, chunks ...string) {
+		r, err := session.NewConnectionRecording(ctx, sess.GetPublicId(), connectionId, worker.GetPublicId(), timestamp.New(start), timestamp.New(start.Add(time.Second)), uint64(len("recording")))
+		require.NoError(t, err)
+		_, err = sessRepo.AddConnectionRecording(ctx, r)
+		require.NoError(t, err)
+		for i, data := range chunks {
+			_, err = sessRepo.AddConnectionRecordingChunk(ctx, sess.GetPublicId(), connectionId, worker.GetPublicId(), uint32(i), []byte(data))
+			require.NoError(t, err)
+		}
+	}
+	addRecording(c.GetPublicId(), time.Now().Add(-time.Hour), "reco", "rding")
+	addRecording(incomplete.GetPublicId(), time.Now().Add(-time.Minute), "reco")
+
+	// Read the recording back so the timestamps have the precision of the database
+	r, err := sessRepo.LookupConnectionRecording(ctx, sess.GetPublicId(), c.GetPublicId())
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	wantRecording := &pb.ConnectionRecording{
+		ConnectionId: r.ConnectionId,
+		SessionId:    r.SessionId,
+		WorkerId:     r.WorkerId,
+		Size:         r.Size,
+		StartTime:    r.StartTime.GetTimestamp(),
+		EndTime:      r.EndTime.GetTimestamp(),
+		CreatedTime:  r.CreateTime.GetTimestamp(),
+	}
+
+	s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+	require.NoError(t, err, "Couldn't create new session service.")
+
+	t.Run("list", func(t *testing.T) {
+		cases := []struct {
+			name string
+			req  *pbs.ListSessionRecordingsRequest
+			res  *pbs.ListSessionRecordingsResponse
+			err  error
+		}{
+			{
+				name: "List recordings",
+				req:  &pbs.ListSessionRecordingsRequest{Id: sess.GetPublicId()},
+				res:  &pbs.ListSessionRecordingsResponse{Items: []*pb.ConnectionRecording{wantRecording}},
+			},
+			{
+				name: "Wrong id prefix",
+				req:  &pbs.ListSessionRecordingsRequest{Id: "j_1234567890"},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+				got, gErr := s.ListSessionRecordings(auth.DisabledAuthTestContext(iamRepoFn, sess.ProjectId), tc.req)
+				if tc.err != nil {
+					require.Error(gErr)
+					assert.True(errors.Is(gErr, tc.err), "ListSessionRecordings(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+					return
+				}
+				require.NoError(gErr)
+				assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListSessionRecordings(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+			})
+		}
+	})
+
+	t.Run("download", func(t *testing.T) {
+		wantDownload := proto.Clone(wantRecording).(*pb.ConnectionRecording)
+		wantDownload.Data = []byte("recording")
+
+		cases := []struct {
+			name string
+			req  *pbs.DownloadSessionRecordingRequest
+			res  *pbs.DownloadSessionRecordingResponse
+			err  error
+		}{
+			{
+				name: "Download recording",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: sess.GetPublicId(), ConnectionId: c.GetPublicId()},
+				res:  &pbs.DownloadSessionRecordingResponse{Item: wantDownload},
+			},
+			{
+				name: "Incomplete recording",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: sess.GetPublicId(), ConnectionId: incomplete.GetPublicId()},
+				err:  handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name: "Connection not recorded",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: sess.GetPublicId(), ConnectionId: notRecorded.GetPublicId()},
+				err:  handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name: "Wrong connection id prefix",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: sess.GetPublicId(), ConnectionId: "j_1234567890"},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name: "Wrong id prefix",
+				req:  &pbs.DownloadSessionRecordingRequest{Id: "j_1234567890", ConnectionId: c.GetPublicId()},
+				err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				assert, require := assert.New(t), require.New(t)
+				got, gErr := s.DownloadSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, sess.ProjectId), tc.req)
+				if tc.err != nil {
+					require.Error(gErr)
+					assert.True(errors.Is(gErr, tc.err), "DownloadSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+					return
+				}
+				require.NoError(gErr)
+				assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "DownloadSessionRecording(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+			})
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	"github.com/hashicorp/boundary/internal/target/http/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+const (
+	defaultPortField = "attributes.default_port"
+	authSchemeField  = "attributes.auth_scheme"
+)
+
+type attribute struct {
+	*pb.HttpTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetEnableTls() != nil {
+		opts = append(opts, target.WithEnableTls(a.GetEnableTls().GetValue()))
+	}
+	if a.GetAuthScheme().GetValue() != "" {
+		opts = append(opts, target.WithAuthScheme(a.GetAuthScheme().GetValue()))
+	}
+	return opts
+}
+
+// Vet does not require the default port or the auth scheme since http.Targets
+// default to port 80 and the basic auth scheme, but the port cannot be
+// explicitly set to zero and the auth scheme must be supported.
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil && a.GetDefaultPort().GetValue() == 0 {
+		badFields[defaultPortField] = "This field cannot be set to zero."
+	}
+	if a.GetAuthScheme() != nil && !validAuthScheme(a.GetAuthScheme().GetValue()) {
+		badFields[authSchemeField] = fmt.Sprintf("Must be %q or %q.", http.BasicAuthScheme, http.BearerAuthScheme)
+	}
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields[defaultPortField] = "This field is required."
+		} else if a.GetDefaultPort().GetValue() == 0 {
+			badFields[defaultPortField] = "This cannot be set to zero."
+		}
+	}
+	if handlers.MaskContains(p, authSchemeField) {
+		if a.GetAuthScheme() == nil {
+			badFields[authSchemeField] = "This field is required."
+		} else if !validAuthScheme(a.GetAuthScheme().GetValue()) {
+			badFields[authSchemeField] = fmt.Sprintf("Must be %q or %q.", http.BasicAuthScheme, http.BearerAuthScheme)
+		}
+	}
+	return badFields
+}
+
+func validAuthScheme(s string) bool {
+	switch s {
+	case http.BasicAuthScheme, http.BearerAuthScheme:
+		return true
+	default:
+		return false
+	}
+}
+
+func newAttribute(m interface{}) targets.Attributes {
+	a := &attribute{
+		&pb.HttpTargetAttributes{},
+	}
+	if httpAttr, ok := m.(*pb.Target_HttpTargetAttributes); ok {
+		a.HttpTargetAttributes = httpAttr.HttpTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(in target.Target, out *pb.Target) error {
+	if in == nil {
+		return nil
+	}
+	t, ok := in.(*http.Target)
+	if !ok {
+		return fmt.Errorf("target %q is not an http target", in.GetPublicId())
+	}
+
+	attrs := &pb.Target_HttpTargetAttributes{
+		HttpTargetAttributes: &pb.HttpTargetAttributes{
+			EnableTls: &wrappers.BoolValue{Value: t.GetEnableTls()},
+		},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.HttpTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetAuthScheme() != "" {
+		attrs.HttpTargetAttributes.AuthScheme = &wrappers.StringValue{Value: t.GetAuthScheme()}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&store.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.HttpTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(http.Subtype, maskManager, newAttribute, setAttributes)
+}
@@ -0,0 +1,231 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/http"
+)
+
+var testAuthorizedActions = []string{
+	"no-op",
+	"read",
+	"update",
+	"delete",
+	"add-host-sets",
+	"set-host-sets",
+	"remove-host-sets",
+	"add-host-sources",
+	"set-host-sources",
+	"remove-host-sources",
+	"add-credential-sources",
+	"set-credential-sources",
+	"remove-credential-sources",
+	"authorize-session",
+}
+
+func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapper) (targets.Service, error) {
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn)
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name string
+		req  *pbs.CreateTargetRequest
+		res  *pbs.CreateTargetResponse
+		err  error
+	}{
+		{
+			name: "Create a valid target",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String("name"),
+				Description: wrapperspb.String("desc"),
+				Type:        http.Subtype.String(),
+				Attrs: &pb.Target_HttpTargetAttributes{
+					HttpTargetAttributes: &pb.HttpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(8443),
+						EnableTls:   wrapperspb.Bool(true),
+						AuthScheme:  wrapperspb.String(http.BearerAuthScheme),
+					},
+				},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", http.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId:     proj.GetPublicId(),
+					Scope:       &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:        wrapperspb.String("name"),
+					Description: wrapperspb.String("desc"),
+					Type:        http.Subtype.String(),
+					Attrs: &pb.Target_HttpTargetAttributes{
+						HttpTargetAttributes: &pb.HttpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(8443),
+							EnableTls:   wrapperspb.Bool(true),
+							AuthScheme:  wrapperspb.String(http.BearerAuthScheme),
+						},
+					},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(-1),
+					AuthorizedActions:      testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create a target with no attributes",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("no-attributes"),
+				Type:    http.Subtype.String(),
+				Address: wrapperspb.String("8.8.8.8"),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", http.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("no-attributes"),
+					Type:    http.Subtype.String(),
+					Attrs: &pb.Target_HttpTargetAttributes{
+						HttpTargetAttributes: &pb.HttpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(http.DefaultPort),
+							EnableTls:   wrapperspb.Bool(false),
+							AuthScheme:  wrapperspb.String(http.BasicAuthScheme),
+						},
+					},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(-1),
+					AuthorizedActions:      testAuthorizedActions,
+					Address:                wrapperspb.String("8.8.8.8"),
+				},
+			},
+		},
+		{
+			name: "Create with default port 0",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("zero-port"),
+				Type:    http.Subtype.String(),
+				Attrs: &pb.Target_HttpTargetAttributes{
+					HttpTargetAttributes: &pb.HttpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(0),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with unsupported auth scheme",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("digest"),
+				Type:    http.Subtype.String(),
+				Attrs: &pb.Target_HttpTargetAttributes{
+					HttpTargetAttributes: &pb.HttpTargetAttributes{
+						AuthScheme: wrapperspb.String("digest"),
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := testService(t, context.Background(), conn, kms, wrapper)
+			require.NoError(err, "Failed to create a new target service.")
+
+			got, gErr := s.CreateTarget(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateTarget(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+			} else {
+				assert.Nil(gErr, "Unexpected err: %v", gErr)
+			}
+
+			if got != nil {
+				assert.Contains(got.GetUri(), tc.res.GetUri())
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), http.TargetPrefix), got.GetItem().GetId())
+
+				// Clear all values which are hard to compare against.
+				got.Uri, tc.res.Uri = "", ""
+				got.Item.Id, tc.res.Item.Id = "", ""
+				got.Item.CreatedTime, got.Item.UpdatedTime, tc.res.Item.CreatedTime, tc.res.Item.UpdatedTime = nil, nil, nil, nil
+			}
+			if tc.res != nil {
+				tc.res.Item.Version = 1
+			}
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "CreateTarget(%q)\n got response %q\n, wanted %q\n", tc.req, got, tc.res)
+		})
+	}
+}
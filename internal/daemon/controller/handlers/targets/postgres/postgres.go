@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/hashicorp/boundary/internal/target/postgres/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+const (
+	defaultPortField = "attributes.default_port"
+	sslModeField     = "attributes.ssl_mode"
+	sslRootCertField = "attributes.ssl_root_cert"
+)
+
+type attribute struct {
+	*pb.PostgresTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetSslMode().GetValue() != "" {
+		opts = append(opts, target.WithSslMode(a.GetSslMode().GetValue()))
+	}
+	if a.GetSslRootCert().GetValue() != "" {
+		opts = append(opts, target.WithSslRootCert(strings.TrimSpace(a.GetSslRootCert().GetValue())))
+	}
+	return opts
+}
+
+// Vet does not require the default port or the ssl mode since postgres.Targets
+// default to port 5432 and the verify-full ssl mode, but the port cannot be
+// explicitly set to zero and the ssl mode must be supported.
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil && a.GetDefaultPort().GetValue() == 0 {
+		badFields["attributes.default_port"] = "This field cannot be set to zero."
+	}
+	if a.GetSslMode() != nil && !validSslMode(a.GetSslMode().GetValue()) {
+		badFields[sslModeField] = sslModeMessage
+	}
+	if a.GetSslRootCert() != nil && !validSslRootCert(a.GetSslRootCert().GetValue()) {
+		badFields[sslRootCertField] = "Must contain at least one PEM encoded certificate."
+	}
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields["attributes.default_port"] = "This field is required."
+		} else if a.GetDefaultPort().GetValue() == 0 {
+			badFields["attributes.default_port"] = "This cannot be set to zero."
+		}
+	}
+	if handlers.MaskContains(p, sslModeField) {
+		if a.GetSslMode() == nil {
+			badFields[sslModeField] = "This field is required."
+		} else if !validSslMode(a.GetSslMode().GetValue()) {
+			badFields[sslModeField] = sslModeMessage
+		}
+	}
+	// The root certificate can be removed by setting it to null.
+	if handlers.MaskContains(p, sslRootCertField) && a.GetSslRootCert() != nil && !validSslRootCert(a.GetSslRootCert().GetValue()) {
+		badFields[sslRootCertField] = "Must contain at least one PEM encoded certificate."
+	}
+	return badFields
+}
+
+var sslModeMessage = fmt.Sprintf("Must be %q, %q, %q or %q.", postgres.SslModeDisable, postgres.SslModeRequire, postgres.SslModeVerifyCa, postgres.SslModeVerifyFull)
+
+func validSslMode(s string) bool {
+	switch s {
+	case postgres.SslModeDisable, postgres.SslModeRequire, postgres.SslModeVerifyCa, postgres.SslModeVerifyFull:
+		return true
+	default:
+		return false
+	}
+}
+
+// validSslRootCert reports whether cert contains at least one PEM encoded
+// certificate.
+func validSslRootCert(cert string) bool {
+	return x509.NewCertPool().AppendCertsFromPEM([]byte(cert))
+}
+
+func newAttribute(m interface{}) targets.Attributes {
+	a := &attribute{
+		&pb.PostgresTargetAttributes{},
+	}
+	if pgAttr, ok := m.(*pb.Target_PostgresTargetAttributes); ok {
+		a.PostgresTargetAttributes = pgAttr.PostgresTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(in target.Target, out *pb.Target) error {
+	if in == nil {
+		return nil
+	}
+	t, ok := in.(*postgres.Target)
+	if !ok {
+		return fmt.Errorf("target %q is not a postgres target", in.GetPublicId())
+	}
+
+	attrs := &pb.Target_PostgresTargetAttributes{
+		PostgresTargetAttributes: &pb.PostgresTargetAttributes{},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.PostgresTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetSslMode() != "" {
+		attrs.PostgresTargetAttributes.SslMode = &wrappers.StringValue{Value: t.GetSslMode()}
+	}
+	if t.GetSslRootCert() != "" {
+		attrs.PostgresTargetAttributes.SslRootCert = &wrappers.StringValue{Value: t.GetSslRootCert()}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&store.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.PostgresTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(postgres.Subtype, maskManager, newAttribute, setAttributes)
+}
@@ -0,0 +1,120 @@
+package ssh
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/ssh/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+const (
+	defaultPortField = "attributes.default_port"
+	hostKeyField     = "attributes.host_key"
+)
+
+type attribute struct {
+	*pb.SshTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetHostKey().GetValue() != "" {
+		opts = append(opts, target.WithHostKey(strings.TrimSpace(a.GetHostKey().GetValue())))
+	}
+	return opts
+}
+
+// Vet does not require the default port since ssh.Targets default to port
+// 22, but it cannot be explicitly set to zero. The host key is not required
+// either, but the worker refuses to connect to the endpoints of ssh.Targets
+// without one.
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil && a.GetDefaultPort().GetValue() == 0 {
+		badFields["attributes.default_port"] = "This field cannot be set to zero."
+	}
+	if a.GetHostKey() != nil && !validHostKey(a.GetHostKey().GetValue()) {
+		badFields[hostKeyField] = "Must be a public key in authorized_keys format."
+	}
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields["attributes.default_port"] = "This field is required."
+		} else if a.GetDefaultPort().GetValue() == 0 {
+			badFields["attributes.default_port"] = "This cannot be set to zero."
+		}
+	}
+	// The host key can be removed by setting it to null.
+	if handlers.MaskContains(p, hostKeyField) && a.GetHostKey() != nil && !validHostKey(a.GetHostKey().GetValue()) {
+		badFields[hostKeyField] = "Must be a public key in authorized_keys format."
+	}
+	return badFields
+}
+
+// validHostKey reports whether key is a single public key in authorized_keys
+// format.
+func validHostKey(key string) bool {
+	_, _, _, rest, err := cryptossh.ParseAuthorizedKey([]byte(key))
+	return err == nil && len(strings.TrimSpace(string(rest))) == 0
+}
+
+func newAttribute(m interface{}) targets.Attributes {
+	a := &attribute{
+		&pb.SshTargetAttributes{},
+	}
+	if sshAttr, ok := m.(*pb.Target_SshTargetAttributes); ok {
+		a.SshTargetAttributes = sshAttr.SshTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(in target.Target, out *pb.Target) error {
+	if in == nil {
+		return nil
+	}
+	t, ok := in.(*ssh.Target)
+	if !ok {
+		return fmt.Errorf("target %q is not an ssh target", in.GetPublicId())
+	}
+
+	attrs := &pb.Target_SshTargetAttributes{
+		SshTargetAttributes: &pb.SshTargetAttributes{},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.SshTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetHostKey() != "" {
+		attrs.SshTargetAttributes.HostKey = &wrappers.StringValue{Value: t.GetHostKey()}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&store.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.SshTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(ssh.Subtype, maskManager, newAttribute, setAttributes)
+}
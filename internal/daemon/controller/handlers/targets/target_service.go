@@ -29,6 +29,10 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	httptarget "github.com/hashicorp/boundary/internal/target/http"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -394,6 +398,9 @@ func (s Service) AddTargetHostSets(ctx context.Context, req *pbs.AddTargetHostSe
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if err := validateNoTargetAddress(authResults); err != nil {
+		return nil, err
+	}
 	t, ts, cl, err := s.addHostSourcesInRepo(ctx, req.GetId(), req.GetHostSetIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
@@ -432,6 +439,11 @@ func (s Service) SetTargetHostSets(ctx context.Context, req *pbs.SetTargetHostSe
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if len(req.GetHostSetIds()) > 0 {
+		if err := validateNoTargetAddress(authResults); err != nil {
+			return nil, err
+		}
+	}
 	t, ts, cl, err := s.setHostSourcesInRepo(ctx, req.GetId(), req.GetHostSetIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
@@ -508,6 +520,9 @@ func (s Service) AddTargetHostSources(ctx context.Context, req *pbs.AddTargetHos
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if err := validateNoTargetAddress(authResults); err != nil {
+		return nil, err
+	}
 	t, ts, cl, err := s.addHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
@@ -546,6 +561,11 @@ func (s Service) SetTargetHostSources(ctx context.Context, req *pbs.SetTargetHos
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
+	if len(req.GetHostSourceIds()) > 0 {
+		if err := validateNoTargetAddress(authResults); err != nil {
+			return nil, err
+		}
+	}
 	t, ts, cl, err := s.setHostSourcesInRepo(ctx, req.GetId(), req.GetHostSourceIds(), req.GetVersion())
 	if err != nil {
 		return nil, err
@@ -879,6 +899,20 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	}
 
 	var chosenEndpoint *host.Endpoint
+	if t.GetAddress() != "" {
+		// A target with an address has no host sources, so there are no hosts
+		// that could be requested.
+		if requestedId != "" {
+			return nil, handlers.InvalidArgumentErrorf(
+				"Errors in provided fields.",
+				map[string]string{
+					"host_id": "The requested target has an address and does not support host ids.",
+				})
+		}
+		chosenEndpoint = &host.Endpoint{
+			Address: t.GetAddress(),
+		}
+	}
 	if requestedId != "" {
 		for _, ep := range endpoints {
 			if ep.HostId == requestedId {
@@ -907,6 +941,9 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	switch {
 	case err != nil && strings.Contains(err.Error(), missingPortErrStr):
 		if t.GetDefaultPort() == 0 {
+			if chosenEndpoint.HostId == "" {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "the target does not provide a port to use with its address")
+			}
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("neither the selected host %q nor the target provides a port to use", chosenEndpoint.HostId))
 		}
 		h = chosenEndpoint.Address
@@ -919,6 +956,36 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		Scheme: t.GetType().String(),
 		Host:   net.JoinHostPort(h, p),
 	}
+	// Tcp targets may close idle connections, http targets whose worker
+	// connects to the endpoint using TLS use the secure variant of their scheme
+	// and pass the scheme of the Authorization header along to the worker, ssh
+	// targets which verify the key of their endpoint pass the key along and
+	// postgres targets pass along how their endpoint is secured.
+	var idleTimeoutSeconds uint32
+	endpointParams := url.Values{}
+	switch tt := t.(type) {
+	case *tcp.Target:
+		idleTimeoutSeconds = tt.GetIdleTimeoutSeconds()
+	case *httptarget.Target:
+		if tt.GetEnableTls() {
+			endpointUrl.Scheme += "s"
+		}
+		if tt.GetAuthScheme() != "" {
+			endpointParams.Set(globals.EndpointAuthSchemeParam, tt.GetAuthScheme())
+		}
+	case *ssh.Target:
+		if tt.GetHostKey() != "" {
+			endpointParams.Set(globals.EndpointHostKeyParam, tt.GetHostKey())
+		}
+	case *postgres.Target:
+		if tt.GetSslMode() != "" {
+			endpointParams.Set(globals.EndpointSslModeParam, tt.GetSslMode())
+		}
+		if tt.GetSslRootCert() != "" {
+			endpointParams.Set(globals.EndpointSslRootCertParam, tt.GetSslRootCert())
+		}
+	}
+	endpointUrl.RawQuery = endpointParams.Encode()
 
 	for _, extraFilter := range ExtraWorkerFilters {
 		selectedWorkers, err = extraFilter(ctx, selectedWorkers, h, p)
@@ -953,18 +1020,20 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:             authResults.UserId,
-		HostId:             chosenEndpoint.HostId,
-		TargetId:           t.GetPublicId(),
-		HostSetId:          chosenEndpoint.SetId,
-		AuthTokenId:        authResults.AuthTokenId,
-		ProjectId:          authResults.Scope.Id,
-		Endpoint:           endpointUrl.String(),
-		ExpirationTime:     &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:    t.GetSessionConnectionLimit(),
-		WorkerFilter:       t.GetWorkerFilter(),
-		DynamicCredentials: dynCreds,
-		StaticCredentials:  staticCreds,
+		UserId:                 authResults.UserId,
+		HostId:                 chosenEndpoint.HostId,
+		TargetId:               t.GetPublicId(),
+		HostSetId:              chosenEndpoint.SetId,
+		AuthTokenId:            authResults.AuthTokenId,
+		ProjectId:              authResults.Scope.Id,
+		Endpoint:               endpointUrl.String(),
+		ExpirationTime:         &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:        t.GetSessionConnectionLimit(),
+		WorkerFilter:           t.GetWorkerFilter(),
+		EnableSessionRecording: enableSessionRecording(t),
+		IdleTimeoutSeconds:     idleTimeoutSeconds,
+		DynamicCredentials:     dynCreds,
+		StaticCredentials:      staticCreds,
 	}
 
 	sess, err := session.New(sessionComposition)
@@ -1099,6 +1168,20 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	return &pbs.AuthorizeSessionResponse{Item: ret}, nil
 }
 
+// validateNoTargetAddress returns an error if the target round tripped in
+// the auth results has an address, since a target cannot have both an address
+// and host sources.
+func validateNoTargetAddress(authResults auth.VerifyResults) error {
+	t, ok := authResults.RoundTripValue.(target.Target)
+	if !ok || t == nil || t.GetAddress() == "" {
+		return nil
+	}
+	return handlers.InvalidArgumentErrorf("Errors in provided fields.",
+		map[string]string{
+			globals.AddressField: "Host sources cannot be added to a target that has an address.",
+		})
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (target.Target, []target.HostSource, []target.CredentialSource, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1132,6 +1215,12 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetWorkerFilter() != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
+	if item.GetAddress() != nil {
+		opts = append(opts, target.WithAddress(item.GetAddress().GetValue()))
+	}
+	if item.GetEnableSessionRecording() != nil {
+		opts = append(opts, target.WithEnableSessionRecording(item.GetEnableSessionRecording().GetValue()))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), item.GetAttrs())
 	if err != nil {
@@ -1175,6 +1264,12 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if filter := item.GetWorkerFilter(); filter != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
+	if address := item.GetAddress(); address != nil {
+		opts = append(opts, target.WithAddress(address.GetValue()))
+	}
+	if enable := item.GetEnableSessionRecording(); enable != nil {
+		opts = append(opts, target.WithEnableSessionRecording(enable.GetValue()))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, item.GetAttrs())
@@ -1442,6 +1537,19 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 	return ret
 }
 
+// sessionRecordingTarget is implemented by the target subtypes whose sessions
+// can be recorded.
+type sessionRecordingTarget interface {
+	GetEnableSessionRecording() bool
+}
+
+// enableSessionRecording reports whether the sessions of the target are
+// recorded.
+func enableSessionRecording(t target.Target) bool {
+	rt, ok := t.(sessionRecordingTarget)
+	return ok && rt.GetEnableSessionRecording()
+}
+
 func toProto(ctx context.Context, in target.Target, hostSources []target.HostSource, credSources []target.CredentialSource, opt ...handlers.Option) (*pb.Target, error) {
 	const op = "target_service.toProto"
 	opts := handlers.GetOpts(opt...)
@@ -1484,6 +1592,12 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.WorkerFilterField) && in.GetWorkerFilter() != "" {
 		out.WorkerFilter = wrapperspb.String(in.GetWorkerFilter())
 	}
+	if outputFields.Has(globals.AddressField) && in.GetAddress() != "" {
+		out.Address = wrapperspb.String(in.GetAddress())
+	}
+	if outputFields.Has(globals.EnableSessionRecordingField) && enableSessionRecording(in) {
+		out.EnableSessionRecording = wrapperspb.Bool(true)
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1608,6 +1722,11 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
 			}
 		}
+		if address := req.GetItem().GetAddress(); address != nil {
+			if msg := validateAddress(address.GetValue()); msg != "" {
+				badFields[globals.AddressField] = msg
+			}
+		}
 
 		subtype := target.SubtypeFromType(req.GetItem().GetType())
 		_, err := subtypeRegistry.get(subtype)
@@ -1651,6 +1770,11 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
 			}
 		}
+		if handlers.MaskContains(paths, globals.AddressField) && req.GetItem().GetAddress().GetValue() != "" {
+			if msg := validateAddress(req.GetItem().GetAddress().GetValue()); msg != "" {
+				badFields[globals.AddressField] = msg
+			}
+		}
 		subtype := target.SubtypeFromId(req.GetId())
 		_, err := subtypeRegistry.get(subtype)
 		if err != nil {
@@ -1673,6 +1797,25 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 	}, target.Prefixes()...)
 }
 
+// validateAddress returns a message describing why the provided target
+// address is invalid, or an empty string if it is valid.
+func validateAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if len(address) < target.MinAddressLength || len(address) > target.MaxAddressLength {
+		return fmt.Sprintf("Address length must be between %d and %d characters.", target.MinAddressLength, target.MaxAddressLength)
+	}
+	_, _, err := net.SplitHostPort(address)
+	switch {
+	case err == nil:
+		return "Address for targets does not support a port."
+	case strings.Contains(err.Error(), missingPortErrStr):
+		// Bare hostname, which we want
+		return ""
+	default:
+		return fmt.Sprintf("Error parsing address: %v.", err)
+	}
+}
+
 func validateDeleteRequest(req *pbs.DeleteTargetRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, target.Prefixes()...)
 }
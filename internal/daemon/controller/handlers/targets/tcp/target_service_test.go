@@ -473,6 +473,119 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a target with address",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String("address"),
+				Description: wrapperspb.String("desc"),
+				Type:        tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(2),
+					},
+				},
+				Address: wrapperspb.String("8.8.8.8"),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId:     proj.GetPublicId(),
+					Scope:       &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:        wrapperspb.String("address"),
+					Description: wrapperspb.String("desc"),
+					Type:        tcp.Subtype.String(),
+					Attrs: &pb.Target_TcpTargetAttributes{
+						TcpTargetAttributes: &pb.TcpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(2),
+						},
+					},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(-1),
+					AuthorizedActions:      testAuthorizedActions,
+					Address:                wrapperspb.String("8.8.8.8"),
+				},
+			},
+		},
+		{
+			name: "Create a target with session recording",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("recorded"),
+				Type:    tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(2),
+					},
+				},
+				EnableSessionRecording: wrapperspb.Bool(true),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("recorded"),
+					Type:    tcp.Subtype.String(),
+					Attrs: &pb.Target_TcpTargetAttributes{
+						TcpTargetAttributes: &pb.TcpTargetAttributes{
+							DefaultPort: wrapperspb.UInt32(2),
+						},
+					},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(-1),
+					AuthorizedActions:      testAuthorizedActions,
+					EnableSessionRecording: wrapperspb.Bool(true),
+				},
+			},
+		},
+		{
+			name: "Create a target with an idle timeout",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("idle"),
+				Type:    tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort:        wrapperspb.UInt32(2),
+						IdleTimeoutSeconds: wrapperspb.UInt32(300),
+					},
+				},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("idle"),
+					Type:    tcp.Subtype.String(),
+					Attrs: &pb.Target_TcpTargetAttributes{
+						TcpTargetAttributes: &pb.TcpTargetAttributes{
+							DefaultPort:        wrapperspb.UInt32(2),
+							IdleTimeoutSeconds: wrapperspb.UInt32(300),
+						},
+					},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(-1),
+					AuthorizedActions:      testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create a target with an address that has a port",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("address-port"),
+				Type:    tcp.Subtype.String(),
+				Attrs: &pb.Target_TcpTargetAttributes{
+					TcpTargetAttributes: &pb.TcpTargetAttributes{
+						DefaultPort: wrapperspb.UInt32(2),
+					},
+				},
+				Address: wrapperspb.String("8.8.8.8:22"),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create a target with no port",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -1520,12 +1633,22 @@ func TestAddTargetHostSources(t *testing.T) {
 	}
 
 	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "test")
+	addressTar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "address", target.WithAddress("8.8.8.8"))
 
 	failCases := []struct {
 		name string
 		req  *pbs.AddTargetHostSourcesRequest
 		err  error
 	}{
+		{
+			name: "Target with address",
+			req: &pbs.AddTargetHostSourcesRequest{
+				Id:            addressTar.GetPublicId(),
+				Version:       addressTar.GetVersion(),
+				HostSourceIds: []string{hs[0].GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Bad Set Id",
 			req: &pbs.AddTargetHostSourcesRequest{
@@ -3192,6 +3315,86 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	}
 }
 
+func TestAuthorizeSession_ServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	repoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	vaultCredRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	// Service accounts authorize sessions through the grants of their roles,
+	// like users do.
+	sat := authtoken.TestServiceAccountToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(ctx),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       sat.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    sat.GetPublicId(),
+		})
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestServiceAccountRole(t, conn, r.GetPublicId(), sat.GetServiceAccountId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
+
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	shs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	_ = static.TestSetMembers(t, conn, shs.GetPublicId(), []*static.Host{h})
+
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn)
+	require.NoError(t, err)
+
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "test", target.WithDefaultPort(2), target.WithHostSources([]string{shs.GetPublicId()}))
+	server.TestKmsWorker(t, conn, wrapper)
+
+	res, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{
+		Id: tar.GetPublicId(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sat.GetServiceAccountId(), res.GetItem().GetUserId())
+
+	sessRepo, err := sessionRepoFn()
+	require.NoError(t, err)
+	sess, _, err := sessRepo.LookupSession(ctx, res.GetItem().GetSessionId())
+	require.NoError(t, err)
+	assert.Equal(t, sat.GetServiceAccountId(), sess.GetUserId())
+	assert.Equal(t, sat.GetPublicId(), sess.AuthTokenId)
+}
+
 func TestAuthorizeSession_Errors(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
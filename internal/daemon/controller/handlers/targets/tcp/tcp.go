@@ -1,6 +1,8 @@
 package tcp
 
 import (
+	"fmt"
+
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
@@ -21,6 +23,9 @@ func (a *attribute) Options() []target.Option {
 	if a.GetDefaultPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
 	}
+	if a.GetIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithIdleTimeoutSeconds(a.GetIdleTimeoutSeconds().GetValue()))
+	}
 	return opts
 }
 
@@ -57,10 +62,14 @@ func newAttribute(m interface{}) targets.Attributes {
 	return a
 }
 
-func setAttributes(t target.Target, out *pb.Target) error {
-	if t == nil {
+func setAttributes(in target.Target, out *pb.Target) error {
+	if in == nil {
 		return nil
 	}
+	t, ok := in.(*tcp.Target)
+	if !ok {
+		return fmt.Errorf("target %q is not a tcp target", in.GetPublicId())
+	}
 
 	attrs := &pb.Target_TcpTargetAttributes{
 		TcpTargetAttributes: &pb.TcpTargetAttributes{},
@@ -68,6 +77,9 @@ func setAttributes(t target.Target, out *pb.Target) error {
 	if t.GetDefaultPort() > 0 {
 		attrs.TcpTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
 	}
+	if t.GetIdleTimeoutSeconds() > 0 {
+		attrs.TcpTargetAttributes.IdleTimeoutSeconds = &wrappers.UInt32Value{Value: t.GetIdleTimeoutSeconds()}
+	}
 
 	out.Attrs = attrs
 	return nil
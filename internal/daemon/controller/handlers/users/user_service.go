@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/jwt"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -419,6 +421,9 @@ func (s Service) createInRepo(ctx context.Context, orgId string, item *pb.User)
 	if item.GetDescription() != nil {
 		opts = append(opts, iam.WithDescription(item.GetDescription().GetValue()))
 	}
+	if item.GetState() != "" {
+		opts = append(opts, iam.WithUserState(iam.UserState(item.GetState())))
+	}
 	u, err := iam.NewUser(orgId, opts...)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build user for creation: %v.", err)
@@ -446,6 +451,9 @@ func (s Service) updateInRepo(ctx context.Context, orgId, id string, mask []stri
 	if name := item.GetName(); name != nil {
 		opts = append(opts, iam.WithName(name.GetValue()))
 	}
+	if state := item.GetState(); state != "" {
+		opts = append(opts, iam.WithUserState(iam.UserState(state)))
+	}
 	version := item.GetVersion()
 	u, err := iam.NewUser(orgId, opts...)
 	if err != nil {
@@ -641,6 +649,9 @@ func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.
 	if outputFields.Has(globals.EmailField) {
 		out.Email = in.GetEmail()
 	}
+	if outputFields.Has(globals.StateField) {
+		out.State = in.GetState()
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -675,12 +686,30 @@ func validateCreateRequest(req *pbs.CreateUserRequest) error {
 			scope.Global.String() != req.GetItem().GetScopeId() {
 			badFields["scope_id"] = "Must be 'global' or a valid org scope id."
 		}
+		if st := req.GetItem().GetState(); st != "" && !validState(st) {
+			badFields[globals.StateField] = fmt.Sprintf("Only %q and %q are valid states.", iam.ActiveUserState, iam.DisabledUserState)
+		}
 		return badFields
 	})
 }
 
 func validateUpdateRequest(req *pbs.UpdateUserRequest) error {
-	return handlers.ValidateUpdateRequest(req, req.GetItem(), handlers.NoopValidatorFn, iam.UserPrefix)
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.StateField) && !validState(req.GetItem().GetState()) {
+			badFields[globals.StateField] = fmt.Sprintf("Only %q and %q are valid states.", iam.ActiveUserState, iam.DisabledUserState)
+		}
+		return badFields
+	}, iam.UserPrefix)
+}
+
+func validState(s string) bool {
+	switch iam.UserState(s) {
+	case iam.ActiveUserState, iam.DisabledUserState:
+		return true
+	default:
+		return false
+	}
 }
 
 func validateDeleteRequest(req *pbs.DeleteUserRequest) error {
@@ -719,6 +748,8 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
+			jwt.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -744,6 +775,8 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
+			jwt.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -772,6 +805,8 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
+			jwt.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
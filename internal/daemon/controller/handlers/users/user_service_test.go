@@ -95,6 +95,7 @@ func TestGet(t *testing.T) {
 		UpdatedTime:       u.UpdateTime.GetTimestamp(),
 		Version:           u.Version,
 		AuthorizedActions: testAuthorizedActions,
+		State:             "active",
 		LoginName:         u.LoginName,
 		FullName:          u.GetFullName(),
 		Email:             u.GetEmail(),
@@ -219,6 +220,7 @@ func TestList(t *testing.T) {
 			UpdatedTime:       u.GetUpdateTime().GetTimestamp(),
 			Version:           2,
 			AuthorizedActions: testAuthorizedActions,
+			State:             "active",
 			LoginName:         oidcAcct.GetSubject(),
 			FullName:          oidcAcct.GetFullName(),
 			Email:             oidcAcct.GetEmail(),
@@ -413,6 +415,7 @@ func TestCreate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Version:           1,
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -432,6 +435,7 @@ func TestCreate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					Version:           1,
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -509,7 +513,8 @@ func TestUpdate(t *testing.T) {
 		repo, err := repoFn()
 		require.NoError(t, err, "Couldn't get a new repo")
 		version++ // From the test case that resulted in calling this
-		u, _, _, err = repo.UpdateUser(context.Background(), u, version, []string{"Name", "Description"})
+		u.State = string(iam.ActiveUserState)
+		u, _, _, err = repo.UpdateUser(context.Background(), u, version, []string{"Name", "Description", "State"})
 		require.NoError(t, err, "Failed to reset the user")
 		version++
 	}
@@ -540,6 +545,7 @@ func TestUpdate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					CreatedTime:       u.GetCreateTime().GetTimestamp(),
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -563,6 +569,7 @@ func TestUpdate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "desc"},
 					CreatedTime:       u.GetCreateTime().GetTimestamp(),
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -616,6 +623,7 @@ func TestUpdate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					CreatedTime:       u.GetCreateTime().GetTimestamp(),
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -639,6 +647,7 @@ func TestUpdate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "default"},
 					CreatedTime:       u.GetCreateTime().GetTimestamp(),
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
@@ -662,9 +671,45 @@ func TestUpdate(t *testing.T) {
 					Description:       &wrapperspb.StringValue{Value: "notignored"},
 					CreatedTime:       u.GetCreateTime().GetTimestamp(),
 					AuthorizedActions: testAuthorizedActions,
+					State:             "active",
 				},
 			},
 		},
+		{
+			name: "Disable an Existing User",
+			req: &pbs.UpdateUserRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"state"},
+				},
+				Item: &pb.User{
+					State: "disabled",
+				},
+			},
+			res: &pbs.UpdateUserResponse{
+				Item: &pb.User{
+					Id:                u.GetPublicId(),
+					ScopeId:           u.GetScopeId(),
+					Scope:             &scopes.ScopeInfo{Id: u.GetScopeId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()},
+					Name:              &wrapperspb.StringValue{Value: "default"},
+					Description:       &wrapperspb.StringValue{Value: "default"},
+					CreatedTime:       u.GetCreateTime().GetTimestamp(),
+					AuthorizedActions: testAuthorizedActions,
+					State:             "disabled",
+				},
+			},
+		},
+		{
+			name: "Invalid State",
+			req: &pbs.UpdateUserRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"state"},
+				},
+				Item: &pb.User{
+					State: "locked",
+				},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Update a Non Existing User",
 			req: &pbs.UpdateUserRequest{
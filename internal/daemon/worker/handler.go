@@ -10,15 +10,18 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/worker/internal/metric"
 	proxyHandlers "github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/proxy"
+	cSession "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"nhooyr.io/websocket"
@@ -260,7 +263,51 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig, sessionMa
 			proxyOpts = append(proxyOpts, proxyHandlers.WithInjectedApplicationCredentials(credentials))
 		}
 
-		if err = handleProxyFn(connCtx, conf, proxyOpts...); err != nil {
+		if secs := sess.GetIdleTimeoutSeconds(); secs > 0 {
+			proxyOpts = append(proxyOpts, proxyHandlers.WithIdleTimeout(time.Duration(secs)*time.Second))
+		}
+
+		if types := w.conf.RawConfig.Worker.SshForwardedChannelTypes; len(types) > 0 {
+			proxyOpts = append(proxyOpts, proxyHandlers.WithSshForwardedChannelTypes(types))
+		}
+		if types := w.conf.RawConfig.Worker.SshForwardedGlobalRequests; len(types) > 0 {
+			proxyOpts = append(proxyOpts, proxyHandlers.WithSshForwardedGlobalRequests(types))
+		}
+
+		if sess.GetEnableSessionRecording() {
+			storagePath := w.conf.RawConfig.Worker.RecordingStoragePath
+			if storagePath == "" {
+				event.WriteError(ctx, op, errors.New("session recording is enabled but no recording storage path is configured"), event.WithInfo("session_id", sessionId))
+				if err = conn.Close(websocket.StatusInternalError, "unable to record session"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+			recorder, err := recording.NewRecorder(storagePath, sessionId, ci.Id)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("unable to create connection recording", "session_id", sessionId, "connection_id", ci.Id))
+				if err = conn.Close(websocket.StatusInternalError, "unable to record session"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+				return
+			}
+			// Queue the recording for upload once the proxied connection
+			// has ended
+			defer w.queueConnectionRecording(w.baseContext, sessionId, workerId, ci.Id, recorder)
+			proxyOpts = append(proxyOpts, proxyHandlers.WithRecorder(recorder))
+		}
+
+		err = handleProxyFn(connCtx, conf, proxyOpts...)
+		switch {
+		case errors.Is(err, proxyHandlers.ErrIdleTimeout):
+			event.WriteSysEvent(ctx, op, "closing idle connection", "session_id", sessionId, "connection_id", ci.Id)
+			if err := sess.ApplyConnectionClosedReason(ci.Id, cSession.ConnectionIdleTimeout); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error setting connection closed reason"))
+			}
+			if err = conn.Close(websocket.StatusNormalClosure, "connection idle timeout"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+		case err != nil:
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error handling proxy", "session_id", sessionId, "endpoint", sess.GetEndpoint()))
 			if err = conn.Close(websocket.StatusInternalError, "unable to establish proxy"); err != nil {
 				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
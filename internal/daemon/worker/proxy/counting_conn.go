@@ -0,0 +1,88 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// CountingConn is a net.Conn which counts the bytes read from and written to
+// the wrapped connection. Proxy handlers wrap the client connection with it
+// so the bytes sent up to and down from the endpoint can be reported to the
+// controller. It also tracks when bytes were last read or written so idle
+// connections can be closed.
+type CountingConn struct {
+	net.Conn
+
+	bytesRead    atomic.Uint64
+	bytesWritten atomic.Uint64
+	lastActivity atomic.Int64
+}
+
+// NewCountingConn returns a CountingConn wrapping c.
+func NewCountingConn(c net.Conn) *CountingConn {
+	cc := &CountingConn{Conn: c}
+	cc.touch()
+	return cc
+}
+
+// Read satisfies the net.Conn interface and counts the bytes read.
+func (c *CountingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.bytesRead.Add(uint64(n))
+		c.touch()
+	}
+	return n, err
+}
+
+// Write satisfies the net.Conn interface and counts the bytes written.
+func (c *CountingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.bytesWritten.Add(uint64(n))
+		c.touch()
+	}
+	return n, err
+}
+
+func (c *CountingConn) touch() {
+	c.lastActivity.Store(time.Now().UnixNano())
+}
+
+// BytesRead returns the number of bytes read from the connection so far. It
+// is safe to call concurrently with Read.
+func (c *CountingConn) BytesRead() uint64 {
+	return c.bytesRead.Load()
+}
+
+// BytesWritten returns the number of bytes written to the connection so far.
+// It is safe to call concurrently with Write.
+func (c *CountingConn) BytesWritten() uint64 {
+	return c.bytesWritten.Load()
+}
+
+// CloseWhenIdle blocks until no bytes were read from or written to the
+// connection for the timeout, in which case the connection is closed and true
+// is returned, or until ctx is done, in which case false is returned.
+func (c *CountingConn) CloseWhenIdle(ctx context.Context, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			if ctx.Err() != nil {
+				return false
+			}
+			idle := time.Since(time.Unix(0, c.lastActivity.Load()))
+			if idle >= timeout {
+				_ = c.Close()
+				return true
+			}
+			timer.Reset(timeout - idle)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountingConn(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	client, server := net.Pipe()
+	defer client.Close()
+	c := NewCountingConn(server)
+	defer c.Close()
+
+	go func() {
+		_, _ = client.Write([]byte("hello"))
+		_, _ = io.Copy(io.Discard, client)
+	}()
+
+	b := make([]byte, 5)
+	_, err := io.ReadFull(c, b)
+	require.NoError(err)
+	assert.Equal("hello", string(b))
+	assert.Equal(uint64(5), c.BytesRead())
+	assert.Zero(c.BytesWritten())
+
+	n, err := c.Write([]byte("hello world"))
+	require.NoError(err)
+	assert.Equal(11, n)
+	n, err = c.Write([]byte("!"))
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(uint64(12), c.BytesWritten())
+	assert.Equal(uint64(5), c.BytesRead())
+}
+
+func TestCountingConn_CloseWhenIdle(t *testing.T) {
+	t.Run("idle", func(t *testing.T) {
+		assert := assert.New(t)
+		client, server := net.Pipe()
+		defer client.Close()
+		c := NewCountingConn(server)
+
+		assert.True(c.CloseWhenIdle(context.Background(), 50*time.Millisecond))
+		_, err := c.Read(make([]byte, 1))
+		assert.Error(err)
+	})
+	t.Run("activity-extends-timeout", func(t *testing.T) {
+		assert := assert.New(t)
+		client, server := net.Pipe()
+		defer client.Close()
+		c := NewCountingConn(server)
+		defer c.Close()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			b := make([]byte, 1)
+			for i := 0; i < 5; i++ {
+				_, _ = client.Write(b)
+				time.Sleep(50 * time.Millisecond)
+			}
+		}()
+		go func() {
+			_, _ = io.Copy(io.Discard, c)
+		}()
+		start := time.Now()
+		assert.True(c.CloseWhenIdle(context.Background(), 150*time.Millisecond))
+		assert.GreaterOrEqual(time.Since(start), 350*time.Millisecond)
+		<-done
+	})
+	t.Run("canceled", func(t *testing.T) {
+		assert := assert.New(t)
+		client, server := net.Pipe()
+		defer client.Close()
+		c := NewCountingConn(server)
+		defer c.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.False(c.CloseWhenIdle(ctx, time.Hour))
+	})
+}
@@ -0,0 +1,303 @@
+// Package http provides the worker proxy handler for http targets. Importing
+// this package registers the handler for the "http" and "https" protocols.
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+)
+
+const (
+	basicAuthScheme  = "basic"
+	bearerAuthScheme = "bearer"
+
+	// requestBatchSize is the number of proxied requests after which they are
+	// sent to the controller while the connection is still open.
+	requestBatchSize = 50
+
+	// requestFlushTimeout is the duration of the timeout when the requests
+	// proxied over a closed connection are sent to the controller.
+	requestFlushTimeout = 30 * time.Second
+)
+
+func init() {
+	for _, scheme := range []string{"http", "https"} {
+		if err := proxy.RegisterHandler(scheme, handleProxy); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// handleProxy serves the HTTP requests of the client arriving on the websocket
+// conn with a reverse proxy to the remote endpoint. The Authorization header
+// of each request is set using the injected application credentials of the
+// session, so the client never sees them. The method, path and response
+// status of each request are sent to the controller to be stored with the
+// connection. handleProxy sets the connectionId as connected in the
+// repository.
+//
+// The endpoint is reached using TLS when its scheme is "https". The scheme of
+// the Authorization header is taken from the auth_scheme parameter of the
+// endpoint and defaults to basic.
+//
+// handleProxy blocks until the client closes the connection or ctx is done.
+// The WithRecorder option is ignored.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	const op = "http.handleProxy"
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "http" && sessionUrl.Scheme != "https" {
+		return fmt.Errorf("invalid scheme for http proxy: %v", sessionUrl.Scheme)
+	}
+	setAuthorization, err := authorizer(sessionUrl.Query().Get(globals.EndpointAuthSchemeParam), opts.WithInjectedApplicationCredentials)
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	remoteConn, err := dialer.DialContext(ctx, "tcp", sessionUrl.Host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "http",
+		UserClientIp:       conf.UserClientIp.String(),
+	}
+	if err := conf.Session.RequestConnectConnection(ctx, connectionInfo); err != nil {
+		_ = remoteConn.Close()
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// The connection dialed to check the endpoint is used for the first
+	// request, later connections are dialed as needed.
+	var firstConn sync.Once
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var c net.Conn
+			firstConn.Do(func() { c = remoteConn })
+			if c != nil {
+				return c, nil
+			}
+			return dialer.DialContext(ctx, network, sessionUrl.Host)
+		},
+	}
+	defer transport.CloseIdleConnections()
+	// Close the first connection if no request was ever proxied.
+	defer firstConn.Do(func() { _ = remoteConn.Close() })
+
+	requests := newRequestLog(conf)
+	defer func() {
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), requestFlushTimeout)
+		defer flushCancel()
+		if err := requests.flush(flushCtx); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to send connection http requests", "session_id", conf.Session.GetId(), "connection_id", conf.ConnectionId))
+		}
+	}()
+
+	reverseProxy := &httputil.ReverseProxy{
+		Director: func(r *http.Request) {
+			r.URL.Scheme = sessionUrl.Scheme
+			r.URL.Host = sessionUrl.Host
+			r.Host = sessionUrl.Host
+			setAuthorization(r)
+		},
+		Transport: transport,
+		ModifyResponse: func(resp *http.Response) error {
+			requests.add(ctx, resp.Request, resp.StatusCode)
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error proxying http request", "session_id", conf.Session.GetId(), "connection_id", conf.ConnectionId))
+			requests.add(ctx, r, http.StatusBadGateway)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	// Count the bytes sent up to and down from the endpoint over the client
+	// connection.
+	netConn := proxy.NewCountingConn(websocket.NetConn(ctx, conf.ClientConn, websocket.MessageBinary))
+	if err := conf.Session.ApplyConnectionCounterCallbacks(conf.ConnectionId, netConn.BytesRead, netConn.BytesWritten); err != nil {
+		_ = netConn.Close()
+		return fmt.Errorf("error setting connection counters: %w", err)
+	}
+	l := newConnListener(netConn)
+	srv := &http.Server{
+		Handler:           reverseProxy,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-l.done:
+		}
+		_ = srv.Close()
+	}()
+	if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, errListenerClosed) {
+		return fmt.Errorf("error serving http connection: %w", err)
+	}
+	return nil
+}
+
+// authorizer returns a function setting the Authorization header of the
+// requests sent to the endpoint using the first username_password credential
+// in creds. The password is used as the token of the bearer scheme. The
+// requests are left unchanged when there are no credentials.
+func authorizer(scheme string, creds []*pbs.Credential) (func(*http.Request), error) {
+	if scheme == "" {
+		scheme = basicAuthScheme
+	}
+	if scheme != basicAuthScheme && scheme != bearerAuthScheme {
+		return nil, fmt.Errorf("unsupported auth scheme for http endpoint: %q", scheme)
+	}
+	if len(creds) == 0 {
+		return func(*http.Request) {}, nil
+	}
+	for _, c := range creds {
+		up, ok := c.GetCredential().(*pbs.Credential_UsernamePassword)
+		if !ok {
+			continue
+		}
+		username, password := up.UsernamePassword.GetUsername(), up.UsernamePassword.GetPassword()
+		if scheme == bearerAuthScheme {
+			return func(r *http.Request) {
+				r.Header.Set("Authorization", "Bearer "+password)
+			}, nil
+		}
+		return func(r *http.Request) {
+			r.SetBasicAuth(username, password)
+		}, nil
+	}
+	return nil, errors.New("missing username_password injected application credentials for http endpoint")
+}
+
+// requestLog collects the requests proxied over a connection and sends them
+// to the controller in batches.
+type requestLog struct {
+	conf proxy.Config
+
+	mu       sync.Mutex
+	sequence uint32
+	pending  []*pbs.ConnectionHttpRequest
+}
+
+func newRequestLog(conf proxy.Config) *requestLog {
+	return &requestLog{conf: conf}
+}
+
+// add records the method, path and status of the response of r. The query of
+// the request is left out since it may hold secrets.
+func (l *requestLog) add(ctx context.Context, r *http.Request, statusCode int) {
+	const op = "http.(requestLog).add"
+	l.mu.Lock()
+	l.sequence++
+	l.pending = append(l.pending, &pbs.ConnectionHttpRequest{
+		Sequence:    l.sequence,
+		Method:      r.Method,
+		Path:        r.URL.Path,
+		StatusCode:  uint32(statusCode),
+		RequestTime: timestamppb.Now(),
+	})
+	full := len(l.pending) >= requestBatchSize
+	l.mu.Unlock()
+
+	if full {
+		if err := l.flush(ctx); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to send connection http requests", "session_id", l.conf.Session.GetId(), "connection_id", l.conf.ConnectionId))
+		}
+	}
+}
+
+// flush sends the pending requests to the controller. The requests are
+// dropped if they cannot be sent.
+func (l *requestLog) flush(ctx context.Context) error {
+	l.mu.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	return l.conf.Session.RequestAddConnectionHttpRequests(ctx, &pbs.AddConnectionHttpRequestsRequest{
+		ConnectionId: l.conf.ConnectionId,
+		SessionId:    l.conf.Session.GetId(),
+		Requests:     pending,
+	})
+}
+
+var errListenerClosed = errors.New("listener closed")
+
+// connListener is a net.Listener which accepts a single connection and
+// closes once that connection is closed.
+type connListener struct {
+	conn   net.Conn
+	once   sync.Once
+	accept chan net.Conn
+	done   chan struct{}
+}
+
+func newConnListener(c net.Conn) *connListener {
+	l := &connListener{
+		accept: make(chan net.Conn, 1),
+		done:   make(chan struct{}),
+	}
+	l.conn = &closeNotifyConn{Conn: c, onClose: l.close}
+	l.accept <- l.conn
+	return l
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.accept:
+		return c, nil
+	case <-l.done:
+		return nil, errListenerClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.close()
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+func (l *connListener) close() {
+	l.once.Do(func() { close(l.done) })
+}
+
+// closeNotifyConn calls onClose when the connection is closed.
+type closeNotifyConn struct {
+	net.Conn
+	onClose func()
+}
+
+func (c *closeNotifyConn) Close() error {
+	defer c.onClose()
+	return c.Conn.Close()
+}
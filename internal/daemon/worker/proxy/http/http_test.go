@@ -0,0 +1,272 @@
+package http
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+)
+
+const (
+	testUsername = "user"
+	testPassword = "secret"
+)
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, "%s %s", r.Host, r.Header.Get("Authorization"))
+	}))
+	t.Cleanup(endpoint.Close)
+	endpointUrl, err := url.Parse(endpoint.URL)
+	require.NoError(t, err)
+
+	creds := []*pbs.Credential{
+		{
+			Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{
+					Username: testUsername,
+					Password: testPassword,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		endpoint string
+		creds    []*pbs.Credential
+		wantAuth string
+	}{
+		{
+			name:     "basic",
+			endpoint: fmt.Sprintf("http://%s", endpointUrl.Host),
+			creds:    creds,
+			wantAuth: "Basic dXNlcjpzZWNyZXQ=",
+		},
+		{
+			name:     "bearer",
+			endpoint: fmt.Sprintf("http://%s?auth_scheme=bearer", endpointUrl.Host),
+			creds:    creds,
+			wantAuth: "Bearer " + testPassword,
+		},
+		{
+			name:     "no-credentials",
+			endpoint: fmt.Sprintf("http://%s", endpointUrl.Host),
+			wantAuth: "Client",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+			clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+			require.NotNil(clientConn)
+			require.NotNil(proxyConn)
+
+			var mu sync.Mutex
+			var got []*pbs.AddConnectionHttpRequestsRequest
+			s := testSession(t, ctx, func(req *pbs.AddConnectionHttpRequestsRequest) {
+				mu.Lock()
+				defer mu.Unlock()
+				got = append(got, req)
+			})
+
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				ClientConn:     proxyConn,
+				RemoteEndpoint: tt.endpoint,
+				Session:        s,
+				ConnectionId:   "mock-connection",
+				UserClientIp:   net.ParseIP("127.0.0.1"),
+			}
+
+			errChan := make(chan error)
+			go func() {
+				errChan <- handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(tt.creds))
+			}()
+
+			netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+			client := &http.Client{
+				Transport: &http.Transport{
+					DialContext: func(context.Context, string, string) (net.Conn, error) {
+						return netConn, nil
+					},
+				},
+			}
+
+			req, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:9200/created?token=xyz", nil)
+			require.NoError(err)
+			req.Header.Set("Authorization", "Client")
+			resp, err := client.Do(req)
+			require.NoError(err)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(err)
+			require.NoError(resp.Body.Close())
+			assert.Equal(http.StatusCreated, resp.StatusCode)
+			assert.Equal(fmt.Sprintf("%s %s", endpointUrl.Host, tt.wantAuth), string(body))
+
+			resp, err = client.Get("http://127.0.0.1:9200/missing")
+			require.NoError(err)
+			require.NoError(resp.Body.Close())
+			assert.Equal(http.StatusNotFound, resp.StatusCode)
+
+			require.NoError(netConn.Close())
+			select {
+			case err := <-errChan:
+				assert.NoError(err)
+			case <-time.After(10 * time.Second):
+				t.Fatal("proxy did not return after the client closed the connection")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(got, 1)
+			assert.Equal("mock-connection", got[0].GetConnectionId())
+			assert.Equal(s.GetId(), got[0].GetSessionId())
+			require.Len(got[0].GetRequests(), 2)
+			first, second := got[0].GetRequests()[0], got[0].GetRequests()[1]
+			assert.Equal(uint32(1), first.GetSequence())
+			assert.Equal(http.MethodPost, first.GetMethod())
+			assert.Equal("/created", first.GetPath())
+			assert.Equal(uint32(http.StatusCreated), first.GetStatusCode())
+			assert.NotNil(first.GetRequestTime())
+			assert.Equal(uint32(2), second.GetSequence())
+			assert.Equal(http.MethodGet, second.GetMethod())
+			assert.Equal("/missing", second.GetPath())
+			assert.Equal(uint32(http.StatusNotFound), second.GetStatusCode())
+		})
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := testSession(t, ctx, nil)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		creds    []*pbs.Credential
+	}{
+		{
+			name:     "invalid-scheme",
+			endpoint: "tcp://localhost:80",
+		},
+		{
+			name:     "invalid-auth-scheme",
+			endpoint: "http://localhost:80?auth_scheme=digest",
+		},
+		{
+			name:     "missing-username-password",
+			endpoint: "http://localhost:80",
+			creds: []*pbs.Credential{
+				{
+					Credential: &pbs.Credential_SshPrivateKey{
+						SshPrivateKey: &pbs.SshPrivateKey{
+							Username:   testUsername,
+							PrivateKey: "key",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				Session:        s,
+				ConnectionId:   "mock-connection",
+			}
+			assert.Error(t, handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(tt.creds)))
+		})
+	}
+}
+
+// testSession returns a session which passes the http requests it is asked to
+// add to addRequests.
+func testSession(t *testing.T, ctx context.Context, addRequests func(*pbs.AddConnectionHttpRequestsRequest)) session.Session {
+	t.Helper()
+	_, sessionKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(5 * time.Minute),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, sessionKey.Public(), sessionKey)
+	require.NoError(t, err)
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	sessClient.LookupSessionFn = func(_ context.Context, request *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   request.GetSessionId(),
+				Certificate: cert,
+				PrivateKey:  sessionKey,
+			},
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+		}, nil
+	}
+	sessClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "mock-connection",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sessClient.ConnectConnectionFn = func(_ context.Context, _ *pbs.ConnectConnectionRequest) (*pbs.ConnectConnectionResponse, error) {
+		return &pbs.ConnectConnectionResponse{
+			Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+		}, nil
+	}
+	sessClient.AddConnectionHttpRequestsFn = func(_ context.Context, req *pbs.AddConnectionHttpRequestsRequest) (*pbs.AddConnectionHttpRequestsResponse, error) {
+		if addRequests != nil {
+			addRequests(req)
+		}
+		return &pbs.AddConnectionHttpRequestsResponse{}, nil
+	}
+	manager, err := session.NewManager(sessClient)
+	require.NoError(t, err)
+	s, err := manager.LoadLocalSession(ctx, "one", "workerid")
+	require.NoError(t, err)
+	_, connCancelFn := context.WithCancel(context.Background())
+	_, _, err = s.RequestAuthorizeConnection(ctx, "workerid", connCancelFn)
+	require.NoError(t, err)
+	return s
+}
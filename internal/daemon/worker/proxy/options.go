@@ -1,6 +1,9 @@
 package proxy
 
 import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
 	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 )
 
@@ -19,11 +22,19 @@ func GetOpts(opt ...Option) Options {
 // Options = how options are represented
 type Options struct {
 	WithInjectedApplicationCredentials []*serverpb.Credential
+	WithRecorder                       *recording.Recorder
+	WithIdleTimeout                    time.Duration
+	WithSshForwardedChannelTypes       []string
+	WithSshForwardedGlobalRequests     []string
 }
 
 func getDefaultOptions() Options {
 	return Options{
 		WithInjectedApplicationCredentials: nil,
+		WithRecorder:                       nil,
+		WithIdleTimeout:                    0,
+		WithSshForwardedChannelTypes:       nil,
+		WithSshForwardedGlobalRequests:     nil,
 	}
 }
 
@@ -34,3 +45,37 @@ func WithInjectedApplicationCredentials(creds []*serverpb.Credential) Option {
 		o.WithInjectedApplicationCredentials = creds
 	}
 }
+
+// WithRecorder provides an optional recorder which the proxy uses to record
+// the bytes sent in both directions of the connection
+func WithRecorder(r *recording.Recorder) Option {
+	return func(o *Options) {
+		o.WithRecorder = r
+	}
+}
+
+// WithIdleTimeout provides an optional duration after which the proxy closes
+// a connection which carried no traffic in either direction. A zero duration
+// disables the timeout.
+func WithIdleTimeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.WithIdleTimeout = d
+	}
+}
+
+// WithSshForwardedChannelTypes provides an optional list of ssh channel types
+// which the ssh proxy forwards in addition to the session channels opened by
+// the client
+func WithSshForwardedChannelTypes(types []string) Option {
+	return func(o *Options) {
+		o.WithSshForwardedChannelTypes = types
+	}
+}
+
+// WithSshForwardedGlobalRequests provides an optional list of ssh global
+// request types which the ssh proxy forwards
+func WithSshForwardedGlobalRequests(types []string) Option {
+	return func(o *Options) {
+		o.WithSshForwardedGlobalRequests = types
+	}
+}
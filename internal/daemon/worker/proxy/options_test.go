@@ -2,9 +2,12 @@ package proxy
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
 	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_GetOpts(t *testing.T) {
@@ -26,4 +29,39 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithInjectedApplicationCredentials = []*serverpb.Credential{c}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRecorder", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r, err := recording.NewRecorder(t.TempDir(), "s_1234567890", "sc_1234567890")
+		require.NoError(err)
+		t.Cleanup(func() { r.Close() })
+		opts := GetOpts(WithRecorder(r))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithRecorder = r
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdleTimeout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithIdleTimeout(time.Minute))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithIdleTimeout = time.Minute
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSshForwardedChannelTypes", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSshForwardedChannelTypes([]string{"direct-tcpip"}))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithSshForwardedChannelTypes = []string{"direct-tcpip"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSshForwardedGlobalRequests", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSshForwardedGlobalRequests([]string{"tcpip-forward"}))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithSshForwardedGlobalRequests = []string{"tcpip-forward"}
+		assert.Equal(opts, testOpts)
+	})
 }
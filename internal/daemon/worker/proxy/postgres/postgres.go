@@ -0,0 +1,547 @@
+// Package postgres provides the worker proxy handler for postgres targets.
+// Importing this package registers the handler for the "postgres" protocol.
+package postgres
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"nhooyr.io/websocket"
+)
+
+const (
+	// The codes sent in place of the protocol version by startup packets.
+	protocolVersion3    = 196608
+	cancelRequestCode   = 80877102
+	sslRequestCode      = 80877103
+	gssEncRequestCode   = 80877104
+	maxStartupPacketLen = 10000
+
+	// maxAuthMessageLen limits the size of the messages read from the endpoint
+	// before the client is authenticated.
+	maxAuthMessageLen = 1 << 16
+
+	// The authentication request codes of the AuthenticationRequest messages.
+	authOk                = 0
+	authCleartextPassword = 3
+	authMD5Password       = 5
+	authSASL              = 10
+	authSASLContinue      = 11
+	authSASLFinal         = 12
+
+	scramSha256 = "SCRAM-SHA-256"
+
+	// The modes in which the connection to the endpoint is secured, sent by
+	// the controller in the sslmode parameter of the endpoint.
+	sslModeDisable    = "disable"
+	sslModeRequire    = "require"
+	sslModeVerifyCa   = "verify-ca"
+	sslModeVerifyFull = "verify-full"
+)
+
+func init() {
+	err := proxy.RegisterHandler("postgres", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy answers the startup of the postgres connection of the client
+// arriving on the websocket conn and authenticates to the remote endpoint with
+// the injected application username_password credential of the session, so
+// the client never sees it. The parameters sent by the client, such as the
+// database, are passed on to the endpoint but the user is replaced with the
+// username of the credential. Once the endpoint accepts the credential the
+// messages are forwarded unchanged between the client and the endpoint.
+// handleProxy sets the connectionId as connected in the repository.
+//
+// The client has already been authenticated and authorized by the session TLS
+// connection, so SSL and GSSAPI encryption requests are declined and the
+// client is not asked for a password.
+//
+// The connection to the endpoint is secured according to the sslmode
+// parameter of the endpoint, which defaults to verify-full, with the
+// certificate of the endpoint verified against the certificate authorities in
+// the sslrootcert parameter or the system ones. SCRAM-SHA-256 password
+// authentication is supported with the endpoint, cleartext and MD5 password
+// authentication only when the connection to the endpoint uses TLS with a
+// verified certificate (verify-ca or verify-full).
+//
+// handleProxy blocks until an error (EOF on happy path) is received on either
+// connection.
+//
+// If the WithRecorder option is provided the bytes sent in both directions
+// after the authentication are recorded.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "postgres" {
+		return fmt.Errorf("invalid scheme for postgres proxy: %v", sessionUrl.Scheme)
+	}
+	username, password, err := endpointCredential(opts.WithInjectedApplicationCredentials)
+	if err != nil {
+		return err
+	}
+	endpointParams := sessionUrl.Query()
+	sslMode := endpointParams.Get(globals.EndpointSslModeParam)
+	tlsConfig, err := endpointTlsConfig(sessionUrl.Hostname(), sslMode, endpointParams.Get(globals.EndpointSslRootCertParam))
+	if err != nil {
+		return err
+	}
+	// With require the certificate of the endpoint is not verified, so the
+	// connection may be intercepted.
+	verified := tlsConfig != nil && sslMode != sslModeRequire
+
+	var dialer net.Dialer
+	remoteConn, err := dialer.DialContext(ctx, "tcp", sessionUrl.Host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	defer remoteConn.Close()
+
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "postgres",
+		UserClientIp:       conf.UserClientIp.String(),
+	}
+
+	if err := conf.Session.RequestConnectConnection(ctx, connectionInfo); err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Get a wrapped net.Conn so we can use io.Copy, counting the bytes sent
+	// up to and down from the endpoint
+	netConn := proxy.NewCountingConn(websocket.NetConn(ctx, conf.ClientConn, websocket.MessageBinary))
+	defer netConn.Close()
+	if err := conf.Session.ApplyConnectionCounterCallbacks(conf.ConnectionId, netConn.BytesRead, netConn.BytesWritten); err != nil {
+		return fmt.Errorf("error setting connection counters: %w", err)
+	}
+
+	params, err := readClientStartup(netConn)
+	if err != nil {
+		_ = writeErrorResponse(netConn, "08P01", err.Error())
+		return fmt.Errorf("error reading startup message from client: %w", err)
+	}
+	if tlsConfig != nil {
+		tlsConn, err := startTls(ctx, remoteConn, tlsConfig)
+		if err != nil {
+			_ = writeErrorResponse(netConn, "08006", "could not secure the connection to the endpoint")
+			return fmt.Errorf("error securing connection to endpoint: %w", err)
+		}
+		defer tlsConn.Close()
+		remoteConn = tlsConn
+	}
+	params = setParameter(params, "user", username)
+	if _, err := remoteConn.Write(startupMessage(params)); err != nil {
+		return fmt.Errorf("error sending startup message to endpoint: %w", err)
+	}
+	if err := authenticate(remoteConn, netConn, username, password, verified); err != nil {
+		return err
+	}
+
+	var fromEndpoint, fromClient io.Reader = remoteConn, netConn
+	if opts.WithRecorder != nil {
+		fromEndpoint = opts.WithRecorder.RecordOutput(remoteConn)
+		fromClient = opts.WithRecorder.RecordInput(netConn)
+	}
+
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(netConn, fromEndpoint)
+		_ = netConn.Close()
+		_ = remoteConn.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		_, _ = io.Copy(remoteConn, fromClient)
+		_ = remoteConn.Close()
+		_ = netConn.Close()
+	}()
+	connWg.Wait()
+	return nil
+}
+
+// endpointCredential returns the username and password of the first
+// username_password credential in creds.
+func endpointCredential(creds []*pbs.Credential) (string, string, error) {
+	for _, c := range creds {
+		if up, ok := c.GetCredential().(*pbs.Credential_UsernamePassword); ok {
+			return up.UsernamePassword.GetUsername(), up.UsernamePassword.GetPassword(), nil
+		}
+	}
+	return "", "", errors.New("missing username_password injected application credentials for postgres endpoint")
+}
+
+// endpointTlsConfig returns the configuration of the TLS connection to the
+// endpoint at host for sslMode, or nil if the connection is not secured. The
+// certificate of the endpoint is verified against the PEM encoded certificate
+// authorities in sslRootCert, or the system ones if it is empty.
+func endpointTlsConfig(host, sslMode, sslRootCert string) (*tls.Config, error) {
+	var rootCAs *x509.CertPool
+	if sslRootCert != "" {
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM([]byte(sslRootCert)) {
+			return nil, errors.New("invalid ssl root certificate for postgres endpoint")
+		}
+	}
+	switch sslMode {
+	case sslModeDisable:
+		return nil, nil
+	case sslModeRequire:
+		return &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true,
+		}, nil
+	case sslModeVerifyCa:
+		// The certificate chain is verified without checking the name of the
+		// endpoint, which tls.Config only supports by skipping the
+		// verification and doing it here instead.
+		return &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true,
+			VerifyConnection: func(cs tls.ConnectionState) error {
+				if len(cs.PeerCertificates) == 0 {
+					return errors.New("endpoint did not present a certificate")
+				}
+				intermediates := x509.NewCertPool()
+				for _, c := range cs.PeerCertificates[1:] {
+					intermediates.AddCert(c)
+				}
+				_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+					Roots:         rootCAs,
+					Intermediates: intermediates,
+				})
+				return err
+			},
+		}, nil
+	case sslModeVerifyFull, "":
+		return &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: host,
+			RootCAs:    rootCAs,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid ssl mode for postgres endpoint: %v", sslMode)
+	}
+}
+
+// startTls asks the endpoint on conn to use TLS and returns the TLS
+// connection to it once the handshake completes.
+func startTls(ctx context.Context, conn net.Conn, config *tls.Config) (*tls.Conn, error) {
+	req := make([]byte, 8)
+	binary.BigEndian.PutUint32(req, 8)
+	binary.BigEndian.PutUint32(req[4:], sslRequestCode)
+	if _, err := conn.Write(req); err != nil {
+		return nil, err
+	}
+	var resp [1]byte
+	if _, err := io.ReadFull(conn, resp[:]); err != nil {
+		return nil, err
+	}
+	if resp[0] != 'S' {
+		return nil, errors.New("endpoint does not support TLS")
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// parameter is a run-time parameter sent in the startup message.
+type parameter struct {
+	name, value string
+}
+
+// readClientStartup reads the startup message of the client and returns its
+// parameters. SSL and GSSAPI encryption requests sent before the startup
+// message are declined.
+func readClientStartup(conn io.ReadWriter) ([]parameter, error) {
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(conn, header[:]); err != nil {
+			return nil, err
+		}
+		l := int(binary.BigEndian.Uint32(header[:]))
+		if l < 8 || l > maxStartupPacketLen {
+			return nil, fmt.Errorf("invalid startup packet length %d", l)
+		}
+		body := make([]byte, l-4)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return nil, err
+		}
+
+		switch code := binary.BigEndian.Uint32(body); code {
+		case sslRequestCode, gssEncRequestCode:
+			if _, err := conn.Write([]byte{'N'}); err != nil {
+				return nil, err
+			}
+		case cancelRequestCode:
+			return nil, errors.New("cancel requests are not supported")
+		case protocolVersion3:
+			return parseParameters(body[4:])
+		default:
+			return nil, fmt.Errorf("unsupported frontend protocol %d.%d", code>>16, code&0xffff)
+		}
+	}
+}
+
+// parseParameters parses the name and value pairs of a startup message, which
+// are terminated by an empty name.
+func parseParameters(b []byte) ([]parameter, error) {
+	var params []parameter
+	for {
+		name, rest, err := readCString(b)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return params, nil
+		}
+		value, rest, err := readCString(rest)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, parameter{name: name, value: value})
+		b = rest
+	}
+}
+
+// setParameter replaces the value of the named parameter, adding it if it is
+// missing.
+func setParameter(params []parameter, name, value string) []parameter {
+	for i := range params {
+		if params[i].name == name {
+			params[i].value = value
+			return params
+		}
+	}
+	return append(params, parameter{name: name, value: value})
+}
+
+// startupMessage encodes a protocol 3.0 startup message with params.
+func startupMessage(params []parameter) []byte {
+	b := make([]byte, 8, 64)
+	binary.BigEndian.PutUint32(b[4:], protocolVersion3)
+	for _, p := range params {
+		b = append(b, p.name...)
+		b = append(b, 0)
+		b = append(b, p.value...)
+		b = append(b, 0)
+	}
+	b = append(b, 0)
+	binary.BigEndian.PutUint32(b, uint32(len(b)))
+	return b
+}
+
+// authenticate answers the authentication requests of the endpoint until it
+// accepts the credential. The AuthenticationOk message and any notices are
+// forwarded to the client. If the endpoint rejects the credential its error
+// is forwarded to the client and returned. Cleartext and MD5 password requests
+// are refused unless the connection to the endpoint uses TLS with a verified
+// certificate, since anyone able to intercept it could otherwise obtain the
+// password.
+func authenticate(endpoint io.ReadWriter, client io.Writer, username, password string, verified bool) error {
+	var scram *scramClient
+	for {
+		typ, body, err := readMessage(endpoint)
+		if err != nil {
+			return fmt.Errorf("error reading authentication message from endpoint: %w", err)
+		}
+		switch typ {
+		case 'E':
+			_, _ = client.Write(message(typ, body))
+			return fmt.Errorf("endpoint returned error during authentication: %s", errorMessage(body))
+		case 'N':
+			if _, err := client.Write(message(typ, body)); err != nil {
+				return err
+			}
+			continue
+		case 'R':
+		default:
+			return fmt.Errorf("unexpected message %q from endpoint during authentication", typ)
+		}
+		if len(body) < 4 {
+			return errors.New("invalid authentication request from endpoint")
+		}
+
+		code, data := binary.BigEndian.Uint32(body), body[4:]
+		if (code == authCleartextPassword || code == authMD5Password) && !verified {
+			_ = writeErrorResponse(client, "28000", "endpoint requested password authentication without verified TLS")
+			return errors.New("refusing to send password to endpoint without verified TLS")
+		}
+
+		var resp []byte
+		switch code {
+		case authOk:
+			if _, err := client.Write(message(typ, body)); err != nil {
+				return err
+			}
+			return nil
+		case authCleartextPassword:
+			resp = message('p', cString(password))
+		case authMD5Password:
+			if len(data) != 4 {
+				return errors.New("invalid md5 password request from endpoint")
+			}
+			resp = message('p', cString(md5Password(username, password, data)))
+		case authSASL:
+			if !hasMechanism(data, scramSha256) {
+				return errors.New("endpoint does not support SCRAM-SHA-256 authentication")
+			}
+			if scram, err = newScramClient("", password); err != nil {
+				return err
+			}
+			first := scram.clientFirstMessage()
+			b := cString(scramSha256)
+			b = binary.BigEndian.AppendUint32(b, uint32(len(first)))
+			resp = message('p', append(b, first...))
+		case authSASLContinue:
+			if scram == nil {
+				return errors.New("unexpected SASL continue message from endpoint")
+			}
+			final, err := scram.clientFinalMessage(data)
+			if err != nil {
+				return err
+			}
+			resp = message('p', final)
+		case authSASLFinal:
+			if scram == nil {
+				return errors.New("unexpected SASL final message from endpoint")
+			}
+			if err := scram.verifyServerFinalMessage(data); err != nil {
+				return err
+			}
+			continue
+		default:
+			_ = writeErrorResponse(client, "28000", "unsupported authentication method requested by endpoint")
+			return fmt.Errorf("unsupported authentication request %d from endpoint", code)
+		}
+		if _, err := endpoint.Write(resp); err != nil {
+			return fmt.Errorf("error sending authentication response to endpoint: %w", err)
+		}
+	}
+}
+
+// md5Password returns the response to an MD5 password request with salt.
+func md5Password(username, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + username))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// hasMechanism reports whether the list of SASL mechanisms in b contains
+// name.
+func hasMechanism(b []byte, name string) bool {
+	for {
+		m, rest, err := readCString(b)
+		if err != nil || m == "" {
+			return false
+		}
+		if m == name {
+			return true
+		}
+		b = rest
+	}
+}
+
+// readMessage reads a message with its type and returns the type and the body
+// of the message.
+func readMessage(r io.Reader) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	l := int(binary.BigEndian.Uint32(header[1:]))
+	if l < 4 || l > maxAuthMessageLen {
+		return 0, nil, fmt.Errorf("invalid message length %d", l)
+	}
+	body := make([]byte, l-4)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header[0], body, nil
+}
+
+// message encodes a message of type typ with body.
+func message(typ byte, body []byte) []byte {
+	b := make([]byte, 5, 5+len(body))
+	b[0] = typ
+	binary.BigEndian.PutUint32(b[1:], uint32(len(body)+4))
+	return append(b, body...)
+}
+
+// writeErrorResponse sends a fatal ErrorResponse message with the SQLSTATE
+// code and msg to w.
+func writeErrorResponse(w io.Writer, code, msg string) error {
+	var b []byte
+	for _, f := range []struct {
+		typ   byte
+		value string
+	}{
+		{'S', "FATAL"},
+		{'V', "FATAL"},
+		{'C', code},
+		{'M', msg},
+	} {
+		b = append(b, f.typ)
+		b = append(b, cString(f.value)...)
+	}
+	b = append(b, 0)
+	_, err := w.Write(message('E', b))
+	return err
+}
+
+// errorMessage returns the message field of the body of an ErrorResponse.
+func errorMessage(b []byte) string {
+	for len(b) > 0 && b[0] != 0 {
+		typ := b[0]
+		value, rest, err := readCString(b[1:])
+		if err != nil {
+			break
+		}
+		if typ == 'M' {
+			return value
+		}
+		b = rest
+	}
+	return "unknown error"
+}
+
+func cString(s string) []byte {
+	return append([]byte(s), 0)
+}
+
+// readCString returns the null terminated string at the start of b and the
+// bytes following it.
+func readCString(b []byte) (string, []byte, error) {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i]), b[i+1:], nil
+		}
+	}
+	return "", nil, errors.New("missing string terminator")
+}
@@ -0,0 +1,489 @@
+package postgres
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/hashicorp/boundary/testing/dbtest"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+)
+
+const (
+	testUsername = "user"
+	testPassword = "secret"
+)
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	cleanup, dbUrl, dbName, err := dbtest.StartUsingTemplate(dbtest.Postgres)
+	require.NoError(err)
+	t.Cleanup(func() {
+		require.NoError(cleanup())
+	})
+	u, err := url.Parse(dbUrl)
+	require.NoError(err)
+	password, _ := u.User.Password()
+
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+	conf := proxy.Config{
+		ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("postgres://%s?%s=%s", u.Host, globals.EndpointSslModeParam, sslModeDisable),
+		Session:        testSession(t, ctx),
+		ConnectionId:   "mock-connection",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+	creds := []*pbs.Credential{usernamePassword(u.User.Username(), password)}
+	errChan := make(chan error)
+	go func() {
+		errChan <- handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(creds))
+	}()
+
+	// The client does not know the credential, so it connects as another user
+	// without a password.
+	config, err := pgx.ParseConfig(fmt.Sprintf("postgres://someone@127.0.0.1:5432/%s?sslmode=disable", dbName))
+	require.NoError(err)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	config.DialFunc = func(context.Context, string, string) (net.Conn, error) {
+		return netConn, nil
+	}
+	conn, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(err)
+
+	var user, database string
+	require.NoError(conn.QueryRow(ctx, "select current_user, current_database()").Scan(&user, &database))
+	assert.Equal(u.User.Username(), user)
+	assert.Equal(dbName, database)
+
+	require.NoError(conn.Close(ctx))
+	select {
+	case err := <-errChan:
+		assert.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("proxy did not return after the client closed the connection")
+	}
+}
+
+func TestHandleProxy_Authentication(t *testing.T) {
+	t.Parallel()
+	cert, rootCert := testCertificate(t)
+	_, otherRootCert := testCertificate(t)
+	tests := []struct {
+		name        string
+		authCode    uint32
+		password    string
+		sslMode     string
+		rootCert    string
+		endpointTls bool
+		wantErr     string
+	}{
+		{
+			name:        "cleartext",
+			authCode:    authCleartextPassword,
+			password:    testPassword,
+			sslMode:     sslModeVerifyFull,
+			rootCert:    rootCert,
+			endpointTls: true,
+		},
+		{
+			name:        "md5",
+			authCode:    authMD5Password,
+			password:    testPassword,
+			sslMode:     sslModeVerifyCa,
+			rootCert:    rootCert,
+			endpointTls: true,
+		},
+		{
+			name:        "cleartext-require",
+			authCode:    authCleartextPassword,
+			password:    testPassword,
+			sslMode:     sslModeRequire,
+			endpointTls: true,
+			wantErr:     "endpoint requested password authentication without verified TLS",
+		},
+		{
+			name:        "md5-require",
+			authCode:    authMD5Password,
+			password:    testPassword,
+			sslMode:     sslModeRequire,
+			endpointTls: true,
+			wantErr:     "endpoint requested password authentication without verified TLS",
+		},
+		{
+			name:        "wrong-password",
+			authCode:    authCleartextPassword,
+			password:    "other",
+			sslMode:     sslModeVerifyFull,
+			rootCert:    rootCert,
+			endpointTls: true,
+			wantErr:     "password authentication failed",
+		},
+		{
+			name:     "cleartext-without-tls",
+			authCode: authCleartextPassword,
+			password: testPassword,
+			sslMode:  sslModeDisable,
+			wantErr:  "endpoint requested password authentication without verified TLS",
+		},
+		{
+			name:     "md5-without-tls",
+			authCode: authMD5Password,
+			password: testPassword,
+			sslMode:  sslModeDisable,
+			wantErr:  "endpoint requested password authentication without verified TLS",
+		},
+		{
+			name:     "endpoint-without-tls",
+			authCode: authCleartextPassword,
+			password: testPassword,
+			sslMode:  sslModeVerifyFull,
+			rootCert: rootCert,
+			wantErr:  "could not secure the connection to the endpoint",
+		},
+		{
+			name:        "untrusted-certificate",
+			authCode:    authCleartextPassword,
+			password:    testPassword,
+			sslMode:     sslModeVerifyFull,
+			rootCert:    otherRootCert,
+			endpointTls: true,
+			wantErr:     "could not secure the connection to the endpoint",
+		},
+		{
+			name:        "untrusted-certificate-verify-ca",
+			authCode:    authCleartextPassword,
+			password:    testPassword,
+			sslMode:     sslModeVerifyCa,
+			rootCert:    otherRootCert,
+			endpointTls: true,
+			wantErr:     "could not secure the connection to the endpoint",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			var endpointTls *tls.Config
+			if tt.endpointTls {
+				endpointTls = &tls.Config{Certificates: []tls.Certificate{cert}}
+			}
+			endpointAddr, startupParams := testEndpoint(t, tt.authCode, tt.password, endpointTls)
+			endpointParams := url.Values{globals.EndpointSslModeParam: []string{tt.sslMode}}
+			if tt.rootCert != "" {
+				endpointParams.Set(globals.EndpointSslRootCertParam, tt.rootCert)
+			}
+			clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+			require.NotNil(clientConn)
+			require.NotNil(proxyConn)
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				ClientConn:     proxyConn,
+				RemoteEndpoint: fmt.Sprintf("postgres://%s?%s", endpointAddr, endpointParams.Encode()),
+				Session:        testSession(t, ctx),
+				ConnectionId:   "mock-connection",
+				UserClientIp:   net.ParseIP("127.0.0.1"),
+			}
+			creds := []*pbs.Credential{usernamePassword(testUsername, testPassword)}
+			errChan := make(chan error)
+			go func() {
+				errChan <- handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(creds))
+			}()
+
+			netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+			defer netConn.Close()
+			sslRequest := make([]byte, 8)
+			binary.BigEndian.PutUint32(sslRequest, 8)
+			binary.BigEndian.PutUint32(sslRequest[4:], sslRequestCode)
+			_, err := netConn.Write(sslRequest)
+			require.NoError(err)
+			resp := make([]byte, 1)
+			_, err = netConn.Read(resp)
+			require.NoError(err)
+			assert.Equal("N", string(resp))
+
+			_, err = netConn.Write(startupMessage([]parameter{{"user", "someone"}, {"database", "db"}}))
+			require.NoError(err)
+
+			typ, body, err := readMessage(netConn)
+			require.NoError(err)
+			if tt.wantErr != "" {
+				assert.Equal(byte('E'), typ)
+				assert.Equal(tt.wantErr, errorMessage(body))
+				_ = netConn.Close()
+				assert.Error(<-errChan)
+				return
+			}
+			assert.Equal(byte('R'), typ)
+			assert.Equal(uint32(authOk), binary.BigEndian.Uint32(body))
+
+			// Messages after the authentication are forwarded as they are.
+			typ, body, err = readMessage(netConn)
+			require.NoError(err)
+			assert.Equal(byte('Z'), typ)
+			assert.Equal("I", string(body))
+			assert.Equal([]parameter{{"user", testUsername}, {"database", "db"}}, <-startupParams)
+
+			require.NoError(netConn.Close())
+			select {
+			case err := <-errChan:
+				assert.NoError(err)
+			case <-time.After(10 * time.Second):
+				t.Fatal("proxy did not return after the client closed the connection")
+			}
+		})
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := testSession(t, ctx)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		creds    []*pbs.Credential
+	}{
+		{
+			name:     "invalid-scheme",
+			endpoint: "tcp://localhost:5432",
+			creds:    []*pbs.Credential{usernamePassword(testUsername, testPassword)},
+		},
+		{
+			name:     "missing-credentials",
+			endpoint: "postgres://localhost:5432",
+		},
+		{
+			name:     "invalid-ssl-mode",
+			endpoint: "postgres://localhost:5432?sslmode=prefer",
+			creds:    []*pbs.Credential{usernamePassword(testUsername, testPassword)},
+		},
+		{
+			name:     "invalid-ssl-root-cert",
+			endpoint: "postgres://localhost:5432?sslmode=verify-full&sslrootcert=invalid",
+			creds:    []*pbs.Credential{usernamePassword(testUsername, testPassword)},
+		},
+		{
+			name:     "missing-username-password",
+			endpoint: "postgres://localhost:5432",
+			creds: []*pbs.Credential{
+				{
+					Credential: &pbs.Credential_SshPrivateKey{
+						SshPrivateKey: &pbs.SshPrivateKey{
+							Username:   testUsername,
+							PrivateKey: "key",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				Session:        s,
+				ConnectionId:   "mock-connection",
+			}
+			assert.Error(t, handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(tt.creds)))
+		})
+	}
+}
+
+// testEndpoint starts a postgres endpoint which accepts a single connection.
+// It accepts SSL requests using tlsConfig, declining them if it is nil. It
+// requests the password with authCode and accepts it if it is password,
+// after which it reports it is ready for a query. The parameters of the
+// startup message it received are sent on the returned channel.
+func testEndpoint(t *testing.T, authCode uint32, password string, tlsConfig *tls.Config) (string, <-chan []parameter) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	startupParams := make(chan []parameter, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var body []byte
+		for {
+			var header [4]byte
+			if _, err := io.ReadFull(conn, header[:]); err != nil {
+				return
+			}
+			body = make([]byte, binary.BigEndian.Uint32(header[:])-4)
+			if _, err := io.ReadFull(conn, body); err != nil {
+				return
+			}
+			if binary.BigEndian.Uint32(body) != sslRequestCode {
+				break
+			}
+			if tlsConfig == nil {
+				if _, err := conn.Write([]byte{'N'}); err != nil {
+					return
+				}
+				continue
+			}
+			if _, err := conn.Write([]byte{'S'}); err != nil {
+				return
+			}
+			tlsConn := tls.Server(conn, tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			defer tlsConn.Close()
+			conn = tlsConn
+		}
+		params, err := parseParameters(body[4:])
+		if err != nil {
+			return
+		}
+		startupParams <- params
+
+		salt := []byte{1, 2, 3, 4}
+		req := binary.BigEndian.AppendUint32(nil, authCode)
+		want := password
+		if authCode == authMD5Password {
+			req = append(req, salt...)
+			want = md5Password(testUsername, password, salt)
+		}
+		if _, err := conn.Write(message('R', req)); err != nil {
+			return
+		}
+		typ, resp, err := readMessage(conn)
+		if err != nil || typ != 'p' {
+			return
+		}
+		if string(resp) != want+"\x00" {
+			_ = writeErrorResponse(conn, "28P01", "password authentication failed")
+			return
+		}
+		_, _ = conn.Write(message('R', binary.BigEndian.AppendUint32(nil, authOk)))
+		_, _ = conn.Write(message('Z', []byte("I")))
+		_, _ = conn.Read(make([]byte, 1))
+	}()
+	return l.Addr().String(), startupParams
+}
+
+// testCertificate returns a self-signed certificate for 127.0.0.1 and its PEM
+// encoding.
+func testCertificate(t *testing.T) (tls.Certificate, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "postgres"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(5 * time.Minute),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func usernamePassword(username, password string) *pbs.Credential {
+	return &pbs.Credential{
+		Credential: &pbs.Credential_UsernamePassword{
+			UsernamePassword: &pbs.UsernamePassword{
+				Username: username,
+				Password: password,
+			},
+		},
+	}
+}
+
+func testSession(t *testing.T, ctx context.Context) session.Session {
+	t.Helper()
+	_, sessionKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(5 * time.Minute),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, sessionKey.Public(), sessionKey)
+	require.NoError(t, err)
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	sessClient.LookupSessionFn = func(_ context.Context, request *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   request.GetSessionId(),
+				Certificate: cert,
+				PrivateKey:  sessionKey,
+			},
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+		}, nil
+	}
+	sessClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "mock-connection",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sessClient.ConnectConnectionFn = func(_ context.Context, _ *pbs.ConnectConnectionRequest) (*pbs.ConnectConnectionResponse, error) {
+		return &pbs.ConnectConnectionResponse{
+			Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+		}, nil
+	}
+	manager, err := session.NewManager(sessClient)
+	require.NoError(t, err)
+	s, err := manager.LoadLocalSession(ctx, "one", "workerid")
+	require.NoError(t, err)
+	_, connCancelFn := context.WithCancel(context.Background())
+	_, _, err = s.RequestAuthorizeConnection(ctx, "workerid", connCancelFn)
+	require.NoError(t, err)
+	return s
+}
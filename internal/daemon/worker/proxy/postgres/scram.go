@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// scramNonceLen is the number of random bytes in the client nonce.
+const scramNonceLen = 18
+
+// scramClient is the client side of a SCRAM-SHA-256 exchange as described in
+// RFC 5802 and RFC 7677. Channel binding is not supported.
+type scramClient struct {
+	password        string
+	clientNonce     string
+	clientFirstBare string
+	authMessage     string
+	saltedPassword  []byte
+}
+
+// newScramClient returns a scramClient with a random nonce. Postgres ignores
+// the username of the exchange in favor of the user of the startup message,
+// so it can be left empty.
+func newScramClient(username, password string) (*scramClient, error) {
+	b := make([]byte, scramNonceLen)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("error generating scram nonce: %w", err)
+	}
+	return newScramClientWithNonce(username, password, base64.StdEncoding.EncodeToString(b)), nil
+}
+
+func newScramClientWithNonce(username, password, nonce string) *scramClient {
+	return &scramClient{
+		password:        password,
+		clientNonce:     nonce,
+		clientFirstBare: "n=" + username + ",r=" + nonce,
+	}
+}
+
+// clientFirstMessage returns the client-first-message of the exchange.
+func (c *scramClient) clientFirstMessage() []byte {
+	return []byte("n,," + c.clientFirstBare)
+}
+
+// clientFinalMessage returns the client-final-message of the exchange which
+// proves the client knows the password to the server sending the
+// server-first-message.
+func (c *scramClient) clientFinalMessage(serverFirst []byte) ([]byte, error) {
+	var nonce, salt string
+	var iterations int
+	for _, attr := range strings.Split(string(serverFirst), ",") {
+		switch {
+		case strings.HasPrefix(attr, "r="):
+			nonce = attr[2:]
+		case strings.HasPrefix(attr, "s="):
+			salt = attr[2:]
+		case strings.HasPrefix(attr, "i="):
+			var err error
+			if iterations, err = strconv.Atoi(attr[2:]); err != nil {
+				return nil, fmt.Errorf("invalid scram iteration count: %w", err)
+			}
+		}
+	}
+	switch {
+	case !strings.HasPrefix(nonce, c.clientNonce) || len(nonce) == len(c.clientNonce):
+		return nil, errors.New("invalid scram server nonce")
+	case iterations <= 0:
+		return nil, errors.New("invalid scram iteration count")
+	}
+	saltBytes, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil || len(saltBytes) == 0 {
+		return nil, errors.New("invalid scram salt")
+	}
+
+	// "biws" is the base64 encoding of the "n,," gs2 header.
+	clientFinalWithoutProof := "c=biws,r=" + nonce
+	c.authMessage = c.clientFirstBare + "," + string(serverFirst) + "," + clientFinalWithoutProof
+	c.saltedPassword = pbkdf2.Key([]byte(c.password), saltBytes, iterations, sha256.Size, sha256.New)
+
+	clientKey := hmacSha256(c.saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := hmacSha256(storedKey[:], c.authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	return []byte(clientFinalWithoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+// verifyServerFinalMessage checks that the server-final-message proves the
+// server knows the password.
+func (c *scramClient) verifyServerFinalMessage(serverFinal []byte) error {
+	if c.saltedPassword == nil {
+		return errors.New("scram server final message received before client final message")
+	}
+	msg := string(serverFinal)
+	if strings.HasPrefix(msg, "e=") {
+		return fmt.Errorf("scram authentication failed: %s", msg[2:])
+	}
+	if !strings.HasPrefix(msg, "v=") {
+		return errors.New("invalid scram server final message")
+	}
+	signature, err := base64.StdEncoding.DecodeString(msg[2:])
+	if err != nil {
+		return errors.New("invalid scram server signature")
+	}
+	serverKey := hmacSha256(c.saltedPassword, "Server Key")
+	if !hmac.Equal(signature, hmacSha256(serverKey, c.authMessage)) {
+		return errors.New("invalid scram server signature")
+	}
+	return nil
+}
+
+func hmacSha256(key []byte, msg string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScramClient uses the SCRAM-SHA-256 example of RFC 7677, section 3.
+func TestScramClient(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	const serverFirst = "r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"
+
+	c := newScramClientWithNonce("user", "pencil", "rOprNGfwEbeRWgbNEkqO")
+	assert.Equal("n,,n=user,r=rOprNGfwEbeRWgbNEkqO", string(c.clientFirstMessage()))
+
+	final, err := c.clientFinalMessage([]byte(serverFirst))
+	require.NoError(err)
+	assert.Equal("c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", string(final))
+
+	assert.NoError(c.verifyServerFinalMessage([]byte("v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=")))
+	assert.Error(c.verifyServerFinalMessage([]byte("v=AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")))
+	assert.Error(c.verifyServerFinalMessage([]byte("e=invalid-proof")))
+}
+
+func TestScramClient_InvalidServerFirst(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		serverFirst string
+	}{
+		{
+			name:        "other-nonce",
+			serverFirst: "r=someoneelse,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096",
+		},
+		{
+			name:        "missing-server-nonce",
+			serverFirst: "r=rOprNGfwEbeRWgbNEkqO,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096",
+		},
+		{
+			name:        "invalid-salt",
+			serverFirst: "r=rOprNGfwEbeRWgbNEkqO%hvYD,s=!!,i=4096",
+		},
+		{
+			name:        "missing-iterations",
+			serverFirst: "r=rOprNGfwEbeRWgbNEkqO%hvYD,s=W22ZaJ0SNY7soEsUEjb6gQ==",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := newScramClientWithNonce("user", "pencil", "rOprNGfwEbeRWgbNEkqO")
+			_, err := c.clientFinalMessage([]byte(tt.serverFirst))
+			assert.Error(t, err)
+		})
+	}
+}
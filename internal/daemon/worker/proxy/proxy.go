@@ -59,6 +59,10 @@ var (
 
 	// ErrProtocolAlreadyRegistered specifies the provided protocol has already been registered
 	ErrProtocolAlreadyRegistered = errors.New("proxy: protocol already registered")
+
+	// ErrIdleTimeout specifies the connection was closed by the proxy since it
+	// carried no traffic for the idle timeout
+	ErrIdleTimeout = errors.New("proxy: connection idle timeout")
 )
 
 // RegisterHandler registers the handler to call for the protocol. The protocol is
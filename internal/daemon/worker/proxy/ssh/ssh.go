@@ -0,0 +1,392 @@
+// Package ssh provides the worker proxy handler for ssh targets. Importing
+// this package registers the handler for the "ssh" protocol.
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"golang.org/x/crypto/ssh"
+	"nhooyr.io/websocket"
+)
+
+// sessionChannelType is the type of the channels opened by the client for
+// shells, commands and subsystems. They are always forwarded to the endpoint.
+const sessionChannelType = "session"
+
+func init() {
+	err := proxy.RegisterHandler("ssh", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy terminates the ssh connection of the client arriving on the
+// websocket conn and opens a new ssh connection with the remote endpoint. The
+// connection with the endpoint is authenticated with the injected application
+// credentials of the session, so the client never sees them. Channels and
+// requests are then forwarded between the two connections. handleProxy sets
+// the connectionId as connected in the repository.
+//
+// Only the session channels opened by the client are forwarded, along with
+// the channel types given with the WithSshForwardedChannelTypes option, which
+// may be opened by either side. Global requests are only forwarded when their
+// type is given with the WithSshForwardedGlobalRequests option. Other channels
+// and global requests, such as port forwarding, are refused.
+//
+// The client has already been authenticated and authorized by the session
+// TLS connection so it is not asked for any credentials. The worker presents
+// the session private key as its host key. The endpoint must present the host
+// key of the target, passed in the host_key query parameter of the endpoint,
+// before any credentials are sent to it; handleProxy refuses to connect when
+// the target has no host key.
+//
+// handleProxy blocks until either ssh connection is closed or ctx is done.
+//
+// If the WithRecorder option is provided the bytes sent in both directions of
+// the channels opened by the client are recorded.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "ssh" {
+		return fmt.Errorf("invalid scheme for ssh proxy: %v", sessionUrl.Scheme)
+	}
+	clientConfig, err := endpointClientConfig(sessionUrl.Query().Get(globals.EndpointHostKeyParam), opts.WithInjectedApplicationCredentials)
+	if err != nil {
+		return err
+	}
+	hostKey, err := hostKeySigner(conf.Session.GetPrivateKey())
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	remoteConn, err := dialer.DialContext(ctx, "tcp", sessionUrl.Host)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	endpointConn, endpointChans, endpointReqs, err := ssh.NewClientConn(remoteConn, sessionUrl.Host, clientConfig)
+	if err != nil {
+		_ = remoteConn.Close()
+		return fmt.Errorf("error establishing ssh connection with endpoint: %w", err)
+	}
+	defer endpointConn.Close()
+
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "ssh",
+		UserClientIp:       conf.UserClientIp.String(),
+	}
+
+	if err := conf.Session.RequestConnectConnection(ctx, connectionInfo); err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Get a wrapped net.Conn so the ssh server can use it, counting the bytes
+	// sent up to and down from the endpoint
+	netConn := proxy.NewCountingConn(websocket.NetConn(ctx, conf.ClientConn, websocket.MessageBinary))
+	if err := conf.Session.ApplyConnectionCounterCallbacks(conf.ConnectionId, netConn.BytesRead, netConn.BytesWritten); err != nil {
+		return fmt.Errorf("error setting connection counters: %w", err)
+	}
+	serverConfig := &ssh.ServerConfig{
+		NoClientAuth: true,
+	}
+	serverConfig.AddHostKey(hostKey)
+	clientConn, clientChans, clientReqs, err := ssh.NewServerConn(netConn, serverConfig)
+	if err != nil {
+		_ = netConn.Close()
+		return fmt.Errorf("error establishing ssh connection with client: %w", err)
+	}
+	defer clientConn.Close()
+
+	clientChanTypes := typeSet(opts.WithSshForwardedChannelTypes, sessionChannelType)
+	endpointChanTypes := typeSet(opts.WithSshForwardedChannelTypes)
+	reqTypes := typeSet(opts.WithSshForwardedGlobalRequests)
+	go forwardGlobalRequests(endpointConn, clientReqs, reqTypes)
+	go forwardGlobalRequests(clientConn, endpointReqs, reqTypes)
+	go forwardChannels(endpointConn, clientChans, clientChanTypes, opts.WithRecorder)
+	go forwardChannels(clientConn, endpointChans, endpointChanTypes, nil)
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_ = clientConn.Wait()
+		done <- struct{}{}
+	}()
+	go func() {
+		_ = endpointConn.Wait()
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// endpointClientConfig returns the configuration used to open the ssh
+// connection with the endpoint. The endpoint must present hostKey, a public
+// key in authorized_keys format. The ssh_private_key and username_password
+// credentials in creds are used to authenticate. The username is taken from
+// the first of these credentials. Keyboard interactive authentication is only
+// used to answer password prompts.
+func endpointClientConfig(hostKey string, creds []*pbs.Credential) (*ssh.ClientConfig, error) {
+	// The injected credentials would be sent to whoever answers on the
+	// endpoint address if its key was not verified.
+	if hostKey == "" {
+		return nil, errors.New("ssh target has no host key, refusing to send injected application credentials to an unverified endpoint")
+	}
+	endpointKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ssh target host key: %w", err)
+	}
+
+	var username string
+	var signers []ssh.Signer
+	var passwords []string
+	for _, c := range creds {
+		switch cred := c.GetCredential().(type) {
+		case *pbs.Credential_SshPrivateKey:
+			var signer ssh.Signer
+			var err error
+			key := cred.SshPrivateKey
+			if key.GetPrivateKeyPassphrase() != "" {
+				signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key.GetPrivateKey()), []byte(key.GetPrivateKeyPassphrase()))
+			} else {
+				signer, err = ssh.ParsePrivateKey([]byte(key.GetPrivateKey()))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error parsing ssh private key credential: %w", err)
+			}
+			signers = append(signers, signer)
+			if username == "" {
+				username = key.GetUsername()
+			}
+		case *pbs.Credential_UsernamePassword:
+			passwords = append(passwords, cred.UsernamePassword.GetPassword())
+			if username == "" {
+				username = cred.UsernamePassword.GetUsername()
+			}
+		}
+	}
+	if username == "" {
+		return nil, errors.New("missing injected application credentials for ssh endpoint")
+	}
+
+	// Each auth method is only tried once by the ssh client, so all the keys
+	// and passwords are offered through a single method of each kind.
+	var auth []ssh.AuthMethod
+	if len(signers) > 0 {
+		auth = append(auth, ssh.PublicKeys(signers...))
+	}
+	if len(passwords) > 0 {
+		auth = append(auth,
+			ssh.RetryableAuthMethod(ssh.PasswordCallback(passwordCallback(passwords)), len(passwords)),
+			ssh.KeyboardInteractive(passwordChallenge(passwords[0])),
+		)
+	}
+	return &ssh.ClientConfig{
+		User:              username,
+		Auth:              auth,
+		HostKeyCallback:   ssh.FixedHostKey(endpointKey),
+		HostKeyAlgorithms: hostKeyAlgorithms(endpointKey),
+	}, nil
+}
+
+// hostKeyAlgorithms returns the host key algorithms the endpoint is asked to
+// use so it presents a key of the same type as key. RSA keys can be used with
+// several signature algorithms.
+func hostKeyAlgorithms(key ssh.PublicKey) []string {
+	if key.Type() == ssh.KeyAlgoRSA {
+		return []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA}
+	}
+	return []string{key.Type()}
+}
+
+// passwordCallback returns a callback which returns the next password each
+// time it is called.
+func passwordCallback(passwords []string) func() (string, error) {
+	var i int
+	return func() (string, error) {
+		if i >= len(passwords) {
+			return "", errors.New("no more passwords")
+		}
+		p := passwords[i]
+		i++
+		return p, nil
+	}
+}
+
+// passwordChallenge returns a keyboard interactive challenge which answers
+// password prompts with password. A password prompt does not echo the answer
+// and asks for a password. The challenge fails on any other prompt, so the
+// password is not sent as the answer to an unrelated question, such as a one
+// time code.
+func passwordChallenge(password string) ssh.KeyboardInteractiveChallenge {
+	return func(_, _ string, questions []string, echos []bool) ([]string, error) {
+		answers := make([]string, len(questions))
+		for i, q := range questions {
+			if i >= len(echos) || echos[i] || !strings.Contains(strings.ToLower(q), "password") {
+				return nil, fmt.Errorf("unable to answer keyboard interactive prompt %q", q)
+			}
+			answers[i] = password
+		}
+		return answers, nil
+	}
+}
+
+// hostKeySigner returns the signer for the session private key which is
+// used as the host key presented to the client.
+func hostKeySigner(privateKey []byte) (ssh.Signer, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid session private key")
+	}
+	signer, err := ssh.NewSignerFromKey(ed25519.PrivateKey(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("error creating ssh host key from session private key: %w", err)
+	}
+	return signer, nil
+}
+
+// typeSet returns the set of the channel or request types.
+func typeSet(types []string, extra ...string) map[string]bool {
+	set := make(map[string]bool, len(types)+len(extra))
+	for _, t := range types {
+		set[t] = true
+	}
+	for _, t := range extra {
+		set[t] = true
+	}
+	return set
+}
+
+// forwardGlobalRequests sends the requests received on one ssh connection to
+// dst and replies with the response of dst. Requests whose type is not in
+// types are refused.
+func forwardGlobalRequests(dst ssh.Conn, reqs <-chan *ssh.Request, types map[string]bool) {
+	for req := range reqs {
+		if !types[req.Type] {
+			if req.WantReply {
+				_ = req.Reply(false, nil)
+			}
+			continue
+		}
+		ok, payload, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok, payload = false, nil
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, payload)
+		}
+	}
+}
+
+// forwardChannels opens a channel on dst for each channel opened on the other
+// ssh connection and pipes the two channels together. Channels whose type is
+// not in types are rejected. If rec is not nil the data of the channels is
+// recorded.
+func forwardChannels(dst ssh.Conn, chans <-chan ssh.NewChannel, types map[string]bool, rec *recording.Recorder) {
+	for newChan := range chans {
+		if !types[newChan.ChannelType()] {
+			_ = newChan.Reject(ssh.Prohibited, fmt.Sprintf("channel type %q is not permitted", newChan.ChannelType()))
+			continue
+		}
+		go forwardChannel(dst, newChan, rec)
+	}
+}
+
+func forwardChannel(dst ssh.Conn, newChan ssh.NewChannel, rec *recording.Recorder) {
+	dstChan, dstReqs, err := dst.OpenChannel(newChan.ChannelType(), newChan.ExtraData())
+	if err != nil {
+		var openErr *ssh.OpenChannelError
+		if errors.As(err, &openErr) {
+			_ = newChan.Reject(openErr.Reason, openErr.Message)
+		} else {
+			_ = newChan.Reject(ssh.ConnectionFailed, err.Error())
+		}
+		return
+	}
+	srcChan, srcReqs, err := newChan.Accept()
+	if err != nil {
+		_ = dstChan.Close()
+		return
+	}
+
+	// Only the data read from dstChan's stderr is copied since the extended
+	// data of an ssh channel flows from the server to the client.
+	var fromSrc, fromDst, fromDstStderr io.Reader = srcChan, dstChan, dstChan.Stderr()
+	if rec != nil {
+		fromSrc = rec.RecordInput(srcChan)
+		fromDst = rec.RecordOutput(dstChan)
+		fromDstStderr = rec.RecordOutput(dstChan.Stderr())
+	}
+
+	outputWg := new(sync.WaitGroup)
+	outputWg.Add(2)
+	go func() {
+		defer outputWg.Done()
+		_, _ = io.Copy(srcChan, fromDst)
+	}()
+	go func() {
+		defer outputWg.Done()
+		_, _ = io.Copy(srcChan.Stderr(), fromDstStderr)
+	}()
+	go func() {
+		_, _ = io.Copy(dstChan, fromSrc)
+		_ = dstChan.CloseWrite()
+	}()
+
+	// replyMu is held while a request from srcChan is forwarded so srcChan is
+	// not closed before the reply from dstChan is sent back.
+	var replyMu sync.Mutex
+	go func() {
+		forwardChannelRequests(srcChan, dstReqs)
+		outputWg.Wait()
+		replyMu.Lock()
+		defer replyMu.Unlock()
+		_ = srcChan.CloseWrite()
+		_ = srcChan.Close()
+	}()
+	for req := range srcReqs {
+		replyMu.Lock()
+		forwardChannelRequest(dstChan, req)
+		replyMu.Unlock()
+	}
+	_ = dstChan.Close()
+}
+
+// forwardChannelRequests sends the requests received on one channel to dst and
+// replies with the response of dst.
+func forwardChannelRequests(dst ssh.Channel, reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		forwardChannelRequest(dst, req)
+	}
+}
+
+func forwardChannelRequest(dst ssh.Channel, req *ssh.Request) {
+	ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+	if err != nil {
+		ok = false
+	}
+	if req.WantReply {
+		_ = req.Reply(ok, nil)
+	}
+}
@@ -0,0 +1,499 @@
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/session"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/testdata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"nhooyr.io/websocket"
+)
+
+const (
+	testUsername = "user"
+	testPassword = "secret"
+)
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+	userKey, err := ssh.ParsePrivateKey(testdata.PEMBytes["ed25519"])
+	require.NoError(t, err)
+	endpointAddr, endpointKey := testEndpoint(t, userKey.PublicKey())
+
+	tests := []struct {
+		name  string
+		creds []*pbs.Credential
+	}{
+		{
+			name: "username-password",
+			creds: []*pbs.Credential{
+				{
+					Credential: &pbs.Credential_UsernamePassword{
+						UsernamePassword: &pbs.UsernamePassword{
+							Username: testUsername,
+							Password: testPassword,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ssh-private-key",
+			creds: []*pbs.Credential{
+				{
+					Credential: &pbs.Credential_SshPrivateKey{
+						SshPrivateKey: &pbs.SshPrivateKey{
+							Username:   testUsername,
+							PrivateKey: string(testdata.PEMBytes["ed25519"]),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+			client, errChan := testProxyClient(t, ctx, testEndpointUrl(endpointAddr, endpointKey), proxy.WithInjectedApplicationCredentials(tt.creds))
+
+			sess, err := client.NewSession()
+			require.NoError(err)
+			out, err := sess.Output("whoami")
+			require.NoError(err)
+			assert.Equal(fmt.Sprintf("%s ran whoami", testUsername), string(out))
+
+			require.NoError(client.Close())
+			select {
+			case err := <-errChan:
+				assert.NoError(err)
+			case <-time.After(10 * time.Second):
+				t.Fatal("proxy did not return after the client closed the connection")
+			}
+		})
+	}
+}
+
+func TestHandleProxy_Forwarding(t *testing.T) {
+	t.Parallel()
+	userKey, err := ssh.ParsePrivateKey(testdata.PEMBytes["ed25519"])
+	require.NoError(t, err)
+	endpointAddr, endpointKey := testEndpoint(t, userKey.PublicKey())
+	creds := []*pbs.Credential{
+		{
+			Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{
+					Username: testUsername,
+					Password: testPassword,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		opts             []proxy.Option
+		wantChanReason   ssh.RejectionReason
+		wantRequestReply bool
+	}{
+		{
+			// The endpoint accepts every global request but never receives
+			// them, and the direct-tcpip channel is rejected by the proxy.
+			name:           "default",
+			wantChanReason: ssh.Prohibited,
+		},
+		{
+			// The endpoint only accepts session channels, so it rejects the
+			// forwarded direct-tcpip channel itself.
+			name: "configured",
+			opts: []proxy.Option{
+				proxy.WithSshForwardedChannelTypes([]string{"direct-tcpip"}),
+				proxy.WithSshForwardedGlobalRequests([]string{"tcpip-forward"}),
+			},
+			wantChanReason:   ssh.UnknownChannelType,
+			wantRequestReply: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+			opts := append([]proxy.Option{proxy.WithInjectedApplicationCredentials(creds)}, tt.opts...)
+			client, errChan := testProxyClient(t, ctx, testEndpointUrl(endpointAddr, endpointKey), opts...)
+
+			// Session channels are always forwarded.
+			sess, err := client.NewSession()
+			require.NoError(err)
+			out, err := sess.Output("whoami")
+			require.NoError(err)
+			assert.Equal(fmt.Sprintf("%s ran whoami", testUsername), string(out))
+
+			_, err = client.Dial("tcp", "127.0.0.1:80")
+			var openErr *ssh.OpenChannelError
+			require.ErrorAs(err, &openErr)
+			assert.Equal(tt.wantChanReason, openErr.Reason)
+
+			ok, _, err := client.SendRequest("tcpip-forward", true, ssh.Marshal(struct {
+				Addr string
+				Port uint32
+			}{"127.0.0.1", 8080}))
+			require.NoError(err)
+			assert.Equal(tt.wantRequestReply, ok)
+
+			require.NoError(client.Close())
+			select {
+			case err := <-errChan:
+				assert.NoError(err)
+			case <-time.After(10 * time.Second):
+				t.Fatal("proxy did not return after the client closed the connection")
+			}
+		})
+	}
+}
+
+func TestPasswordChallenge(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		questions   []string
+		echos       []bool
+		wantAnswers []string
+		wantErr     bool
+	}{
+		{
+			name:        "no-prompts",
+			wantAnswers: []string{},
+		},
+		{
+			name:        "password",
+			questions:   []string{"Password: "},
+			echos:       []bool{false},
+			wantAnswers: []string{testPassword},
+		},
+		{
+			name:        "user-password",
+			questions:   []string{"user@host's password:"},
+			echos:       []bool{false},
+			wantAnswers: []string{testPassword},
+		},
+		{
+			name:      "one-time-code",
+			questions: []string{"Verification code: "},
+			echos:     []bool{false},
+			wantErr:   true,
+		},
+		{
+			name:      "echoed-prompt",
+			questions: []string{"Password hint: "},
+			echos:     []bool{true},
+			wantErr:   true,
+		},
+		{
+			name:      "password-and-code",
+			questions: []string{"Password: ", "Verification code: "},
+			echos:     []bool{false, false},
+			wantErr:   true,
+		},
+		{
+			name:      "missing-echos",
+			questions: []string{"Password: "},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			answers, err := passwordChallenge(testPassword)("", "", tt.questions, tt.echos)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, answers)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAnswers, answers)
+		})
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, sessionKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	s := testSession(t, ctx, sessionKey)
+	userKey, err := ssh.ParsePrivateKey(testdata.PEMBytes["ed25519"])
+	require.NoError(t, err)
+	endpointAddr, _ := testEndpoint(t, userKey.PublicKey())
+	otherKey, err := ssh.ParsePrivateKey(testdata.PEMBytes["rsa"])
+	require.NoError(t, err)
+	creds := []*pbs.Credential{
+		{
+			Credential: &pbs.Credential_UsernamePassword{
+				UsernamePassword: &pbs.UsernamePassword{
+					Username: testUsername,
+					Password: testPassword,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		creds    []*pbs.Credential
+	}{
+		{
+			name:     "invalid-scheme",
+			endpoint: "tcp://localhost:22",
+			creds:    creds,
+		},
+		{
+			name:     "missing-host-key",
+			endpoint: fmt.Sprintf("ssh://%s", endpointAddr),
+			creds:    creds,
+		},
+		{
+			name:     "invalid-host-key",
+			endpoint: fmt.Sprintf("ssh://%s?%s=%s", endpointAddr, globals.EndpointHostKeyParam, "not-a-key"),
+			creds:    creds,
+		},
+		{
+			name:     "mismatched-host-key",
+			endpoint: testEndpointUrl(endpointAddr, otherKey.PublicKey()),
+			creds:    creds,
+		},
+		{
+			name:     "missing-credentials",
+			endpoint: testEndpointUrl("localhost:22", otherKey.PublicKey()),
+		},
+		{
+			name:     "invalid-private-key",
+			endpoint: testEndpointUrl("localhost:22", otherKey.PublicKey()),
+			creds: []*pbs.Credential{
+				{
+					Credential: &pbs.Credential_SshPrivateKey{
+						SshPrivateKey: &pbs.SshPrivateKey{
+							Username:   testUsername,
+							PrivateKey: "not a private key",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				Session:        s,
+				ConnectionId:   "mock-connection",
+			}
+			assert.Error(t, handleProxy(ctx, conf, proxy.WithInjectedApplicationCredentials(tt.creds)))
+		})
+	}
+}
+
+// testSession returns a session whose private key is sessionKey.
+func testSession(t *testing.T, ctx context.Context, sessionKey ed25519.PrivateKey) session.Session {
+	t.Helper()
+	template := &x509.Certificate{
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(5 * time.Minute),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, sessionKey.Public(), sessionKey)
+	require.NoError(t, err)
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	sessClient.LookupSessionFn = func(_ context.Context, request *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   request.GetSessionId(),
+				Certificate: cert,
+				PrivateKey:  sessionKey,
+			},
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+		}, nil
+	}
+	sessClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "mock-connection",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sessClient.ConnectConnectionFn = func(_ context.Context, _ *pbs.ConnectConnectionRequest) (*pbs.ConnectConnectionResponse, error) {
+		return &pbs.ConnectConnectionResponse{
+			Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+		}, nil
+	}
+	manager, err := session.NewManager(sessClient)
+	require.NoError(t, err)
+	s, err := manager.LoadLocalSession(ctx, "one", "workerid")
+	require.NoError(t, err)
+	_, connCancelFn := context.WithCancel(context.Background())
+	_, _, err = s.RequestAuthorizeConnection(ctx, "workerid", connCancelFn)
+	require.NoError(t, err)
+	return s
+}
+
+// testProxyClient runs handleProxy with a new session for the endpoint with
+// the options and returns an ssh client connected to it along with the
+// channel receiving the result of handleProxy. The client does not provide any
+// credentials and must be presented the session key as host key.
+func testProxyClient(t *testing.T, ctx context.Context, endpoint string, opt ...proxy.Option) (*ssh.Client, <-chan error) {
+	t.Helper()
+	require := require.New(t)
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	_, sessionKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	conf := proxy.Config{
+		ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: endpoint,
+		Session:        testSession(t, ctx, sessionKey),
+		ConnectionId:   "mock-connection",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+	errChan := make(chan error)
+	go func() {
+		errChan <- handleProxy(ctx, conf, opt...)
+	}()
+
+	hostKey, err := ssh.NewSignerFromKey(sessionKey)
+	require.NoError(err)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, chans, reqs, err := ssh.NewClientConn(netConn, "localhost", &ssh.ClientConfig{
+		User:            "someone-else",
+		HostKeyCallback: ssh.FixedHostKey(hostKey.PublicKey()),
+	})
+	require.NoError(err)
+	return ssh.NewClient(c, chans, reqs), errChan
+}
+
+// testEndpointUrl returns the url of the session endpoint at addr whose host
+// key is key.
+func testEndpointUrl(addr string, key ssh.PublicKey) string {
+	hostKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	return fmt.Sprintf("ssh://%s?%s", addr, url.Values{globals.EndpointHostKeyParam: []string{hostKey}}.Encode())
+}
+
+// testEndpoint starts an ssh server which accepts testUsername with either
+// testPassword or userKey. Each exec request is answered with the name of the
+// authenticated user and the command, and every global request is accepted. The address and host key of the server
+// are returned.
+func testEndpoint(t *testing.T, userKey ssh.PublicKey) (string, ssh.PublicKey) {
+	t.Helper()
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if c.User() == testUsername && string(password) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("password rejected")
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if c.User() == testUsername && string(key.Marshal()) == string(userKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, errors.New("public key rejected")
+		},
+	}
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+	config.AddHostKey(signer)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			nc, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestConn(nc, config)
+		}
+	}()
+	return l.Addr().String(), signer.PublicKey()
+}
+
+func serveTestConn(nc net.Conn, config *ssh.ServerConfig) {
+	conn, chans, reqs, err := ssh.NewServerConn(nc, config)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	go func() {
+		for req := range reqs {
+			if req.WantReply {
+				_ = req.Reply(true, nil)
+			}
+		}
+	}()
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			_ = newChan.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		ch, chReqs, err := newChan.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer ch.Close()
+			for req := range chReqs {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				var exec struct{ Command string }
+				if err := ssh.Unmarshal(req.Payload, &exec); err != nil {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+				_, _ = fmt.Fprintf(ch, "%s ran %s", conn.User(), exec.Command)
+				_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				return
+			}
+		}()
+	}
+}
@@ -27,8 +27,12 @@ func init() {
 // handleProxy blocks until an error (EOF on happy path) is received on either
 // connection.
 //
-// All options are ignored.
-func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) error {
+// If the WithRecorder option is provided the bytes sent in both directions are
+// recorded. If the WithIdleTimeout option is provided the connection is closed
+// once it carried no traffic for the timeout and ErrIdleTimeout is returned.
+// All other options are ignored.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
 	conn := conf.ClientConn
 	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
 	if err != nil {
@@ -59,23 +63,51 @@ func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) erro
 		return fmt.Errorf("error marking connection as connected: %w", err)
 	}
 
-	// Get a wrapped net.Conn so we can use io.Copy
-	netConn := websocket.NetConn(ctx, conn, websocket.MessageBinary)
+	// Get a wrapped net.Conn so we can use io.Copy, counting the bytes sent
+	// up to and down from the endpoint
+	netConn := proxy.NewCountingConn(websocket.NetConn(ctx, conn, websocket.MessageBinary))
+	if err := conf.Session.ApplyConnectionCounterCallbacks(conf.ConnectionId, netConn.BytesRead, netConn.BytesWritten); err != nil {
+		_ = tcpRemoteConn.Close()
+		return fmt.Errorf("error setting connection counters: %w", err)
+	}
+
+	// Only wrap the connections when recording so the unrecorded case keeps
+	// splice support
+	var fromEndpoint, fromClient io.Reader = tcpRemoteConn, netConn
+	if opts.WithRecorder != nil {
+		fromEndpoint = opts.WithRecorder.RecordOutput(tcpRemoteConn)
+		fromClient = opts.WithRecorder.RecordInput(netConn)
+	}
+
+	idleCtx, idleCancel := context.WithCancel(ctx)
+	defer idleCancel()
+	idleClosed := make(chan bool, 1)
+	if opts.WithIdleTimeout > 0 {
+		go func() {
+			idleClosed <- netConn.CloseWhenIdle(idleCtx, opts.WithIdleTimeout)
+		}()
+	} else {
+		idleClosed <- false
+	}
 
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(netConn, tcpRemoteConn)
+		_, _ = io.Copy(netConn, fromEndpoint)
 		_ = netConn.Close()
 		_ = tcpRemoteConn.Close()
 	}()
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(tcpRemoteConn, netConn)
+		_, _ = io.Copy(tcpRemoteConn, fromClient)
 		_ = tcpRemoteConn.Close()
 		_ = netConn.Close()
 	}()
 	connWg.Wait()
+	idleCancel()
+	if <-idleClosed {
+		return proxy.ErrIdleTimeout
+	}
 	return nil
 }
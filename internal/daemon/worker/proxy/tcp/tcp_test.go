@@ -5,13 +5,18 @@ import (
 	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"math/big"
 	"net"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
@@ -130,9 +135,230 @@ func TestHandleTcpProxyV1(t *testing.T) {
 	assert.Equal(writeLen, readLen)
 	assert.Equal("client write to endpoint via proxy", string(b1))
 
+	// The bytes sent in both directions are counted on the connection
+	assert.Eventually(func() bool {
+		c := s.GetLocalConnections()["mock-connection"]
+		return c.BytesUp == uint64(len("client write to endpoint via proxy")) &&
+			c.BytesDown == uint64(len("endpoint write to client via proxy"))
+	}, 5*time.Second, 10*time.Millisecond)
+
 	cancelCtx()
 }
 
+func TestHandleTcpProxyV1_Recording(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(err)
+	defer l.Close()
+
+	var endpointConn net.Conn
+	var endpointErr error
+	ready := make(chan struct{})
+	go func() {
+		endpointConn, endpointErr = l.Accept()
+
+		defer endpointConn.Close()
+		ready <- struct{}{}
+
+		// block waiting for test to complete
+		<-ctx.Done()
+	}()
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	sessClient.LookupSessionFn = func(_ context.Context, request *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		cert, _, _ := createTestCert(t)
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   request.GetSessionId(),
+				Certificate: cert,
+			},
+			Expiration:             timestamppb.New(time.Now().Add(time.Hour)),
+			EnableSessionRecording: true,
+		}, nil
+	}
+	sessClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "mock-connection",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sessClient.ConnectConnectionFn = func(_ context.Context, _ *pbs.ConnectConnectionRequest) (*pbs.ConnectConnectionResponse, error) {
+		return &pbs.ConnectConnectionResponse{
+			Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+		}, nil
+	}
+	manager, err := session.NewManager(sessClient)
+	require.NoError(err)
+	s, err := manager.LoadLocalSession(ctx, "one", "workerid")
+	require.NoError(err)
+	assert.True(s.GetEnableSessionRecording())
+	_, connCancelFn := context.WithCancel(context.Background())
+	_, _, err = s.RequestAuthorizeConnection(ctx, "workerid", connCancelFn)
+	require.NoError(err)
+
+	recorder, err := recording.NewRecorder(t.TempDir(), "one", "mock-connection")
+	require.NoError(err)
+
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("tcp://localhost:%d", port),
+		Session:        s,
+		ConnectionId:   "mock-connection",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- handleProxy(ctx, conf, proxy.WithRecorder(recorder))
+	}()
+
+	// wait for HandleTcpProxyV1 to dial endpoint
+	<-ready
+	require.NoError(endpointErr)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+
+	// Write from endpoint to client
+	writeLen, err := endpointConn.Write([]byte("endpoint write to client via proxy"))
+	require.NoError(err)
+	b := make([]byte, writeLen)
+	_, err = io.ReadFull(netConn, b)
+	require.NoError(err)
+	assert.Equal("endpoint write to client via proxy", string(b))
+
+	// Write from client to endpoint
+	writeLen, err = netConn.Write([]byte("client write to endpoint via proxy"))
+	require.NoError(err)
+	b1 := make([]byte, writeLen)
+	_, err = io.ReadFull(endpointConn, b1)
+	require.NoError(err)
+	assert.Equal("client write to endpoint via proxy", string(b1))
+
+	cancelCtx()
+	require.NoError(<-errChan)
+	require.NoError(recorder.Close())
+
+	rec, err := os.ReadFile(recorder.Path())
+	require.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(rec)), "\n")
+	require.Len(lines, 3, "expected a header and an event per direction")
+	assert.Contains(lines[1], fmt.Sprintf("%q,%q", recording.Output, base64.StdEncoding.EncodeToString([]byte("endpoint write to client via proxy"))))
+	assert.Contains(lines[2], fmt.Sprintf("%q,%q", recording.Input, base64.StdEncoding.EncodeToString([]byte("client write to endpoint via proxy"))))
+}
+
+func TestHandleTcpProxyV1_IdleTimeout(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(err)
+	defer l.Close()
+
+	var endpointConn net.Conn
+	var endpointErr error
+	ready := make(chan struct{})
+	go func() {
+		endpointConn, endpointErr = l.Accept()
+
+		defer endpointConn.Close()
+		ready <- struct{}{}
+
+		// block waiting for test to complete
+		<-ctx.Done()
+	}()
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	sessClient.LookupSessionFn = func(_ context.Context, request *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		cert, _, _ := createTestCert(t)
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   request.GetSessionId(),
+				Certificate: cert,
+			},
+			Expiration:         timestamppb.New(time.Now().Add(time.Hour)),
+			IdleTimeoutSeconds: 1,
+		}, nil
+	}
+	sessClient.AuthorizeConnectionFn = func(_ context.Context, req *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "mock-connection",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	sessClient.ConnectConnectionFn = func(_ context.Context, _ *pbs.ConnectConnectionRequest) (*pbs.ConnectConnectionResponse, error) {
+		return &pbs.ConnectConnectionResponse{
+			Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED,
+		}, nil
+	}
+	manager, err := session.NewManager(sessClient)
+	require.NoError(err)
+	s, err := manager.LoadLocalSession(ctx, "one", "workerid")
+	require.NoError(err)
+	assert.Equal(uint32(1), s.GetIdleTimeoutSeconds())
+	_, connCancelFn := context.WithCancel(context.Background())
+	_, _, err = s.RequestAuthorizeConnection(ctx, "workerid", connCancelFn)
+	require.NoError(err)
+
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("tcp://localhost:%d", port),
+		Session:        s,
+		ConnectionId:   "mock-connection",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- handleProxy(ctx, conf, proxy.WithIdleTimeout(time.Duration(s.GetIdleTimeoutSeconds())*time.Second))
+	}()
+
+	// wait for HandleTcpProxyV1 to dial endpoint
+	<-ready
+	require.NoError(endpointErr)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+
+	// Traffic keeps the connection open
+	writeLen, err := netConn.Write([]byte("client write to endpoint via proxy"))
+	require.NoError(err)
+	b := make([]byte, writeLen)
+	_, err = io.ReadFull(endpointConn, b)
+	require.NoError(err)
+
+	// The proxy closes the connection once it has been idle for the timeout
+	select {
+	case err := <-errChan:
+		assert.ErrorIs(err, proxy.ErrIdleTimeout)
+	case <-time.After(10 * time.Second):
+		t.Fatal("connection was not closed after the idle timeout")
+	}
+	_, err = endpointConn.Read(make([]byte, 1))
+	assert.Error(err)
+}
+
 func createTestCert(t *testing.T) ([]byte, ed25519.PublicKey, ed25519.PrivateKey) {
 	pub, priv, err := ed25519.GenerateKey(rand.Reader)
 	require.NoError(t, err)
@@ -0,0 +1,198 @@
+// Package recording records the byte streams of connections proxied by the
+// worker.
+//
+// A recording is written to <storage path>/<session id>/<connection id>.rec
+// in a line based format modeled after asciicast v2. The first line is a JSON
+// header and every following line is a JSON array describing the bytes proxied
+// in one direction at a point in time:
+//
+//	{"version":1,"session_id":"s_1234567890","connection_id":"sc_1234567890","timestamp":1663000000}
+//	[0.000512,"i","aGVsbG8K"]
+//	[0.010203,"o","d29ybGQK"]
+//
+// The first element of an event is the time in seconds since the start of the
+// recording. The second is the direction of the bytes, "i" for bytes sent by
+// the client to the endpoint and "o" for bytes sent by the endpoint to the
+// client. The third is the base64 encoded bytes.
+package recording
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// FormatVersion is the version of the recording format written in the
+	// header of each recording.
+	FormatVersion = 1
+
+	// FileExtension is the extension of recording files.
+	FileExtension = ".rec"
+)
+
+// Direction is the direction of the bytes of an event.
+type Direction string
+
+const (
+	// Input is used for bytes sent by the client to the endpoint.
+	Input Direction = "i"
+	// Output is used for bytes sent by the endpoint to the client.
+	Output Direction = "o"
+)
+
+// Header is the first line of a recording.
+type Header struct {
+	Version      int    `json:"version"`
+	SessionId    string `json:"session_id"`
+	ConnectionId string `json:"connection_id"`
+	// Timestamp is the unix time the recording started.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// Recorder writes the recording of a single connection. It is safe for
+// concurrent use by the goroutines copying each direction of the connection.
+type Recorder struct {
+	lock      sync.Mutex
+	file      *os.File
+	path      string
+	startTime time.Time
+	endTime   time.Time
+}
+
+// NewRecorder creates the recording file for the connection of the session
+// below storagePath and writes its header. The recording starts when
+// NewRecorder returns.
+func NewRecorder(storagePath, sessionId, connectionId string) (*Recorder, error) {
+	switch {
+	case storagePath == "":
+		return nil, errors.New("storage path is empty")
+	case sessionId == "":
+		return nil, errors.New("session id is empty")
+	case connectionId == "":
+		return nil, errors.New("connection id is empty")
+	case filepath.Base(sessionId) != sessionId:
+		return nil, fmt.Errorf("invalid session id %q", sessionId)
+	case filepath.Base(connectionId) != connectionId:
+		return nil, fmt.Errorf("invalid connection id %q", connectionId)
+	}
+
+	dir := filepath.Join(storagePath, sessionId)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating recording directory: %w", err)
+	}
+	path := filepath.Join(dir, connectionId+FileExtension)
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+
+	r := &Recorder{
+		file:      file,
+		path:      path,
+		startTime: time.Now(),
+	}
+	header, err := json.Marshal(Header{
+		Version:      FormatVersion,
+		SessionId:    sessionId,
+		ConnectionId: connectionId,
+		Timestamp:    r.startTime.Unix(),
+	})
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("error encoding recording header: %w", err)
+	}
+	if _, err := file.Write(append(header, '\n')); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("error writing recording header: %w", err)
+	}
+	return r, nil
+}
+
+// Path returns the path of the recording file.
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// StartTime returns the time the recording started.
+func (r *Recorder) StartTime() time.Time {
+	return r.startTime
+}
+
+// EndTime returns the time the recording was closed. It is the zero time
+// while the recording is still open.
+func (r *Recorder) EndTime() time.Time {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.endTime
+}
+
+// RecordInput returns a reader which records the bytes read from rd as bytes
+// sent by the client to the endpoint.
+func (r *Recorder) RecordInput(rd io.Reader) io.Reader {
+	return &recordingReader{recorder: r, direction: Input, reader: rd}
+}
+
+// RecordOutput returns a reader which records the bytes read from rd as bytes
+// sent by the endpoint to the client.
+func (r *Recorder) RecordOutput(rd io.Reader) io.Reader {
+	return &recordingReader{recorder: r, direction: Output, reader: rd}
+}
+
+// Close ends the recording and closes the recording file. Subsequent calls
+// are a no-op.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.endTime.IsZero() {
+		return nil
+	}
+	r.endTime = time.Now()
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("error closing recording file: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) writeEvent(direction Direction, b []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.endTime.IsZero() {
+		return errors.New("recording is closed")
+	}
+	elapsed := math.Round(time.Since(r.startTime).Seconds()*1e6) / 1e6
+	event, err := json.Marshal([]interface{}{elapsed, direction, base64.StdEncoding.EncodeToString(b)})
+	if err != nil {
+		return fmt.Errorf("error encoding recording event: %w", err)
+	}
+	if _, err := r.file.Write(append(event, '\n')); err != nil {
+		return fmt.Errorf("error writing recording event: %w", err)
+	}
+	return nil
+}
+
+// recordingReader records every read from the wrapped reader. A failure to
+// record is returned as a read error so the proxied connection is not
+// continued without being recorded.
+type recordingReader struct {
+	recorder  *Recorder
+	direction Direction
+	reader    io.Reader
+}
+
+func (rr *recordingReader) Read(p []byte) (int, error) {
+	n, err := rr.reader.Read(p)
+	if n > 0 {
+		if recErr := rr.recorder.writeEvent(rr.direction, p[:n]); recErr != nil {
+			return 0, recErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,154 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		storagePath  string
+		sessionId    string
+		connectionId string
+		wantErr      string
+	}{
+		{
+			name:         "missing-storage-path",
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+			wantErr:      "storage path is empty",
+		},
+		{
+			name:         "missing-session-id",
+			storagePath:  t.TempDir(),
+			connectionId: "sc_1234567890",
+			wantErr:      "session id is empty",
+		},
+		{
+			name:        "missing-connection-id",
+			storagePath: t.TempDir(),
+			sessionId:   "s_1234567890",
+			wantErr:     "connection id is empty",
+		},
+		{
+			name:         "session-id-with-path",
+			storagePath:  t.TempDir(),
+			sessionId:    "../s_1234567890",
+			connectionId: "sc_1234567890",
+			wantErr:      `invalid session id "../s_1234567890"`,
+		},
+		{
+			name:         "connection-id-with-path",
+			storagePath:  t.TempDir(),
+			sessionId:    "s_1234567890",
+			connectionId: "../sc_1234567890",
+			wantErr:      `invalid connection id "../sc_1234567890"`,
+		},
+		{
+			name:         "valid",
+			storagePath:  t.TempDir(),
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			r, err := NewRecorder(tt.storagePath, tt.sessionId, tt.connectionId)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				assert.Nil(r)
+				return
+			}
+			require.NoError(err)
+			t.Cleanup(func() { r.Close() })
+			assert.Equal(filepath.Join(tt.storagePath, tt.sessionId, tt.connectionId+FileExtension), r.Path())
+			assert.False(r.StartTime().IsZero())
+			assert.True(r.EndTime().IsZero())
+
+			// A connection can only be recorded once
+			_, err = NewRecorder(tt.storagePath, tt.sessionId, tt.connectionId)
+			require.Error(err)
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	r, err := NewRecorder(t.TempDir(), "s_1234567890", "sc_1234567890")
+	require.NoError(err)
+
+	var toEndpoint, toClient bytes.Buffer
+	_, err = io.Copy(&toEndpoint, r.RecordInput(strings.NewReader("client to endpoint")))
+	require.NoError(err)
+	_, err = io.Copy(&toClient, r.RecordOutput(strings.NewReader("endpoint to client")))
+	require.NoError(err)
+	assert.Equal("client to endpoint", toEndpoint.String())
+	assert.Equal("endpoint to client", toClient.String())
+
+	require.NoError(r.Close())
+	assert.False(r.EndTime().IsZero())
+	require.NoError(r.Close(), "closing twice should be a no-op")
+
+	_, err = io.Copy(io.Discard, r.RecordInput(strings.NewReader("after close")))
+	require.Error(err, "recording after close should fail the copy")
+
+	f, err := os.Open(r.Path())
+	require.NoError(err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+
+	require.True(scanner.Scan())
+	var header Header
+	require.NoError(json.Unmarshal(scanner.Bytes(), &header))
+	assert.Equal(Header{
+		Version:      FormatVersion,
+		SessionId:    "s_1234567890",
+		ConnectionId: "sc_1234567890",
+		Timestamp:    r.StartTime().Unix(),
+	}, header)
+
+	var events []struct {
+		direction Direction
+		data      string
+	}
+	var lastElapsed float64
+	for scanner.Scan() {
+		var event []interface{}
+		require.NoError(json.Unmarshal(scanner.Bytes(), &event))
+		require.Len(event, 3)
+		elapsed, ok := event[0].(float64)
+		require.True(ok)
+		assert.GreaterOrEqual(elapsed, lastElapsed)
+		lastElapsed = elapsed
+		data, err := base64.StdEncoding.DecodeString(event[2].(string))
+		require.NoError(err)
+		events = append(events, struct {
+			direction Direction
+			data      string
+		}{Direction(event[1].(string)), string(data)})
+	}
+	require.NoError(scanner.Err())
+	require.Len(events, 2)
+	assert.Equal(Input, events[0].direction)
+	assert.Equal("client to endpoint", events[0].data)
+	assert.Equal(Output, events[1].direction)
+	assert.Equal("endpoint to client", events[1].data)
+}
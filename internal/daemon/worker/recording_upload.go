@@ -0,0 +1,266 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// recordingUploadTimeout is the duration of the timeout of each request
+	// the worker sends to the controller when it uploads the recording of a
+	// connection.
+	recordingUploadTimeout = 90 * time.Second
+
+	// recordingChunkSize is the maximum number of bytes of a recording the
+	// worker sends to the controller in a single request.
+	recordingChunkSize = 1 << 20
+
+	// recordingUploadMinBackoff and recordingUploadMaxBackoff bound the time
+	// the worker waits before retrying a failed upload of a recording. The
+	// wait doubles with every failed attempt.
+	recordingUploadMinBackoff = 5 * time.Second
+	recordingUploadMaxBackoff = 5 * time.Minute
+)
+
+// recordingUpload is the recording of a connection waiting to be uploaded to
+// the controller.
+type recordingUpload struct {
+	sessionId    string
+	connectionId string
+	workerId     string
+	path         string
+	startTime    time.Time
+	endTime      time.Time
+	size         uint64
+
+	attempts    int
+	nextAttempt time.Time
+}
+
+// recordingUploadQueue holds the recordings the worker has not uploaded to
+// the controller yet. The recordings are kept in the worker's recording
+// storage path regardless of the outcome of their upload, so recordings still
+// queued when the worker shuts down can be recovered from there.
+type recordingUploadQueue struct {
+	lock    sync.Mutex
+	pending []*recordingUpload
+
+	// wake is signalled when a recording is queued.
+	wake chan struct{}
+}
+
+func newRecordingUploadQueue() *recordingUploadQueue {
+	return &recordingUploadQueue{
+		wake: make(chan struct{}, 1),
+	}
+}
+
+func (q *recordingUploadQueue) push(u *recordingUpload) {
+	q.lock.Lock()
+	q.pending = append(q.pending, u)
+	q.lock.Unlock()
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// due returns the queued recordings whose next upload attempt is due.
+func (q *recordingUploadQueue) due(now time.Time) []*recordingUpload {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	var due []*recordingUpload
+	for _, u := range q.pending {
+		if !u.nextAttempt.After(now) {
+			due = append(due, u)
+		}
+	}
+	return due
+}
+
+func (q *recordingUploadQueue) remove(u *recordingUpload) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for i, p := range q.pending {
+		if p == u {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// next returns the time until the next upload attempt of a queued recording
+// is due. It returns recordingUploadMaxBackoff if no recording is queued.
+func (q *recordingUploadQueue) next(now time.Time) time.Duration {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	next := recordingUploadMaxBackoff
+	for _, u := range q.pending {
+		if d := u.nextAttempt.Sub(now); d < next {
+			next = d
+		}
+	}
+	if next < 0 {
+		next = 0
+	}
+	return next
+}
+
+func (q *recordingUploadQueue) len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	return len(q.pending)
+}
+
+// queueConnectionRecording ends the recording of the connection and queues it
+// to be uploaded to the controller in the background, so the upload neither
+// delays the proxy handler nor is lost when a single request fails.
+func (w *Worker) queueConnectionRecording(ctx context.Context, sessionId, workerId, connectionId string, recorder *recording.Recorder) {
+	const op = "worker.(Worker).queueConnectionRecording"
+	if err := recorder.Close(); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfo("session_id", sessionId, "connection_id", connectionId))
+		return
+	}
+	info, err := os.Stat(recorder.Path())
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to read connection recording", "session_id", sessionId, "connection_id", connectionId))
+		return
+	}
+	if info.Size() == 0 {
+		event.WriteSysEvent(ctx, op, "connection recording is empty and is not uploaded", "session_id", sessionId, "connection_id", connectionId)
+		return
+	}
+	w.recordingUploads.push(&recordingUpload{
+		sessionId:    sessionId,
+		connectionId: connectionId,
+		workerId:     workerId,
+		path:         recorder.Path(),
+		startTime:    recorder.StartTime(),
+		endTime:      recorder.EndTime(),
+		size:         uint64(info.Size()),
+	})
+}
+
+// startRecordingUploading uploads the queued connection recordings until
+// cancelCtx is done, retrying failed uploads with an exponential backoff.
+func (w *Worker) startRecordingUploading(cancelCtx context.Context, client pbs.SessionServiceClient) {
+	const op = "worker.(Worker).startRecordingUploading"
+	var wait time.Duration
+	for {
+		select {
+		case <-cancelCtx.Done():
+			if n := w.recordingUploads.len(); n > 0 {
+				event.WriteSysEvent(w.baseContext, op, "recording uploading shutting down with recordings left to upload", "recordings", n)
+			} else {
+				event.WriteSysEvent(w.baseContext, op, "recording uploading shutting down")
+			}
+			return
+		case <-w.recordingUploads.wake:
+		case <-time.After(wait):
+		}
+
+		for _, u := range w.recordingUploads.due(time.Now()) {
+			if cancelCtx.Err() != nil {
+				break
+			}
+			retry, err := uploadConnectionRecording(cancelCtx, client, u)
+			switch {
+			case err == nil:
+				event.WriteSysEvent(cancelCtx, op, "connection recording uploaded", "session_id", u.sessionId, "connection_id", u.connectionId)
+				w.recordingUploads.remove(u)
+			case !retry:
+				event.WriteError(cancelCtx, op, err, event.WithInfoMsg("unable to upload connection recording, giving up", "session_id", u.sessionId, "connection_id", u.connectionId, "path", u.path))
+				w.recordingUploads.remove(u)
+			default:
+				u.attempts++
+				backoff := recordingUploadMinBackoff << (u.attempts - 1)
+				if backoff <= 0 || backoff > recordingUploadMaxBackoff {
+					backoff = recordingUploadMaxBackoff
+				}
+				u.nextAttempt = time.Now().Add(backoff)
+				event.WriteError(cancelCtx, op, err, event.WithInfoMsg("unable to upload connection recording, retrying", "session_id", u.sessionId, "connection_id", u.connectionId, "attempts", u.attempts, "retry_in", backoff.String()))
+			}
+		}
+		wait = w.recordingUploads.next(time.Now())
+	}
+}
+
+// uploadConnectionRecording indexes the recording with the controller and
+// uploads the chunks the controller has not stored yet. Indexing the
+// recording returns how much of it the controller has stored, so an upload
+// interrupted by a failed request is resumed where it stopped. It reports
+// whether a failed upload should be retried.
+func uploadConnectionRecording(ctx context.Context, client pbs.SessionServiceClient, u *recordingUpload) (bool, error) {
+	f, err := os.Open(u.path)
+	if err != nil {
+		return false, fmt.Errorf("error opening connection recording: %w", err)
+	}
+	defer f.Close()
+
+	reqCtx, reqCancel := context.WithTimeout(ctx, recordingUploadTimeout)
+	resp, err := client.UploadConnectionRecording(reqCtx, &pbs.UploadConnectionRecordingRequest{
+		ConnectionId: u.connectionId,
+		SessionId:    u.sessionId,
+		WorkerId:     u.workerId,
+		StartTime:    timestamppb.New(u.startTime),
+		EndTime:      timestamppb.New(u.endTime),
+		Size:         u.size,
+	})
+	reqCancel()
+	if err != nil {
+		return retryRecordingUpload(err), fmt.Errorf("error indexing connection recording: %w", err)
+	}
+	if resp.GetUploadedSize() > u.size {
+		return false, fmt.Errorf("controller stored %d bytes of a connection recording of %d bytes", resp.GetUploadedSize(), u.size)
+	}
+	if _, err := f.Seek(int64(resp.GetUploadedSize()), io.SeekStart); err != nil {
+		return false, fmt.Errorf("error reading connection recording: %w", err)
+	}
+
+	buf := make([]byte, recordingChunkSize)
+	for chunkNumber := resp.GetUploadedChunks(); ; chunkNumber++ {
+		n, err := io.ReadFull(f, buf)
+		switch {
+		case errors.Is(err, io.EOF):
+			return false, nil
+		case err != nil && !errors.Is(err, io.ErrUnexpectedEOF):
+			return false, fmt.Errorf("error reading connection recording: %w", err)
+		}
+		reqCtx, reqCancel := context.WithTimeout(ctx, recordingUploadTimeout)
+		_, err = client.UploadConnectionRecordingChunk(reqCtx, &pbs.UploadConnectionRecordingChunkRequest{
+			ConnectionId: u.connectionId,
+			SessionId:    u.sessionId,
+			WorkerId:     u.workerId,
+			ChunkNumber:  chunkNumber,
+			Data:         buf[:n],
+		})
+		reqCancel()
+		if err != nil {
+			return retryRecordingUpload(err), fmt.Errorf("error uploading chunk %d of connection recording: %w", chunkNumber, err)
+		}
+	}
+}
+
+// retryRecordingUpload reports whether the upload of a recording should be
+// retried after the controller failed a request with err. Requests the
+// controller rejects won't succeed when they are sent again.
+func retryRecordingUpload(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadConnectionRecording(t *testing.T) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("recording"), recordingChunkSize/4)
+	path := filepath.Join(t.TempDir(), "sc_1234567890.rec")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	newUpload := func() *recordingUpload {
+		return &recordingUpload{
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+			workerId:     "w_1234567890",
+			path:         path,
+			startTime:    time.Now().Add(-time.Minute),
+			endTime:      time.Now(),
+			size:         uint64(len(data)),
+		}
+	}
+
+	// controller mocks a controller storing the uploaded chunks of the
+	// recording. The chunk with failChunk fails once with failCode.
+	type controller struct {
+		uploaded  []byte
+		chunks    uint32
+		failChunk uint32
+		failCode  codes.Code
+	}
+	newClient := func(c *controller) pbs.SessionServiceClient {
+		client := pbs.NewMockSessionServiceClient()
+		client.UploadConnectionRecordingFn = func(_ context.Context, req *pbs.UploadConnectionRecordingRequest) (*pbs.UploadConnectionRecordingResponse, error) {
+			assert.Equal(t, "w_1234567890", req.GetWorkerId())
+			assert.Equal(t, uint64(len(data)), req.GetSize())
+			return &pbs.UploadConnectionRecordingResponse{
+				UploadedChunks: c.chunks,
+				UploadedSize:   uint64(len(c.uploaded)),
+			}, nil
+		}
+		client.UploadConnectionRecordingChunkFn = func(_ context.Context, req *pbs.UploadConnectionRecordingChunkRequest) (*pbs.UploadConnectionRecordingChunkResponse, error) {
+			assert.Equal(t, "w_1234567890", req.GetWorkerId())
+			if c.failCode != codes.OK && req.GetChunkNumber() == c.failChunk {
+				code := c.failCode
+				c.failCode = codes.OK
+				return nil, status.Error(code, "failed")
+			}
+			require.Equal(t, c.chunks, req.GetChunkNumber())
+			c.uploaded = append(c.uploaded, req.GetData()...)
+			c.chunks++
+			return &pbs.UploadConnectionRecordingChunkResponse{}, nil
+		}
+		return client
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		c := &controller{}
+		retry, err := uploadConnectionRecording(ctx, newClient(c), newUpload())
+		require.NoError(t, err)
+		assert.False(t, retry)
+		assert.Equal(t, uint32(3), c.chunks)
+		assert.Equal(t, data, c.uploaded)
+	})
+
+	t.Run("retry-resumes-upload", func(t *testing.T) {
+		c := &controller{failChunk: 1, failCode: codes.Unavailable}
+		client := newClient(c)
+		u := newUpload()
+		retry, err := uploadConnectionRecording(ctx, client, u)
+		require.Error(t, err)
+		assert.True(t, retry)
+		assert.Equal(t, uint32(1), c.chunks)
+
+		retry, err = uploadConnectionRecording(ctx, client, u)
+		require.NoError(t, err)
+		assert.False(t, retry)
+		assert.Equal(t, uint32(3), c.chunks)
+		assert.Equal(t, data, c.uploaded)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		c := &controller{failChunk: 0, failCode: codes.PermissionDenied}
+		retry, err := uploadConnectionRecording(ctx, newClient(c), newUpload())
+		require.Error(t, err)
+		assert.False(t, retry)
+	})
+
+	t.Run("missing-recording", func(t *testing.T) {
+		u := newUpload()
+		u.path = filepath.Join(t.TempDir(), "missing.rec")
+		retry, err := uploadConnectionRecording(ctx, newClient(&controller{}), u)
+		require.Error(t, err)
+		assert.False(t, retry)
+	})
+}
+
+func TestRecordingUploadQueue(t *testing.T) {
+	assert := assert.New(t)
+	q := newRecordingUploadQueue()
+	now := time.Now()
+	assert.Equal(recordingUploadMaxBackoff, q.next(now))
+
+	due := &recordingUpload{connectionId: "sc_due"}
+	later := &recordingUpload{connectionId: "sc_later", nextAttempt: now.Add(time.Minute)}
+	q.push(due)
+	q.push(later)
+	select {
+	case <-q.wake:
+	default:
+		assert.Fail("pushing a recording must wake the uploader")
+	}
+
+	assert.Equal([]*recordingUpload{due}, q.due(now))
+	assert.Equal(time.Duration(0), q.next(now))
+
+	q.remove(due)
+	assert.Equal(1, q.len())
+	assert.Empty(q.due(now))
+	assert.Equal(time.Minute, q.next(now))
+}
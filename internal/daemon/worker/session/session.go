@@ -32,6 +32,39 @@ type ConnInfo struct {
 	// The time the controller has successfully reported that this connection is
 	// closed.
 	CloseTime time.Time
+
+	// The number of bytes sent up to and received down from the endpoint over
+	// this connection at the time this ConnInfo was retrieved.
+	BytesUp   uint64
+	BytesDown uint64
+
+	// The functions returning the current number of bytes sent up to and
+	// received down from the endpoint.  They are set by the proxy handler
+	// through ApplyConnectionCounterCallbacks.
+	bytesUpFn   func() uint64
+	bytesDownFn func() uint64
+
+	// The reason the connection was closed by the worker, if known.  It is
+	// reported to the controller when the connection is closed.
+	ClosedReason session.ClosedReason
+}
+
+// snapshot returns a copy of the ConnInfo with the byte counts set to the
+// current values of the counter callbacks.
+func (c *ConnInfo) snapshot() ConnInfo {
+	ci := ConnInfo{
+		Id:           c.Id,
+		Status:       c.Status,
+		CloseTime:    c.CloseTime,
+		ClosedReason: c.ClosedReason,
+	}
+	if c.bytesUpFn != nil {
+		ci.BytesUp = c.bytesUpFn()
+	}
+	if c.bytesDownFn != nil {
+		ci.BytesDown = c.bytesDownFn()
+	}
+	return ci
 }
 
 // Session is the local representation of a session.  After initial loading
@@ -42,6 +75,18 @@ type Session interface {
 	// If there is no connection with the provided id, an error is returned.
 	ApplyLocalConnectionStatus(connId string, status pbs.CONNECTIONSTATUS) error
 
+	// ApplyConnectionCounterCallbacks sets the functions returning the number
+	// of bytes sent up to and received down from the endpoint over the
+	// connection. If there is no connection with the provided id, an error is
+	// returned.
+	ApplyConnectionCounterCallbacks(connId string, bytesUp func() uint64, bytesDown func() uint64) error
+
+	// ApplyConnectionClosedReason sets the reason the connection was closed
+	// by the worker, which is reported to the controller when the connection
+	// is closed. If there is no connection with the provided id, an error is
+	// returned.
+	ApplyConnectionClosedReason(connId string, reason session.ClosedReason) error
+
 	// ApplyLocalStatus updates the given session with the status provided by
 	// the SessionJobInfo.  It returns an error if any of the connections
 	// in the SessionJobInfo are not present, however, it still applies the
@@ -54,8 +99,10 @@ type Session interface {
 
 	GetTofuToken() string
 	GetConnectionLimit() int32
+	GetIdleTimeoutSeconds() uint32
 	GetEndpoint() string
 	GetCredentials() []*pbs.Credential
+	GetEnableSessionRecording() bool
 	GetExpiration() time.Time
 	GetCertificate() *x509.Certificate
 	GetPrivateKey() []byte
@@ -98,6 +145,11 @@ type Session interface {
 	// authorized.  The local connection's status is updated with the result of the
 	// call.
 	RequestConnectConnection(ctx context.Context, info *pbs.ConnectConnectionRequest) error
+
+	// RequestAddConnectionHttpRequests sends the HTTP requests proxied over a
+	// connection to the controller. It is called by the proxy handlers of
+	// http targets.
+	RequestAddConnectionHttpRequests(ctx context.Context, req *pbs.AddConnectionHttpRequestsRequest) error
 }
 
 type sess struct {
@@ -154,6 +206,37 @@ func (s *sess) ApplyLocalConnectionStatus(connId string, status pbs.CONNECTIONST
 	return nil
 }
 
+// ApplyConnectionCounterCallbacks Satisfies the Session interface
+func (s *sess) ApplyConnectionCounterCallbacks(connId string, bytesUp func() uint64, bytesDown func() uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	connInfo, ok := s.connInfoMap[connId]
+	if !ok {
+		return fmt.Errorf("could not find connection ID %q for session ID %q in local state",
+			connId,
+			s.GetId())
+	}
+
+	connInfo.bytesUpFn = bytesUp
+	connInfo.bytesDownFn = bytesDown
+	return nil
+}
+
+// ApplyConnectionClosedReason Satisfies the Session interface
+func (s *sess) ApplyConnectionClosedReason(connId string, reason session.ClosedReason) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	connInfo, ok := s.connInfoMap[connId]
+	if !ok {
+		return fmt.Errorf("could not find connection ID %q for session ID %q in local state",
+			connId,
+			s.GetId())
+	}
+
+	connInfo.ClosedReason = reason
+	return nil
+}
+
 func (s *sess) ApplySessionUpdate(r *pbs.LookupSessionResponse) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -173,11 +256,7 @@ func (s *sess) GetLocalConnections() map[string]ConnInfo {
 	res := make(map[string]ConnInfo, len(s.connInfoMap))
 	// Returning the s.connInfoMap directly wouldn't be thread safe.
 	for k, v := range s.connInfoMap {
-		res[k] = ConnInfo{
-			Id:        v.Id,
-			Status:    v.Status,
-			CloseTime: v.CloseTime,
-		}
+		res[k] = v.snapshot()
 	}
 	return res
 }
@@ -194,6 +273,12 @@ func (s *sess) GetConnectionLimit() int32 {
 	return s.resp.GetConnectionLimit()
 }
 
+func (s *sess) GetIdleTimeoutSeconds() uint32 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetIdleTimeoutSeconds()
+}
+
 func (s *sess) GetEndpoint() string {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -206,6 +291,12 @@ func (s *sess) GetCredentials() []*pbs.Credential {
 	return s.resp.GetCredentials()
 }
 
+func (s *sess) GetEnableSessionRecording() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.resp.GetEnableSessionRecording()
+}
+
 func (s *sess) GetStatus() pbs.SESSIONSTATUS {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -267,11 +358,7 @@ func (s *sess) RequestAuthorizeConnection(ctx context.Context, workerId string,
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	s.connInfoMap[ci.Id] = ci
-	return ConnInfo{
-		Id:        ci.Id,
-		Status:    ci.Status,
-		CloseTime: ci.CloseTime,
-	}, connsLeft, err
+	return ci.snapshot(), connsLeft, err
 }
 
 func (s *sess) RequestConnectConnection(ctx context.Context, info *pbs.ConnectConnectionRequest) error {
@@ -283,6 +370,13 @@ func (s *sess) RequestConnectConnection(ctx context.Context, info *pbs.ConnectCo
 	return nil
 }
 
+func (s *sess) RequestAddConnectionHttpRequests(ctx context.Context, req *pbs.AddConnectionHttpRequestsRequest) error {
+	if _, err := s.client.AddConnectionHttpRequests(ctx, req); err != nil {
+		return fmt.Errorf("error adding connection http requests: %w", err)
+	}
+	return nil
+}
+
 // CancelOpenLocalConnections closes the local connections in this session
 // based on the connection's state by calling the connections context cancel
 // function.
@@ -429,7 +523,7 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 	// within an adequate period of time.
 	closeConnCtx, closeConnCancel := context.WithTimeout(ctx, common.StatusTimeout)
 	defer closeConnCancel()
-	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(closeInfo))
+	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(sManager, closeInfo))
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error marking connections closed",
 			"warning", "error contacting controller, connections will be closed only on worker",
@@ -465,15 +559,29 @@ func closeConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 // use with closing connections.
 //
 // closeInfo is a map, indexed by connection ID, to the individual
-// sessions IDs that those connections belong to. The values are
-// ignored; the parameter is expected as such just for convenience of
-// its caller.
-func makeCloseConnectionRequest(closeInfo map[string]string) *pbs.CloseConnectionRequest {
+// sessions IDs that those connections belong to. The session IDs are
+// used to look up the bytes sent over the connections and the reason
+// they were closed in sManager.
+func makeCloseConnectionRequest(sManager Manager, closeInfo map[string]string) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
-	for connId := range closeInfo {
+	localConns := make(map[string]map[string]ConnInfo)
+	for connId, sessionId := range closeInfo {
+		conns, ok := localConns[sessionId]
+		if !ok {
+			if si := sManager.Get(sessionId); si != nil {
+				conns = si.GetLocalConnections()
+			}
+			localConns[sessionId] = conns
+		}
+		reason := conns[connId].ClosedReason
+		if reason == "" {
+			reason = session.UnknownReason
+		}
 		closeData = append(closeData, &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
-			Reason:       session.UnknownReason.String(),
+			BytesUp:      conns[connId].BytesUp,
+			BytesDown:    conns[connId].BytesDown,
+			Reason:       reason.String(),
 		})
 	}
 
@@ -8,8 +8,10 @@ import (
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestSession_ApplyLocalStatus(t *testing.T) {
@@ -27,6 +29,50 @@ func TestSession_ApplyLocalStatus(t *testing.T) {
 	}
 }
 
+func TestSession_ApplyConnectionCounterCallbacks(t *testing.T) {
+	sess := &sess{
+		sessionId: "s_1",
+		connInfoMap: map[string]*ConnInfo{
+			"1": {Id: "1", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED},
+			"2": {Id: "2", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED},
+		},
+	}
+	var up, down uint64
+	require.NoError(t, sess.ApplyConnectionCounterCallbacks("1",
+		func() uint64 { return up },
+		func() uint64 { return down }))
+	require.Error(t, sess.ApplyConnectionCounterCallbacks("unknown",
+		func() uint64 { return up },
+		func() uint64 { return down }))
+
+	conns := sess.GetLocalConnections()
+	assert.Zero(t, conns["1"].BytesUp)
+	assert.Zero(t, conns["1"].BytesDown)
+
+	up, down = 5, 7
+	conns = sess.GetLocalConnections()
+	assert.Equal(t, uint64(5), conns["1"].BytesUp)
+	assert.Equal(t, uint64(7), conns["1"].BytesDown)
+	assert.Zero(t, conns["2"].BytesUp)
+	assert.Zero(t, conns["2"].BytesDown)
+}
+
+func TestSession_ApplyConnectionClosedReason(t *testing.T) {
+	sess := &sess{
+		sessionId: "s_1",
+		connInfoMap: map[string]*ConnInfo{
+			"1": {Id: "1", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED},
+			"2": {Id: "2", Status: pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED},
+		},
+	}
+	require.NoError(t, sess.ApplyConnectionClosedReason("1", session.ConnectionIdleTimeout))
+	require.Error(t, sess.ApplyConnectionClosedReason("unknown", session.ConnectionIdleTimeout))
+
+	conns := sess.GetLocalConnections()
+	assert.Equal(t, session.ConnectionIdleTimeout, conns["1"].ClosedReason)
+	assert.Empty(t, conns["2"].ClosedReason)
+}
+
 func TestSession_CancelAllLocalConnections(t *testing.T) {
 	var closedContextCalled []string
 	cancelFn := func(id string) context.CancelFunc {
@@ -176,14 +222,48 @@ func TestSession_RequestAuthorizeConnection(t *testing.T) {
 
 func TestWorkerMakeCloseConnectionRequest(t *testing.T) {
 	require := require.New(t)
+	ctx := context.Background()
+	mockSessionClient := pbs.NewMockSessionServiceClient()
+	mockSessionClient.LookupSessionFn = func(_ context.Context, req *pbs.LookupSessionRequest) (*pbs.LookupSessionResponse, error) {
+		return &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   req.GetSessionId(),
+				Certificate: createTestCert(t),
+			},
+			Version:    1,
+			Expiration: timestamppb.New(time.Now().Add(time.Hour)),
+			Status:     pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE,
+		}, nil
+	}
+	mockSessionClient.AuthorizeConnectionFn = func(context.Context, *pbs.AuthorizeConnectionRequest) (*pbs.AuthorizeConnectionResponse, error) {
+		return &pbs.AuthorizeConnectionResponse{
+			ConnectionId:    "foo",
+			Status:          pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_AUTHORIZED,
+			ConnectionsLeft: -1,
+		}, nil
+	}
+	manager, err := NewManager(mockSessionClient)
+	require.NoError(err)
+	s, err := manager.LoadLocalSession(ctx, "one", "worker id")
+	require.NoError(err)
+	_, cancelFn := context.WithCancel(ctx)
+	_, _, err = s.RequestAuthorizeConnection(ctx, "worker id", cancelFn)
+	require.NoError(err)
+	require.NoError(s.ApplyConnectionCounterCallbacks("foo",
+		func() uint64 { return 10 },
+		func() uint64 { return 20 }))
+	require.NoError(s.ApplyConnectionClosedReason("foo", session.ConnectionIdleTimeout))
+
+	// Connections without local state are closed without byte counts and
+	// with an unknown reason.
 	in := map[string]string{"foo": "one", "bar": "two"}
 	expected := &pbs.CloseConnectionRequest{
 		CloseRequestData: []*pbs.CloseConnectionRequestData{
-			{ConnectionId: "foo", Reason: session.UnknownReason.String()},
+			{ConnectionId: "foo", BytesUp: 10, BytesDown: 20, Reason: session.ConnectionIdleTimeout.String()},
 			{ConnectionId: "bar", Reason: session.UnknownReason.String()},
 		},
 	}
-	actual := makeCloseConnectionRequest(in)
+	actual := makeCloseConnectionRequest(manager, in)
 	require.ElementsMatch(expected.GetCloseRequestData(), actual.GetCloseRequestData())
 }
 
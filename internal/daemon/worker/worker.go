@@ -86,6 +86,10 @@ type Worker struct {
 
 	sessionManager session.Manager
 
+	// recordingUploads holds the connection recordings waiting to be
+	// uploaded to the controller.
+	recordingUploads *recordingUploadQueue
+
 	controllerStatusConn *atomic.Value
 	everAuthenticated    *ua.Uint32
 	lastStatusSuccess    *atomic.Value
@@ -142,6 +146,7 @@ func New(conf *Config) (*Worker, error) {
 		updateTags:             ua.NewBool(false),
 		nonceFn:                base62.Random,
 		WorkerAuthCurrentKeyId: new(ua.String),
+		recordingUploads:       newRecordingUploadQueue(),
 	}
 
 	if downstreamRouterFactory != nil {
@@ -385,7 +390,7 @@ func (w *Worker) Start() error {
 	// Rather than deal with some of the potential error conditions for Add on
 	// the waitgroup vs. Done (in case a function exits immediately), we will
 	// always start rotation and simply exit early if we're using KMS
-	w.tickerWg.Add(3)
+	w.tickerWg.Add(4)
 	go func() {
 		defer w.tickerWg.Done()
 		w.startStatusTicking(w.baseContext, w.sessionManager, &w.addressReceivers)
@@ -394,6 +399,10 @@ func (w *Worker) Start() error {
 		defer w.tickerWg.Done()
 		w.startAuthRotationTicking(w.baseContext)
 	}()
+	go func() {
+		defer w.tickerWg.Done()
+		w.startRecordingUploading(w.baseContext, pbs.NewSessionServiceClient(w.GrpcClientConn))
+	}()
 	go func() {
 		defer w.tickerWg.Done()
 		if w.downstreamRoutes != nil {
@@ -70,3 +70,15 @@ func (ws *workerProxyServiceServer) ConnectConnection(ctx context.Context, req *
 func (ws *workerProxyServiceServer) CloseConnection(ctx context.Context, req *pbs.CloseConnectionRequest) (*pbs.CloseConnectionResponse, error) {
 	return ws.ssClient.CloseConnection(ctx, req)
 }
+
+func (ws *workerProxyServiceServer) UploadConnectionRecording(ctx context.Context, req *pbs.UploadConnectionRecordingRequest) (*pbs.UploadConnectionRecordingResponse, error) {
+	return ws.ssClient.UploadConnectionRecording(ctx, req)
+}
+
+func (ws *workerProxyServiceServer) UploadConnectionRecordingChunk(ctx context.Context, req *pbs.UploadConnectionRecordingChunkRequest) (*pbs.UploadConnectionRecordingChunkResponse, error) {
+	return ws.ssClient.UploadConnectionRecordingChunk(ctx, req)
+}
+
+func (ws *workerProxyServiceServer) AddConnectionHttpRequests(ctx context.Context, req *pbs.AddConnectionHttpRequestsRequest) (*pbs.AddConnectionHttpRequestsResponse, error) {
+	return ws.ssClient.AddConnectionHttpRequests(ctx, req)
+}
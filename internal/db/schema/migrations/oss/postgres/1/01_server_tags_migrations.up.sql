@@ -43,6 +43,7 @@ alter table session
   add column worker_filter wt_bexprfilter;
 
 -- Replace the immutable columns trigger from 50 to add worker_filter
+-- Replaced in 49/02_session_recording.up.sql
 drop trigger immutable_columns on session;
 create trigger immutable_columns before update on session
     for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter');
@@ -82,6 +82,7 @@ begin;
   -- warehouse
 
   -- Replaces whx_host_dimension_source defined in 26/02_wh_network_address_dimensions.up.sql.
+  -- Replaced in 49/03_ssh_targets.up.sql
   drop view whx_host_dimension_source;
   create view whx_host_dimension_source as
   select -- id is the first column in the target view
@@ -156,6 +157,7 @@ begin;
   -- The whx_credential_dimension_source view shows the current values in the
   -- operational tables of the credential dimension.
   -- Replaces whx_credential_dimension_source defined in 16/02_wh_credential_dimension.up.sql
+  -- Replaced in 49/03_ssh_targets.up.sql
   drop view whx_credential_dimension_source;
   create view whx_credential_dimension_source as
        select -- id is the first column in the target view
@@ -216,6 +218,7 @@ begin;
         and o.type = 'org';
 
   -- Replaces target_all_subtypes defined in 1/01_server_tags_migrations.up.sql
+  -- Replaced in 49/01_target_address.up.sql
   drop view target_all_subtypes;
   create view target_all_subtypes as
   select public_id,
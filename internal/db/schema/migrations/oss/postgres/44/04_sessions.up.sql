@@ -12,6 +12,7 @@ begin;
   ;
 
   -- Replaces trigger from 01/50_session.up.sql
+  -- Replaced in 49/01_target_address.up.sql
   create or replace function cancel_session_with_null_fk() returns trigger
   as $$
   begin
@@ -34,6 +35,7 @@ begin;
   $$ language plpgsql;
 
   -- Replaces trigger from 01/50_session.up.sql
+  -- Replaced in 49/01_target_address.up.sql
   create or replace function insert_session() returns trigger
   as $$
   begin
@@ -0,0 +1,202 @@
+begin;
+
+  -- target_address is an optional network address assigned directly to a
+  -- target. A target with an address can be connected to without a host
+  -- catalog, host or host set.
+  create table target_address (
+    target_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    address wt_network_address not null,
+    create_time wt_timestamp
+  );
+  comment on table target_address is
+    'target_address entries represent a network address assigned directly to a target.';
+
+  create trigger immutable_columns before update on target_address
+    for each row execute procedure immutable_columns('target_id', 'create_time');
+
+  create trigger default_create_time_column before insert on target_address
+    for each row execute procedure default_create_time();
+
+  -- target_address_host_sources_exclusive() is a before insert trigger
+  -- function for target_address. It ensures an address is not assigned to a
+  -- target that already has host sources.
+  create function target_address_host_sources_exclusive() returns trigger
+  as $$
+  begin
+    perform from target_host_set
+      where target_id = new.target_id;
+    if found then
+      raise exception 'target % has host sources and cannot have an address', new.target_id using
+        errcode = '23000',
+        schema  = tg_table_schema,
+        table   = tg_table_name;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger target_address_host_sources_exclusive before insert on target_address
+    for each row execute function target_address_host_sources_exclusive();
+
+  -- target_host_set_address_exclusive() is a before insert trigger function
+  -- for target_host_set. It ensures a host source is not added to a target
+  -- that already has an address.
+  create function target_host_set_address_exclusive() returns trigger
+  as $$
+  begin
+    perform from target_address
+      where target_id = new.target_id;
+    if found then
+      raise exception 'target % has an address and cannot have host sources', new.target_id using
+        errcode = '23000',
+        schema  = tg_table_schema,
+        table   = tg_table_name;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger target_host_set_address_exclusive before insert on target_host_set
+    for each row execute function target_host_set_address_exclusive();
+
+  -- Replaces target_all_subtypes defined in 44/03_targets.up.sql
+  -- Replaced in 49/02_session_recording.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id;
+
+  -- Sessions for a target with an address have no host or host set.
+
+  -- Replaces trigger function from 44/04_sessions.up.sql
+  create or replace function insert_session() returns trigger
+  as $$
+  begin
+    case
+      when new.user_id is null then
+        raise exception 'user_id is null';
+      when new.target_id is null then
+        raise exception 'target_id is null';
+      when (new.host_id is null) != (new.host_set_id is null) then
+        raise exception 'host_id and host_set_id must both be set or both be null';
+      when new.auth_token_id is null then
+        raise exception 'auth_token_id is null';
+      when new.project_id is null then
+        raise exception 'project_id is null';
+      when new.endpoint is null then
+        raise exception 'endpoint is null';
+    else
+    end case;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- Replaces trigger function from 44/04_sessions.up.sql
+  create or replace function cancel_session_with_null_fk() returns trigger
+  as $$
+  begin
+   case
+      when new.user_id is null then
+        perform cancel_session(new.public_id);
+      when old.host_id is not null and new.host_id is null then
+        perform cancel_session(new.public_id);
+      when new.target_id is null then
+        perform cancel_session(new.public_id);
+      when old.host_set_id is not null and new.host_set_id is null then
+        perform cancel_session(new.public_id);
+      when new.auth_token_id is null then
+        perform cancel_session(new.public_id);
+      when new.project_id is null then
+        perform cancel_session(new.public_id);
+    else
+    end case;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- Add a "no host" host dimension. It is used for sessions of targets that
+  -- have an address instead of host sources.
+  insert into wh_host_dimension (
+    key,
+    host_id,                    host_type,                  host_name,                       host_description,
+    network_address_group_key,
+    host_set_id,                host_set_type,              host_set_name,                   host_set_description,
+    host_catalog_id,            host_catalog_type,          host_catalog_name,               host_catalog_description,
+    target_id,                  target_type,                target_name,                     target_description,
+    target_default_port_number, target_session_max_seconds, target_session_connection_limit,
+    project_id,                 project_name,               project_description,
+    organization_id,            organization_name,          organization_description,
+    current_row_indicator,      row_effective_time,         row_expiration_time
+  )
+  values
+  (
+    'no host',
+    'None',                     'None',                     'None',                          'None',
+    'Unsupported',
+    'None',                     'None',                     'None',                          'None',
+    'None',                     'None',                     'None',                          'None',
+    'None',                     'None',                     'None',                          'None',
+    -1,                         -1,                         -1,
+    '00000000000',              'None',                     'None',
+    '00000000000',              'None',                     'None',
+    'Current',                  now(),                      'infinity'::timestamptz
+  );
+
+  -- Replaces wh_insert_session defined in 16/04_wh_credential_dimension.up.sql
+  create or replace function wh_insert_session() returns trigger
+  as $$
+  declare
+    new_row wh_session_accumulating_fact%rowtype;
+  begin
+    with
+    pending_timestamp (date_dim_key, time_dim_key, ts) as (
+      select wh_date_key(start_time), wh_time_key(start_time), start_time
+        from session_state
+       where session_id = new.public_id
+         and state      = 'pending'
+    )
+    insert into wh_session_accumulating_fact (
+           session_id,
+           auth_token_id,
+           host_key,
+           user_key,
+           credential_group_key,
+           session_pending_date_key,
+           session_pending_time_key,
+           session_pending_time
+    )
+    select new.public_id,
+           new.auth_token_id,
+           case
+             when new.host_id is null then 'no host'
+             else wh_upsert_host(new.host_id, new.host_set_id, new.target_id)
+           end,
+           wh_upsert_user(new.user_id, new.auth_token_id),
+           'no credentials', -- will be updated by wh_upsert_credentail_group
+           pending_timestamp.date_dim_key,
+           pending_timestamp.time_dim_key,
+           pending_timestamp.ts
+      from pending_timestamp
+      returning * into strict new_row;
+    return null;
+  end;
+  $$ language plpgsql;
+
+commit;
@@ -0,0 +1,95 @@
+begin;
+
+  alter table target_tcp
+    add column enable_session_recording boolean not null default false;
+
+  -- Replaces target_all_subtypes defined in 49/01_target_address.up.sql
+  -- Replaced in 49/03_ssh_targets.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id;
+
+  -- enable_session_recording is copied from the target when the session is
+  -- created and tells the worker to record the connections of the session.
+  alter table session
+    add column enable_session_recording boolean not null default false;
+
+  -- Replaces the immutable columns trigger from 1/01_server_tags_migrations.up.sql
+  -- Replaced in 49/06_connection_idle_timeout.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter', 'enable_session_recording');
+
+  -- session_connection_recording indexes the recording of the bytes proxied
+  -- for a session connection. The recording is captured by the worker that
+  -- proxied the connection and uploaded in chunks to the controller once the
+  -- connection is closed. The controller stores the recording itself in its
+  -- recording storage path, outside of the database. A session connection has
+  -- at most one recording.
+  create table session_connection_recording (
+    connection_id wt_public_id primary key
+      constraint session_connection_fkey
+        references session_connection (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    worker_id wt_public_id
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete set null
+        on update cascade,
+    start_time wt_timestamp,
+    end_time wt_timestamp,
+    size bigint not null
+      constraint size_must_be_positive
+        check(size > 0),
+    uploaded_chunks integer not null default 0
+      constraint uploaded_chunks_must_not_be_negative
+        check(uploaded_chunks >= 0),
+    uploaded_size bigint not null default 0
+      constraint uploaded_size_must_not_be_negative
+        check(uploaded_size >= 0),
+    -- complete is set once all the bytes of the recording have been uploaded.
+    -- Only complete recordings can be listed and downloaded.
+    complete boolean not null default false,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint end_time_must_not_be_before_start_time
+      check(end_time >= start_time),
+    constraint uploaded_size_must_not_exceed_size
+      check(uploaded_size <= size),
+    constraint complete_only_when_fully_uploaded
+      check(complete = (uploaded_size = size))
+  );
+  comment on table session_connection_recording is
+    'session_connection_recording is a table where each row indexes the recording of a session connection and tracks its upload.';
+
+  create trigger immutable_columns before update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'session_id', 'start_time', 'end_time', 'size', 'create_time');
+
+  create trigger default_create_time_column before insert on session_connection_recording
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on session_connection_recording
+    for each row execute procedure update_time_column();
+
+commit;
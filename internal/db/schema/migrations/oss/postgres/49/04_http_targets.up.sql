@@ -0,0 +1,192 @@
+begin;
+
+  -- target_http is a target subtype for HTTP targets. The worker acts as a
+  -- reverse proxy for the endpoint and sets the Authorization header of the
+  -- requests it forwards using the injected application credentials of the
+  -- target.
+  create table target_http (
+    public_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+        check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default -1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+        check(session_connection_limit > 0 or session_connection_limit = -1),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    worker_filter wt_bexprfilter,
+    enable_session_recording boolean not null default false,
+    enable_tls boolean not null default false,
+    auth_scheme text not null default 'basic'
+      constraint auth_scheme_must_be_basic_or_bearer
+        check(auth_scheme in ('basic', 'bearer')),
+    constraint target_http_project_id_name_uq
+      unique(project_id, name) -- name must be unique within a project
+  );
+  comment on table target_http is
+    'target_http is a table where each row is a resource that represents an http target. It is a target subtype.';
+
+  create trigger insert_target_subtype before insert on target_http
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_http
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_http
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger update_version_column after update on target_http
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_http
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_http
+    for each row execute procedure default_create_time();
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('target_http', 1);
+
+  -- Replaces target_all_subtypes defined in 49/03_ssh_targets.up.sql
+  -- Replaced in 49/05_postgres_targets.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         'ssh' as type
+    from target_ssh t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         t.enable_tls,
+         t.auth_scheme,
+         'http' as type
+    from target_http t
+         left join target_address ta on t.public_id = ta.target_id;
+
+  -- session_connection_http_request contains the HTTP requests proxied by a
+  -- worker over a connection of a session for an http target. The requests are
+  -- numbered by the worker in the order they were received on the connection.
+  create table session_connection_http_request (
+    connection_id wt_public_id not null
+      constraint session_connection_fkey
+        references session_connection (public_id)
+        on delete cascade
+        on update cascade,
+    sequence int not null
+      constraint sequence_must_be_greater_than_0
+        check(sequence > 0),
+    method text not null
+      constraint method_must_not_be_empty
+        check(length(trim(method)) > 0),
+    path text not null,
+    status_code int not null
+      constraint status_code_must_be_valid
+        check(status_code between 100 and 599),
+    request_time wt_timestamp,
+    create_time wt_timestamp,
+    primary key(connection_id, sequence)
+  );
+  comment on table session_connection_http_request is
+    'session_connection_http_request is a table where each row contains the method, path and response status of an HTTP request proxied over a session connection.';
+
+  create trigger immutable_columns before update on session_connection_http_request
+    for each row execute procedure immutable_columns('connection_id', 'sequence', 'method', 'path', 'status_code', 'request_time', 'create_time');
+
+  create trigger default_create_time_column before insert on session_connection_http_request
+    for each row execute procedure default_create_time();
+
+  -- warehouse
+
+  -- Replaces whx_target_subtype defined in 49/03_ssh_targets.up.sql
+  -- Replaced in 49/05_postgres_targets.up.sql
+  create or replace view whx_target_subtype as
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'tcp target' as target_type
+    from target_tcp
+  union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'ssh target' as target_type
+    from target_ssh
+  union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'http target' as target_type
+    from target_http;
+
+commit;
@@ -0,0 +1,183 @@
+begin;
+
+  -- target_postgres is a target subtype for PostgreSQL targets. The worker
+  -- completes the startup of the client's connection and authenticates to the
+  -- endpoint using the injected application credentials of the target.
+  create table target_postgres (
+    public_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+        check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default -1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+        check(session_connection_limit > 0 or session_connection_limit = -1),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    worker_filter wt_bexprfilter,
+    enable_session_recording boolean not null default false,
+    constraint target_postgres_project_id_name_uq
+      unique(project_id, name) -- name must be unique within a project
+  );
+  comment on table target_postgres is
+    'target_postgres is a table where each row is a resource that represents a postgres target. It is a target subtype.';
+
+  create trigger insert_target_subtype before insert on target_postgres
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_postgres
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_postgres
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger update_version_column after update on target_postgres
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_postgres
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_postgres
+    for each row execute procedure default_create_time();
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('target_postgres', 1);
+
+  -- Replaces target_all_subtypes defined in 49/04_http_targets.up.sql
+  -- Replaced in 49/06_connection_idle_timeout.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         'ssh' as type
+    from target_ssh t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         t.enable_tls,
+         t.auth_scheme,
+         'http' as type
+    from target_http t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         'postgres' as type
+    from target_postgres t
+         left join target_address ta on t.public_id = ta.target_id;
+
+  -- warehouse
+
+  -- Replaces whx_target_subtype defined in 49/04_http_targets.up.sql
+  create or replace view whx_target_subtype as
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'tcp target' as target_type
+    from target_tcp
+  union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'ssh target' as target_type
+    from target_ssh
+  union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'http target' as target_type
+    from target_http
+  union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         'postgres target' as target_type
+    from target_postgres;
+
+commit;
@@ -0,0 +1,131 @@
+begin;
+
+  -- idle_timeout_seconds is the number of seconds a connection made in a
+  -- session of the target may carry no traffic before the worker closes it.
+  -- 0 means connections are never closed for being idle.
+  alter table target_tcp
+    add column idle_timeout_seconds int not null default 0
+      constraint idle_timeout_seconds_must_not_be_negative
+        check(idle_timeout_seconds >= 0);
+
+  -- Replaces target_all_subtypes defined in 49/05_postgres_targets.up.sql
+  -- Replaced in 49/17_ssh_target_host_key.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         t.idle_timeout_seconds,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         'ssh' as type
+    from target_ssh t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         t.enable_tls,
+         t.auth_scheme,
+         0 as idle_timeout_seconds,
+         'http' as type
+    from target_http t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         'postgres' as type
+    from target_postgres t
+         left join target_address ta on t.public_id = ta.target_id;
+
+  -- idle_timeout_seconds is copied from the target when the session is created
+  -- and tells the worker when to close idle connections of the session.
+  alter table session
+    add column idle_timeout_seconds int not null default 0
+      constraint idle_timeout_seconds_must_not_be_negative
+        check(idle_timeout_seconds >= 0);
+
+  -- Replaces the immutable columns trigger from 49/02_session_recording.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter', 'enable_session_recording', 'idle_timeout_seconds');
+
+  -- drop constraint so we can add idle timeout
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed;
+
+  -- Add new constraint that only allows known reasons
+  -- This replaces the constraint defined in 0/51_connection.up.sql
+  alter table session_connection_closed_reason_enm
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'idle timeout'
+        )
+      );
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
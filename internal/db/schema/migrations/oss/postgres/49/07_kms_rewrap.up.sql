@@ -0,0 +1,81 @@
+begin;
+
+  -- The encrypted columns below are re-encrypted with the current version of
+  -- the scope's database key after a key rotation, so they can no longer be
+  -- immutable.
+
+  -- Replaces function from 0/11_auth_token.up.sql
+  create or replace function immutable_auth_token_columns() returns trigger
+  as $$
+  begin
+    if new.auth_account_id is distinct from old.auth_account_id then
+      raise exception 'auth_account_id is read-only';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function immutable_auth_token_columns() is
+    'function used in before update triggers to make specific columns immutable';
+
+  -- Replaces trigger from 10/04_vault_credential.up.sql
+  drop trigger immutable_columns on credential_vault_token;
+  create trigger immutable_columns before update on credential_vault_token
+    for each row execute procedure immutable_columns('token_hmac', 'store_id', 'create_time');
+
+  -- Replaces trigger from 43/01_session_credentials.up.sql
+  drop trigger immutable_columns on session_credential;
+  create trigger immutable_columns before update on session_credential
+    for each row execute procedure immutable_columns('session_id', 'credential_sha256');
+
+  -- Keep credential_sha256 in sync with the re-encrypted credential. Triggers
+  -- fire in name order, so immutable_columns has already checked that the
+  -- update itself did not change credential_sha256.
+  create trigger session_credentials_sha256_credential_update before update of credential on session_credential
+    for each row execute procedure session_credentials_sha256_credential();
+
+  -- The tofu token of a session is encrypted with the database key of its
+  -- project, while key_id holds the version of the sessions key. tofu_key_id
+  -- records the database key version used for the tofu token, so the token
+  -- can be rewrapped and the version is kept while it still protects one.
+  -- Sessions outlive their project, whose keys are deleted along with it, so
+  -- the column is cleared rather than blocking the deletion of the project.
+  alter table session
+    add column tofu_key_id text
+      constraint tofu_key_id_kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete set null
+        on update cascade;
+
+  -- Keys could not be rotated before this migration, so the tofu tokens of
+  -- the existing sessions are encrypted with the only version of the
+  -- database key of their project. Sessions whose project has been deleted
+  -- have no database key left to decrypt their tofu token with.
+  update session as s
+     set tofu_key_id = (
+           select dkv.private_id
+             from kms_data_key_version as dkv
+             join kms_data_key as dk
+               on dk.private_id = dkv.data_key_id
+             join kms_root_key as rk
+               on rk.private_id = dk.root_key_id
+            where rk.scope_id = s.project_id
+              and dk.purpose = 'database'
+         order by dkv.version desc
+            limit 1
+         )
+   where s.tofu_token is not null;
+
+  -- The rewrap job and the check for data key versions that are still in use
+  -- look up rows by the key_id column.
+  create index auth_token_key_id_ix
+    on auth_token (key_id);
+  create index session_key_id_ix
+    on session (key_id);
+  create index session_tofu_key_id_ix
+    on session (tofu_key_id);
+  create index session_credential_key_id_ix
+    on session_credential (key_id);
+  create index credential_vault_token_key_id_ix
+    on credential_vault_token (key_id);
+
+commit;
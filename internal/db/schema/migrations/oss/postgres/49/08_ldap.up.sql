@@ -0,0 +1,431 @@
+begin;
+
+  -- auth_ldap_method entries are the current ldap auth methods configured for
+  -- existing scopes.
+  create table auth_ldap_method (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    start_tls bool not null default false,
+    insecure_tls bool not null default false,
+    discover_dn bool not null default false,
+    anon_group_search bool not null default false,
+    upn_domain text
+      constraint upn_domain_not_empty
+        check(length(trim(upn_domain)) > 0),
+    user_dn text
+      constraint user_dn_not_empty
+        check(length(trim(user_dn)) > 0),
+    user_attr text
+      constraint user_attr_not_empty
+        check(length(trim(user_attr)) > 0),
+    user_filter text
+      constraint user_filter_not_empty
+        check(length(trim(user_filter)) > 0),
+    enable_groups bool not null default false,
+    group_dn text
+      constraint group_dn_not_empty
+        check(length(trim(group_dn)) > 0),
+    group_attr text
+      constraint group_attr_not_empty
+        check(length(trim(group_attr)) > 0),
+    group_filter text
+      constraint group_filter_not_empty
+        check(length(trim(group_filter)) > 0),
+    bind_dn text
+      constraint bind_dn_not_empty
+        check(length(trim(bind_dn)) > 0),
+    bind_password bytea -- encrypted password used with bind_dn for user and group searches.
+      constraint bind_password_not_empty
+        check(length(bind_password) > 0),
+    bind_password_hmac text
+      constraint bind_password_hmac_not_empty
+        check(length(trim(bind_password_hmac)) > 0),
+    key_id kms_private_id -- key used to encrypt bind_password via the wrapping wrapper.
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint bind_password_requires_bind_dn
+      check(bind_password is null or bind_dn is not null),
+    constraint bind_password_requires_hmac_and_key_id
+      check(
+        (bind_password is null and bind_password_hmac is null and key_id is null)
+        or
+        (bind_password is not null and bind_password_hmac is not null and key_id is not null)
+      ),
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_ldap_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_ldap_method is
+    'auth_ldap_method entries are the current ldap auth methods configured for existing scopes.';
+
+  create trigger insert_auth_method_subtype before insert on auth_ldap_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_ldap_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_ldap_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_time_column before update on auth_ldap_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_ldap_method
+    for each row execute procedure update_version_column();
+
+  -- auth_ldap_url entries are the ldap servers of an ldap auth method. An ldap
+  -- auth method must have at least one url and the urls are tried in order of
+  -- their connection_priority.
+  create table auth_ldap_url (
+    create_time wt_timestamp,
+    ldap_method_id wt_public_id not null
+      constraint auth_ldap_method_fkey
+        references auth_ldap_method(public_id)
+        on delete cascade
+        on update cascade,
+    connection_priority int not null
+      constraint connection_priority_less_than_one
+        check(connection_priority >= 1),
+    url text not null
+      constraint url_too_short
+        check (length(trim(url)) > 3)
+      constraint url_too_long
+        check (length(trim(url)) < 4000)
+      constraint url_invalid_protocol
+        check (url ~ 'ldaps?:\/\/*'),
+    primary key(ldap_method_id, connection_priority),
+    constraint auth_ldap_url_ldap_method_id_url_uq
+      unique(ldap_method_id, url)
+  );
+  comment on table auth_ldap_url is
+    'auth_ldap_url entries are the ldap servers of an ldap auth method, tried in order of their connection priority.';
+
+  create trigger default_create_time_column before insert on auth_ldap_url
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_url
+    for each row execute procedure immutable_columns('ldap_method_id', 'connection_priority', 'url', 'create_time');
+
+  -- auth_ldap_certificate entries are optional PEM encoded x509 certificates.
+  -- Each entry is a single certificate. If an auth method has any cert
+  -- entries, they are used as trust anchors when connecting to the auth
+  -- method's ldap servers (instead of the host system's cert chain).
+  create table auth_ldap_certificate (
+    create_time wt_timestamp,
+    ldap_method_id wt_public_id not null
+      constraint auth_ldap_method_fkey
+        references auth_ldap_method(public_id)
+        on delete cascade
+        on update cascade,
+    certificate text not null
+      constraint certificate_must_not_be_empty
+        check(length(trim(certificate)) > 0),
+    primary key(ldap_method_id, certificate)
+  );
+  comment on table auth_ldap_certificate is
+    'auth_ldap_certificate entries are optional PEM encoded x509 certificates used as trust anchors when connecting to the ldap servers of an ldap auth method.';
+
+  create trigger default_create_time_column before insert on auth_ldap_certificate
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_certificate
+    for each row execute procedure immutable_columns('ldap_method_id', 'certificate', 'create_time');
+
+  -- auth_ldap_account entries are subtypes of auth_account and represent an
+  -- ldap account.
+  create table auth_ldap_account (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    login_name text not null
+      constraint login_name_must_not_be_empty
+        check(length(trim(login_name)) > 0)
+      constraint login_name_must_be_lowercase
+        check(lower(trim(login_name)) = login_name),
+    full_name wt_full_name,
+    email wt_email,
+    dn text
+      constraint dn_must_not_be_empty
+        check(length(trim(dn)) > 0),
+    member_of_groups text -- a json array of the names of the ldap groups of the account.
+      constraint member_of_groups_must_be_a_json_array
+        check(jsonb_typeof(member_of_groups::jsonb) = 'array'),
+    constraint auth_ldap_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_ldap_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    -- ###############################################################
+    -- any change to this constraints name must be aligned with the
+    -- acctUpsertQuery const in internal/auth/ldap/query.go
+    -- ###############################################################
+    constraint auth_ldap_account_auth_method_id_login_name_uq
+      unique(auth_method_id, login_name),
+    constraint auth_ldap_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_ldap_account is
+    'auth_ldap_account entries are subtypes of auth_account and represent an ldap account.';
+
+  create trigger insert_auth_account_subtype before insert on auth_ldap_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_ldap_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'login_name', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_ldap_account
+    for each row execute procedure update_version_column();
+
+  -- auth_ldap_managed_group entries are subtypes of auth_managed_group. An
+  -- ldap account is a member of an ldap managed group when it was a member of
+  -- any of the group's ldap groups the last time it authenticated.
+  create table auth_ldap_managed_group (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    group_names text not null -- a json array of ldap group names.
+      constraint group_names_must_be_a_non_empty_json_array
+        check(jsonb_typeof(group_names::jsonb) = 'array' and jsonb_array_length(group_names::jsonb) > 0),
+    -- Ensure that this managed group relates to an ldap auth method, as opposed
+    -- to other types
+    constraint auth_ldap_method_fkey
+      foreign key (auth_method_id) -- fk1
+        references auth_ldap_method (public_id)
+        on delete cascade
+        on update cascade,
+    -- Ensure it relates to an abstract managed group
+    constraint auth_managed_group_fkey
+      foreign key (auth_method_id, public_id) -- fk2
+        references auth_managed_group (auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_managed_group_auth_method_id_name_uq
+      unique(auth_method_id, name)
+  );
+  comment on table auth_ldap_managed_group is
+    'auth_ldap_managed_group entries are subtypes of auth_managed_group and represent an ldap managed group.';
+
+  create trigger immutable_columns before update on auth_ldap_managed_group
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_managed_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_ldap_managed_group
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_ldap_managed_group
+    for each row execute procedure update_version_column();
+
+  create trigger insert_managed_group_subtype before insert on auth_ldap_managed_group
+    for each row execute procedure insert_managed_group_subtype();
+
+  create trigger delete_managed_group_subtype after delete on auth_ldap_managed_group
+    for each row execute procedure delete_managed_group_subtype();
+
+  -- ldap_auth_method_with_value_obj is useful for reading an ldap auth method
+  -- with its associated value objects (urls and certs) as columns with |
+  -- delimited values. The urls are aggregated in order of their connection
+  -- priority. The view also includes an is_primary_auth_method bool.
+  create view ldap_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.user_dn,
+    am.user_attr,
+    am.user_filter,
+    am.enable_groups,
+    am.group_dn,
+    am.group_attr,
+    am.group_filter,
+    am.bind_dn,
+    am.bind_password,
+    am.bind_password_hmac,
+    am.key_id,
+    -- the aggregated columns will be null if there are no associated value objects
+    (select string_agg(url.url, '|' order by url.connection_priority)
+       from auth_ldap_url url
+      where url.ldap_method_id = am.public_id) as urls,
+    (select string_agg(cert.certificate, '|')
+       from auth_ldap_certificate cert
+      where cert.ldap_method_id = am.public_id) as certs
+  from
+    auth_ldap_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects';
+
+  -- auth_ldap_managed_group_member_account lists the members of ldap managed
+  -- groups. Membership is derived from the ldap groups recorded on the
+  -- account when it last authenticated and the group names are compared
+  -- case insensitively.
+  create view auth_ldap_managed_group_member_account as
+  select
+    a.update_time as create_time,
+    mg.public_id as managed_group_id,
+    a.public_id as member_id
+  from
+    auth_ldap_managed_group mg
+    join auth_ldap_account a on a.auth_method_id = mg.auth_method_id
+  where
+    a.member_of_groups is not null
+    and exists (
+      select
+      from
+        jsonb_array_elements_text(a.member_of_groups::jsonb) ag(name),
+        jsonb_array_elements_text(mg.group_names::jsonb) mgn(name)
+      where
+        lower(ag.name) = lower(mgn.name)
+    );
+  comment on view auth_ldap_managed_group_member_account is
+    'auth_ldap_managed_group_member_account lists the accounts which are members of ldap managed groups';
+
+  -- Replaces view from 9/03_oidc_managed_group_member.up.sql
+  drop view auth_managed_group_member_account;
+  create view auth_managed_group_member_account as
+  select
+    oidc.create_time,
+    oidc.managed_group_id,
+    oidc.member_id
+  from
+    auth_oidc_managed_group_member_account oidc
+  union
+  select
+    ldap.create_time,
+    ldap.managed_group_id,
+    ldap.member_id
+  from
+    auth_ldap_managed_group_member_account ldap;
+
+  -- Replaces views from 4/01_iam.up.sql
+  drop view iam_user_acct_info;
+  drop view iam_acct_info;
+
+  create view iam_acct_info as
+  select
+    aa.iam_user_id,
+    oa.subject as login_name,
+    oa.public_id as primary_account_id,
+    oa.full_name as full_name,
+    oa.email as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_oidc_account oa
+  where
+    aa.public_id = oa.public_id and
+    aa.auth_method_id = s.primary_auth_method_id
+  union
+  select
+    aa.iam_user_id,
+    pa.login_name,
+    pa.public_id as primary_account_id,
+    '' as full_name,
+    '' as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_password_account pa
+  where
+    aa.public_id = pa.public_id and
+    aa.auth_method_id = s.primary_auth_method_id
+  union
+  select
+    aa.iam_user_id,
+    la.login_name,
+    la.public_id as primary_account_id,
+    la.full_name as full_name,
+    la.email as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_ldap_account la
+  where
+    aa.public_id = la.public_id and
+    aa.auth_method_id = s.primary_auth_method_id;
+
+  create view iam_user_acct_info as
+  select
+    u.public_id,
+    u.scope_id,
+    u.name,
+    u.description,
+    u.create_time,
+    u.update_time,
+    u.version,
+    i.primary_account_id,
+    i.login_name,
+    i.full_name,
+    i.email
+  from
+    iam_user u
+    left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_ldap_method', 1), -- auth method is the root aggregate itself and all of its value objects.
+    ('auth_ldap_account', 1),
+    ('auth_ldap_managed_group', 1);
+
+commit;
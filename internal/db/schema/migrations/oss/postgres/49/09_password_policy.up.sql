@@ -0,0 +1,155 @@
+begin;
+
+  -- The password policy of a password auth method. The policy is enforced by
+  -- the password repository whenever a password is set or changed.
+  alter table auth_password_method
+    add column require_uppercase boolean not null default false,
+    add column require_lowercase boolean not null default false,
+    add column require_digit boolean not null default false,
+    add column require_special boolean not null default false,
+    add column disallow_login_name boolean not null default false,
+    -- disallowed_words is a JSON array of words which a password must not
+    -- contain.
+    add column disallowed_words text
+      constraint disallowed_words_must_not_be_empty
+        check(length(trim(disallowed_words)) > 0),
+    -- password_history_count is the number of previous passwords of an
+    -- account which can not be reused.
+    add column password_history_count int not null default 0
+      constraint password_history_count_must_be_between_0_and_24
+        check(password_history_count between 0 and 24),
+    -- max_password_age_seconds is the number of seconds after which a password
+    -- expires and must be changed. A value of 0 means passwords never expire.
+    add column max_password_age_seconds int not null default 0
+      constraint max_password_age_seconds_must_not_be_negative
+        check(max_password_age_seconds >= 0);
+
+  -- auth_password_argon2_cred_history contains the previous argon2 credentials
+  -- of password accounts. Rows are inserted by a trigger when a credential is
+  -- deleted and pruned to the password_history_count of the auth method.
+  create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    password_conf_id wt_private_id not null,
+    password_method_id text not null,
+    -- create_time is the time the credential was created, not the time the
+    -- history row was inserted.
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+        check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+        check(length(derived_key) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+        check(length(trim(key_id)) > 0),
+    constraint auth_password_argon2_conf_fkey
+      foreign key (password_method_id, password_conf_id)
+        references auth_password_argon2_conf (password_method_id, private_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table auth_password_argon2_cred_history is
+    'auth_password_argon2_cred_history contains the previous argon2 credentials of password accounts.';
+
+  create trigger update_time_column before update on auth_password_argon2_cred_history
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_argon2_cred_history
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'derived_key', 'create_time');
+
+  create function insert_auth_password_argon2_cred_history() returns trigger
+  as $$
+  declare
+    history_count int;
+  begin
+    select pm.password_history_count
+      into history_count
+      from auth_password_method pm
+     where pm.public_id = old.password_method_id;
+
+    if history_count is null or history_count = 0 then
+      return null;
+    end if;
+
+    -- The credential is being deleted because its account is being deleted.
+    perform from auth_password_account
+     where public_id = old.password_account_id;
+    if not found then
+      return null;
+    end if;
+
+    insert into auth_password_argon2_cred_history
+      (private_id, password_account_id, password_conf_id, password_method_id, create_time, salt, derived_key, key_id)
+    values
+      (old.private_id, old.password_account_id, old.password_conf_id, old.password_method_id, old.create_time, old.salt, old.derived_key, old.key_id);
+
+    delete from auth_password_argon2_cred_history
+     where password_account_id = old.password_account_id
+       and private_id not in (
+             select private_id
+               from auth_password_argon2_cred_history
+              where password_account_id = old.password_account_id
+           order by create_time desc
+              limit history_count
+           );
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function insert_auth_password_argon2_cred_history() is
+    'function used in after delete triggers to keep a history of the previous argon2 credentials of an account';
+
+  create trigger insert_auth_password_argon2_cred_history after delete on auth_password_argon2_cred
+    for each row execute procedure insert_auth_password_argon2_cred_history();
+
+  -- Replaces view from 0/14_auth_password_views.up.sql
+  drop view auth_password_current_conf;
+  create view auth_password_current_conf as
+      select pm.min_login_name_length, pm.min_password_length,
+             pm.require_uppercase, pm.require_lowercase, pm.require_digit, pm.require_special,
+             pm.disallow_login_name, pm.disallowed_words,
+             pm.password_history_count, pm.max_password_age_seconds,
+             c.*
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  -- Replaces view from 2/20_pass.up.sql
+  drop view auth_password_method_with_is_primary;
+  create view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.require_uppercase,
+    am.require_lowercase,
+    am.require_digit,
+    am.require_special,
+    am.disallow_login_name,
+    am.disallowed_words,
+    am.password_history_count,
+    am.max_password_age_seconds
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+commit;
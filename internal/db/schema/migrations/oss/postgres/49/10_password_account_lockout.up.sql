@@ -0,0 +1,82 @@
+begin;
+
+  -- The account lockout settings of a password auth method. An account is
+  -- locked after lockout_threshold consecutive failed authentication attempts.
+  alter table auth_password_method
+    -- lockout_threshold is the number of consecutive failed authentication
+    -- attempts after which an account is locked. A value of 0 disables account
+    -- lockout.
+    add column lockout_threshold int not null default 0
+      constraint lockout_threshold_must_not_be_negative
+        check(lockout_threshold >= 0),
+    -- lockout_duration_seconds is the number of seconds an account stays
+    -- locked. A value of 0 means the account stays locked until it is unlocked
+    -- by an administrator.
+    add column lockout_duration_seconds int not null default 0
+      constraint lockout_duration_seconds_must_not_be_negative
+        check(lockout_duration_seconds >= 0);
+
+  -- auth_password_account_lockout contains the number of consecutive failed
+  -- authentication attempts of a password account and the time until which the
+  -- account is locked. Rows are deleted when the account successfully
+  -- authenticates or is unlocked.
+  create table auth_password_account_lockout (
+    password_account_id wt_public_id primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    failed_attempts int not null default 0
+      constraint failed_attempts_must_not_be_negative
+        check(failed_attempts >= 0),
+    locked_until timestamp with time zone
+  );
+  comment on table auth_password_account_lockout is
+    'auth_password_account_lockout contains the failed authentication attempts and lockout state of password accounts.';
+
+  create trigger default_create_time_column before insert on auth_password_account_lockout
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_password_account_lockout
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_account_lockout
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  -- Replaces view from 49/09_password_policy.up.sql
+  drop view auth_password_method_with_is_primary;
+  create view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.require_uppercase,
+    am.require_lowercase,
+    am.require_digit,
+    am.require_special,
+    am.disallow_login_name,
+    am.disallowed_words,
+    am.password_history_count,
+    am.max_password_age_seconds,
+    am.lockout_threshold,
+    am.lockout_duration_seconds
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+commit;
@@ -0,0 +1,89 @@
+begin;
+
+  -- mfa_required if true, requires every account of the auth method to
+  -- complete a TOTP enrollment and provide a TOTP code when authenticating.
+  alter table auth_password_method
+    add column mfa_required boolean not null default false;
+
+  -- auth_password_account_totp contains the time-based one-time password
+  -- (TOTP) enrollment of a password account. An enrollment is only used for
+  -- authentication once it has been verified.
+  create table auth_password_account_totp (
+    password_account_id wt_public_id primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null -- encrypted TOTP secret
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    recovery_codes bytea not null -- encrypted JSON array of unused recovery codes
+      constraint recovery_codes_must_not_be_empty
+        check(length(recovery_codes) > 0),
+    key_id kms_private_id not null -- key used to encrypt secret and recovery_codes
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    verified boolean not null default false,
+    -- last_used_step is the TOTP time step of the last code used to
+    -- authenticate. Codes for the same or an earlier time step are rejected.
+    last_used_step bigint not null default 0
+      constraint last_used_step_must_not_be_negative
+        check(last_used_step >= 0)
+  );
+  comment on table auth_password_account_totp is
+    'auth_password_account_totp contains the TOTP enrollments of password accounts.';
+
+  create trigger default_create_time_column before insert on auth_password_account_totp
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_password_account_totp
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_account_totp
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  -- The rewrap job and the check for data key versions that are still in use
+  -- look up rows by the key_id column.
+  create index auth_password_account_totp_key_id_ix
+    on auth_password_account_totp (key_id);
+
+  -- Replaces view from 49/10_password_account_lockout.up.sql
+  drop view auth_password_method_with_is_primary;
+  create view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.require_uppercase,
+    am.require_lowercase,
+    am.require_digit,
+    am.require_special,
+    am.disallow_login_name,
+    am.disallowed_words,
+    am.password_history_count,
+    am.max_password_age_seconds,
+    am.lockout_threshold,
+    am.lockout_duration_seconds,
+    am.mfa_required
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+commit;
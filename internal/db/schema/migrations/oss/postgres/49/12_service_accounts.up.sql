@@ -0,0 +1,225 @@
+begin;
+
+  -- iam_service_account is a principal for non-human clients, such as CI
+  -- pipelines. Service accounts cannot log in via an auth method; they
+  -- authenticate with the tokens in auth_service_account_token.
+  create table iam_service_account (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_fkey
+        references iam_scope (public_id)
+        on delete cascade
+        on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint iam_service_account_name_scope_id_uq
+      unique(name, scope_id),
+    -- add unique index so a composite fk can be declared.
+    constraint iam_service_account_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table iam_service_account is
+    'iam_service_account contains the service account principals.';
+
+  create function service_account_scope_id_valid() returns trigger
+  as $$
+  begin
+    perform from iam_scope where public_id = new.scope_id and type in ('global', 'org');
+    if not found then
+      raise exception 'invalid scope type for service account creation';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger ensure_service_account_scope_id_valid before insert or update on iam_service_account
+    for each row execute procedure service_account_scope_id_valid();
+
+  create trigger update_version_column after update on iam_service_account
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on iam_service_account
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on iam_service_account
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on iam_service_account
+    for each row execute procedure immutable_columns('public_id', 'create_time', 'scope_id');
+
+  -- iam_service_account_role contains roles that have been assigned to service
+  -- accounts. Service accounts can be from any scope. The rows in this table
+  -- must be immutable after insert, which will be ensured with a before update
+  -- trigger using iam_immutable_role_principal().
+  create table iam_service_account_role (
+    create_time wt_timestamp,
+    role_id wt_role_id
+      references iam_role (public_id)
+      on delete cascade
+      on update cascade,
+    principal_id wt_public_id
+      references iam_service_account (public_id)
+      on delete cascade
+      on update cascade,
+    primary key (role_id, principal_id)
+  );
+
+  create trigger immutable_role_principal before update on iam_service_account_role
+    for each row execute procedure iam_immutable_role_principal();
+
+  create trigger default_create_time_column before insert on iam_service_account_role
+    for each row execute procedure default_create_time();
+
+  -- Replaces view from 9/04_oidc_managed_group_principal_role.up.sql
+  create or replace view iam_principal_role as
+  select
+    ur.create_time,
+    ur.principal_id,
+    ur.role_id,
+    u.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, u.scope_id, ur.principal_id) as scoped_principal_id,
+    'user' as type
+  from
+    iam_user_role ur,
+    iam_role r,
+    iam_user u
+  where
+    ur.role_id = r.public_id and
+    u.public_id = ur.principal_id
+  union
+  select
+    gr.create_time,
+    gr.principal_id,
+    gr.role_id,
+    g.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, g.scope_id, gr.principal_id) as scoped_principal_id,
+    'group' as type
+  from
+    iam_group_role gr,
+    iam_role r,
+    iam_group g
+  where
+    gr.role_id = r.public_id and
+    g.public_id = gr.principal_id
+  union
+  select
+    mgr.create_time,
+    mgr.principal_id,
+    mgr.role_id,
+    (select scope_id from auth_method am where am.public_id = amg.auth_method_id) as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, (select scope_id from auth_method am where am.public_id = amg.auth_method_id), mgr.principal_id) as scoped_principal_id,
+    'managed group' as type
+  from
+    iam_managed_group_role mgr,
+    iam_role r,
+    auth_managed_group amg
+  where
+    mgr.role_id = r.public_id and
+    amg.public_id = mgr.principal_id
+  union
+  select
+    sar.create_time,
+    sar.principal_id,
+    sar.role_id,
+    sa.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, sa.scope_id, sar.principal_id) as scoped_principal_id,
+    'service account' as type
+  from
+    iam_service_account_role sar,
+    iam_role r,
+    iam_service_account sa
+  where
+    sar.role_id = r.public_id and
+    sa.public_id = sar.principal_id;
+
+  -- auth_service_account_token contains the tokens of service accounts. Unlike
+  -- auth_token, these tokens are not tied to an auth account and are created
+  -- with an explicit expiration time. They are not replicated, so they do not
+  -- need oplog entries.
+  create table auth_service_account_token (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    service_account_id wt_public_id not null,
+    constraint iam_service_account_fkey
+      foreign key (scope_id, service_account_id)
+        references iam_service_account (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    token bytea not null
+      constraint auth_service_account_token_token_uq
+        unique,
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    -- This column is not updated every time the token is used. See
+    -- update_last_access_time().
+    approximate_last_access_time wt_timestamp
+      constraint last_access_time_must_not_be_after_expiration_time
+        check(approximate_last_access_time <= expiration_time),
+    expiration_time timestamp with time zone not null
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time)
+  );
+  comment on table auth_service_account_token is
+    'auth_service_account_token contains the tokens of service accounts.';
+
+  create trigger default_create_time_column before insert on auth_service_account_token
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_service_account_token
+    for each row execute procedure update_time_column();
+
+  create trigger update_last_access_time before update on auth_service_account_token
+    for each row execute procedure update_last_access_time();
+
+  create trigger immutable_columns before update on auth_service_account_token
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'service_account_id', 'description', 'create_time', 'expiration_time');
+
+  -- The rewrap job and the check for data key versions that are still in use
+  -- look up rows by the key_id column.
+  create index auth_service_account_token_key_id_ix
+    on auth_service_account_token (key_id);
+
+  create index auth_service_account_token_service_account_id_ix
+    on auth_service_account_token (service_account_id);
+
+  -- auth_service_account_token_cidr contains the client address ranges a
+  -- service account token can be used from. A token without any rows in this
+  -- table can be used from any address.
+  create table auth_service_account_token_cidr (
+    token_id wt_public_id not null
+      constraint auth_service_account_token_fkey
+        references auth_service_account_token (public_id)
+        on delete cascade
+        on update cascade,
+    cidr cidr not null,
+    create_time wt_timestamp,
+    primary key (token_id, cidr)
+  );
+  comment on table auth_service_account_token_cidr is
+    'auth_service_account_token_cidr contains the allowed client address ranges of service account tokens.';
+
+  create trigger default_create_time_column before insert on auth_service_account_token_cidr
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_service_account_token_cidr
+    for each row execute procedure immutable_columns('token_id', 'cidr', 'create_time');
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('iam_service_account', 1);
+
+commit;
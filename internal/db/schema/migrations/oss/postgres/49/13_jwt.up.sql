@@ -0,0 +1,487 @@
+begin;
+
+  -- auth_jwt_method entries are the current jwt auth methods configured for
+  -- existing scopes. The signature of a token is verified with either the
+  -- keys of the jwks_url or with the auth method's public keys.
+  create table auth_jwt_method (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    bound_issuer text
+      constraint bound_issuer_not_empty
+        check(length(trim(bound_issuer)) > 0),
+    jwks_url wt_url,
+    jwks_ca_cert text
+      constraint jwks_ca_cert_not_empty
+        check(length(trim(jwks_ca_cert)) > 0),
+    constraint jwks_ca_cert_requires_jwks_url
+      check(jwks_ca_cert is null or jwks_url is not null),
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_jwt_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_jwt_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_jwt_method is
+    'auth_jwt_method entries are the current jwt auth methods configured for existing scopes.';
+
+  create trigger insert_auth_method_subtype before insert on auth_jwt_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_jwt_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_jwt_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_time_column before update on auth_jwt_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_jwt_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_jwt_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_jwt_method
+    for each row execute procedure update_version_column();
+
+  -- auth_jwt_public_key entries are the PEM encoded public keys used to verify
+  -- the signature of tokens when the jwt auth method has no jwks_url.
+  create table auth_jwt_public_key (
+    create_time wt_timestamp,
+    jwt_method_id wt_public_id not null
+      constraint auth_jwt_method_fkey
+        references auth_jwt_method(public_id)
+        on delete cascade
+        on update cascade,
+    public_key text not null
+      constraint public_key_must_not_be_empty
+        check(length(trim(public_key)) > 0),
+    primary key(jwt_method_id, public_key)
+  );
+  comment on table auth_jwt_public_key is
+    'auth_jwt_public_key entries are the PEM encoded public keys used to verify the signature of tokens of a jwt auth method.';
+
+  create trigger default_create_time_column before insert on auth_jwt_public_key
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_jwt_public_key
+    for each row execute procedure immutable_columns('jwt_method_id', 'public_key', 'create_time');
+
+  -- auth_jwt_bound_audience entries are the audiences a token must be issued
+  -- for. When a jwt auth method has any bound audiences, the aud claim of a
+  -- token must contain at least one of them.
+  create table auth_jwt_bound_audience (
+    create_time wt_timestamp,
+    jwt_method_id wt_public_id not null
+      constraint auth_jwt_method_fkey
+        references auth_jwt_method(public_id)
+        on delete cascade
+        on update cascade,
+    audience text not null
+      constraint audience_must_not_be_empty
+        check(length(trim(audience)) > 0)
+      constraint audience_must_be_less_than_1024_chars
+        check(length(trim(audience)) < 1024),
+    primary key(jwt_method_id, audience)
+  );
+  comment on table auth_jwt_bound_audience is
+    'auth_jwt_bound_audience entries are the audiences a token of a jwt auth method must be issued for.';
+
+  create trigger default_create_time_column before insert on auth_jwt_bound_audience
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_jwt_bound_audience
+    for each row execute procedure immutable_columns('jwt_method_id', 'audience', 'create_time');
+
+  -- auth_jwt_signing_alg entries are the signing algorithms allowed for a jwt
+  -- auth method. The algorithms are shared with oidc auth methods.
+  create table auth_jwt_signing_alg (
+    create_time wt_timestamp,
+    jwt_method_id wt_public_id not null
+      constraint auth_jwt_method_fkey
+        references auth_jwt_method(public_id)
+        on delete cascade
+        on update cascade,
+    signing_alg_name text not null
+      constraint auth_oidc_signing_alg_enm_fkey
+        references auth_oidc_signing_alg_enm(name)
+        on delete restrict
+        on update cascade,
+    primary key(jwt_method_id, signing_alg_name)
+  );
+  comment on table auth_jwt_signing_alg is
+    'auth_jwt_signing_alg entries are the signing algorithms allowed for a jwt auth method.';
+
+  create trigger default_create_time_column before insert on auth_jwt_signing_alg
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_jwt_signing_alg
+    for each row execute procedure immutable_columns('jwt_method_id', 'signing_alg_name', 'create_time');
+
+  -- auth_jwt_bound_claim entries are the claim constraints of a jwt auth
+  -- method. A token must contain every bound claim and the claim must match
+  -- one of the values bound to it.
+  create table auth_jwt_bound_claim (
+    create_time wt_timestamp,
+    jwt_method_id wt_public_id not null
+      constraint auth_jwt_method_fkey
+        references auth_jwt_method(public_id)
+        on delete cascade
+        on update cascade,
+    claim text not null
+      constraint claim_must_not_be_empty
+        check(length(trim(claim)) > 0)
+      constraint claim_must_be_less_than_1024_chars
+        check(length(trim(claim)) < 1024),
+    value text not null
+      constraint value_must_not_be_empty
+        check(length(trim(value)) > 0)
+      constraint value_must_be_less_than_1024_chars
+        check(length(trim(value)) < 1024),
+    primary key(jwt_method_id, claim, value)
+  );
+  comment on table auth_jwt_bound_claim is
+    'auth_jwt_bound_claim entries are the claim constraints a token of a jwt auth method must satisfy.';
+
+  create trigger default_create_time_column before insert on auth_jwt_bound_claim
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_jwt_bound_claim
+    for each row execute procedure immutable_columns('jwt_method_id', 'claim', 'value', 'create_time');
+
+  -- auth_jwt_account_claim_map entries are the optional claim maps from custom
+  -- claims to the standard claims of sub, name and email.
+  create table auth_jwt_account_claim_map (
+    create_time wt_timestamp,
+    jwt_method_id wt_public_id not null
+      constraint auth_jwt_method_fkey
+        references auth_jwt_method(public_id)
+        on delete cascade
+        on update cascade,
+    from_claim text not null
+      constraint from_claim_must_not_be_empty
+        check(length(trim(from_claim)) > 0)
+      constraint from_claim_must_be_less_than_1024_chars
+        check(length(trim(from_claim)) < 1024),
+    to_claim text not null
+      constraint to_claim_valid_values
+        check (to_claim in ('sub', 'name', 'email')), -- intentionally case-sensitive matching
+    primary key(jwt_method_id, to_claim)
+  );
+  comment on table auth_jwt_account_claim_map is
+    'auth_jwt_account_claim_map entries are the optional claim maps from custom claims to the standard claims of sub, name and email.  There can be 0 or more for each parent jwt auth method.';
+
+  create trigger default_create_time_column before insert on auth_jwt_account_claim_map
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_jwt_account_claim_map
+    for each row execute procedure immutable_columns('jwt_method_id', 'from_claim', 'to_claim', 'create_time');
+
+  -- auth_jwt_account entries are subtypes of auth_account and represent a jwt
+  -- account.
+  create table auth_jwt_account (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    subject text not null
+      constraint subject_must_not_be_empty
+        check(length(trim(subject)) > 0)
+      constraint subject_must_be_less_than_256_chars
+        check(length(trim(subject)) <= 255),
+    full_name wt_full_name,
+    email wt_email,
+    token_claims text
+      constraint token_claims_must_not_be_empty
+        check(length(trim(token_claims)) > 0),
+    constraint auth_jwt_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_jwt_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_jwt_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    -- ###############################################################
+    -- any change to this constraints name must be aligned with the
+    -- acctUpsertQuery const in internal/auth/jwt/query.go
+    -- ###############################################################
+    constraint auth_jwt_account_auth_method_id_subject_uq
+      unique(auth_method_id, subject),
+    constraint auth_jwt_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_jwt_account is
+    'auth_jwt_account entries are subtypes of auth_account and represent a jwt account.';
+
+  create trigger insert_auth_account_subtype before insert on auth_jwt_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_jwt_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_jwt_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'subject', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_jwt_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_jwt_account
+    for each row execute procedure update_version_column();
+
+  -- auth_jwt_managed_group entries are subtypes of auth_managed_group. A jwt
+  -- account is a member of a jwt managed group when the claims of the token
+  -- it last authenticated with matched the group's filter.
+  create table auth_jwt_managed_group (
+    public_id wt_public_id primary key,
+    auth_method_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    filter wt_bexprfilter not null,
+    -- Ensure that this managed group relates to a jwt auth method, as opposed
+    -- to other types
+    constraint auth_jwt_method_fkey
+      foreign key (auth_method_id) -- fk1
+        references auth_jwt_method (public_id)
+        on delete cascade
+        on update cascade,
+    -- Ensure it relates to an abstract managed group
+    constraint auth_managed_group_fkey
+      foreign key (auth_method_id, public_id) -- fk2
+        references auth_managed_group (auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_jwt_managed_group_auth_method_id_name_uq
+      unique(auth_method_id, name)
+  );
+  comment on table auth_jwt_managed_group is
+    'auth_jwt_managed_group entries are subtypes of auth_managed_group and represent a jwt managed group.';
+
+  create trigger immutable_columns before update on auth_jwt_managed_group
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_jwt_managed_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_jwt_managed_group
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_jwt_managed_group
+    for each row execute procedure update_version_column();
+
+  create trigger insert_managed_group_subtype before insert on auth_jwt_managed_group
+    for each row execute procedure insert_managed_group_subtype();
+
+  create trigger delete_managed_group_subtype after delete on auth_jwt_managed_group
+    for each row execute procedure delete_managed_group_subtype();
+
+  -- Mappings of account to jwt managed groups. They are set every time an
+  -- account authenticates.
+  create table auth_jwt_managed_group_member_account (
+    create_time wt_timestamp,
+    managed_group_id wt_public_id
+      references auth_jwt_managed_group(public_id)
+      on delete cascade
+      on update cascade,
+    member_id wt_public_id
+      references auth_jwt_account(public_id)
+      on delete cascade
+      on update cascade,
+    primary key (managed_group_id, member_id)
+  );
+  comment on table auth_jwt_managed_group_member_account is
+    'auth_jwt_managed_group_member_account is the join table for managed jwt groups and accounts.';
+
+  create function auth_immutable_managed_jwt_group_member_account() returns trigger
+  as $$
+  begin
+    raise exception 'managed jwt group members are immutable';
+  end;
+  $$ language plpgsql;
+
+  create trigger default_create_time_column before insert on auth_jwt_managed_group_member_account
+    for each row execute procedure default_create_time();
+
+  create trigger auth_immutable_managed_jwt_group_member_account before update on auth_jwt_managed_group_member_account
+    for each row execute procedure auth_immutable_managed_jwt_group_member_account();
+
+  -- jwt_auth_method_with_value_obj is useful for reading a jwt auth method
+  -- with its associated value objects (public keys, audiences, signing
+  -- algorithms, bound claims and account claim maps) as columns with |
+  -- delimited values. The bound claims and account claim maps are
+  -- represented as key=value. The view also includes an
+  -- is_primary_auth_method bool.
+  create view jwt_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.bound_issuer,
+    am.jwks_url,
+    am.jwks_ca_cert,
+    -- the aggregated columns will be null if there are no associated value objects
+    (select string_agg(pk.public_key, '|')
+       from auth_jwt_public_key pk
+      where pk.jwt_method_id = am.public_id) as public_keys,
+    (select string_agg(aud.audience, '|')
+       from auth_jwt_bound_audience aud
+      where aud.jwt_method_id = am.public_id) as audiences,
+    (select string_agg(alg.signing_alg_name, '|')
+       from auth_jwt_signing_alg alg
+      where alg.jwt_method_id = am.public_id) as algs,
+    (select string_agg(concat_ws('=', bc.claim, bc.value), '|')
+       from auth_jwt_bound_claim bc
+      where bc.jwt_method_id = am.public_id) as bound_claims,
+    (select string_agg(concat_ws('=', acm.from_claim, acm.to_claim), '|')
+       from auth_jwt_account_claim_map acm
+      where acm.jwt_method_id = am.public_id) as account_claim_maps
+  from
+    auth_jwt_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view jwt_auth_method_with_value_obj is
+    'jwt auth method with its associated value objects';
+
+  -- Replaces view from 49/08_ldap.up.sql
+  drop view auth_managed_group_member_account;
+  create view auth_managed_group_member_account as
+  select
+    oidc.create_time,
+    oidc.managed_group_id,
+    oidc.member_id
+  from
+    auth_oidc_managed_group_member_account oidc
+  union
+  select
+    ldap.create_time,
+    ldap.managed_group_id,
+    ldap.member_id
+  from
+    auth_ldap_managed_group_member_account ldap
+  union
+  select
+    jwt.create_time,
+    jwt.managed_group_id,
+    jwt.member_id
+  from
+    auth_jwt_managed_group_member_account jwt;
+
+  -- Replaces views from 49/08_ldap.up.sql
+  drop view iam_user_acct_info;
+  drop view iam_acct_info;
+
+  create view iam_acct_info as
+  select
+    aa.iam_user_id,
+    oa.subject as login_name,
+    oa.public_id as primary_account_id,
+    oa.full_name as full_name,
+    oa.email as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_oidc_account oa
+  where
+    aa.public_id = oa.public_id and
+    aa.auth_method_id = s.primary_auth_method_id
+  union
+  select
+    aa.iam_user_id,
+    pa.login_name,
+    pa.public_id as primary_account_id,
+    '' as full_name,
+    '' as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_password_account pa
+  where
+    aa.public_id = pa.public_id and
+    aa.auth_method_id = s.primary_auth_method_id
+  union
+  select
+    aa.iam_user_id,
+    la.login_name,
+    la.public_id as primary_account_id,
+    la.full_name as full_name,
+    la.email as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_ldap_account la
+  where
+    aa.public_id = la.public_id and
+    aa.auth_method_id = s.primary_auth_method_id
+  union
+  select
+    aa.iam_user_id,
+    ja.subject as login_name,
+    ja.public_id as primary_account_id,
+    ja.full_name as full_name,
+    ja.email as email
+  from
+    iam_scope s,
+    auth_account aa,
+    auth_jwt_account ja
+  where
+    aa.public_id = ja.public_id and
+    aa.auth_method_id = s.primary_auth_method_id;
+
+  create view iam_user_acct_info as
+  select
+    u.public_id,
+    u.scope_id,
+    u.name,
+    u.description,
+    u.create_time,
+    u.update_time,
+    u.version,
+    i.primary_account_id,
+    i.login_name,
+    i.full_name,
+    i.email
+  from
+    iam_user u
+    left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_jwt_method', 1), -- auth method is the root aggregate itself and all of its value objects.
+    ('auth_jwt_account', 1),
+    ('auth_jwt_managed_group', 1);
+
+commit;
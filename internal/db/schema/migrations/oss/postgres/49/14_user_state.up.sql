@@ -0,0 +1,45 @@
+begin;
+
+  -- iam_user_state_enm contains the possible states of a user.
+  create table iam_user_state_enm (
+    name text primary key
+      constraint only_predefined_user_states_allowed
+        check(name in ('active', 'disabled'))
+  );
+  comment on table iam_user_state_enm is
+    'iam_user_state_enm is an enumeration table for the state of users.';
+
+  insert into iam_user_state_enm (name)
+  values
+    ('active'),
+    ('disabled');
+
+  -- A disabled user cannot authenticate and its auth tokens are not valid.
+  alter table iam_user
+    add column state text not null default 'active'
+      constraint iam_user_state_enm_fkey
+        references iam_user_state_enm (name)
+        on delete restrict
+        on update cascade;
+
+  -- Replaces view from 49/13_jwt.up.sql
+  drop view iam_user_acct_info;
+  create view iam_user_acct_info as
+  select
+    u.public_id,
+    u.scope_id,
+    u.name,
+    u.description,
+    u.create_time,
+    u.update_time,
+    u.version,
+    u.state,
+    i.primary_account_id,
+    i.login_name,
+    i.full_name,
+    i.email
+  from
+    iam_user u
+    left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+commit;
@@ -0,0 +1,113 @@
+begin;
+
+  -- The lifetime of the auth tokens issued by an auth method. These override
+  -- the controller's auth_token_time_to_live and auth_token_time_to_stale
+  -- settings for the auth method. They are set on the subtype tables, so they
+  -- are updated with the auth method's version and oplog entries, and copied to
+  -- the auth_method base table by update_auth_method_token_lifetime, so the
+  -- lifetime of an auth token can be read without knowing the subtype of its
+  -- auth method.
+  alter table auth_method
+    -- auth_token_time_to_live_seconds is the maximum lifetime of an auth token
+    -- issued by the auth method. A value of 0 means the controller's default is
+    -- used.
+    add column auth_token_time_to_live_seconds int not null default 0
+      constraint auth_token_time_to_live_seconds_must_not_be_negative
+        check(auth_token_time_to_live_seconds >= 0),
+    -- auth_token_time_to_stale_seconds is the amount of time an auth token
+    -- issued by the auth method can go unused before it is no longer valid. A
+    -- value of 0 means the controller's default is used.
+    add column auth_token_time_to_stale_seconds int not null default 0
+      constraint auth_token_time_to_stale_seconds_must_not_be_negative
+        check(auth_token_time_to_stale_seconds >= 0),
+    add constraint auth_token_time_to_stale_must_not_exceed_time_to_live
+      check(
+        auth_token_time_to_live_seconds = 0
+        or
+        auth_token_time_to_stale_seconds <= auth_token_time_to_live_seconds
+      );
+
+  alter table auth_password_method
+    add column auth_token_time_to_live_seconds int not null default 0
+      constraint auth_token_time_to_live_seconds_must_not_be_negative
+        check(auth_token_time_to_live_seconds >= 0),
+    add column auth_token_time_to_stale_seconds int not null default 0
+      constraint auth_token_time_to_stale_seconds_must_not_be_negative
+        check(auth_token_time_to_stale_seconds >= 0),
+    add constraint auth_token_time_to_stale_must_not_exceed_time_to_live
+      check(
+        auth_token_time_to_live_seconds = 0
+        or
+        auth_token_time_to_stale_seconds <= auth_token_time_to_live_seconds
+      );
+  alter table auth_oidc_method
+    add column auth_token_time_to_live_seconds int not null default 0
+      constraint auth_token_time_to_live_seconds_must_not_be_negative
+        check(auth_token_time_to_live_seconds >= 0),
+    add column auth_token_time_to_stale_seconds int not null default 0
+      constraint auth_token_time_to_stale_seconds_must_not_be_negative
+        check(auth_token_time_to_stale_seconds >= 0),
+    add constraint auth_token_time_to_stale_must_not_exceed_time_to_live
+      check(
+        auth_token_time_to_live_seconds = 0
+        or
+        auth_token_time_to_stale_seconds <= auth_token_time_to_live_seconds
+      );
+  alter table auth_ldap_method
+    add column auth_token_time_to_live_seconds int not null default 0
+      constraint auth_token_time_to_live_seconds_must_not_be_negative
+        check(auth_token_time_to_live_seconds >= 0),
+    add column auth_token_time_to_stale_seconds int not null default 0
+      constraint auth_token_time_to_stale_seconds_must_not_be_negative
+        check(auth_token_time_to_stale_seconds >= 0),
+    add constraint auth_token_time_to_stale_must_not_exceed_time_to_live
+      check(
+        auth_token_time_to_live_seconds = 0
+        or
+        auth_token_time_to_stale_seconds <= auth_token_time_to_live_seconds
+      );
+  alter table auth_jwt_method
+    add column auth_token_time_to_live_seconds int not null default 0
+      constraint auth_token_time_to_live_seconds_must_not_be_negative
+        check(auth_token_time_to_live_seconds >= 0),
+    add column auth_token_time_to_stale_seconds int not null default 0
+      constraint auth_token_time_to_stale_seconds_must_not_be_negative
+        check(auth_token_time_to_stale_seconds >= 0),
+    add constraint auth_token_time_to_stale_must_not_exceed_time_to_live
+      check(
+        auth_token_time_to_live_seconds = 0
+        or
+        auth_token_time_to_stale_seconds <= auth_token_time_to_live_seconds
+      );
+
+  -- update_auth_method_token_lifetime is an after insert or update trigger on
+  -- the auth method subtype tables. It copies the auth token lifetime of the
+  -- auth method to the auth_method base table.
+  create function update_auth_method_token_lifetime() returns trigger
+  as $$
+  begin
+    update auth_method
+       set auth_token_time_to_live_seconds  = new.auth_token_time_to_live_seconds,
+           auth_token_time_to_stale_seconds = new.auth_token_time_to_stale_seconds
+     where public_id = new.public_id
+       and (auth_token_time_to_live_seconds, auth_token_time_to_stale_seconds)
+           is distinct from
+           (new.auth_token_time_to_live_seconds, new.auth_token_time_to_stale_seconds);
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger update_auth_method_token_lifetime
+    after insert or update of auth_token_time_to_live_seconds, auth_token_time_to_stale_seconds on auth_password_method
+    for each row execute procedure update_auth_method_token_lifetime();
+  create trigger update_auth_method_token_lifetime
+    after insert or update of auth_token_time_to_live_seconds, auth_token_time_to_stale_seconds on auth_oidc_method
+    for each row execute procedure update_auth_method_token_lifetime();
+  create trigger update_auth_method_token_lifetime
+    after insert or update of auth_token_time_to_live_seconds, auth_token_time_to_stale_seconds on auth_ldap_method
+    for each row execute procedure update_auth_method_token_lifetime();
+  create trigger update_auth_method_token_lifetime
+    after insert or update of auth_token_time_to_live_seconds, auth_token_time_to_stale_seconds on auth_jwt_method
+    for each row execute procedure update_auth_method_token_lifetime();
+
+commit;
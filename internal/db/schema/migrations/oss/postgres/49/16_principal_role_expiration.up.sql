@@ -0,0 +1,110 @@
+begin;
+
+  -- An optional expiration time for the assignment of a principal to a role.
+  -- Expired assignments are ignored when determining the grants of a user and
+  -- are periodically deleted. The rows of these tables are immutable, so the
+  -- expiration time of an assignment cannot be changed after insert.
+  alter table iam_user_role
+    add column expiration_time timestamp with time zone
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time);
+
+  alter table iam_group_role
+    add column expiration_time timestamp with time zone
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time);
+
+  alter table iam_managed_group_role
+    add column expiration_time timestamp with time zone
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time);
+
+  alter table iam_service_account_role
+    add column expiration_time timestamp with time zone
+      constraint create_time_must_be_before_expiration_time
+        check(create_time < expiration_time);
+
+  create index iam_user_role_expiration_time_ix
+    on iam_user_role (expiration_time)
+    where expiration_time is not null;
+  create index iam_group_role_expiration_time_ix
+    on iam_group_role (expiration_time)
+    where expiration_time is not null;
+  create index iam_managed_group_role_expiration_time_ix
+    on iam_managed_group_role (expiration_time)
+    where expiration_time is not null;
+  create index iam_service_account_role_expiration_time_ix
+    on iam_service_account_role (expiration_time)
+    where expiration_time is not null;
+
+  -- Replaces view from 49/12_service_accounts.up.sql
+  create or replace view iam_principal_role as
+  select
+    ur.create_time,
+    ur.principal_id,
+    ur.role_id,
+    u.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, u.scope_id, ur.principal_id) as scoped_principal_id,
+    'user' as type,
+    ur.expiration_time
+  from
+    iam_user_role ur,
+    iam_role r,
+    iam_user u
+  where
+    ur.role_id = r.public_id and
+    u.public_id = ur.principal_id
+  union
+  select
+    gr.create_time,
+    gr.principal_id,
+    gr.role_id,
+    g.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, g.scope_id, gr.principal_id) as scoped_principal_id,
+    'group' as type,
+    gr.expiration_time
+  from
+    iam_group_role gr,
+    iam_role r,
+    iam_group g
+  where
+    gr.role_id = r.public_id and
+    g.public_id = gr.principal_id
+  union
+  select
+    mgr.create_time,
+    mgr.principal_id,
+    mgr.role_id,
+    (select scope_id from auth_method am where am.public_id = amg.auth_method_id) as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, (select scope_id from auth_method am where am.public_id = amg.auth_method_id), mgr.principal_id) as scoped_principal_id,
+    'managed group' as type,
+    mgr.expiration_time
+  from
+    iam_managed_group_role mgr,
+    iam_role r,
+    auth_managed_group amg
+  where
+    mgr.role_id = r.public_id and
+    amg.public_id = mgr.principal_id
+  union
+  select
+    sar.create_time,
+    sar.principal_id,
+    sar.role_id,
+    sa.scope_id as principal_scope_id,
+    r.scope_id as role_scope_id,
+    get_scoped_principal_id(r.scope_id, sa.scope_id, sar.principal_id) as scoped_principal_id,
+    'service account' as type,
+    sar.expiration_time
+  from
+    iam_service_account_role sar,
+    iam_role r,
+    iam_service_account sa
+  where
+    sar.role_id = r.public_id and
+    sa.public_id = sar.principal_id;
+
+commit;
@@ -0,0 +1,100 @@
+begin;
+
+  -- host_key is the public key, in authorized_keys format, the endpoint of the
+  -- ssh target must present. The worker verifies the endpoint against it before
+  -- authenticating with the injected application credentials of the target and
+  -- refuses to connect to endpoints of targets without a host key.
+  alter table target_ssh
+    add column host_key text
+      constraint host_key_must_not_be_empty
+        check(length(trim(host_key)) > 0);
+
+  -- Replaces target_all_subtypes defined in 49/06_connection_idle_timeout.up.sql
+  -- Replaced in 49/18_postgres_target_ssl.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         t.idle_timeout_seconds,
+         null as host_key,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         t.host_key,
+         'ssh' as type
+    from target_ssh t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         t.enable_tls,
+         t.auth_scheme,
+         0 as idle_timeout_seconds,
+         null as host_key,
+         'http' as type
+    from target_http t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         null as host_key,
+         'postgres' as type
+    from target_postgres t
+         left join target_address ta on t.public_id = ta.target_id;
+
+commit;
@@ -0,0 +1,115 @@
+begin;
+
+  -- ssl_mode is how the worker secures its connection to the endpoint of the
+  -- postgres target, using the sslmode values of libpq which require the
+  -- endpoint to support TLS. The worker only answers cleartext and MD5 password
+  -- requests of the endpoint over TLS.
+  alter table target_postgres
+    add column ssl_mode text not null default 'verify-full'
+      constraint ssl_mode_must_be_disable_require_verify_ca_or_verify_full
+        check(ssl_mode in ('disable', 'require', 'verify-ca', 'verify-full'));
+
+  -- ssl_root_cert is the PEM encoded certificate authorities the certificate
+  -- of the endpoint is verified against. The worker uses the system certificate
+  -- authorities when it is not set.
+  alter table target_postgres
+    add column ssl_root_cert text
+      constraint ssl_root_cert_must_not_be_empty
+        check(length(trim(ssl_root_cert)) > 0);
+
+  -- Replaces target_all_subtypes defined in 49/17_ssh_target_host_key.up.sql
+  drop view target_all_subtypes;
+  create view target_all_subtypes as
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         t.idle_timeout_seconds,
+         null as host_key,
+         null as ssl_mode,
+         null as ssl_root_cert,
+         'tcp' as type
+    from target_tcp t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         t.host_key,
+         null as ssl_mode,
+         null as ssl_root_cert,
+         'ssh' as type
+    from target_ssh t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         t.enable_tls,
+         t.auth_scheme,
+         0 as idle_timeout_seconds,
+         null as host_key,
+         null as ssl_mode,
+         null as ssl_root_cert,
+         'http' as type
+    from target_http t
+         left join target_address ta on t.public_id = ta.target_id
+  union
+  select t.public_id,
+         t.project_id,
+         t.name,
+         t.description,
+         t.default_port,
+         t.session_max_seconds,
+         t.session_connection_limit,
+         t.version,
+         t.create_time,
+         t.update_time,
+         t.worker_filter,
+         ta.address,
+         t.enable_session_recording,
+         false as enable_tls,
+         null as auth_scheme,
+         0 as idle_timeout_seconds,
+         null as host_key,
+         t.ssl_mode,
+         t.ssl_root_cert,
+         'postgres' as type
+    from target_postgres t
+         left join target_address ta on t.public_id = ta.target_id;
+
+commit;
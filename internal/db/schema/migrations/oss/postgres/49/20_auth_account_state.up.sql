@@ -0,0 +1,114 @@
+begin;
+
+  -- auth_account_state_enm contains the possible states of an account.
+  create table auth_account_state_enm (
+    name text primary key
+      constraint only_predefined_account_states_allowed
+        check(name in ('active', 'disabled'))
+  );
+  comment on table auth_account_state_enm is
+    'auth_account_state_enm is an enumeration table for the state of accounts.';
+
+  insert into auth_account_state_enm (name)
+  values
+    ('active'),
+    ('disabled');
+
+  -- A disabled account cannot authenticate and the auth tokens issued to it
+  -- are not valid. The state is set on the subtype tables and copied to the
+  -- auth_account base table by update_auth_account_state, so the state of an
+  -- account can be read without knowing its subtype.
+  alter table auth_account
+    add column state text not null default 'active'
+      constraint auth_account_state_enm_fkey
+        references auth_account_state_enm (name)
+        on delete restrict
+        on update cascade;
+  alter table auth_password_account
+    add column state text not null default 'active'
+      constraint auth_account_state_enm_fkey
+        references auth_account_state_enm (name)
+        on delete restrict
+        on update cascade;
+  alter table auth_oidc_account
+    add column state text not null default 'active'
+      constraint auth_account_state_enm_fkey
+        references auth_account_state_enm (name)
+        on delete restrict
+        on update cascade;
+  alter table auth_ldap_account
+    add column state text not null default 'active'
+      constraint auth_account_state_enm_fkey
+        references auth_account_state_enm (name)
+        on delete restrict
+        on update cascade;
+  alter table auth_jwt_account
+    add column state text not null default 'active'
+      constraint auth_account_state_enm_fkey
+        references auth_account_state_enm (name)
+        on delete restrict
+        on update cascade;
+
+  -- update_auth_account_state is an after insert or update trigger on the
+  -- auth account subtype tables. It copies the state of the account to the
+  -- auth_account base table.
+  create function update_auth_account_state() returns trigger
+  as $$
+  begin
+    update auth_account
+       set state = new.state
+     where public_id = new.public_id
+       and state is distinct from new.state;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger update_auth_account_state after insert or update of state on auth_password_account
+    for each row execute procedure update_auth_account_state();
+  create trigger update_auth_account_state after insert or update of state on auth_oidc_account
+    for each row execute procedure update_auth_account_state();
+  create trigger update_auth_account_state after insert or update of state on auth_ldap_account
+    for each row execute procedure update_auth_account_state();
+  create trigger update_auth_account_state after insert or update of state on auth_jwt_account
+    for each row execute procedure update_auth_account_state();
+
+  -- cancel_sessions_of_disabled_account is an after update trigger on the
+  -- auth_account table. It cancels the sessions created with the auth tokens
+  -- issued to an account when the account is disabled, in the same
+  -- transaction.
+  create function cancel_sessions_of_disabled_account() returns trigger
+  as $$
+  begin
+    perform cancel_session(s.public_id)
+       from session s
+       join auth_token t
+         on s.auth_token_id = t.public_id
+      where t.auth_account_id = new.public_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger cancel_sessions_of_disabled_account after update of state on auth_account
+    for each row
+    when (new.state = 'disabled' and old.state is distinct from 'disabled')
+    execute procedure cancel_sessions_of_disabled_account();
+
+  -- cancel_sessions_of_disabled_user is an after update trigger on the
+  -- iam_user table. It cancels the sessions of a user when the user is
+  -- disabled, in the same transaction.
+  create function cancel_sessions_of_disabled_user() returns trigger
+  as $$
+  begin
+    perform cancel_session(s.public_id)
+       from session s
+      where s.user_id = new.public_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger cancel_sessions_of_disabled_user after update of state on iam_user
+    for each row
+    when (new.state = 'disabled' and old.state is distinct from 'disabled')
+    execute procedure cancel_sessions_of_disabled_user();
+
+commit;
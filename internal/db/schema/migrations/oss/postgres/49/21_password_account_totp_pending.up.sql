@@ -0,0 +1,49 @@
+begin;
+
+  -- auth_password_account_totp_pending contains the new TOTP enrollment of a
+  -- password account which already has a verified enrollment. The verified
+  -- enrollment in auth_password_account_totp keeps being used for
+  -- authentication until the pending enrollment has been verified, at which
+  -- point the pending enrollment replaces it.
+  create table auth_password_account_totp_pending (
+    password_account_id wt_public_id primary key
+      constraint auth_password_account_totp_fkey
+        references auth_password_account_totp (password_account_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null -- encrypted TOTP secret
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    recovery_codes bytea not null -- encrypted JSON array of recovery codes
+      constraint recovery_codes_must_not_be_empty
+        check(length(recovery_codes) > 0),
+    key_id kms_private_id not null -- key used to encrypt secret and recovery_codes
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    verified boolean not null default false
+      constraint pending_enrollment_must_not_be_verified
+        check(verified = false),
+    last_used_step bigint not null default 0
+      constraint last_used_step_must_not_be_negative
+        check(last_used_step >= 0)
+  );
+  comment on table auth_password_account_totp_pending is
+    'auth_password_account_totp_pending contains the unverified TOTP enrollments which replace verified enrollments of password accounts.';
+
+  create trigger default_create_time_column before insert on auth_password_account_totp_pending
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_password_account_totp_pending
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_password_account_totp_pending
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  create index auth_password_account_totp_pending_key_id_ix
+    on auth_password_account_totp_pending (key_id);
+
+commit;
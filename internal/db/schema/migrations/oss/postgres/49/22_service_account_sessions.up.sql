@@ -0,0 +1,240 @@
+begin;
+
+  -- Service accounts authorize sessions with their own tokens, so the user_id
+  -- and auth_token_id of a session can reference either a user and an auth
+  -- token or a service account and a service account token. The foreign keys
+  -- from 0/50_session.up.sql only allow the former, so they are replaced by
+  -- the triggers below.
+  alter table session
+    drop constraint session_user_id_fkey,
+    drop constraint session_auth_token_id_fkey;
+
+  -- session_principal_fkey is a before insert or update trigger on the session
+  -- table. It ensures user_id references a user or a service account and
+  -- auth_token_id references an auth token or a service account token.
+  create function session_principal_fkey() returns trigger
+  as $$
+  begin
+    if new.user_id is not null
+      and not exists (select from iam_user where public_id = new.user_id)
+      and not exists (select from iam_service_account where public_id = new.user_id)
+    then
+      raise exception 'user % does not exist', new.user_id
+        using errcode = 'foreign_key_violation';
+    end if;
+    if new.auth_token_id is not null
+      and not exists (select from auth_token where public_id = new.auth_token_id)
+      and not exists (select from auth_service_account_token where public_id = new.auth_token_id)
+    then
+      raise exception 'auth token % does not exist', new.auth_token_id
+        using errcode = 'foreign_key_violation';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger session_principal_fkey before insert or update of user_id, auth_token_id on session
+    for each row execute procedure session_principal_fkey();
+
+  -- set_null_session_user_id is an after delete trigger on the iam_user and
+  -- iam_service_account tables. Like the foreign key it replaces, it sets the
+  -- user_id of the principal's sessions to null, which cancels them.
+  create function set_null_session_user_id() returns trigger
+  as $$
+  begin
+    update session
+       set user_id = null
+     where user_id = old.public_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger set_null_session_user_id after delete on iam_user
+    for each row execute procedure set_null_session_user_id();
+  create trigger set_null_session_user_id after delete on iam_service_account
+    for each row execute procedure set_null_session_user_id();
+
+  -- set_null_session_auth_token_id is an after delete trigger on the
+  -- auth_token and auth_service_account_token tables. Like the foreign key it
+  -- replaces, it sets the auth_token_id of the sessions created with the token
+  -- to null, which cancels them.
+  create function set_null_session_auth_token_id() returns trigger
+  as $$
+  begin
+    update session
+       set auth_token_id = null
+     where auth_token_id = old.public_id;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  create trigger set_null_session_auth_token_id after delete on auth_token
+    for each row execute procedure set_null_session_auth_token_id();
+  create trigger set_null_session_auth_token_id after delete on auth_service_account_token
+    for each row execute procedure set_null_session_auth_token_id();
+
+  -- Replaces view from 14/01_wh_user_dimension_oidc.up.sql to add service
+  -- accounts, which have no auth account or auth method.
+  drop view whx_user_dimension_source;
+  create view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case
+                   when apa.public_id is not null then 'password auth account'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   else 'None'
+                   end                          as auth_account_type,
+              case
+                   when apa.public_id is not null then coalesce(apa.name, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.name, 'None')
+                   else 'None'
+                   end                          as auth_account_name,
+              case
+                   when apa.public_id is not null then coalesce(apa.description, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.description, 'None')
+                   else 'None'
+                   end                          as auth_account_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when aoa.public_id is null then 'None'
+                  else aoa.subject
+                  end                           as auth_account_external_id,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  aoa.public_id is not null
+                    and aoa.full_name is not null then aoa.full_name
+                  else 'None'
+                  end                           as auth_account_full_name,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  aoa.public_id is not null
+                    and aoa.email is not null then aoa.email
+                  else 'None'
+                  end                           as auth_account_email,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case
+                   when apa.public_id is not null then 'password auth method'
+                   when aoa.public_id is not null then 'oidc auth method'
+                   else 'None'
+                   end                          as auth_method_type,
+              case
+                   when apm.public_id is not null then coalesce(apm.name, 'None')
+                   when aom.public_id is not null then coalesce(aom.name, 'None')
+                   else 'None'
+                   end                          as auth_method_name,
+              case
+                   when apm.public_id is not null then coalesce(apm.description, 'None')
+                   when aom.public_id is not null then coalesce(aom.description, 'None')
+                   else 'None'
+                   end                          as auth_method_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when aom.public_id is null then 'None'
+                  else aom.issuer
+                  end                           as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id       = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id      = apa.public_id
+    left join auth_password_method as apm on  am.public_id      = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id      = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id      = aom.public_id
+         join iam_scope as org on             u.scope_id        = org.public_id
+  union
+       select sa.public_id                      as user_id,
+              coalesce(sa.name, 'None')         as user_name,
+              coalesce(sa.description, 'None')  as user_description,
+              'None'                            as auth_account_id,
+              'None'                            as auth_account_type,
+              'None'                            as auth_account_name,
+              'None'                            as auth_account_description,
+              'None'                            as auth_account_external_id,
+              'None'                            as auth_account_full_name,
+              'None'                            as auth_account_email,
+              'None'                            as auth_method_id,
+              'None'                            as auth_method_type,
+              'None'                            as auth_method_name,
+              'None'                            as auth_method_description,
+              'None'                            as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_service_account as sa
+         join iam_scope as org on             sa.scope_id       = org.public_id
+  ;
+
+  -- Replaces function from 15/01_wh_rename_key_columns.up.sql to look up the
+  -- tokens of service accounts, which are not issued to an auth account.
+  create or replace function wh_upsert_user(p_user_id wt_user_id, p_auth_token_id wt_public_id) returns wh_dim_key
+  as $$
+  declare
+    src     whx_user_dimension_target%rowtype;
+    target  whx_user_dimension_target%rowtype;
+    new_row wh_user_dimension%rowtype;
+    acct_id wh_public_id;
+  begin
+    perform from auth_service_account_token where public_id = p_auth_token_id;
+    if found then
+      acct_id := 'None';
+    else
+      select auth_account_id into strict acct_id
+        from auth_token
+       where public_id = p_auth_token_id;
+    end if;
+
+    select * into target
+      from whx_user_dimension_target as t
+     where t.user_id               = p_user_id
+       and t.auth_account_id       = acct_id;
+
+    select target.key, t.* into src
+      from whx_user_dimension_source as t
+     where t.user_id               = p_user_id
+       and t.auth_account_id       = acct_id;
+
+    if src is distinct from target then
+
+      -- expire the current row
+      update wh_user_dimension
+         set current_row_indicator = 'Expired',
+             row_expiration_time   = current_timestamp
+       where user_id               = p_user_id
+         and auth_account_id       = acct_id
+         and current_row_indicator = 'Current';
+
+      -- insert a new row
+      insert into wh_user_dimension (
+             user_id,                  user_name,              user_description,
+             auth_account_id,          auth_account_type,      auth_account_name,             auth_account_description,
+             auth_account_external_id, auth_account_full_name, auth_account_email,
+             auth_method_id,           auth_method_type,       auth_method_name,              auth_method_description,
+             auth_method_external_id,
+             user_organization_id,     user_organization_name, user_organization_description,
+             current_row_indicator,    row_effective_time,     row_expiration_time
+      )
+      select user_id,                  user_name,              user_description,
+             auth_account_id,          auth_account_type,      auth_account_name,             auth_account_description,
+             auth_account_external_id, auth_account_full_name, auth_account_email,
+             auth_method_id,           auth_method_type,       auth_method_name,              auth_method_description,
+             auth_method_external_id,
+             user_organization_id,     user_organization_name, user_organization_description,
+             'Current',                current_timestamp,      'infinity'::timestamptz
+        from whx_user_dimension_source
+       where user_id               = p_user_id
+         and auth_account_id       = acct_id
+      returning * into new_row;
+
+      return new_row.key;
+    end if;
+    return target.key;
+
+  end;
+  $$ language plpgsql;
+
+commit;
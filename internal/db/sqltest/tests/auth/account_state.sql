@@ -0,0 +1,31 @@
+-- account_state tests:
+--   the following triggers
+--    update_auth_account_state
+--    cancel_sessions_of_disabled_account
+--    cancel_sessions_of_disabled_user
+
+begin;
+  select plan(8);
+
+  -- validate the setup data
+  select is(state, 'active') from auth_account where public_id = 'apa____clare';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____clare' and state = 'pending' and end_time is null;
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____carly' and state = 'active' and end_time is null;
+
+  -- disabling an account copies the state to auth_account and cancels the
+  -- sessions created with its auth tokens
+  update auth_password_account set state = 'disabled' where public_id = 'apa____clare';
+  select is(state, 'disabled') from auth_account where public_id = 'apa____clare';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____clare' and state = 'canceling' and end_time is null;
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____carly' and state = 'active' and end_time is null;
+
+  -- disabling a user cancels its sessions
+  update iam_user set state = 'disabled' where public_id = 'u______carly';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____carly' and state = 'canceling' and end_time is null;
+
+  -- disabling an already disabled user is a no-op
+  update iam_user set state = 'disabled' where public_id = 'u______carly';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____carly' and state = 'canceling';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,30 @@
+-- auth_method_token_lifetime tests:
+--   the following triggers
+--    update_auth_method_token_lifetime
+
+begin;
+  select plan(6);
+
+  -- validate the setup data
+  select is(auth_token_time_to_live_seconds, 0) from auth_method where public_id = 'apm___colors';
+  select is(version, 1) from auth_password_method where public_id = 'apm___colors';
+
+  -- updating the lifetime of a subtype copies it to auth_method and bumps the
+  -- version of the subtype
+  update auth_password_method
+     set auth_token_time_to_live_seconds  = 3600,
+         auth_token_time_to_stale_seconds = 600
+   where public_id = 'apm___colors';
+  select is(auth_token_time_to_live_seconds, 3600) from auth_method where public_id = 'apm___colors';
+  select is(auth_token_time_to_stale_seconds, 600) from auth_method where public_id = 'apm___colors';
+  select is(version, 2) from auth_password_method where public_id = 'apm___colors';
+
+  -- the staleness cannot exceed the time to live
+  prepare stale_exceeds_ttl as
+    update auth_password_method
+       set auth_token_time_to_stale_seconds = 7200
+     where public_id = 'apm___colors';
+  select throws_ok('stale_exceeds_ttl', '23514', null, 'update of auth_password_method with stale exceeding ttl succeeded');
+
+  select * from finish();
+rollback;
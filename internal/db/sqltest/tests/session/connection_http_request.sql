@@ -0,0 +1,44 @@
+begin;
+  select plan(6);
+
+  prepare insert_invalid_status_code as
+    insert into session_connection_http_request
+      (connection_id,   sequence, method, path,     status_code, request_time)
+    values
+      ('sc1_____clare', 1,        'GET',  '/index', 42,          now());
+  select throws_ok('insert_invalid_status_code', '23514', null, 'insert of http request with invalid status code succeeded');
+
+  prepare insert_empty_method as
+    insert into session_connection_http_request
+      (connection_id,   sequence, method, path,     status_code, request_time)
+    values
+      ('sc1_____clare', 1,        ' ',    '/index', 200,         now());
+  select throws_ok('insert_empty_method', '23514', null, 'insert of http request with empty method succeeded');
+
+  prepare insert_valid_http_request as
+    insert into session_connection_http_request
+      (connection_id,   sequence, method, path,     status_code, request_time)
+    values
+      ('sc1_____clare', 1,        'GET',  '/index', 200,         now());
+  select lives_ok('insert_valid_http_request', 'insert valid session_connection_http_request failed');
+
+  prepare insert_duplicate_sequence as
+    insert into session_connection_http_request
+      (connection_id,   sequence, method, path,     status_code, request_time)
+    values
+      ('sc1_____clare', 1,        'POST', '/login', 302,         now());
+  select throws_ok('insert_duplicate_sequence', '23505', null, 'insert of http request with duplicate sequence succeeded');
+
+  prepare update_http_request as
+    update session_connection_http_request
+       set status_code = 500
+     where connection_id = 'sc1_____clare';
+  select throws_ok('update_http_request', '23601', null, 'update of immutable session_connection_http_request.status_code succeeded');
+
+  delete from session_connection where public_id = 'sc1_____clare';
+  select is(count(*), 0::bigint)
+    from session_connection_http_request
+   where connection_id = 'sc1_____clare';
+
+  select * from finish();
+rollback;
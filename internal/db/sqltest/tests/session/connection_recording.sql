@@ -0,0 +1,70 @@
+begin;
+  select plan(10);
+
+  prepare insert_empty_recording as
+    insert into session_connection_recording
+      (connection_id,   session_id,     start_time,                      end_time,  size)
+    values
+      ('sc1_____clare', 's1_____clare', now() - interval '1 minute', now(),     0);
+  select throws_ok('insert_empty_recording', '23514', null, 'insert of empty recording succeeded');
+
+  prepare insert_recording_ending_before_start as
+    insert into session_connection_recording
+      (connection_id,   session_id,     start_time, end_time,                        size)
+    values
+      ('sc1_____clare', 's1_____clare', now(),      now() - interval '1 minute', 6);
+  select throws_ok('insert_recording_ending_before_start', '23514', null, 'insert of recording ending before it started succeeded');
+
+  prepare insert_valid_recording as
+    insert into session_connection_recording
+      (connection_id,   session_id,     start_time,                      end_time,  size)
+    values
+      ('sc1_____clare', 's1_____clare', now() - interval '1 minute', now(),     6);
+  select lives_ok('insert_valid_recording', 'insert valid session_connection_recording failed');
+
+  prepare complete_partial_upload as
+    update session_connection_recording
+       set uploaded_chunks = 1,
+           uploaded_size   = 5,
+           complete        = true
+     where connection_id = 'sc1_____clare';
+  select throws_ok('complete_partial_upload', '23514', null, 'partially uploaded recording was marked complete');
+
+  prepare upload_past_size as
+    update session_connection_recording
+       set uploaded_chunks = 1,
+           uploaded_size   = 7
+     where connection_id = 'sc1_____clare';
+  select throws_ok('upload_past_size', '23514', null, 'upload past the size of the recording succeeded');
+
+  prepare complete_upload as
+    update session_connection_recording
+       set uploaded_chunks = 2,
+           uploaded_size   = 6,
+           complete        = true
+     where connection_id = 'sc1_____clare';
+  select lives_ok('complete_upload', 'completing the upload of session_connection_recording failed');
+
+  select is(complete, true)
+    from session_connection_recording
+   where connection_id = 'sc1_____clare';
+
+  prepare update_recording as
+    update session_connection_recording
+       set size = 5
+     where connection_id = 'sc1_____clare';
+  select throws_ok('update_recording', '23601', null, 'update of immutable session_connection_recording.size succeeded');
+
+  prepare update_session_enable_session_recording as
+    update session
+       set enable_session_recording = true
+     where public_id = 's1_____clare';
+  select throws_ok('update_session_enable_session_recording', '23601', null, 'update of immutable session.enable_session_recording succeeded');
+
+  delete from session_connection where public_id = 'sc1_____clare';
+  select is(count(*), 0::bigint)
+    from session_connection_recording
+   where connection_id = 'sc1_____clare';
+
+  select * from finish();
+rollback;
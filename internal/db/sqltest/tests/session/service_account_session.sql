@@ -0,0 +1,62 @@
+-- service_account_session tests:
+--   the following triggers
+--    session_principal_fkey
+--    set_null_session_user_id
+--    set_null_session_auth_token_id
+--   and that wh_upsert_user handles service account tokens
+
+begin;
+  select plan(10);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets', 'sessions');
+
+  insert into iam_service_account
+    (scope_id,       public_id,      name)
+  values
+    ('o_____widget', 'sa_____wanda', 'Wanda');
+
+  insert into auth_service_account_token
+    (scope_id,       service_account_id, public_id,      token,          key_id,          expiration_time)
+  values
+    ('o_____widget', 'sa_____wanda',     'atsa___wanda', 'wanda'::bytea, 'kdkv___widget', now() + interval '1 hour');
+
+  -- a session can belong to a service account
+  select lives_ok($$
+    insert into session
+      ( project_id,     target_id,      host_set_id,    host_id,        user_id,        auth_token_id,  certificate,  endpoint, public_id)
+    values
+      ('p____bwidget', 't_________wb', 's___1wb-sths', 'h_____wb__01', 'sa_____wanda', 'atsa___wanda', 'abc'::bytea, 'ep1',    's1_____wanda')
+  $$);
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____wanda' and state = 'pending';
+  select is(count(*), 1::bigint) from wh_user_dimension where user_id = 'sa_____wanda' and auth_account_id = 'None';
+
+  -- the user and auth token must exist
+  select throws_ok($$
+    insert into session
+      ( project_id,     target_id,      host_set_id,    host_id,        user_id,        auth_token_id,  certificate,  endpoint, public_id)
+    values
+      ('p____bwidget', 't_________wb', 's___1wb-sths', 'h_____wb__01', 'sa_____wilma', 'atsa___wanda', 'abc'::bytea, 'ep1',    's1_____wilma')
+  $$, '23503');
+  select throws_ok($$
+    insert into session
+      ( project_id,     target_id,      host_set_id,    host_id,        user_id,        auth_token_id,  certificate,  endpoint, public_id)
+    values
+      ('p____bwidget', 't_________wb', 's___1wb-sths', 'h_____wb__01', 'sa_____wanda', 'atsa___wilma', 'abc'::bytea, 'ep1',    's1_____wilma')
+  $$, '23503');
+  select throws_ok($$update session set user_id = 'u_____wilma' where public_id = 's1____warren'$$, '23503');
+
+  -- deleting the token cancels the sessions created with it
+  delete from auth_service_account_token where public_id = 'atsa___wanda';
+  select ok(auth_token_id is null) from session where public_id = 's1_____wanda';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1_____wanda' and state = 'canceling';
+
+  -- deleting the service account clears the user of its sessions
+  delete from iam_service_account where public_id = 'sa_____wanda';
+  select ok(user_id is null) from session where public_id = 's1_____wanda';
+
+  -- deleting an auth token still cancels the sessions of users
+  delete from auth_token where public_id = 'tok___warren';
+  select is(count(*), 1::bigint) from session_state where session_id = 's1____warren' and state = 'canceling';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,52 @@
+begin;
+  select plan(7);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  insert into target_tcp
+    (project_id,     public_id,      name)
+  values
+    ('p____bwidget', 'test______wb', 'Big Widget Address Target');
+
+  prepare insert_valid_target_address as
+    insert into target_address
+      (target_id,      address)
+    values
+      ('test______wb', '8.8.8.8');
+  select lives_ok('insert_valid_target_address', 'insert valid target_address failed');
+
+  select is(address, '8.8.8.8')
+    from target_all_subtypes
+   where public_id = 'test______wb';
+
+  prepare insert_target_host_set_with_address as
+    insert into target_host_set
+      (project_id,     target_id,      host_set_id)
+    values
+      ('p____bwidget', 'test______wb', 's___1wb-sths');
+  select throws_ok('insert_target_host_set_with_address', '23000', null, 'insert target_host_set for target with address succeeded');
+
+  prepare insert_target_address_with_host_sets as
+    insert into target_address
+      (target_id,      address)
+    values
+      ('t_________wb', '8.8.8.8');
+  select throws_ok('insert_target_address_with_host_sets', '23000', null, 'insert target_address for target with host sets succeeded');
+
+  prepare update_target_address_target_id as
+    update target_address
+       set target_id = 't_________ws'
+     where target_id = 'test______wb';
+  select throws_ok('update_target_address_target_id', '23601', null, 'update of immutable target_address.target_id succeeded');
+
+  select is(count(*), 0::bigint)
+    from target_all_subtypes
+   where public_id = 't_________wb'
+     and address is not null;
+
+  delete from target where public_id = 'test______wb';
+  select is(count(*), 0::bigint)
+    from target_address
+   where target_id = 'test______wb';
+
+  select * from finish();
+rollback;
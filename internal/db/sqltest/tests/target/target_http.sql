@@ -0,0 +1,57 @@
+begin;
+  select plan(10);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  prepare insert_valid_target_http as
+    insert into target_http
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'thttp_____wb', 'Big Widget HTTP Target');
+  select lives_ok('insert_valid_target_http', 'insert valid target_http failed');
+
+  select is(count(*), 1::bigint)
+    from target
+   where public_id = 'thttp_____wb';
+
+  select is(type, 'http')
+    from target_all_subtypes
+   where public_id = 'thttp_____wb';
+
+  select is(auth_scheme, 'basic')
+    from target_all_subtypes
+   where public_id = 'thttp_____wb';
+
+  select is(enable_tls, false)
+    from target_http
+   where public_id = 'thttp_____wb';
+
+  prepare update_target_http_auth_scheme as
+    update target_http
+       set auth_scheme = 'digest'
+     where public_id = 'thttp_____wb';
+  select throws_ok('update_target_http_auth_scheme', '23514', null, 'update of target_http.auth_scheme to an unsupported scheme succeeded');
+
+  prepare insert_duplicate_name_target_http as
+    insert into target_http
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'thttp_____w2', 'Big Widget HTTP Target');
+  select throws_ok('insert_duplicate_name_target_http', '23505', null, 'insert target_http with duplicate name succeeded');
+
+  prepare update_target_http_project_id as
+    update target_http
+       set project_id = 'p____swidget'
+     where public_id = 'thttp_____wb';
+  select throws_ok('update_target_http_project_id', '23601', null, 'update of immutable target_http.project_id succeeded');
+
+  select is(target_type, 'http target')
+    from whx_target_subtype
+   where public_id = 'thttp_____wb';
+
+  delete from target_http where public_id = 'thttp_____wb';
+  select is(count(*), 0::bigint)
+    from target
+   where public_id = 'thttp_____wb';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,63 @@
+begin;
+  select plan(11);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  prepare insert_valid_target_postgres as
+    insert into target_postgres
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'tpg_______wb', 'Big Widget Postgres Target');
+  select lives_ok('insert_valid_target_postgres', 'insert valid target_postgres failed');
+
+  select is(count(*), 1::bigint)
+    from target
+   where public_id = 'tpg_______wb';
+
+  select is(type, 'postgres')
+    from target_all_subtypes
+   where public_id = 'tpg_______wb';
+
+  select is(session_connection_limit, -1)
+    from target_postgres
+   where public_id = 'tpg_______wb';
+
+  select is(ssl_mode, 'verify-full')
+    from target_all_subtypes
+   where public_id = 'tpg_______wb';
+
+  prepare insert_duplicate_name_target_postgres as
+    insert into target_postgres
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'tpg_______w2', 'Big Widget Postgres Target');
+  select throws_ok('insert_duplicate_name_target_postgres', '23505', null, 'insert target_postgres with duplicate name succeeded');
+
+  prepare update_target_postgres_project_id as
+    update target_postgres
+       set project_id = 'p____swidget'
+     where public_id = 'tpg_______wb';
+  select throws_ok('update_target_postgres_project_id', '23601', null, 'update of immutable target_postgres.project_id succeeded');
+
+  select is(target_type, 'postgres target')
+    from whx_target_subtype
+   where public_id = 'tpg_______wb';
+
+  prepare update_target_postgres_invalid_ssl_mode as
+    update target_postgres
+       set ssl_mode = 'prefer'
+     where public_id = 'tpg_______wb';
+  select throws_ok('update_target_postgres_invalid_ssl_mode', '23514', null, 'update of target_postgres with invalid ssl_mode succeeded');
+
+  prepare update_target_postgres_empty_ssl_root_cert as
+    update target_postgres
+       set ssl_root_cert = ' '
+     where public_id = 'tpg_______wb';
+  select throws_ok('update_target_postgres_empty_ssl_root_cert', '23514', null, 'update of target_postgres with empty ssl_root_cert succeeded');
+
+  delete from target_postgres where public_id = 'tpg_______wb';
+  select is(count(*), 0::bigint)
+    from target
+   where public_id = 'tpg_______wb';
+
+  select * from finish();
+rollback;
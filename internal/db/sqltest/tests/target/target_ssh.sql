@@ -0,0 +1,60 @@
+begin;
+  select plan(10);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  prepare insert_valid_target_ssh as
+    insert into target_ssh
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'tssh______wb', 'Big Widget SSH Target');
+  select lives_ok('insert_valid_target_ssh', 'insert valid target_ssh failed');
+
+  select is(count(*), 1::bigint)
+    from target
+   where public_id = 'tssh______wb';
+
+  select is(type, 'ssh')
+    from target_all_subtypes
+   where public_id = 'tssh______wb';
+
+  select is(session_connection_limit, -1)
+    from target_ssh
+   where public_id = 'tssh______wb';
+
+  prepare insert_duplicate_name_target_ssh as
+    insert into target_ssh
+      (project_id,     public_id,      name)
+    values
+      ('p____bwidget', 'tssh______w2', 'Big Widget SSH Target');
+  select throws_ok('insert_duplicate_name_target_ssh', '23505', null, 'insert target_ssh with duplicate name succeeded');
+
+  prepare update_target_ssh_project_id as
+    update target_ssh
+       set project_id = 'p____swidget'
+     where public_id = 'tssh______wb';
+  select throws_ok('update_target_ssh_project_id', '23601', null, 'update of immutable target_ssh.project_id succeeded');
+
+  select is(target_type, 'ssh target')
+    from whx_target_subtype
+   where public_id = 'tssh______wb';
+
+  update target_ssh
+     set host_key = 'ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEwidgetHostKey'
+   where public_id = 'tssh______wb';
+  select is(host_key, 'ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEwidgetHostKey')
+    from target_all_subtypes
+   where public_id = 'tssh______wb';
+
+  prepare update_target_ssh_empty_host_key as
+    update target_ssh
+       set host_key = ' '
+     where public_id = 'tssh______wb';
+  select throws_ok('update_target_ssh_empty_host_key', '23514', null, 'update of target_ssh with empty host_key succeeded');
+
+  delete from target_ssh where public_id = 'tssh______wb';
+  select is(count(*), 0::bigint)
+    from target
+   where public_id = 'tssh______wb';
+
+  select * from finish();
+rollback;
@@ -45,6 +45,7 @@ const (
 	CycleFound                         = 121 // CycleFound represents an error when a cycle is found between a parent and child worker
 	WorkerConnNotFound                 = 122 // WorkerConnNotFound represents an error when a connection to a worker is not found
 	KmsWorkerUnsupportedOperation      = 123 // KmsWorkerUnsupportedOperation represents an error when a KMS worker is not supported for an operation
+	KeyVersionInUse                    = 124 // KeyVersionInUse represents an error when a key version can not be destroyed since it still protects data or keys
 
 	AuthAttemptExpired Code = 198 // AuthAttemptExpired represents an expired authentication attempt
 	AuthMethodInactive Code = 199 // AuthMethodInactive represents an error that means the auth method is not active.
@@ -64,6 +65,35 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// PasswordTooWeak results from attempting to set a password which does not
+	// contain the character classes required by the password policy.
+	PasswordTooWeak Code = 204
+
+	// PasswordDisallowed results from attempting to set a password which
+	// contains the login name or a word disallowed by the password policy.
+	PasswordDisallowed Code = 205
+
+	// PasswordReused results from attempting to set a password which matches
+	// one of the previous passwords of an account.
+	PasswordReused Code = 206
+
+	// PasswordExpired is returned from Authenticate when the password of an
+	// account has expired and a new password was not provided.
+	PasswordExpired Code = 207
+
+	// MfaCodeRequired is returned from Authenticate when the account requires
+	// a multi-factor authentication code and none was provided.
+	MfaCodeRequired Code = 208
+
+	// MfaNotEnrolled is returned from Authenticate when the auth method
+	// requires multi-factor authentication and the account has not completed
+	// enrollment.
+	MfaNotEnrolled Code = 209
+
+	// MfaInvalidCode results from attempting to verify a multi-factor
+	// authentication enrollment with an invalid code.
+	MfaInvalidCode Code = 210
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
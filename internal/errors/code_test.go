@@ -162,6 +162,41 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "PasswordTooWeak",
+			c:    PasswordTooWeak,
+			want: PasswordTooWeak,
+		},
+		{
+			name: "PasswordDisallowed",
+			c:    PasswordDisallowed,
+			want: PasswordDisallowed,
+		},
+		{
+			name: "PasswordReused",
+			c:    PasswordReused,
+			want: PasswordReused,
+		},
+		{
+			name: "PasswordExpired",
+			c:    PasswordExpired,
+			want: PasswordExpired,
+		},
+		{
+			name: "MfaCodeRequired",
+			c:    MfaCodeRequired,
+			want: MfaCodeRequired,
+		},
+		{
+			name: "MfaNotEnrolled",
+			c:    MfaNotEnrolled,
+			want: MfaNotEnrolled,
+		},
+		{
+			name: "MfaInvalidCode",
+			c:    MfaInvalidCode,
+			want: MfaInvalidCode,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
@@ -337,6 +372,11 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    KmsWorkerUnsupportedOperation,
 			want: KmsWorkerUnsupportedOperation,
 		},
+		{
+			name: "KeyVersionInUse",
+			c:    KeyVersionInUse,
+			want: KeyVersionInUse,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -116,6 +116,34 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	PasswordTooWeak: {
+		Message: "too weak",
+		Kind:    Password,
+	},
+	PasswordDisallowed: {
+		Message: "contains a disallowed word",
+		Kind:    Password,
+	},
+	PasswordReused: {
+		Message: "matches a previous password",
+		Kind:    Password,
+	},
+	PasswordExpired: {
+		Message: "password has expired",
+		Kind:    Password,
+	},
+	MfaCodeRequired: {
+		Message: "multi-factor authentication code required",
+		Kind:    Password,
+	},
+	MfaNotEnrolled: {
+		Message: "multi-factor authentication not enrolled",
+		Kind:    Password,
+	},
+	MfaInvalidCode: {
+		Message: "invalid multi-factor authentication code",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
@@ -272,4 +300,8 @@ var errorCodeInfo = map[Code]Info{
 		Message: "unsupported operation for a kms worker",
 		Kind:    State,
 	},
+	KeyVersionInUse: {
+		Message: "key version in use",
+		Kind:    Integrity,
+	},
 }
@@ -54,6 +54,23 @@ type AccountServiceClient interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// UnlockAccount unlocks an Account which has been locked after too many
+	// failed authentication attempts and resets its count of failed attempts.
+	// This method is intended for administration purpose. Unlocking an Account
+	// which is not locked is not an error.
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	// EnrollTotp starts a time-based one-time password (TOTP) enrollment for
+	// the Account and returns an otpauth URI containing the TOTP secret, which
+	// can be imported into an authenticator app. The enrollment is not used for
+	// authentication until it is verified with VerifyTotp. A verified enrollment
+	// of the Account keeps being used for authentication until the new
+	// enrollment has been verified.
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+	// VerifyTotp completes the TOTP enrollment of the Account with a code
+	// generated from the enrolled secret and returns recovery codes, each of
+	// which can be used once in place of a TOTP code. The recovery codes are
+	// only returned once.
+	VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...grpc.CallOption) (*VerifyTotpResponse, error)
 }
 
 type accountServiceClient struct {
@@ -127,6 +144,33 @@ func (c *accountServiceClient) ChangePassword(ctx context.Context, in *ChangePas
 	return out, nil
 }
 
+func (c *accountServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/UnlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	out := new(EnrollTotpResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/EnrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...grpc.CallOption) (*VerifyTotpResponse, error) {
+	out := new(VerifyTotpResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/VerifyTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility
@@ -167,6 +211,23 @@ type AccountServiceServer interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// UnlockAccount unlocks an Account which has been locked after too many
+	// failed authentication attempts and resets its count of failed attempts.
+	// This method is intended for administration purpose. Unlocking an Account
+	// which is not locked is not an error.
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	// EnrollTotp starts a time-based one-time password (TOTP) enrollment for
+	// the Account and returns an otpauth URI containing the TOTP secret, which
+	// can be imported into an authenticator app. The enrollment is not used for
+	// authentication until it is verified with VerifyTotp. A verified enrollment
+	// of the Account keeps being used for authentication until the new
+	// enrollment has been verified.
+	EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error)
+	// VerifyTotp completes the TOTP enrollment of the Account with a code
+	// generated from the enrolled secret and returns recovery codes, each of
+	// which can be used once in place of a TOTP code. The recovery codes are
+	// only returned once.
+	VerifyTotp(context.Context, *VerifyTotpRequest) (*VerifyTotpResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -195,6 +256,15 @@ func (UnimplementedAccountServiceServer) SetPassword(context.Context, *SetPasswo
 func (UnimplementedAccountServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedAccountServiceServer) UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedAccountServiceServer) VerifyTotp(context.Context, *VerifyTotpRequest) (*VerifyTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTotp not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +404,60 @@ func _AccountService_ChangePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/UnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/EnrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, req.(*EnrollTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_VerifyTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).VerifyTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/VerifyTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).VerifyTotp(ctx, req.(*VerifyTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +493,18 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _AccountService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _AccountService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _AccountService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "VerifyTotp",
+			Handler:    _AccountService_VerifyTotp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/account_service.proto",
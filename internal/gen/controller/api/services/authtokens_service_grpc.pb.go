@@ -27,6 +27,12 @@ type AuthTokenServiceClient interface {
 	// the Auth Tokens being listed.  If the scope id is missing, malformed, or
 	// referencing a non existing resource, an error is returned.
 	ListAuthTokens(ctx context.Context, in *ListAuthTokensRequest, opts ...grpc.CallOption) (*ListAuthTokensResponse, error)
+	// RefreshAuthToken extends the expiration time of an Auth Token by the auth
+	// token time-to-live of its Auth Method, so long-running clients can keep
+	// using it without authenticating again. Expired or stale Auth Tokens can
+	// not be refreshed. If the provided Auth Token id is malformed or not
+	// provided an error is returned.
+	RefreshAuthToken(ctx context.Context, in *RefreshAuthTokenRequest, opts ...grpc.CallOption) (*RefreshAuthTokenResponse, error)
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(ctx context.Context, in *DeleteAuthTokenRequest, opts ...grpc.CallOption) (*DeleteAuthTokenResponse, error)
@@ -58,6 +64,15 @@ func (c *authTokenServiceClient) ListAuthTokens(ctx context.Context, in *ListAut
 	return out, nil
 }
 
+func (c *authTokenServiceClient) RefreshAuthToken(ctx context.Context, in *RefreshAuthTokenRequest, opts ...grpc.CallOption) (*RefreshAuthTokenResponse, error) {
+	out := new(RefreshAuthTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/RefreshAuthToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authTokenServiceClient) DeleteAuthToken(ctx context.Context, in *DeleteAuthTokenRequest, opts ...grpc.CallOption) (*DeleteAuthTokenResponse, error) {
 	out := new(DeleteAuthTokenResponse)
 	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AuthTokenService/DeleteAuthToken", in, out, opts...)
@@ -80,6 +95,12 @@ type AuthTokenServiceServer interface {
 	// the Auth Tokens being listed.  If the scope id is missing, malformed, or
 	// referencing a non existing resource, an error is returned.
 	ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error)
+	// RefreshAuthToken extends the expiration time of an Auth Token by the auth
+	// token time-to-live of its Auth Method, so long-running clients can keep
+	// using it without authenticating again. Expired or stale Auth Tokens can
+	// not be refreshed. If the provided Auth Token id is malformed or not
+	// provided an error is returned.
+	RefreshAuthToken(context.Context, *RefreshAuthTokenRequest) (*RefreshAuthTokenResponse, error)
 	// DeleteAuthToken removes a Auth Token from Boundary. If the provided
 	// Auth Token id is malformed or not provided an error is returned.
 	DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error)
@@ -96,6 +117,9 @@ func (UnimplementedAuthTokenServiceServer) GetAuthToken(context.Context, *GetAut
 func (UnimplementedAuthTokenServiceServer) ListAuthTokens(context.Context, *ListAuthTokensRequest) (*ListAuthTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuthTokens not implemented")
 }
+func (UnimplementedAuthTokenServiceServer) RefreshAuthToken(context.Context, *RefreshAuthTokenRequest) (*RefreshAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAuthToken not implemented")
+}
 func (UnimplementedAuthTokenServiceServer) DeleteAuthToken(context.Context, *DeleteAuthTokenRequest) (*DeleteAuthTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthToken not implemented")
 }
@@ -148,6 +172,24 @@ func _AuthTokenService_ListAuthTokens_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthTokenService_RefreshAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthTokenServiceServer).RefreshAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AuthTokenService/RefreshAuthToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthTokenServiceServer).RefreshAuthToken(ctx, req.(*RefreshAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthTokenService_DeleteAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAuthTokenRequest)
 	if err := dec(in); err != nil {
@@ -181,6 +223,10 @@ var AuthTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAuthTokens",
 			Handler:    _AuthTokenService_ListAuthTokens_Handler,
 		},
+		{
+			MethodName: "RefreshAuthToken",
+			Handler:    _AuthTokenService_RefreshAuthToken_Handler,
+		},
 		{
 			MethodName: "DeleteAuthToken",
 			Handler:    _AuthTokenService_DeleteAuthToken_Handler,
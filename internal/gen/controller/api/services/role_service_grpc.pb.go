@@ -79,6 +79,12 @@ type RoleServiceClient interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(ctx context.Context, in *RemoveRoleGrantsRequest, opts ...grpc.CallOption) (*RemoveRoleGrantsResponse, error)
+	// ExplainGrants returns whether an action is allowed on a resource, along with
+	// the grants applying to the resource's scope and whether each of them allows
+	// the action. The grants evaluated are either those of the provided user or a
+	// hypothetical set of grants provided in the request. The request must include
+	// the scope ID containing the resource, the resource type and the action.
+	ExplainGrants(ctx context.Context, in *ExplainGrantsRequest, opts ...grpc.CallOption) (*ExplainGrantsResponse, error)
 }
 
 type roleServiceClient struct {
@@ -188,6 +194,15 @@ func (c *roleServiceClient) RemoveRoleGrants(ctx context.Context, in *RemoveRole
 	return out, nil
 }
 
+func (c *roleServiceClient) ExplainGrants(ctx context.Context, in *ExplainGrantsRequest, opts ...grpc.CallOption) (*ExplainGrantsResponse, error) {
+	out := new(ExplainGrantsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.RoleService/ExplainGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -253,6 +268,12 @@ type RoleServiceServer interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error)
+	// ExplainGrants returns whether an action is allowed on a resource, along with
+	// the grants applying to the resource's scope and whether each of them allows
+	// the action. The grants evaluated are either those of the provided user or a
+	// hypothetical set of grants provided in the request. The request must include
+	// the scope ID containing the resource, the resource type and the action.
+	ExplainGrants(context.Context, *ExplainGrantsRequest) (*ExplainGrantsResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -293,6 +314,9 @@ func (UnimplementedRoleServiceServer) SetRoleGrants(context.Context, *SetRoleGra
 func (UnimplementedRoleServiceServer) RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleGrants not implemented")
 }
+func (UnimplementedRoleServiceServer) ExplainGrants(context.Context, *ExplainGrantsRequest) (*ExplainGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainGrants not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +528,24 @@ func _RoleService_RemoveRoleGrants_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_ExplainGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ExplainGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.RoleService/ExplainGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ExplainGrants(ctx, req.(*ExplainGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -555,6 +597,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleGrants",
 			Handler:    _RoleService_RemoveRoleGrants_Handler,
 		},
+		{
+			MethodName: "ExplainGrants",
+			Handler:    _RoleService_ExplainGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/role_service.proto",
@@ -44,6 +44,22 @@ type ScopeServiceClient interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(ctx context.Context, in *DeleteScopeRequest, opts ...grpc.CallOption) (*DeleteScopeResponse, error)
+	// RotateScopeKeys creates a new version of the root key and of every data
+	// encryption key of the Scope. Data is encrypted using the new key versions
+	// from then on, while data encrypted with the previous versions can still be
+	// decrypted. An error is returned if the Scope ID is missing, malformed or
+	// references a non existing scope.
+	RotateScopeKeys(ctx context.Context, in *RotateScopeKeysRequest, opts ...grpc.CallOption) (*RotateScopeKeysResponse, error)
+	// ListKeys returns the root key and data encryption keys of a Scope along
+	// with their versions. An error is returned if the Scope ID is missing,
+	// malformed or references a non existing scope.
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	// DestroyKeyVersion destroys a version of the root key or of a data
+	// encryption key of a Scope. An error is returned if the Scope ID or the
+	// Key Version ID is missing, malformed or references a non existing
+	// resource, or if the Key Version is the latest version of its Key or still
+	// protects data.
+	DestroyKeyVersion(ctx context.Context, in *DestroyKeyVersionRequest, opts ...grpc.CallOption) (*DestroyKeyVersionResponse, error)
 }
 
 type scopeServiceClient struct {
@@ -99,6 +115,33 @@ func (c *scopeServiceClient) DeleteScope(ctx context.Context, in *DeleteScopeReq
 	return out, nil
 }
 
+func (c *scopeServiceClient) RotateScopeKeys(ctx context.Context, in *RotateScopeKeysRequest, opts ...grpc.CallOption) (*RotateScopeKeysResponse, error) {
+	out := new(RotateScopeKeysResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/RotateScopeKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) DestroyKeyVersion(ctx context.Context, in *DestroyKeyVersionRequest, opts ...grpc.CallOption) (*DestroyKeyVersionResponse, error) {
+	out := new(DestroyKeyVersionResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/DestroyKeyVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScopeServiceServer is the server API for ScopeService service.
 // All implementations must embed UnimplementedScopeServiceServer
 // for forward compatibility
@@ -129,6 +172,22 @@ type ScopeServiceServer interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error)
+	// RotateScopeKeys creates a new version of the root key and of every data
+	// encryption key of the Scope. Data is encrypted using the new key versions
+	// from then on, while data encrypted with the previous versions can still be
+	// decrypted. An error is returned if the Scope ID is missing, malformed or
+	// references a non existing scope.
+	RotateScopeKeys(context.Context, *RotateScopeKeysRequest) (*RotateScopeKeysResponse, error)
+	// ListKeys returns the root key and data encryption keys of a Scope along
+	// with their versions. An error is returned if the Scope ID is missing,
+	// malformed or references a non existing scope.
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	// DestroyKeyVersion destroys a version of the root key or of a data
+	// encryption key of a Scope. An error is returned if the Scope ID or the
+	// Key Version ID is missing, malformed or references a non existing
+	// resource, or if the Key Version is the latest version of its Key or still
+	// protects data.
+	DestroyKeyVersion(context.Context, *DestroyKeyVersionRequest) (*DestroyKeyVersionResponse, error)
 	mustEmbedUnimplementedScopeServiceServer()
 }
 
@@ -151,6 +210,15 @@ func (UnimplementedScopeServiceServer) UpdateScope(context.Context, *UpdateScope
 func (UnimplementedScopeServiceServer) DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
+func (UnimplementedScopeServiceServer) RotateScopeKeys(context.Context, *RotateScopeKeysRequest) (*RotateScopeKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateScopeKeys not implemented")
+}
+func (UnimplementedScopeServiceServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedScopeServiceServer) DestroyKeyVersion(context.Context, *DestroyKeyVersionRequest) (*DestroyKeyVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestroyKeyVersion not implemented")
+}
 func (UnimplementedScopeServiceServer) mustEmbedUnimplementedScopeServiceServer() {}
 
 // UnsafeScopeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +322,60 @@ func _ScopeService_DeleteScope_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_RotateScopeKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateScopeKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).RotateScopeKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/RotateScopeKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).RotateScopeKeys(ctx, req.(*RotateScopeKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_DestroyKeyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyKeyVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).DestroyKeyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/DestroyKeyVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).DestroyKeyVersion(ctx, req.(*DestroyKeyVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScopeService_ServiceDesc is the grpc.ServiceDesc for ScopeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +403,18 @@ var ScopeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScope",
 			Handler:    _ScopeService_DeleteScope_Handler,
 		},
+		{
+			MethodName: "RotateScopeKeys",
+			Handler:    _ScopeService_RotateScopeKeys_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _ScopeService_ListKeys_Handler,
+		},
+		{
+			MethodName: "DestroyKeyVersion",
+			Handler:    _ScopeService_DestroyKeyVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/scope_service.proto",
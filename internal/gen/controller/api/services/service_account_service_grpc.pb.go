@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ServiceAccountServiceClient is the client API for ServiceAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceAccountServiceClient interface {
+	// GetServiceAccount returns a stored Service Account if present. The
+	// provided request must include the Service Account id and if it is
+	// missing, malformed or referencing a non existing resource an error is
+	// returned.
+	GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error)
+	// ListServiceAccounts returns a list of stored Service Accounts which exist
+	// inside the provided scope id. If that id is missing, malformed, or
+	// references a non-existing scope, an error is returned.
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount creates and stores a Service Account in boundary.
+	// The provided request must include the scope ID in which the Service
+	// Account will be created. If the scope ID is missing, malformed or
+	// references a non existing resource, an error is returned. If a name is
+	// provided that is in use in another Service Account in the same scope, an
+	// error is returned.
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// UpdateServiceAccount updates an existing Service Account in boundary.
+	// The provided Service Account must not have any read only fields set. The
+	// update mask must be included in the request and contain at least 1
+	// mutable field. To unset a field's value, include the field in the update
+	// mask and don't set it in the provided Service Account. An error is
+	// returned if the Service Account id is missing or reference a
+	// non-existing resource. An error is also returned if the request attempts
+	// to update the name to one that is already used by another Service Account
+	// in the same scope.
+	UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountRequest, opts ...grpc.CallOption) (*UpdateServiceAccountResponse, error)
+	// DeleteServiceAccount removes a Service Account and all of its tokens
+	// from Boundary. If the provided Service Account ID is malformed or not
+	// provided an error is returned.
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
+	// CreateServiceAccountToken creates a token for a Service Account which
+	// expires after the provided time to live. The token value is only
+	// returned in the response to this request. An error is returned if the
+	// Service Account ID is missing, malformed or references a non existing
+	// resource, or if any of the allowed CIDRs is malformed.
+	CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*CreateServiceAccountTokenResponse, error)
+	// ListServiceAccountTokens returns the tokens of a Service Account. The
+	// token values are never returned. An error is returned if the Service
+	// Account ID is missing, malformed or references a non existing resource.
+	ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error)
+	// RevokeServiceAccountToken deletes a token of a Service Account, after
+	// which it can no longer be used. An error is returned if the Service
+	// Account ID or the token ID is missing, malformed or references a non
+	// existing resource.
+	RevokeServiceAccountToken(ctx context.Context, in *RevokeServiceAccountTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAccountTokenResponse, error)
+}
+
+type serviceAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceAccountServiceClient(cc grpc.ClientConnInterface) ServiceAccountServiceClient {
+	return &serviceAccountServiceClient{cc}
+}
+
+func (c *serviceAccountServiceClient) GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error) {
+	out := new(GetServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/GetServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/ListServiceAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountRequest, opts ...grpc.CallOption) (*UpdateServiceAccountResponse, error) {
+	out := new(UpdateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/UpdateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error) {
+	out := new(DeleteServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/DeleteServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*CreateServiceAccountTokenResponse, error) {
+	out := new(CreateServiceAccountTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/CreateServiceAccountToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error) {
+	out := new(ListServiceAccountTokensResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/ListServiceAccountTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) RevokeServiceAccountToken(ctx context.Context, in *RevokeServiceAccountTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAccountTokenResponse, error) {
+	out := new(RevokeServiceAccountTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ServiceAccountService/RevokeServiceAccountToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceAccountServiceServer is the server API for ServiceAccountService service.
+// All implementations must embed UnimplementedServiceAccountServiceServer
+// for forward compatibility
+type ServiceAccountServiceServer interface {
+	// GetServiceAccount returns a stored Service Account if present. The
+	// provided request must include the Service Account id and if it is
+	// missing, malformed or referencing a non existing resource an error is
+	// returned.
+	GetServiceAccount(context.Context, *GetServiceAccountRequest) (*GetServiceAccountResponse, error)
+	// ListServiceAccounts returns a list of stored Service Accounts which exist
+	// inside the provided scope id. If that id is missing, malformed, or
+	// references a non-existing scope, an error is returned.
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount creates and stores a Service Account in boundary.
+	// The provided request must include the scope ID in which the Service
+	// Account will be created. If the scope ID is missing, malformed or
+	// references a non existing resource, an error is returned. If a name is
+	// provided that is in use in another Service Account in the same scope, an
+	// error is returned.
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// UpdateServiceAccount updates an existing Service Account in boundary.
+	// The provided Service Account must not have any read only fields set. The
+	// update mask must be included in the request and contain at least 1
+	// mutable field. To unset a field's value, include the field in the update
+	// mask and don't set it in the provided Service Account. An error is
+	// returned if the Service Account id is missing or reference a
+	// non-existing resource. An error is also returned if the request attempts
+	// to update the name to one that is already used by another Service Account
+	// in the same scope.
+	UpdateServiceAccount(context.Context, *UpdateServiceAccountRequest) (*UpdateServiceAccountResponse, error)
+	// DeleteServiceAccount removes a Service Account and all of its tokens
+	// from Boundary. If the provided Service Account ID is malformed or not
+	// provided an error is returned.
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
+	// CreateServiceAccountToken creates a token for a Service Account which
+	// expires after the provided time to live. The token value is only
+	// returned in the response to this request. An error is returned if the
+	// Service Account ID is missing, malformed or references a non existing
+	// resource, or if any of the allowed CIDRs is malformed.
+	CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*CreateServiceAccountTokenResponse, error)
+	// ListServiceAccountTokens returns the tokens of a Service Account. The
+	// token values are never returned. An error is returned if the Service
+	// Account ID is missing, malformed or references a non existing resource.
+	ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error)
+	// RevokeServiceAccountToken deletes a token of a Service Account, after
+	// which it can no longer be used. An error is returned if the Service
+	// Account ID or the token ID is missing, malformed or references a non
+	// existing resource.
+	RevokeServiceAccountToken(context.Context, *RevokeServiceAccountTokenRequest) (*RevokeServiceAccountTokenResponse, error)
+	mustEmbedUnimplementedServiceAccountServiceServer()
+}
+
+// UnimplementedServiceAccountServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceAccountServiceServer struct {
+}
+
+func (UnimplementedServiceAccountServiceServer) GetServiceAccount(context.Context, *GetServiceAccountRequest) (*GetServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) UpdateServiceAccount(context.Context, *UpdateServiceAccountRequest) (*UpdateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*CreateServiceAccountTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccountToken not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccountTokens not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) RevokeServiceAccountToken(context.Context, *RevokeServiceAccountTokenRequest) (*RevokeServiceAccountTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAccountToken not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) mustEmbedUnimplementedServiceAccountServiceServer() {}
+
+// UnsafeServiceAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceAccountServiceServer will
+// result in compilation errors.
+type UnsafeServiceAccountServiceServer interface {
+	mustEmbedUnimplementedServiceAccountServiceServer()
+}
+
+func RegisterServiceAccountServiceServer(s grpc.ServiceRegistrar, srv ServiceAccountServiceServer) {
+	s.RegisterService(&ServiceAccountService_ServiceDesc, srv)
+}
+
+func _ServiceAccountService_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/GetServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).GetServiceAccount(ctx, req.(*GetServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/ListServiceAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/CreateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_UpdateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).UpdateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/UpdateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).UpdateServiceAccount(ctx, req.(*UpdateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/DeleteServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_CreateServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/CreateServiceAccountToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccountToken(ctx, req.(*CreateServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_ListServiceAccountTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).ListServiceAccountTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/ListServiceAccountTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).ListServiceAccountTokens(ctx, req.(*ListServiceAccountTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_RevokeServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).RevokeServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ServiceAccountService/RevokeServiceAccountToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).RevokeServiceAccountToken(ctx, req.(*RevokeServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceAccountService_ServiceDesc is the grpc.ServiceDesc for ServiceAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.api.services.v1.ServiceAccountService",
+	HandlerType: (*ServiceAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _ServiceAccountService_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _ServiceAccountService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ServiceAccountService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "UpdateServiceAccount",
+			Handler:    _ServiceAccountService_UpdateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _ServiceAccountService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccountToken",
+			Handler:    _ServiceAccountService_CreateServiceAccountToken_Handler,
+		},
+		{
+			MethodName: "ListServiceAccountTokens",
+			Handler:    _ServiceAccountService_ListServiceAccountTokens_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAccountToken",
+			Handler:    _ServiceAccountService_RevokeServiceAccountToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/api/services/v1/service_account_service.proto",
+}
@@ -31,6 +31,21 @@ type SessionServiceClient interface {
 	ConnectConnection(ctx context.Context, in *ConnectConnectionRequest, opts ...grpc.CallOption) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*CloseConnectionResponse, error)
+	// UploadConnectionRecording indexes the recording of a connection's byte
+	// stream that a worker captured while proxying the connection. The worker
+	// keeps the recording in its recording storage path and uploads it
+	// afterwards using UploadConnectionRecordingChunk. Indexing a recording
+	// again returns how much of it has been uploaded, so the worker can resume
+	// an interrupted upload.
+	UploadConnectionRecording(ctx context.Context, in *UploadConnectionRecordingRequest, opts ...grpc.CallOption) (*UploadConnectionRecordingResponse, error)
+	// UploadConnectionRecordingChunk stores the next chunk of the recording of a
+	// connection indexed by UploadConnectionRecording in the controller's
+	// recording storage path. The recording is complete once all of its bytes
+	// have been stored.
+	UploadConnectionRecordingChunk(ctx context.Context, in *UploadConnectionRecordingChunkRequest, opts ...grpc.CallOption) (*UploadConnectionRecordingChunkResponse, error)
+	// AddConnectionHttpRequests stores the HTTP requests a worker proxied over
+	// a connection of an http target.
+	AddConnectionHttpRequests(ctx context.Context, in *AddConnectionHttpRequestsRequest, opts ...grpc.CallOption) (*AddConnectionHttpRequestsResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -95,6 +110,33 @@ func (c *sessionServiceClient) CloseConnection(ctx context.Context, in *CloseCon
 	return out, nil
 }
 
+func (c *sessionServiceClient) UploadConnectionRecording(ctx context.Context, in *UploadConnectionRecordingRequest, opts ...grpc.CallOption) (*UploadConnectionRecordingResponse, error) {
+	out := new(UploadConnectionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.servers.services.v1.SessionService/UploadConnectionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UploadConnectionRecordingChunk(ctx context.Context, in *UploadConnectionRecordingChunkRequest, opts ...grpc.CallOption) (*UploadConnectionRecordingChunkResponse, error) {
+	out := new(UploadConnectionRecordingChunkResponse)
+	err := c.cc.Invoke(ctx, "/controller.servers.services.v1.SessionService/UploadConnectionRecordingChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) AddConnectionHttpRequests(ctx context.Context, in *AddConnectionHttpRequestsRequest, opts ...grpc.CallOption) (*AddConnectionHttpRequestsResponse, error) {
+	out := new(AddConnectionHttpRequestsResponse)
+	err := c.cc.Invoke(ctx, "/controller.servers.services.v1.SessionService/AddConnectionHttpRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -112,6 +154,21 @@ type SessionServiceServer interface {
 	ConnectConnection(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	// CloseConnections updates a connection to set it to closed
 	CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+	// UploadConnectionRecording indexes the recording of a connection's byte
+	// stream that a worker captured while proxying the connection. The worker
+	// keeps the recording in its recording storage path and uploads it
+	// afterwards using UploadConnectionRecordingChunk. Indexing a recording
+	// again returns how much of it has been uploaded, so the worker can resume
+	// an interrupted upload.
+	UploadConnectionRecording(context.Context, *UploadConnectionRecordingRequest) (*UploadConnectionRecordingResponse, error)
+	// UploadConnectionRecordingChunk stores the next chunk of the recording of a
+	// connection indexed by UploadConnectionRecording in the controller's
+	// recording storage path. The recording is complete once all of its bytes
+	// have been stored.
+	UploadConnectionRecordingChunk(context.Context, *UploadConnectionRecordingChunkRequest) (*UploadConnectionRecordingChunkResponse, error)
+	// AddConnectionHttpRequests stores the HTTP requests a worker proxied over
+	// a connection of an http target.
+	AddConnectionHttpRequests(context.Context, *AddConnectionHttpRequestsRequest) (*AddConnectionHttpRequestsResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -137,6 +194,15 @@ func (UnimplementedSessionServiceServer) ConnectConnection(context.Context, *Con
 func (UnimplementedSessionServiceServer) CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseConnection not implemented")
 }
+func (UnimplementedSessionServiceServer) UploadConnectionRecording(context.Context, *UploadConnectionRecordingRequest) (*UploadConnectionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadConnectionRecording not implemented")
+}
+func (UnimplementedSessionServiceServer) UploadConnectionRecordingChunk(context.Context, *UploadConnectionRecordingChunkRequest) (*UploadConnectionRecordingChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadConnectionRecordingChunk not implemented")
+}
+func (UnimplementedSessionServiceServer) AddConnectionHttpRequests(context.Context, *AddConnectionHttpRequestsRequest) (*AddConnectionHttpRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConnectionHttpRequests not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +324,60 @@ func _SessionService_CloseConnection_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_UploadConnectionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadConnectionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UploadConnectionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.servers.services.v1.SessionService/UploadConnectionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UploadConnectionRecording(ctx, req.(*UploadConnectionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UploadConnectionRecordingChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadConnectionRecordingChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UploadConnectionRecordingChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.servers.services.v1.SessionService/UploadConnectionRecordingChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UploadConnectionRecordingChunk(ctx, req.(*UploadConnectionRecordingChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_AddConnectionHttpRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConnectionHttpRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).AddConnectionHttpRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.servers.services.v1.SessionService/AddConnectionHttpRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).AddConnectionHttpRequests(ctx, req.(*AddConnectionHttpRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +409,18 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseConnection",
 			Handler:    _SessionService_CloseConnection_Handler,
 		},
+		{
+			MethodName: "UploadConnectionRecording",
+			Handler:    _SessionService_UploadConnectionRecording_Handler,
+		},
+		{
+			MethodName: "UploadConnectionRecordingChunk",
+			Handler:    _SessionService_UploadConnectionRecordingChunk_Handler,
+		},
+		{
+			MethodName: "AddConnectionHttpRequests",
+			Handler:    _SessionService_AddConnectionHttpRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/servers/services/v1/session_service.proto",
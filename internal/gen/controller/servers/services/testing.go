@@ -13,6 +13,10 @@ type mockSessionServiceClient struct {
 	AuthorizeConnectionFn func(context.Context, *AuthorizeConnectionRequest) (*AuthorizeConnectionResponse, error)
 	ConnectConnectionFn   func(context.Context, *ConnectConnectionRequest) (*ConnectConnectionResponse, error)
 	CloseConnectionFn     func(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
+
+	UploadConnectionRecordingFn      func(context.Context, *UploadConnectionRecordingRequest) (*UploadConnectionRecordingResponse, error)
+	UploadConnectionRecordingChunkFn func(context.Context, *UploadConnectionRecordingChunkRequest) (*UploadConnectionRecordingChunkResponse, error)
+	AddConnectionHttpRequestsFn      func(context.Context, *AddConnectionHttpRequestsRequest) (*AddConnectionHttpRequestsResponse, error)
 }
 
 // NewMockSessionServiceClient returns a mock SessionServiceClient which allows
@@ -63,3 +67,24 @@ func (c *mockSessionServiceClient) CloseConnection(ctx context.Context, req *Clo
 	}
 	panic("not implemented")
 }
+
+func (c *mockSessionServiceClient) UploadConnectionRecording(ctx context.Context, req *UploadConnectionRecordingRequest, _ ...grpc.CallOption) (*UploadConnectionRecordingResponse, error) {
+	if c.UploadConnectionRecordingFn != nil {
+		return c.UploadConnectionRecordingFn(ctx, req)
+	}
+	panic("not implemented")
+}
+
+func (c *mockSessionServiceClient) UploadConnectionRecordingChunk(ctx context.Context, req *UploadConnectionRecordingChunkRequest, _ ...grpc.CallOption) (*UploadConnectionRecordingChunkResponse, error) {
+	if c.UploadConnectionRecordingChunkFn != nil {
+		return c.UploadConnectionRecordingChunkFn(ctx, req)
+	}
+	panic("not implemented")
+}
+
+func (c *mockSessionServiceClient) AddConnectionHttpRequests(ctx context.Context, req *AddConnectionHttpRequestsRequest, _ ...grpc.CallOption) (*AddConnectionHttpRequestsResponse, error) {
+	if c.AddConnectionHttpRequestsFn != nil {
+		return c.AddConnectionHttpRequestsFn(ctx, req)
+	}
+	panic("not implemented")
+}
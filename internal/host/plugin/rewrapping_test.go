@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRewrap_hostCatalogSecretRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := hostCatalogSecretRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = hostCatalogSecretRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		plg := host.TestPlugin(t, conn, "test")
+		cat := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+
+		secretData := mustMarshal(map[string]interface{}{"foo": "bar"})
+		secret, err := newHostCatalogSecret(ctx, cat.GetPublicId(), mustStruct(map[string]interface{}{"foo": "bar"}))
+		require.NoError(err)
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		require.NoError(secret.encrypt(ctx, databaseWrapper))
+		require.NoError(rw.Create(ctx, secret))
+
+		orig := &HostCatalogSecret{HostCatalogSecret: &store.HostCatalogSecret{CatalogId: cat.GetPublicId()}}
+		require.NoError(rw.LookupById(ctx, orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, prj.GetPublicId()))
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.True(referenced)
+
+		require.NoError(hostCatalogSecretRewrapFn(ctx, orig.GetKeyId(), prj.GetPublicId(), rw, rw, kmsCache))
+
+		got := &HostCatalogSecret{HostCatalogSecret: &store.HostCatalogSecret{CatalogId: cat.GetPublicId()}}
+		require.NoError(rw.LookupById(ctx, got))
+
+		databaseWrapper, err = kmsCache.GetWrapper(ctx, prj.GetPublicId(), kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.GetKeyId())
+		assert.NotEqual(orig.GetCtSecret(), got.GetCtSecret())
+
+		require.NoError(got.decrypt(ctx, databaseWrapper))
+		assert.Empty(cmp.Diff(secretData, got.GetSecret(), protocmp.Transform()))
+
+		referenced, err = kmsCache.DataKeyVersionReferenced(ctx, orig.GetKeyId())
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
@@ -9,10 +9,11 @@ import (
 )
 
 const (
-	UserPrefix      = "u"
-	GroupPrefix     = "g"
-	RolePrefix      = "r"
-	RoleGrantPrefix = "rg"
+	UserPrefix           = "u"
+	GroupPrefix          = "g"
+	RolePrefix           = "r"
+	RoleGrantPrefix      = "rg"
+	ServiceAccountPrefix = "sa"
 )
 
 func newRoleId() (string, error) {
@@ -39,6 +40,14 @@ func newGroupId() (string, error) {
 	return id, nil
 }
 
+func newServiceAccountId() (string, error) {
+	id, err := db.NewPublicId(ServiceAccountPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "iam.newServiceAccountId")
+	}
+	return id, nil
+}
+
 func newScopeId(scopeType scope.Type) (string, error) {
 	const op = "iam.newScopeId"
 	if scopeType == scope.Unknown {
@@ -27,6 +27,11 @@ func Test_PublicIds(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, GroupPrefix+"_"))
 	})
+	t.Run("service account", func(t *testing.T) {
+		id, err := newServiceAccountId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, ServiceAccountPrefix+"_"))
+	})
 	t.Run("oidc managed group", func(t *testing.T) {
 		assert.True(t, strings.HasPrefix("mgoidc_1234567890", intglobals.OidcManagedGroupPrefix+"_"))
 	})
@@ -0,0 +1,128 @@
+package iamjob
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+// purgeExpiredPrincipalRolesFrequency is how often expired assignments are
+// deleted. Expired assignments are already ignored when determining grants, so
+// deleting them promptly is not required.
+const purgeExpiredPrincipalRolesFrequency = 10 * time.Minute
+
+// purgeExpiredPrincipalRolesJob defines a periodic job that deletes the
+// assignments of principals to roles which have expired. Expired assignments
+// are already ignored when determining grants, the job removes them so they
+// are no longer listed on the role. An audit event is written for every role
+// whose principals were removed.
+type purgeExpiredPrincipalRolesJob struct {
+	repo *iam.Repository
+
+	running   ua.Bool
+	numRoles  int
+	numPurged int
+}
+
+// newPurgeExpiredPrincipalRolesJob instantiates the purge expired principal
+// roles job.
+func newPurgeExpiredPrincipalRolesJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms) (*purgeExpiredPrincipalRolesJob, error) {
+	const op = "iamjob.newPurgeExpiredPrincipalRolesJob"
+	switch {
+	case isNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case isNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	repo, err := iam.NewRepository(r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &purgeExpiredPrincipalRolesJob{
+		repo: repo,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (p *purgeExpiredPrincipalRolesJob) Name() string { return "iam_purge_expired_principal_roles" }
+
+// Description returns the description for the job.
+func (p *purgeExpiredPrincipalRolesJob) Description() string {
+	return "Remove principals from roles once their assignment has expired"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+// This is represented by purgeExpiredPrincipalRolesFrequency
+func (p *purgeExpiredPrincipalRolesJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return purgeExpiredPrincipalRolesFrequency, nil
+}
+
+// Status returns the status of the running job. Total is the number of roles
+// which had expired principals removed by the last run and Completed is the
+// number of them for which an audit event has been written.
+func (p *purgeExpiredPrincipalRolesJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: p.numPurged,
+		Total:     p.numRoles,
+	}
+}
+
+// Run deletes the expired assignments of principals to roles and writes an
+// audit event per role listing the principals which were removed. Can not be
+// run in parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (p *purgeExpiredPrincipalRolesJob) Run(ctx context.Context) error {
+	const op = "iamjob.(purgeExpiredPrincipalRolesJob).Run"
+	if !p.running.CAS(p.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer p.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	deleted, err := p.repo.DeleteExpiredPrincipalRoles(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	principalIds := make(map[string][]string)
+	for _, pr := range deleted {
+		principalIds[pr.GetRoleId()] = append(principalIds[pr.GetRoleId()], pr.GetPrincipalId())
+	}
+	roleIds := make([]string, 0, len(principalIds))
+	for roleId := range principalIds {
+		roleIds = append(roleIds, roleId)
+	}
+	sort.Strings(roleIds)
+
+	// Set numPurged and numRoles for status report
+	p.numPurged, p.numRoles = 0, len(roleIds)
+
+	for _, roleId := range roleIds {
+		details := &pbs.RemoveRolePrincipalsRequest{
+			Id:           roleId,
+			PrincipalIds: principalIds[roleId],
+		}
+		if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{Operation: "purge-expired-principals", Details: details})); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error writing audit event for expired principals", "role id", roleId))
+		}
+		p.numPurged++
+	}
+
+	return nil
+}
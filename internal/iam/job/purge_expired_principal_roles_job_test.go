@@ -0,0 +1,202 @@
+package iamjob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeExpiredPrincipalRolesJob(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	type args struct {
+		w   db.Writer
+		r   db.Reader
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name: "nil writer",
+			args: args{
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil reader",
+			args: args{
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				w: rw,
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPurgeExpiredPrincipalRolesJob(ctx, tt.args.r, tt.args.w, tt.args.kms)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal("iam_purge_expired_principal_roles", got.Name())
+			assert.Equal("Remove principals from roles once their assignment has expired", got.Description())
+			nextRun, err := got.NextRunIn(ctx)
+			require.NoError(err)
+			assert.Equal(10*time.Minute, nextRun)
+
+			role := iam.TestRole(t, conn, proj.GetPublicId())
+			user := iam.TestUser(t, iamRepo, org.GetPublicId())
+			otherUser := iam.TestUser(t, iamRepo, org.GetPublicId())
+			_, err = iamRepo.AddPrincipalRoles(ctx, role.GetPublicId(), role.GetVersion(), []string{user.GetPublicId()}, iam.WithExpirationTime(time.Now().Add(2*time.Second)))
+			require.NoError(err)
+			_, err = iamRepo.AddPrincipalRoles(ctx, role.GetPublicId(), role.GetVersion()+1, []string{otherUser.GetPublicId()})
+			require.NoError(err)
+
+			// Run job before the assignment expires, nothing should be purged
+			require.NoError(got.Run(ctx))
+			assert.Equal(scheduler.JobStatus{}, got.Status())
+
+			// Run job after the assignment expired and ensure only the
+			// expired principal was removed from the role
+			time.Sleep(2 * time.Second)
+			require.NoError(got.Run(ctx))
+			assert.Equal(scheduler.JobStatus{Completed: 1, Total: 1}, got.Status())
+
+			principals, err := iamRepo.ListPrincipalRoles(ctx, role.GetPublicId())
+			require.NoError(err)
+			require.Len(principals, 1)
+			assert.Equal(otherUser.GetPublicId(), principals[0].GetPrincipalId())
+		})
+	}
+}
+
+func TestRegisterJobs(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	type args struct {
+		s   *scheduler.Scheduler
+		w   db.Writer
+		r   db.Reader
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name: "nil scheduler",
+			args: args{
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil writer",
+			args: args{
+				s:   sched,
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil reader",
+			args: args{
+				s:   sched,
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				s: sched,
+				w: rw,
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{
+				s:   sched,
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterJobs(ctx, tt.args.s, tt.args.r, tt.args.w, tt.args.kms)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package iam
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
 	for _, o := range opt {
-		o(&opts)
+		if o != nil {
+			o(&opts)
+		}
 	}
 	return opts
 }
@@ -29,6 +34,8 @@ type options struct {
 	withRandomReader            io.Reader
 	withAccountIds              []string
 	withPrimaryAuthMethodId     string
+	withUserState               UserState
+	withExpirationTime          time.Time
 }
 
 func getDefaultOptions() options {
@@ -141,3 +148,18 @@ func WithPrimaryAuthMethodId(id string) Option {
 		o.withPrimaryAuthMethodId = id
 	}
 }
+
+// WithUserState provides an option to specify the state of a user.
+func WithUserState(state UserState) Option {
+	return func(o *options) {
+		o.withUserState = state
+	}
+}
+
+// WithExpirationTime provides an option to specify when the assignment of a
+// principal to a role expires.
+func WithExpirationTime(t time.Time) Option {
+	return func(o *options) {
+		o.withExpirationTime = t
+	}
+}
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -79,4 +80,24 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrimaryAuthMethodId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithUserState", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserState(DisabledUserState))
+		testOpts := getDefaultOptions()
+		testOpts.withUserState = DisabledUserState
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithExpirationTime", func(t *testing.T) {
+		assert := assert.New(t)
+		now := time.Now()
+		opts := getOpts(WithExpirationTime(now))
+		testOpts := getDefaultOptions()
+		testOpts.withExpirationTime = now
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("nil option", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(nil)
+		assert.Equal(opts, getDefaultOptions())
+	})
 }
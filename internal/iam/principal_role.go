@@ -2,8 +2,10 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"google.golang.org/protobuf/proto"
@@ -13,10 +15,11 @@ import (
 type RoleType uint32
 
 const (
-	UnknownRoleType      RoleType = 0
-	UserRoleType         RoleType = 1
-	GroupRoleType        RoleType = 2
-	ManagedGroupRoleType RoleType = 3
+	UnknownRoleType        RoleType = 0
+	UserRoleType           RoleType = 1
+	GroupRoleType          RoleType = 2
+	ManagedGroupRoleType   RoleType = 3
+	ServiceAccountRoleType RoleType = 4
 )
 
 // String returns a string representation of the role type.
@@ -26,14 +29,16 @@ func (r RoleType) String() string {
 		"user",
 		"group",
 		"managed group",
+		"service account",
 	}[r]
 }
 
 const (
-	principalRoleViewDefaultTable = "iam_principal_role"
-	userRoleDefaultTable          = "iam_user_role"
-	groupRoleDefaultTable         = "iam_group_role"
-	managedGroupRoleDefaultTable  = "iam_managed_group_role"
+	principalRoleViewDefaultTable  = "iam_principal_role"
+	userRoleDefaultTable           = "iam_user_role"
+	groupRoleDefaultTable          = "iam_group_role"
+	managedGroupRoleDefaultTable   = "iam_managed_group_role"
+	serviceAccountRoleDefaultTable = "iam_service_account_role"
 )
 
 // PrincipalRole provides a common way to return roles regardless of their
@@ -62,6 +67,16 @@ func (v *PrincipalRole) SetTableName(n string) {
 	}
 }
 
+// expirationTime returns the expiration time provided by the
+// WithExpirationTime option truncated to the second, or nil if the option was
+// not provided.
+func expirationTime(opts options) *timestamp.Timestamp {
+	if opts.withExpirationTime.IsZero() {
+		return nil
+	}
+	return timestamp.New(opts.withExpirationTime.Truncate(time.Second))
+}
+
 // UserRole is a user assigned to a role
 type UserRole struct {
 	*store.UserRole
@@ -75,9 +90,9 @@ var (
 	_ db.VetForWriter = (*UserRole)(nil)
 )
 
-// NewUserRole creates a new user role in memory. No options are supported
-// currently.
-func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
+// NewUserRole creates a new user role in memory. The WithExpirationTime option
+// is supported and all other options are ignored.
+func NewUserRole(roleId, userId string, opt ...Option) (*UserRole, error) {
 	const op = "iam.NewUserRole"
 	if roleId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing role id")
@@ -85,10 +100,12 @@ func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
 	if userId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
 	}
+	opts := getOpts(opt...)
 	return &UserRole{
 		UserRole: &store.UserRole{
-			PrincipalId: userId,
-			RoleId:      roleId,
+			PrincipalId:    userId,
+			RoleId:         roleId,
+			ExpirationTime: expirationTime(opts),
 		},
 	}, nil
 }
@@ -152,8 +169,8 @@ var (
 	_ db.VetForWriter = (*GroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewGroupRole creates a new group role in memory. The WithExpirationTime
+// option is supported and all other options are ignored.
 func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	const op = "iam.NewGroupRole"
 	if roleId == "" {
@@ -162,10 +179,12 @@ func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	if groupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing group id")
 	}
+	opts := getOpts(opt...)
 	return &GroupRole{
 		GroupRole: &store.GroupRole{
-			PrincipalId: groupId,
-			RoleId:      roleId,
+			PrincipalId:    groupId,
+			RoleId:         roleId,
+			ExpirationTime: expirationTime(opts),
 		},
 	}, nil
 }
@@ -229,8 +248,8 @@ var (
 	_ db.VetForWriter = (*ManagedGroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewManagedGroupRole creates a new managed group role in memory. The
+// WithExpirationTime option is supported and all other options are ignored.
 func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*ManagedGroupRole, error) {
 	const op = "iam.NewManagedGroupRole"
 	if roleId == "" {
@@ -239,10 +258,12 @@ func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*Managed
 	if managedGroupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing managed group id")
 	}
+	opts := getOpts(opt...)
 	return &ManagedGroupRole{
 		ManagedGroupRole: &store.ManagedGroupRole{
-			PrincipalId: managedGroupId,
-			RoleId:      roleId,
+			PrincipalId:    managedGroupId,
+			RoleId:         roleId,
+			ExpirationTime: expirationTime(opts),
 		},
 	}, nil
 }
@@ -294,3 +315,82 @@ func (r *ManagedGroupRole) SetTableName(n string) {
 		r.tableName = n
 	}
 }
+
+// ServiceAccountRole is a service account assigned to a role
+type ServiceAccountRole struct {
+	*store.ServiceAccountRole
+	tableName string `gorm:"-"`
+}
+
+// ensure that ServiceAccountRole implements the interfaces of: Cloneable and
+// db.VetForWriter
+var (
+	_ Cloneable       = (*ServiceAccountRole)(nil)
+	_ db.VetForWriter = (*ServiceAccountRole)(nil)
+)
+
+// NewServiceAccountRole creates a new service account role in memory. The
+// WithExpirationTime option is supported and all other options are ignored.
+func NewServiceAccountRole(roleId, serviceAccountId string, opt ...Option) (*ServiceAccountRole, error) {
+	const op = "iam.NewServiceAccountRole"
+	if roleId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing role id")
+	}
+	if serviceAccountId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing service account id")
+	}
+	opts := getOpts(opt...)
+	return &ServiceAccountRole{
+		ServiceAccountRole: &store.ServiceAccountRole{
+			PrincipalId:    serviceAccountId,
+			RoleId:         roleId,
+			ExpirationTime: expirationTime(opts),
+		},
+	}, nil
+}
+
+func allocServiceAccountRole() ServiceAccountRole {
+	return ServiceAccountRole{
+		ServiceAccountRole: &store.ServiceAccountRole{},
+	}
+}
+
+// Clone creates a clone of the ServiceAccountRole.
+func (r *ServiceAccountRole) Clone() interface{} {
+	cp := proto.Clone(r.ServiceAccountRole)
+	return &ServiceAccountRole{
+		ServiceAccountRole: cp.(*store.ServiceAccountRole),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface for service account roles.
+func (role *ServiceAccountRole) VetForWrite(ctx context.Context, _ db.Reader, _ db.OpType, _ ...db.Option) error {
+	const op = "iam.(ServiceAccountRole).VetForWrite"
+	if role.RoleId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+	}
+	if role.PrincipalId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing service account id")
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name for
+// service account roles.
+func (r *ServiceAccountRole) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return serviceAccountRoleDefaultTable
+}
+
+// SetTableName sets the table name for the resource. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (r *ServiceAccountRole) SetTableName(n string) {
+	switch n {
+	case "":
+		r.tableName = serviceAccountRoleDefaultTable
+	default:
+		r.tableName = n
+	}
+}
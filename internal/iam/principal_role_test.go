@@ -3,9 +3,11 @@ package iam
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +25,7 @@ func TestNewUserRole(t *testing.T) {
 	orgRole := TestRole(t, conn, org.PublicId)
 	projRole := TestRole(t, conn, proj.PublicId)
 	user := TestUser(t, repo, org.PublicId)
+	expiration := time.Now().Add(time.Hour)
 
 	type args struct {
 		roleId string
@@ -62,6 +65,21 @@ func TestNewUserRole(t *testing.T) {
 				return &r
 			}(),
 		},
+		{
+			name: "valid-with-expiration-time",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithExpirationTime(expiration)},
+			},
+			want: func() *UserRole {
+				r := allocUserRole()
+				r.RoleId = orgRole.PublicId
+				r.PrincipalId = user.PublicId
+				r.ExpirationTime = timestamp.New(expiration.Truncate(time.Second))
+				return &r
+			}(),
+		},
 		{
 			name: "empty-role-id",
 			args: args{
@@ -903,3 +921,23 @@ func TestManagedGroupRole_SetTableName(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAccountRole_Clone(t *testing.T) {
+	t.Parallel()
+	r, err := NewServiceAccountRole("r_1234567890", "sa_1234567890")
+	require.NoError(t, err)
+	cp := r.Clone().(*ServiceAccountRole)
+	assert.True(t, proto.Equal(cp.ServiceAccountRole, r.ServiceAccountRole))
+
+	def := allocServiceAccountRole()
+	assert.Equal(t, serviceAccountRoleDefaultTable, def.TableName())
+	def.SetTableName("custom")
+	assert.Equal(t, "custom", def.TableName())
+	def.SetTableName("")
+	assert.Equal(t, serviceAccountRoleDefaultTable, def.TableName())
+
+	_, err = NewServiceAccountRole("", "sa_1234567890")
+	assert.Error(t, err)
+	_, err = NewServiceAccountRole("r_1234567890", "")
+	assert.Error(t, err)
+}
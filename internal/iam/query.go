@@ -108,4 +108,109 @@ const (
 	select * from final
 	order by action, member_id;
 	`
+
+	// lookupResourceQuery returns the type, the scope and the pin of the
+	// resource with the provided public id. The scope of a scope is its parent
+	// and the pin is the id of the resource containing the resource, if any.
+	lookupResourceQuery = `
+	select 'scope' as type, coalesce(parent_id, public_id) as scope_id, null as pin_id
+	  from iam_scope
+	 where public_id = @public_id
+	 union all
+	select 'user', scope_id, null
+	  from iam_user
+	 where public_id = @public_id
+	 union all
+	select 'group', scope_id, null
+	  from iam_group
+	 where public_id = @public_id
+	 union all
+	select 'role', scope_id, null
+	  from iam_role
+	 where public_id = @public_id
+	 union all
+	select 'service-account', scope_id, null
+	  from iam_service_account
+	 where public_id = @public_id
+	 union all
+	select 'auth-method', scope_id, null
+	  from auth_method
+	 where public_id = @public_id
+	 union all
+	select 'account', scope_id, auth_method_id
+	  from auth_account
+	 where public_id = @public_id
+	 union all
+	select 'managed-group', am.scope_id, mg.auth_method_id
+	  from auth_managed_group mg
+	  join auth_method am
+	    on am.public_id = mg.auth_method_id
+	 where mg.public_id = @public_id
+	 union all
+	select 'auth-token', acct.scope_id, null
+	  from auth_token at
+	  join auth_account acct
+	    on acct.public_id = at.auth_account_id
+	 where at.public_id = @public_id
+	 union all
+	select 'auth-token', scope_id, null
+	  from auth_service_account_token
+	 where public_id = @public_id
+	 union all
+	select 'host-catalog', project_id, null
+	  from host_catalog
+	 where public_id = @public_id
+	 union all
+	select 'host-set', hc.project_id, hs.catalog_id
+	  from host_set hs
+	  join host_catalog hc
+	    on hc.public_id = hs.catalog_id
+	 where hs.public_id = @public_id
+	 union all
+	select 'host', hc.project_id, h.catalog_id
+	  from host h
+	  join host_catalog hc
+	    on hc.public_id = h.catalog_id
+	 where h.public_id = @public_id
+	 union all
+	select 'target', project_id, null
+	  from target
+	 where public_id = @public_id
+	 union all
+	select 'session', project_id, null
+	  from session
+	 where public_id = @public_id
+	 union all
+	select 'credential-store', project_id, null
+	  from credential_store
+	 where public_id = @public_id
+	 union all
+	select 'credential-library', cs.project_id, cl.store_id
+	  from credential_library cl
+	  join credential_store cs
+	    on cs.public_id = cl.store_id
+	 where cl.public_id = @public_id
+	 union all
+	select 'credential', cs.project_id, c.store_id
+	  from credential_static c
+	  join credential_store cs
+	    on cs.public_id = c.store_id
+	 where c.public_id = @public_id
+	 union all
+	select 'worker', scope_id, null
+	  from server_worker
+	 where public_id = @public_id;
+	`
+
+	expiredPrincipalRolesQuery = `
+	select pr.role_id,
+	       pr.principal_id,
+	       pr.type,
+	       r.version as role_version
+	  from iam_principal_role pr
+	  join iam_role r
+	    on r.public_id = pr.role_id
+	 where pr.expiration_time <= current_timestamp
+	order by pr.role_id, pr.principal_id;
+	`
 )
@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 )
 
-// AddPrincipalRoles provides the ability to add principals (userIds and
-// groupIds) to a role (roleId).  The role's current db version must match the
+// AddPrincipalRoles provides the ability to add principals (userIds, groupIds,
+// managedGroupIds and serviceAccountIds) to a role (roleId).  The role's current db version must match the
 // roleVersion or an error will be returned.  The list of current PrincipalRoles
 // after the adds will be returned on success. Zero is not a valid value for
-// the WithVersion option and will return an error.
-func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]*PrincipalRole, error) {
+// the WithVersion option and will return an error.  The WithExpirationTime
+// option sets when the assignment of the added principals expires; it must be
+// in the future.  Expired assignments are not considered when determining
+// grants.
+func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]*PrincipalRole, error) {
 	const op = "iam.(Repository).AddPrincipalRoles"
 	if roleId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -25,17 +31,20 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	if roleVersion == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
-	userIds, groupIds, managedGroupIds, err := splitPrincipals(ctx, principalIds)
+	if err := validateExpirationTime(ctx, getOpts(opt...)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	userIds, groupIds, managedGroupIds, serviceAccountIds, err := splitPrincipals(ctx, principalIds)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	if len(userIds) == 0 && len(groupIds) == 0 && len(managedGroupIds) == 0 {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing any of users, groups, or managed groups to add")
+	if len(userIds) == 0 && len(groupIds) == 0 && len(managedGroupIds) == 0 && len(serviceAccountIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing any of users, groups, managed groups, or service accounts to add")
 	}
 
 	newUserRoles := make([]interface{}, 0, len(userIds))
 	for _, id := range userIds {
-		usrRole, err := NewUserRole(roleId, id)
+		usrRole, err := NewUserRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role"))
 		}
@@ -43,7 +52,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newGrpRoles := make([]interface{}, 0, len(groupIds))
 	for _, id := range groupIds {
-		grpRole, err := NewGroupRole(roleId, id)
+		grpRole, err := NewGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role"))
 		}
@@ -51,12 +60,20 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newManagedGrpRoles := make([]interface{}, 0, len(managedGroupIds))
 	for _, id := range managedGroupIds {
-		managedGrpRole, err := NewManagedGroupRole(roleId, id)
+		managedGrpRole, err := NewManagedGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role"))
 		}
 		newManagedGrpRoles = append(newManagedGrpRoles, managedGrpRole)
 	}
+	newServiceAccountRoles := make([]interface{}, 0, len(serviceAccountIds))
+	for _, id := range serviceAccountIds {
+		saRole, err := NewServiceAccountRole(roleId, id, opt...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory service account role"))
+		}
+		newServiceAccountRoles = append(newServiceAccountRoles, saRole)
+	}
 
 	role := allocRole()
 	role.PublicId = roleId
@@ -114,6 +131,13 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 				}
 				msgs = append(msgs, managedGrpOplogMsgs...)
 			}
+			if len(newServiceAccountRoles) > 0 {
+				saOplogMsgs := make([]*oplog.Message, 0, len(newServiceAccountRoles))
+				if err := w.CreateItems(ctx, newServiceAccountRoles, db.NewOplogMsgs(&saOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add service accounts"))
+				}
+				msgs = append(msgs, saOplogMsgs...)
+			}
 			metadata := oplog.Metadata{
 				"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
 				"scope-id":           []string{scope.PublicId},
@@ -148,8 +172,11 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 // principals as need to reconcile the existing principals with the principals
 // requested. If both userIds and groupIds are empty, the principal roles will
 // be cleared. Zero is not a valid value for the WithVersion option and will
-// return an error.
-func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]*PrincipalRole, int, error) {
+// return an error. The WithExpirationTime option sets when the assignment of
+// the principals expires; it must be in the future. When the option is set,
+// existing principals whose assignment expires at a different time are
+// replaced; otherwise the expiration of existing principals is unchanged.
+func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]*PrincipalRole, int, error) {
 	const op = "iam.(Repository).SetPrincipalRoles"
 	if roleId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -157,17 +184,20 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	if roleVersion == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
+	if err := validateExpirationTime(ctx, getOpts(opt...)); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
 	role := allocRole()
 	role.PublicId = roleId
 
 	// it's "safe" to do this lookup outside the DoTx transaction because we
 	// have a roleVersion so the principals can’t change without the version
 	// changing.
-	userIds, groupIds, managedGroupIds, err := splitPrincipals(ctx, principalIds)
+	userIds, groupIds, managedGroupIds, serviceAccountIds, err := splitPrincipals(ctx, principalIds)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds)
+	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds, serviceAccountIds, opt...)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
@@ -222,7 +252,8 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 
 			if len(toSet.DeleteUserRoles) > 0 ||
 				len(toSet.DeleteGroupRoles) > 0 ||
-				len(toSet.DeleteManagedGroupRoles) > 0 {
+				len(toSet.DeleteManagedGroupRoles) > 0 ||
+				len(toSet.DeleteServiceAccountRoles) > 0 {
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
 				if len(toSet.DeleteUserRoles) > 0 {
 					userOplogMsgs := make([]*oplog.Message, 0, len(toSet.DeleteUserRoles))
@@ -260,10 +291,23 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 					totalRowsAffected += rowsDeleted
 					msgs = append(msgs, managedGrpOplogMsgs...)
 				}
+				if len(toSet.DeleteServiceAccountRoles) > 0 {
+					saOplogMsgs := make([]*oplog.Message, 0, len(toSet.DeleteServiceAccountRoles))
+					rowsDeleted, err := w.DeleteItems(ctx, toSet.DeleteServiceAccountRoles, db.NewOplogMsgs(&saOplogMsgs))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete service accounts"))
+					}
+					if rowsDeleted != len(toSet.DeleteServiceAccountRoles) {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("service account roles deleted %d did not match request for %d", rowsDeleted, len(toSet.DeleteServiceAccountRoles)))
+					}
+					totalRowsAffected += rowsDeleted
+					msgs = append(msgs, saOplogMsgs...)
+				}
 			}
 			if len(toSet.AddUserRoles) > 0 ||
 				len(toSet.AddGroupRoles) > 0 ||
-				len(toSet.AddManagedGroupRoles) > 0 {
+				len(toSet.AddManagedGroupRoles) > 0 ||
+				len(toSet.AddServiceAccountRoles) > 0 {
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
 				if len(toSet.AddUserRoles) > 0 {
 					userOplogMsgs := make([]*oplog.Message, 0, len(toSet.AddUserRoles))
@@ -289,6 +333,14 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 					totalRowsAffected += len(toSet.AddManagedGroupRoles)
 					msgs = append(msgs, managedGrpOplogMsgs...)
 				}
+				if len(toSet.AddServiceAccountRoles) > 0 {
+					saOplogMsgs := make([]*oplog.Message, 0, len(toSet.AddServiceAccountRoles))
+					if err := w.CreateItems(ctx, toSet.AddServiceAccountRoles, db.NewOplogMsgs(&saOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add service accounts"))
+					}
+					totalRowsAffected += len(toSet.AddServiceAccountRoles)
+					msgs = append(msgs, saOplogMsgs...)
+				}
 			}
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -313,7 +365,8 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	return currentPrincipals, totalRowsAffected, nil
 }
 
-// DeletePrincipalRoles principals (userIds and/or groupIds) from a role
+// DeletePrincipalRoles principals (userIds, groupIds, managedGroupIds and/or
+// serviceAccountIds) from a role
 // (roleId). The role's current db version must match the roleVersion or an
 // error will be returned. Zero is not a valid value for the WithVersion option
 // and will return an error.
@@ -322,12 +375,12 @@ func (r *Repository) DeletePrincipalRoles(ctx context.Context, roleId string, ro
 	if roleId == "" {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
 	}
-	userIds, groupIds, managedGroupIds, err := splitPrincipals(ctx, principalIds)
+	userIds, groupIds, managedGroupIds, serviceAccountIds, err := splitPrincipals(ctx, principalIds)
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	if len(userIds) == 0 && len(groupIds) == 0 && len(managedGroupIds) == 0 {
-		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing any of users, groups, or managed groups to delete")
+	if len(userIds) == 0 && len(groupIds) == 0 && len(managedGroupIds) == 0 && len(serviceAccountIds) == 0 {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing any of users, groups, managed groups, or service accounts to delete")
 	}
 	if roleVersion == 0 {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
@@ -359,6 +412,14 @@ func (r *Repository) DeletePrincipalRoles(ctx context.Context, roleId string, ro
 		}
 		deleteManagedGrpRoles = append(deleteManagedGrpRoles, managedGrpRole)
 	}
+	deleteServiceAccountRoles := make([]interface{}, 0, len(serviceAccountIds))
+	for _, id := range serviceAccountIds {
+		saRole, err := NewServiceAccountRole(roleId, id)
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory service account role"))
+		}
+		deleteServiceAccountRoles = append(deleteServiceAccountRoles, saRole)
+	}
 
 	scope, err := role.GetScope(ctx, r.reader)
 	if err != nil {
@@ -428,6 +489,18 @@ func (r *Repository) DeletePrincipalRoles(ctx context.Context, roleId string, ro
 				totalRowsDeleted += rowsDeleted
 				msgs = append(msgs, managedGrpOplogMsgs...)
 			}
+			if len(deleteServiceAccountRoles) > 0 {
+				saOplogMsgs := make([]*oplog.Message, 0, len(deleteServiceAccountRoles))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteServiceAccountRoles, db.NewOplogMsgs(&saOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete service accounts"))
+				}
+				if rowsDeleted != len(deleteServiceAccountRoles) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("service account roles deleted %d did not match request for %d", rowsDeleted, len(deleteServiceAccountRoles)))
+				}
+				totalRowsDeleted += rowsDeleted
+				msgs = append(msgs, saOplogMsgs...)
+			}
 			metadata := oplog.Metadata{
 				"op-type":            []string{oplog.OpType_OP_TYPE_DELETE.String()},
 				"scope-id":           []string{scope.PublicId},
@@ -461,26 +534,92 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// DeleteExpiredPrincipalRoles deletes the assignments of principals to roles
+// which have expired and returns them. The principals of each role are deleted
+// with DeletePrincipalRoles, so the role version is incremented and an oplog
+// entry is written. Roles which are updated concurrently are skipped and
+// their expired principals are deleted by a later call. Only the role id,
+// principal id and type of the returned principal roles are set. All options
+// are ignored.
+func (r *Repository) DeleteExpiredPrincipalRoles(ctx context.Context, _ ...Option) ([]*PrincipalRole, error) {
+	const op = "iam.(Repository).DeleteExpiredPrincipalRoles"
+	rows, err := r.reader.Query(ctx, expiredPrincipalRolesQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	type expiredPrincipalRole struct {
+		RoleId      string
+		PrincipalId string
+		Type        string
+		RoleVersion uint32
+	}
+	var expired []*expiredPrincipalRole
+	for rows.Next() {
+		var e expiredPrincipalRole
+		if err := r.reader.ScanRows(ctx, rows, &e); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		expired = append(expired, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var deleted []*PrincipalRole
+	for i := 0; i < len(expired); {
+		roleId, roleVersion := expired[i].RoleId, expired[i].RoleVersion
+		var principalIds []string
+		var principalRoles []*PrincipalRole
+		for ; i < len(expired) && expired[i].RoleId == roleId; i++ {
+			principalIds = append(principalIds, expired[i].PrincipalId)
+			principalRoles = append(principalRoles, &PrincipalRole{
+				PrincipalRoleView: &store.PrincipalRoleView{
+					RoleId:      roleId,
+					PrincipalId: expired[i].PrincipalId,
+					Type:        expired[i].Type,
+				},
+			})
+		}
+		// The role version read with the expired principals guards against
+		// deleting principals which were added again since.
+		if _, err := r.DeletePrincipalRoles(ctx, roleId, roleVersion, principalIds); err != nil {
+			if errors.Match(errors.T(errors.MultipleRecords), err) || errors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete expired principals of role %s", roleId)))
+		}
+		deleted = append(deleted, principalRoles...)
+	}
+	return deleted, nil
+}
+
 type PrincipalSet struct {
-	AddUserRoles            []interface{}
-	AddGroupRoles           []interface{}
-	AddManagedGroupRoles    []interface{}
-	DeleteUserRoles         []interface{}
-	DeleteGroupRoles        []interface{}
-	DeleteManagedGroupRoles []interface{}
+	AddUserRoles              []interface{}
+	AddGroupRoles             []interface{}
+	AddManagedGroupRoles      []interface{}
+	AddServiceAccountRoles    []interface{}
+	DeleteUserRoles           []interface{}
+	DeleteGroupRoles          []interface{}
+	DeleteManagedGroupRoles   []interface{}
+	DeleteServiceAccountRoles []interface{}
 	// unchangedPrincipalRoles is set iff there are no changes, that is, the
 	// length of all other members is zero
 	UnchangedPrincipalRoles []*PrincipalRole
 }
 
 // TODO: Should this be moved inside the transaction, at this point?
-// PrincipalsToSet sets principals on a role from the given lists.
-func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string) (*PrincipalSet, error) {
+// PrincipalsToSet sets principals on a role from the given lists. The
+// WithExpirationTime option is supported and, when it is set, existing
+// principals whose assignment expires at a different time are deleted and
+// added again. Without it the expiration of existing principals is kept.
+func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds, serviceAccountIds []string, opt ...Option) (*PrincipalSet, error) {
 	const op = "iam.(Repository).PrincipalsToSet"
 	// TODO(mgaffney) 08/2020: Use SQL to calculate changes.
 	if role == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role")
 	}
+	expiration := expirationTime(getOpts(opt...))
 	existing, err := r.ListPrincipalRoles(ctx, role.PublicId)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to list existing principal role %s", role.PublicId)))
@@ -488,6 +627,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	existingUsers := map[string]*PrincipalRole{}
 	existingGroups := map[string]*PrincipalRole{}
 	existingManagedGroups := map[string]*PrincipalRole{}
+	existingServiceAccounts := map[string]*PrincipalRole{}
 	for _, p := range existing {
 		switch p.GetType() {
 		case UserRoleType.String():
@@ -496,6 +636,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 			existingGroups[p.PrincipalId] = p
 		case ManagedGroupRoleType.String():
 			existingManagedGroups[p.PrincipalId] = p
+		case ServiceAccountRoleType.String():
+			existingServiceAccounts[p.PrincipalId] = p
 		default:
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is unknown principal type %s", p.PrincipalId, p.GetType()))
 		}
@@ -504,8 +646,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	userIdsMap := map[string]struct{}{}
 	for _, id := range userIds {
 		userIdsMap[id] = struct{}{}
-		if _, ok := existingUsers[id]; !ok {
-			usrRole, err := NewUserRole(role.PublicId, id)
+		if p, ok := existingUsers[id]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
+			usrRole, err := NewUserRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for add"))
 			}
@@ -516,8 +658,8 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	groupIdsMap := map[string]struct{}{}
 	for _, id := range groupIds {
 		groupIdsMap[id] = struct{}{}
-		if _, ok := existingGroups[id]; !ok {
-			grpRole, err := NewGroupRole(role.PublicId, id)
+		if p, ok := existingGroups[id]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
+			grpRole, err := NewGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for add"))
 			}
@@ -528,17 +670,29 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	managedGroupIdsMap := map[string]struct{}{}
 	for _, id := range managedGroupIds {
 		managedGroupIdsMap[id] = struct{}{}
-		if _, ok := existingManagedGroups[id]; !ok {
-			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id)
+		if p, ok := existingManagedGroups[id]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
+			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for add"))
 			}
 			newManagedGrpRoles = append(newManagedGrpRoles, managedGrpRole)
 		}
 	}
+	var newServiceAccountRoles []interface{}
+	serviceAccountIdsMap := map[string]struct{}{}
+	for _, id := range serviceAccountIds {
+		serviceAccountIdsMap[id] = struct{}{}
+		if p, ok := existingServiceAccounts[id]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
+			saRole, err := NewServiceAccountRole(role.PublicId, id, opt...)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory service account role for add"))
+			}
+			newServiceAccountRoles = append(newServiceAccountRoles, saRole)
+		}
+	}
 	var deleteUserRoles []interface{}
 	for _, p := range existingUsers {
-		if _, ok := userIdsMap[p.PrincipalId]; !ok {
+		if _, ok := userIdsMap[p.PrincipalId]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
 			usrRole, err := NewUserRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for delete"))
@@ -548,7 +702,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteGrpRoles []interface{}
 	for _, p := range existingGroups {
-		if _, ok := groupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := groupIdsMap[p.PrincipalId]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
 			grpRole, err := NewGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for delete"))
@@ -558,7 +712,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	}
 	var deleteManagedGrpRoles []interface{}
 	for _, p := range existingManagedGroups {
-		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok {
+		if _, ok := managedGroupIdsMap[p.PrincipalId]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
 			managedGrpRole, err := NewManagedGroupRole(p.GetRoleId(), p.GetPrincipalId())
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for delete"))
@@ -566,29 +720,62 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 			deleteManagedGrpRoles = append(deleteManagedGrpRoles, managedGrpRole)
 		}
 	}
+	var deleteServiceAccountRoles []interface{}
+	for _, p := range existingServiceAccounts {
+		if _, ok := serviceAccountIdsMap[p.PrincipalId]; !ok || expirationTimeChanged(p.GetExpirationTime(), expiration) {
+			saRole, err := NewServiceAccountRole(p.GetRoleId(), p.GetPrincipalId())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory service account role for delete"))
+			}
+			deleteServiceAccountRoles = append(deleteServiceAccountRoles, saRole)
+		}
+	}
 
 	toSet := &PrincipalSet{
-		AddUserRoles:            newUserRoles,
-		AddGroupRoles:           newGrpRoles,
-		AddManagedGroupRoles:    newManagedGrpRoles,
-		DeleteUserRoles:         deleteUserRoles,
-		DeleteGroupRoles:        deleteGrpRoles,
-		DeleteManagedGroupRoles: deleteManagedGrpRoles,
+		AddUserRoles:              newUserRoles,
+		AddGroupRoles:             newGrpRoles,
+		AddManagedGroupRoles:      newManagedGrpRoles,
+		AddServiceAccountRoles:    newServiceAccountRoles,
+		DeleteUserRoles:           deleteUserRoles,
+		DeleteGroupRoles:          deleteGrpRoles,
+		DeleteManagedGroupRoles:   deleteManagedGrpRoles,
+		DeleteServiceAccountRoles: deleteServiceAccountRoles,
 	}
 
 	if len(toSet.AddUserRoles) == 0 &&
 		len(toSet.AddGroupRoles) == 0 &&
 		len(toSet.AddManagedGroupRoles) == 0 &&
+		len(toSet.AddServiceAccountRoles) == 0 &&
 		len(toSet.DeleteUserRoles) == 0 &&
 		len(toSet.DeleteGroupRoles) == 0 &&
-		len(toSet.DeleteManagedGroupRoles) == 0 {
+		len(toSet.DeleteManagedGroupRoles) == 0 &&
+		len(toSet.DeleteServiceAccountRoles) == 0 {
 		toSet.UnchangedPrincipalRoles = existing
 	}
 
 	return toSet, nil
 }
 
-func splitPrincipals(ctx context.Context, principals []string) (users, groups, managedGroups []string, retErr error) {
+// validateExpirationTime returns an error if the expiration time provided by
+// the WithExpirationTime option is not in the future.
+func validateExpirationTime(ctx context.Context, opts options) error {
+	const op = "iam.validateExpirationTime"
+	if !opts.withExpirationTime.IsZero() && !opts.withExpirationTime.After(time.Now()) {
+		return errors.New(ctx, errors.InvalidParameter, op, "expiration time must be in the future")
+	}
+	return nil
+}
+
+// expirationTimeChanged returns true if the requested expiration time is set
+// and differs from the current expiration time of an assignment.
+func expirationTimeChanged(current, requested *timestamp.Timestamp) bool {
+	if requested == nil {
+		return false
+	}
+	return current == nil || !current.AsTime().Equal(requested.AsTime())
+}
+
+func splitPrincipals(ctx context.Context, principals []string) (users, groups, managedGroups, serviceAccounts []string, retErr error) {
 	const op = "iam.splitPrincipals"
 	for _, principal := range principals {
 		switch {
@@ -602,13 +789,20 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 				groups = make([]string, 0, len(principals))
 			}
 			groups = append(groups, principal)
-		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix):
+		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix),
+			strings.HasPrefix(principal, intglobals.LdapManagedGroupPrefix),
+			strings.HasPrefix(principal, intglobals.JwtManagedGroupPrefix):
 			if managedGroups == nil {
 				managedGroups = make([]string, 0, len(principals))
 			}
 			managedGroups = append(managedGroups, principal)
+		case strings.HasPrefix(principal, ServiceAccountPrefix):
+			if serviceAccounts == nil {
+				serviceAccounts = make([]string, 0, len(principals))
+			}
+			serviceAccounts = append(serviceAccounts, principal)
 		default:
-			return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid principal ID %q", principal))
+			return nil, nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid principal ID %q", principal))
 		}
 	}
 
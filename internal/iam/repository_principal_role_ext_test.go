@@ -130,7 +130,7 @@ func TestRepository_PrincipalsToSet(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
 			r, origUsers, origGrps, origManagedGrps := setupFn()
-			got, err := repo.PrincipalsToSet(context.Background(), r, tt.args.userIds, tt.args.groupIds, tt.args.managedGroupIds)
+			got, err := repo.PrincipalsToSet(context.Background(), r, tt.args.userIds, tt.args.groupIds, tt.args.managedGroupIds, nil)
 			if tt.wantErr {
 				require.Error(err)
 				return
@@ -142,7 +142,7 @@ func TestRepository_PrincipalsToSet(t *testing.T) {
 	t.Run("nil role", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		_, users, grps, managedGrps := setupFn()
-		got, err := repo.PrincipalsToSet(context.Background(), nil, users, grps, managedGrps)
+		got, err := repo.PrincipalsToSet(context.Background(), nil, users, grps, managedGrps, nil)
 		require.Error(err)
 		assert.Nil(got)
 		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %s", err.Error())
@@ -150,7 +150,7 @@ func TestRepository_PrincipalsToSet(t *testing.T) {
 	t.Run("no change", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		r, users, grps, managedGrps := setupFn()
-		got, err := repo.PrincipalsToSet(context.Background(), r, users, grps, managedGrps)
+		got, err := repo.PrincipalsToSet(context.Background(), r, users, grps, managedGrps, nil)
 		require.NoError(err)
 		assert.Empty(got.AddUserRoles)
 		assert.Empty(got.AddGroupRoles)
@@ -192,7 +192,7 @@ func TestRepository_PrincipalsToSet(t *testing.T) {
 		wantSetGrps = append(wantSetGrps, newGrp.PublicId)
 		wantSetManagedGrps = append(wantSetManagedGrps, newManagedGrp.PublicId)
 
-		got, err := repo.PrincipalsToSet(context.Background(), r, wantSetUsers, wantSetGrps, wantSetManagedGrps)
+		got, err := repo.PrincipalsToSet(context.Background(), r, wantSetUsers, wantSetGrps, wantSetManagedGrps, nil)
 		require.NoError(err)
 		assertSetResults(t, got, []string{newUser.PublicId}, []string{newGrp.PublicId}, []string{newManagedGrp.PublicId}, wantDeleteUsers, wantDeleteGrps, wantDeleteManagedGrps)
 	})
@@ -677,3 +677,82 @@ func TestRepository_SetPrincipalRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_PrincipalRoleExpiration(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+
+	role := TestRole(t, conn, proj.PublicId)
+	TestRoleGrant(t, conn, role.PublicId, "id=*;type=target;actions=read")
+	user := TestUser(t, repo, org.PublicId)
+	otherUser := TestUser(t, repo, org.PublicId)
+
+	hasRoleGrant := func(userId string) bool {
+		grants, err := repo.GrantsForUser(ctx, userId)
+		require.NoError(t, err)
+		for _, g := range grants {
+			if g.RoleId == role.PublicId {
+				return true
+			}
+		}
+		return false
+	}
+
+	_, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId}, WithExpirationTime(time.Now().Add(-time.Minute)))
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+
+	expiration := time.Now().Add(2 * time.Second)
+	got, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{user.PublicId}, WithExpirationTime(expiration))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, expiration.Truncate(time.Second).UTC(), got[0].GetExpirationTime().AsTime())
+
+	got, err = repo.AddPrincipalRoles(ctx, role.PublicId, role.Version+1, []string{otherUser.PublicId})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.True(t, hasRoleGrant(user.PublicId))
+	assert.True(t, hasRoleGrant(otherUser.PublicId))
+
+	// setting the principals without an expiration time keeps the expiration
+	// time of existing principals
+	got, affected, err := repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+2, []string{user.PublicId, otherUser.PublicId})
+	require.NoError(t, err)
+	assert.Equal(t, 0, affected)
+	require.Len(t, got, 2)
+	for _, pr := range got {
+		if pr.GetPrincipalId() == user.PublicId {
+			assert.Equal(t, expiration.Truncate(time.Second).UTC(), pr.GetExpirationTime().AsTime())
+		}
+	}
+
+	deleted, err := repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	time.Sleep(2 * time.Second)
+	assert.False(t, hasRoleGrant(user.PublicId))
+	assert.True(t, hasRoleGrant(otherUser.PublicId))
+
+	deleted, err = repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, role.PublicId, deleted[0].GetRoleId())
+	assert.Equal(t, user.PublicId, deleted[0].GetPrincipalId())
+	assert.Equal(t, UserRoleType.String(), deleted[0].GetType())
+
+	// the principals are deleted like DeletePrincipalRoles does
+	updatedRole, _, _, err := repo.LookupRole(ctx, role.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, role.Version+3, updatedRole.Version)
+	assert.NoError(t, db.TestVerifyOplog(t, db.New(conn), role.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_DELETE), db.WithCreateNotBefore(10*time.Second)))
+
+	principals, err := repo.ListPrincipalRoles(ctx, role.PublicId)
+	require.NoError(t, err)
+	require.Len(t, principals, 1)
+	assert.Equal(t, otherUser.PublicId, principals[0].GetPrincipalId())
+	assert.Nil(t, principals[0].GetExpirationTime())
+}
@@ -0,0 +1,63 @@
+package iam
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ResourceInfo is the type, the scope and the pin of a resource, as used when
+// evaluating grants against it.
+type ResourceInfo struct {
+	PublicId string
+	Type     resource.Type
+	ScopeId  string
+	PinId    string
+}
+
+// resourceInfo is used to scan the rows of the lookupResourceQuery.
+type resourceInfo struct {
+	Type    string
+	ScopeId sql.NullString
+	PinId   sql.NullString
+}
+
+// LookupResource returns the type, the scope and the pin of the resource with
+// the provided public id. The resource can be of any type grants can be
+// evaluated against. A nil ResourceInfo is returned if no resource is found.
+// No options are currently supported.
+func (r *Repository) LookupResource(ctx context.Context, publicId string, _ ...Option) (*ResourceInfo, error) {
+	const op = "iam.(Repository).LookupResource"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	rows, err := r.reader.Query(ctx, lookupResourceQuery, []interface{}{sql.Named("public_id", publicId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var found []resourceInfo
+	for rows.Next() {
+		var ri resourceInfo
+		if err := r.reader.ScanRows(ctx, rows, &ri); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		found = append(found, ri)
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%s matches %d resources", publicId, len(found)))
+	}
+	return &ResourceInfo{
+		PublicId: publicId,
+		Type:     resource.Map[found[0].Type],
+		ScopeId:  found[0].ScopeId.String,
+		PinId:    found[0].PinId.String,
+	}, nil
+}
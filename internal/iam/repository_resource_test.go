@@ -0,0 +1,80 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_LookupResource(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	role := TestRole(t, conn, proj.PublicId)
+	grp := TestGroup(t, conn, org.PublicId)
+
+	tests := []struct {
+		name        string
+		id          string
+		want        *ResourceInfo
+		wantIsError errors.Code
+	}{
+		{
+			name: "global",
+			id:   scope.Global.String(),
+			want: &ResourceInfo{PublicId: scope.Global.String(), Type: resource.Scope, ScopeId: scope.Global.String()},
+		},
+		{
+			name: "project",
+			id:   proj.PublicId,
+			want: &ResourceInfo{PublicId: proj.PublicId, Type: resource.Scope, ScopeId: org.PublicId},
+		},
+		{
+			name: "user",
+			id:   user.PublicId,
+			want: &ResourceInfo{PublicId: user.PublicId, Type: resource.User, ScopeId: org.PublicId},
+		},
+		{
+			name: "role",
+			id:   role.PublicId,
+			want: &ResourceInfo{PublicId: role.PublicId, Type: resource.Role, ScopeId: proj.PublicId},
+		},
+		{
+			name: "group",
+			id:   grp.PublicId,
+			want: &ResourceInfo{PublicId: grp.PublicId, Type: resource.Group, ScopeId: org.PublicId},
+		},
+		{
+			name: "not-found",
+			id:   "u_doesntexist",
+		},
+		{
+			name:        "missing-id",
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.LookupResource(ctx, tt.id)
+			if tt.wantIsError != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "Unexpected error %s", err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
@@ -385,6 +385,9 @@ func (r *Repository) ListRoleGrants(ctx context.Context, roleId string, opt ...O
 	return roleGrants, nil
 }
 
+// GrantsForUser returns the grants of the roles the user (or service account)
+// is assigned to, directly or through its groups and managed groups.
+// Assignments which have expired are ignored.
 func (r *Repository) GrantsForUser(ctx context.Context, userId string, _ ...Option) ([]perms.GrantTuple, error) {
 	const op = "iam.(Repository).GrantsForUser"
 	if userId == "" {
@@ -424,18 +427,27 @@ managed_group_roles (role_id) as (
     from iam_managed_group_role,
          user_managed_groups
    where principal_id in (user_managed_groups.id)
+     and (expiration_time is null or expiration_time > current_timestamp)
 ),
 group_roles (role_id) as (
   select role_id
     from iam_group_role,
          user_groups
    where principal_id in (user_groups.id)
+     and (expiration_time is null or expiration_time > current_timestamp)
 ),
 user_roles (role_id) as (
   select role_id
     from iam_user_role,
          users
    where principal_id in (users.id)
+     and (expiration_time is null or expiration_time > current_timestamp)
+),
+service_account_roles (role_id) as (
+  select role_id
+    from iam_service_account_role
+   where principal_id in (?)
+     and (expiration_time is null or expiration_time > current_timestamp)
 ),
 user_group_roles (role_id) as (
   select role_id
@@ -446,6 +458,9 @@ user_group_roles (role_id) as (
    union
   select role_id
     from managed_group_roles
+   union
+  select role_id
+    from service_account_roles
 ),
 roles (role_id, grant_scope_id) as (
   select iam_role.public_id,
@@ -476,7 +491,9 @@ select role_id as role_id, role_scope as scope_id, role_grant as grant from fina
 	}
 
 	var grants []perms.GrantTuple
-	rows, err := r.reader.Query(ctx, query, []interface{}{userId})
+	// userId is bound twice: once for the users and once for the service
+	// account roles, as the id may belong to a service account.
+	rows, err := r.reader.Query(ctx, query, []interface{}{userId, userId})
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
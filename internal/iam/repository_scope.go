@@ -229,7 +229,15 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 				}
 				msgs = append(msgs, roleGrantOplogMsgs...)
 
-				rolePrincipal, err := NewUserRole(adminRolePublicId, userId)
+				// A service account creating a scope is given the admin role
+				// the same way a user is.
+				var rolePrincipal interface{}
+				switch {
+				case strings.HasPrefix(userId, ServiceAccountPrefix+"_"):
+					rolePrincipal, err = NewServiceAccountRole(adminRolePublicId, userId)
+				default:
+					rolePrincipal, err = NewUserRole(adminRolePublicId, userId)
+				}
 				if err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role user"))
 				}
@@ -318,7 +326,7 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 						}
 						grants = append(grants, roleGrant)
 
-						roleGrant, err = NewRoleGrant(defaultRolePublicId, "id=*;type=auth-token;actions=list,read:self,delete:self")
+						roleGrant, err = NewRoleGrant(defaultRolePublicId, "id=*;type=auth-token;actions=list,read:self,delete:self,refresh:self")
 						if err != nil {
 							return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
 						}
@@ -0,0 +1,143 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-dbw"
+)
+
+// CreateServiceAccount will create a service account in the repository and
+// return the written service account. No options are currently supported.
+func (r *Repository) CreateServiceAccount(ctx context.Context, serviceAccount *ServiceAccount, _ ...Option) (*ServiceAccount, error) {
+	const op = "iam.(Repository).CreateServiceAccount"
+	if serviceAccount == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing service account")
+	}
+	if serviceAccount.ServiceAccount == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing service account store")
+	}
+	if serviceAccount.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if serviceAccount.ScopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	id, err := newServiceAccountId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sa := serviceAccount.Clone().(*ServiceAccount)
+	sa.PublicId = id
+	resource, err := r.create(ctx, sa)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("service account %s already exists in scope %s", serviceAccount.Name, serviceAccount.ScopeId))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for service account %s", sa.PublicId)))
+	}
+	return resource.(*ServiceAccount), nil
+}
+
+// UpdateServiceAccount will update a service account in the repository and
+// return the written service account. fieldMaskPaths provides
+// field_mask.proto paths for fields that should be updated. Fields will be
+// set to NULL if the field is a zero value and included in fieldMask. Name
+// and Description are the only updatable fields. If no updatable fields are
+// included in the fieldMaskPaths, then an error is returned.
+func (r *Repository) UpdateServiceAccount(ctx context.Context, serviceAccount *ServiceAccount, version uint32, fieldMaskPaths []string, _ ...Option) (*ServiceAccount, int, error) {
+	const op = "iam.(Repository).UpdateServiceAccount"
+	if serviceAccount == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing service account")
+	}
+	if serviceAccount.ServiceAccount == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing service account store")
+	}
+	if serviceAccount.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbw.BuildUpdatePaths(
+		map[string]interface{}{
+			"name":        serviceAccount.Name,
+			"description": serviceAccount.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	sa := serviceAccount.Clone().(*ServiceAccount)
+	resource, rowsUpdated, err := r.update(ctx, sa, version, dbMask, nullFields)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("service account %s already exists in scope %s", serviceAccount.Name, serviceAccount.ScopeId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for service account %s", serviceAccount.PublicId)))
+	}
+	return resource.(*ServiceAccount), rowsUpdated, nil
+}
+
+// LookupServiceAccount will look up a service account in the repository. If
+// the service account is not found, it will return nil, nil.
+func (r *Repository) LookupServiceAccount(ctx context.Context, withPublicId string, _ ...Option) (*ServiceAccount, error) {
+	const op = "iam.(Repository).LookupServiceAccount"
+	if withPublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	sa := AllocServiceAccount()
+	sa.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, &sa); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for service account %s", withPublicId)))
+	}
+	return &sa, nil
+}
+
+// DeleteServiceAccount will delete a service account, and all of its tokens,
+// from the repository.
+func (r *Repository) DeleteServiceAccount(ctx context.Context, withPublicId string, _ ...Option) (int, error) {
+	const op = "iam.(Repository).DeleteServiceAccount"
+	if withPublicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	sa := AllocServiceAccount()
+	sa.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, &sa); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for service account %s", withPublicId)))
+	}
+	rowsDeleted, err := r.delete(ctx, &sa)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for service account %s", withPublicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// ListServiceAccounts lists service accounts in the given scopes and supports
+// the WithLimit option.
+func (r *Repository) ListServiceAccounts(ctx context.Context, withScopeIds []string, opt ...Option) ([]*ServiceAccount, error) {
+	const op = "iam.(Repository).ListServiceAccounts"
+	if len(withScopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	var serviceAccounts []*ServiceAccount
+	err := r.list(ctx, &serviceAccounts, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return serviceAccounts, nil
+}
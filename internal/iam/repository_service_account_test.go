@@ -0,0 +1,221 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateServiceAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+
+	tests := []struct {
+		name        string
+		sa          func() *ServiceAccount
+		wantIsError errors.Code
+	}{
+		{
+			name: "valid-org",
+			sa: func() *ServiceAccount {
+				sa, err := NewServiceAccount(ctx, org.PublicId, WithName("ci"), WithDescription("pipelines"))
+				require.NoError(t, err)
+				return sa
+			},
+		},
+		{
+			name: "valid-global",
+			sa: func() *ServiceAccount {
+				sa, err := NewServiceAccount(ctx, "global", WithName("ci"))
+				require.NoError(t, err)
+				return sa
+			},
+		},
+		{
+			name: "dup-name",
+			sa: func() *ServiceAccount {
+				sa, err := NewServiceAccount(ctx, org.PublicId, WithName("ci"))
+				require.NoError(t, err)
+				return sa
+			},
+			wantIsError: errors.NotUnique,
+		},
+		{
+			name: "project-scope",
+			sa: func() *ServiceAccount {
+				sa, err := NewServiceAccount(ctx, proj.PublicId)
+				require.NoError(t, err)
+				return sa
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			sa: func() *ServiceAccount {
+				sa, err := NewServiceAccount(ctx, org.PublicId)
+				require.NoError(t, err)
+				sa.PublicId = "sa_1234567890"
+				return sa
+			},
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name:        "nil",
+			sa:          func() *ServiceAccount { return nil },
+			wantIsError: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateServiceAccount(ctx, tt.sa())
+			if tt.wantIsError != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "want err code: %q got: %q", tt.wantIsError, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(uint32(1), got.Version)
+
+			found, err := repo.LookupServiceAccount(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(got.Name, found.Name)
+			assert.Equal(got.ScopeId, found.ScopeId)
+		})
+	}
+}
+
+func TestRepository_UpdateServiceAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+
+	sa := TestServiceAccount(t, conn, org.PublicId, WithName("ci"))
+	TestServiceAccount(t, conn, org.PublicId, WithName("taken"))
+
+	t.Run("invalid-mask", func(t *testing.T) {
+		updated := sa.Clone().(*ServiceAccount)
+		_, _, err := repo.UpdateServiceAccount(ctx, updated, sa.Version, []string{"ScopeId"})
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidFieldMask), err))
+	})
+	t.Run("dup-name", func(t *testing.T) {
+		updated := sa.Clone().(*ServiceAccount)
+		updated.Name = "taken"
+		_, _, err := repo.UpdateServiceAccount(ctx, updated, sa.Version, []string{"Name"})
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.NotUnique), err))
+	})
+	t.Run("valid", func(t *testing.T) {
+		updated := sa.Clone().(*ServiceAccount)
+		updated.Name = "deploy"
+		updated.Description = "deploys"
+		got, rowsUpdated, err := repo.UpdateServiceAccount(ctx, updated, sa.Version, []string{"Name", "Description"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, rowsUpdated)
+		assert.Equal(t, "deploy", got.Name)
+		assert.Equal(t, "deploys", got.Description)
+		assert.Equal(t, sa.Version+1, got.Version)
+	})
+}
+
+func TestRepository_DeleteServiceAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+
+	sa := TestServiceAccount(t, conn, org.PublicId)
+	rowsDeleted, err := repo.DeleteServiceAccount(ctx, sa.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rowsDeleted)
+
+	found, err := repo.LookupServiceAccount(ctx, sa.PublicId)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	_, err = repo.DeleteServiceAccount(ctx, sa.PublicId)
+	require.Error(t, err)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestRepository_ListServiceAccounts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+
+	for i := 0; i < 3; i++ {
+		TestServiceAccount(t, conn, org.PublicId)
+	}
+	TestServiceAccount(t, conn, "global")
+
+	got, err := repo.ListServiceAccounts(ctx, []string{org.PublicId})
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+
+	got, err = repo.ListServiceAccounts(ctx, []string{org.PublicId}, WithLimit(1))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = repo.ListServiceAccounts(ctx, nil)
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestRepository_ServiceAccountPrincipalRoles(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+
+	sa := TestServiceAccount(t, conn, org.PublicId)
+	role := TestRole(t, conn, proj.PublicId)
+	TestRoleGrant(t, conn, role.PublicId, "id=*;type=target;actions=read")
+
+	got, err := repo.AddPrincipalRoles(ctx, role.PublicId, role.Version, []string{sa.PublicId})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, sa.PublicId, got[0].PrincipalId)
+	assert.Equal(t, ServiceAccountRoleType.String(), got[0].GetType())
+
+	grants, err := repo.GrantsForUser(ctx, sa.PublicId)
+	require.NoError(t, err)
+	var found bool
+	for _, g := range grants {
+		if g.RoleId == role.PublicId {
+			found = true
+			assert.Equal(t, "id=*;type=target;actions=read", g.Grant)
+		}
+	}
+	assert.True(t, found)
+
+	got, _, err = repo.SetPrincipalRoles(ctx, role.PublicId, role.Version+1, nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	grants, err = repo.GrantsForUser(ctx, sa.PublicId)
+	require.NoError(t, err)
+	for _, g := range grants {
+		assert.NotEqual(t, role.PublicId, g.RoleId)
+	}
+}
@@ -62,8 +62,8 @@ func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option)
 // UpdateUser will update a user in the repository and return the written user
 // plus its associated account ids. fieldMaskPaths provides field_mask.proto
 // paths for fields that should be updated.  Fields will be set to NULL if the
-// field is a zero value and included in fieldMask. Name and Description are the
-// only updatable fields, if no updatable fields are included in the
+// field is a zero value and included in fieldMask. Name, Description and State
+// are the only updatable fields, if no updatable fields are included in the
 // fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32, fieldMaskPaths []string, opt ...Option) (*User, []string, int, error) {
 	const op = "iam.(Repository).UpdateUser"
@@ -77,6 +77,10 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 		switch {
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
+		case strings.EqualFold("state", f):
+			if !validUserState(user.State) {
+				return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid state: %q", user.State))
+			}
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -86,6 +90,7 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 		map[string]interface{}{
 			"name":        user.Name,
 			"description": user.Description,
+			"state":       user.State,
 		},
 		fieldMaskPaths,
 		nil,
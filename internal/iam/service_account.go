@@ -0,0 +1,114 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultServiceAccountTableName = "iam_service_account"
+)
+
+// ServiceAccount is a principal for non-human clients, such as CI pipelines.
+// Service accounts cannot authenticate via an auth method; they authenticate
+// with tokens created for them in the authtoken package.
+type ServiceAccount struct {
+	*store.ServiceAccount
+	tableName string `gorm:"-"`
+}
+
+// ensure that ServiceAccount implements the interfaces of: Resource,
+// Cloneable, and db.VetForWriter.
+var (
+	_ Resource        = (*ServiceAccount)(nil)
+	_ Cloneable       = (*ServiceAccount)(nil)
+	_ db.VetForWriter = (*ServiceAccount)(nil)
+)
+
+// NewServiceAccount creates a new in memory service account with a scope
+// (global/org) and allowed options include: WithDescription, WithName.
+func NewServiceAccount(ctx context.Context, scopeId string, opt ...Option) (*ServiceAccount, error) {
+	const op = "iam.NewServiceAccount"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	return &ServiceAccount{
+		ServiceAccount: &store.ServiceAccount{
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			ScopeId:     scopeId,
+		},
+	}, nil
+}
+
+// Clone creates a clone of the ServiceAccount.
+func (sa *ServiceAccount) Clone() interface{} {
+	cp := proto.Clone(sa.ServiceAccount)
+	return &ServiceAccount{
+		ServiceAccount: cp.(*store.ServiceAccount),
+	}
+}
+
+// AllocServiceAccount will allocate an empty service account.
+func AllocServiceAccount() ServiceAccount {
+	return ServiceAccount{
+		ServiceAccount: &store.ServiceAccount{},
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the service
+// account before it's written.
+func (sa *ServiceAccount) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType, opt ...db.Option) error {
+	const op = "iam.(ServiceAccount).VetForWrite"
+	if sa.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if err := validateScopeForWrite(ctx, r, sa, opType, opt...); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (*ServiceAccount) validScopeTypes() []scope.Type {
+	return []scope.Type{scope.Global, scope.Org}
+}
+
+// GetScope returns the scope for the ServiceAccount.
+func (sa *ServiceAccount) GetScope(ctx context.Context, r db.Reader) (*Scope, error) {
+	return LookupScope(ctx, r, sa)
+}
+
+// ResourceType returns the type of the ServiceAccount.
+func (*ServiceAccount) ResourceType() resource.Type { return resource.ServiceAccount }
+
+// Actions returns the available actions for ServiceAccount.
+func (*ServiceAccount) Actions() map[string]action.Type {
+	ret := CrudActions()
+	ret[action.CreateToken.String()] = action.CreateToken
+	ret[action.ListTokens.String()] = action.ListTokens
+	ret[action.RevokeToken.String()] = action.RevokeToken
+	return ret
+}
+
+// TableName returns the tablename to override the default gorm table name.
+func (sa *ServiceAccount) TableName() string {
+	if sa.tableName != "" {
+		return sa.tableName
+	}
+	return defaultServiceAccountTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (sa *ServiceAccount) SetTableName(n string) {
+	sa.tableName = n
+}
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -17,6 +18,23 @@ const (
 	defaultUserAccountInfoTableName = "iam_user_acct_info"
 )
 
+// UserState defines the possible states of a user
+type UserState string
+
+const (
+	ActiveUserState   UserState = "active"
+	DisabledUserState UserState = "disabled"
+)
+
+func validUserState(s string) bool {
+	switch UserState(s) {
+	case ActiveUserState, DisabledUserState:
+		return true
+	default:
+		return false
+	}
+}
+
 // User defines boundary users which are scoped to an Org
 type User struct {
 	*store.User
@@ -31,24 +49,33 @@ var (
 )
 
 // NewUser creates a new in memory user and allows options:
-// WithName - to specify the user's friendly name and WithDescription - to
-// specify a user description
+// WithName - to specify the user's friendly name, WithDescription - to
+// specify a user description and WithUserState - to specify the user's state
 func NewUser(scopeId string, opt ...Option) (*User, error) {
 	const op = "iam.NewUser"
 	opts := getOpts(opt...)
 	if scopeId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing scope id")
 	}
+	if opts.withUserState != "" && !validUserState(string(opts.withUserState)) {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("invalid state: %s", opts.withUserState))
+	}
 	u := &User{
 		User: &store.User{
 			Name:        opts.withName,
 			Description: opts.withDescription,
 			ScopeId:     scopeId,
+			State:       string(opts.withUserState),
 		},
 	}
 	return u, nil
 }
 
+// IsDisabled returns true if the user is disabled.
+func (u *User) IsDisabled() bool {
+	return UserState(u.GetState()) == DisabledUserState
+}
+
 // AllocUser will allocate an empty user
 func AllocUser() User {
 	return User{
@@ -71,6 +98,9 @@ func (u *User) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType, o
 	if u.PublicId == "" {
 		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
 	}
+	if u.State != "" && !validUserState(u.State) {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid state: %s", u.State))
+	}
 	if err := validateScopeForWrite(ctx, r, u, opType, opt...); err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
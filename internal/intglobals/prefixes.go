@@ -10,6 +10,14 @@ const (
 	// from the OIDC auth method.
 	OidcManagedGroupPrefix = "mgoidc"
 
+	// LdapManagedGroupPrefix defines the prefix for ManagedGroup public ids
+	// from the LDAP auth method.
+	LdapManagedGroupPrefix = "mgldap"
+
+	// JwtManagedGroupPrefix defines the prefix for ManagedGroup public ids
+	// from the JWT auth method.
+	JwtManagedGroupPrefix = "mgjwt"
+
 	// OldPasswordAccountPrefix is the previously-used account prefix
 	OldPasswordAccountPrefix = "apw"
 
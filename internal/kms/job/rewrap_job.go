@@ -0,0 +1,131 @@
+package kmsjob
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const rewrapFrequency = time.Hour
+
+// rewrapJob defines a periodic job that re-encrypts data which was encrypted
+// with a data key version that has since been superseded by a key rotation,
+// so that it is encrypted with the current version of the data key. Once the
+// job has rewrapped all the data encrypted with a data key version, nothing
+// references it anymore and it can be destroyed.
+type rewrapJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	running      ua.Bool
+	numVersions  int
+	numProcessed int
+}
+
+// newRewrapJob instantiates the rewrap job.
+func newRewrapJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms) (*rewrapJob, error) {
+	const op = "kmsjob.newRewrapJob"
+	switch {
+	case isNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case isNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	return &rewrapJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (r *rewrapJob) Name() string { return "kms_rewrap_data_key_versions" }
+
+// Description returns the description for the job.
+func (r *rewrapJob) Description() string {
+	return "Re-encrypt data encrypted with previous data key versions"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+// This is represented by rewrapFrequency
+func (r *rewrapJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return rewrapFrequency, nil
+}
+
+// Status returns the status of the running job. Total is the number of
+// previous data key versions found when the job started and Completed is the
+// number of them which have been processed.
+func (r *rewrapJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numVersions,
+	}
+}
+
+// Run lists the data key versions which have been superseded by a newer
+// version and calls every registered kms.RewrapFn for each of them. The rows
+// of all the tables are rewrapped within a single transaction per data key
+// version. Can not be run in parallel, if Run is invoked while already
+// running an error with code JobAlreadyRunning will be returned.
+func (r *rewrapJob) Run(ctx context.Context) error {
+	const op = "kmsjob.(rewrapJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	versions, err := r.kms.ListPreviousDataKeyVersions(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numVersions for status report
+	r.numProcessed, r.numVersions = 0, len(versions)
+
+	fns := kms.ListTablesRewrapFns()
+	tableNames := make([]string, 0, len(fns))
+	for tableName := range fns {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+
+	for _, v := range versions {
+		// Verify context is not done before rewrapping the next version
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(reader db.Reader, w db.Writer) error {
+				for _, tableName := range tableNames {
+					if err := fns[tableName](ctx, v.PrivateId, v.ScopeId, reader, w, r.kms); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rewrap %s", tableName)))
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error rewrapping data key version", "data key version id", v.PrivateId, "scope id", v.ScopeId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
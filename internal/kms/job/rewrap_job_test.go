@@ -0,0 +1,218 @@
+package kmsjob
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrapJob(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	type args struct {
+		w   db.Writer
+		r   db.Reader
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name: "nil writer",
+			args: args{
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil reader",
+			args: args{
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				w: rw,
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newRewrapJob(ctx, tt.args.r, tt.args.w, tt.args.kms)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal("kms_rewrap_data_key_versions", got.Name())
+			assert.Equal("Re-encrypt data encrypted with previous data key versions", got.Description())
+			nextRun, err := got.NextRunIn(ctx)
+			require.NoError(err)
+			assert.Equal(time.Hour, nextRun)
+
+			at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+			origKeyId := testAuthTokenKeyId(t, rw, at.GetPublicId())
+
+			require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+			referenced, err := kmsCache.DataKeyVersionReferenced(ctx, origKeyId)
+			require.NoError(err)
+			assert.True(referenced)
+
+			// Run job and ensure the auth token was rewrapped
+			require.NoError(got.Run(ctx))
+			status := got.Status()
+			assert.Greater(status.Total, 0)
+			assert.Equal(status.Total, status.Completed)
+
+			referenced, err = kmsCache.DataKeyVersionReferenced(ctx, origKeyId)
+			require.NoError(err)
+			assert.False(referenced)
+
+			assert.NotEqual(origKeyId, testAuthTokenKeyId(t, rw, at.GetPublicId()))
+			atRepo, err := authtoken.NewRepository(rw, rw, kmsCache)
+			require.NoError(err)
+			validated, err := atRepo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+			require.NoError(err)
+			assert.NotNil(validated)
+		})
+	}
+}
+
+func TestRegisterJobs(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	type args struct {
+		s   *scheduler.Scheduler
+		w   db.Writer
+		r   db.Reader
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name: "nil scheduler",
+			args: args{
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil writer",
+			args: args{
+				s:   sched,
+				r:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil reader",
+			args: args{
+				s:   sched,
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				s: sched,
+				w: rw,
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{
+				s:   sched,
+				w:   rw,
+				r:   rw,
+				kms: kmsCache,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterJobs(ctx, tt.args.s, tt.args.r, tt.args.w, tt.args.kms)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func testAuthTokenKeyId(t *testing.T, rw *db.Db, publicId string) string {
+	t.Helper()
+	rows, err := rw.Query(context.Background(), "select key_id from auth_token where public_id = ?", []interface{}{publicId})
+	require.NoError(t, err)
+	defer rows.Close()
+	var keyId string
+	for rows.Next() {
+		require.NoError(t, rows.Scan(&keyId))
+	}
+	require.NoError(t, rows.Err())
+	require.NotEmpty(t, keyId)
+	return keyId
+}
@@ -0,0 +1,198 @@
+package kms
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	wrappingKms "github.com/hashicorp/go-kms-wrapping/extras/kms/v2"
+)
+
+const (
+	// KeyTypeKek is the type of a root key, which is a key encryption key.
+	KeyTypeKek = "kek"
+
+	// KeyTypeDek is the type of a data encryption key.
+	KeyTypeDek = "dek"
+)
+
+// Key is a root key or data encryption key of a scope along with its
+// versions.
+type Key struct {
+	Id         string
+	Scope      string
+	Type       string
+	Purpose    string
+	CreateTime time.Time
+	Versions   []*KeyVersion
+}
+
+// KeyVersion is a version of a Key.
+type KeyVersion struct {
+	Id         string
+	Version    uint32
+	CreateTime time.Time
+}
+
+type keyVersionRow struct {
+	KeyId             string
+	Type              string
+	Purpose           string
+	KeyCreateTime     time.Time
+	VersionId         string
+	Version           uint32
+	VersionCreateTime time.Time
+}
+
+// ListKeys returns the root key and data encryption keys of the scope, each
+// with its versions ordered by version. The root key is returned first,
+// followed by the data encryption keys ordered by purpose.
+func (k *Kms) ListKeys(ctx context.Context, scopeId string) ([]*Key, error) {
+	const op = "kms.(Kms).ListKeys"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	rows, err := k.reader.Query(ctx, listKeysQuery, []interface{}{sql.Named("scope_id", scopeId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var keys []*Key
+	for rows.Next() {
+		var r keyVersionRow
+		if err := k.reader.ScanRows(ctx, rows, &r); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		if len(keys) == 0 || keys[len(keys)-1].Id != r.KeyId {
+			keys = append(keys, &Key{
+				Id:         r.KeyId,
+				Scope:      scopeId,
+				Type:       r.Type,
+				Purpose:    r.Purpose,
+				CreateTime: r.KeyCreateTime,
+			})
+		}
+		key := keys[len(keys)-1]
+		key.Versions = append(key.Versions, &KeyVersion{
+			Id:         r.VersionId,
+			Version:    r.Version,
+			CreateTime: r.VersionCreateTime,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return keys, nil
+}
+
+// DestroyKeyVersion destroys a version of the root key or of a data
+// encryption key of the scope. Before a root key version is destroyed, the
+// data key versions of the scope are rewrapped with the latest root key
+// version. An error with code KeyVersionInUse is returned, and nothing is
+// destroyed, if the key version is the latest version of its key or if it
+// still protects data:
+//
+// - a data key version protects data while a row of a table with a registered
+// RewrapFn records it in the key id column the RewrapFn was registered for,
+// such as the database key version of a session's tofu token, or while a row
+// references it through a foreign key.
+//
+// - a tokens data key version protects data while an auth token of the scope
+// which was issued before the version was superseded exists.
+//
+// - oplog and audit data key versions always protect data, since the entries
+// and events encrypted with them are not tracked.
+//
+// An error with code RecordNotFound is returned if the scope has no key
+// version with the provided id.
+func (k *Kms) DestroyKeyVersion(ctx context.Context, scopeId, keyVersionId string) error {
+	const op = "kms.(Kms).DestroyKeyVersion"
+	switch {
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case keyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing key version id")
+	}
+
+	keys, err := k.ListKeys(ctx, scopeId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var key *Key
+	var next *KeyVersion
+	for _, kk := range keys {
+		for i, v := range kk.Versions {
+			if v.Id != keyVersionId {
+				continue
+			}
+			key = kk
+			if i < len(kk.Versions)-1 {
+				next = kk.Versions[i+1]
+			}
+		}
+	}
+	switch {
+	case key == nil:
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("key version %s not found in scope %s", keyVersionId, scopeId))
+	case next == nil:
+		return errors.New(ctx, errors.KeyVersionInUse, op, fmt.Sprintf("key version %s is the latest version of its key", keyVersionId))
+	}
+
+	var referenced bool
+	switch {
+	case key.Type == KeyTypeKek:
+		if err := k.underlying.RewrapKeys(ctx, scopeId); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rewrap keys in scope %s", scopeId)))
+		}
+		referenced, err = k.exists(ctx, rootKeyVersionReferencedQuery, keyVersionId)
+	case key.Purpose == KeyPurposeOplog.String(), key.Purpose == KeyPurposeAudit.String():
+		referenced = true
+	case key.Purpose == KeyPurposeTokens.String():
+		referenced, err = k.exists(ctx, authTokenIssuedBeforeQuery, sql.Named("scope_id", scopeId), sql.Named("superseded_time", next.CreateTime))
+	default:
+		referenced, err = k.DataKeyVersionReferenced(ctx, keyVersionId)
+	}
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if referenced {
+		return errors.New(ctx, errors.KeyVersionInUse, op, fmt.Sprintf("key version %s still protects data", keyVersionId))
+	}
+
+	if err := k.underlying.RevokeKey(ctx, keyVersionId); err != nil {
+		if errors.Is(err, wrappingKms.ErrKeyNotFound) {
+			return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("key version %s not found in scope %s", keyVersionId, scopeId))
+		}
+		if convErr := errors.Convert(err); convErr != nil && convErr.Code == errors.NotSpecificIntegrity {
+			// A row of a table without a registered RewrapFn still references
+			// the key version through a foreign key.
+			return errors.New(ctx, errors.KeyVersionInUse, op, fmt.Sprintf("key version %s still protects data", keyVersionId))
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to destroy key version %s", keyVersionId)))
+	}
+	return nil
+}
+
+// exists runs a query which selects a single boolean.
+func (k *Kms) exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	const op = "kms.(Kms).exists"
+	rows, err := k.reader.Query(ctx, query, args)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var exists bool
+	for rows.Next() {
+		if err := rows.Scan(&exists); err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return exists, nil
+}
@@ -228,6 +228,41 @@ func (k *Kms) CreateKeys(ctx context.Context, scopeId string, opt ...Option) err
 	return nil
 }
 
+// RotateKeys rotates the root key and DEKs of the scope by creating a new
+// version of each of them. Data is encrypted using the latest versions from
+// then on, while the previous versions are kept so data encrypted with them can
+// still be decrypted. Supports the WithRandomReader(...) and
+// WithReaderWriter(...) options. When WithReaderWriter(...) is used the caller
+// is responsible for managing the transaction.
+func (k *Kms) RotateKeys(ctx context.Context, scopeId string, opt ...Option) error {
+	const op = "kms.(Kms).RotateKeys"
+	if scopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	kmsOpts := []wrappingKms.Option{wrappingKms.WithRandomReader(opts.withRandomReader)}
+	switch {
+	case !isNil(opts.withReader) && isNil(opts.withWriter):
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	case isNil(opts.withReader) && !isNil(opts.withWriter):
+		return errors.New(ctx, errors.InvalidParameter, op, "missing reader")
+	case !isNil(opts.withReader) && !isNil(opts.withWriter):
+		r, err := convertToRW(ctx, opts.withReader)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert reader"))
+		}
+		w, err := convertToRW(ctx, opts.withWriter)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert writer"))
+		}
+		kmsOpts = append(kmsOpts, wrappingKms.WithReaderWriter(r, w))
+	}
+	if err := k.underlying.RotateKeys(ctx, scopeId, kmsOpts...); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to rotate keys in scope %s", scopeId)))
+	}
+	return nil
+}
+
 // VerifyGlobalRoot will verify that the global root wrapper is reasonable.
 func (k *Kms) VerifyGlobalRoot(ctx context.Context) error {
 	const op = "kms.(Kms).VerifyGlobalRoot"
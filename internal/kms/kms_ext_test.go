@@ -9,11 +9,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	aead "github.com/hashicorp/go-kms-wrapping/v2/aead"
 	"github.com/hashicorp/go-kms-wrapping/v2/extras/multi"
 	"github.com/stretchr/testify/assert"
@@ -364,3 +367,389 @@ func TestKms_CreateKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestKms_RotateKeys(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	rw := db.New(conn)
+
+	tests := []struct {
+		name            string
+		scopeId         string
+		opt             []kms.Option
+		wantErr         bool
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:            "missing-scope-id",
+			wantErr:         true,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:    "scope-without-keys",
+			scopeId: "o_1234567890",
+			wantErr: true,
+		},
+		{
+			name:            "missing-writer-opt",
+			scopeId:         org.GetPublicId(),
+			opt:             []kms.Option{kms.WithReaderWriter(rw, nil)},
+			wantErr:         true,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing writer",
+		},
+		{
+			name:            "missing-reader-opt",
+			scopeId:         org.GetPublicId(),
+			opt:             []kms.Option{kms.WithReaderWriter(nil, rw)},
+			wantErr:         true,
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing reader",
+		},
+		{
+			name:    "success",
+			scopeId: org.GetPublicId(),
+		},
+		{
+			name:    "success-with-reader-writer",
+			scopeId: org.GetPublicId(),
+			opt:     []kms.Option{kms.WithReaderWriter(rw, rw)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			if tc.wantErr {
+				err := kmsCache.RotateKeys(testCtx, tc.scopeId, tc.opt...)
+				require.Error(err)
+				if tc.wantErrMatch != nil {
+					assert.Truef(errors.Match(tc.wantErrMatch, err), "expected %q and got err: %+v", tc.wantErrMatch.Code, err)
+				}
+				if tc.wantErrContains != "" {
+					assert.True(strings.Contains(err.Error(), tc.wantErrContains))
+				}
+				return
+			}
+
+			purposes := kms.ValidDekPurposes()
+			prevKeyIds := make(map[kms.KeyPurpose]string, len(purposes))
+			prevBlobs := make(map[kms.KeyPurpose]*wrapping.BlobInfo, len(purposes))
+			for _, purpose := range purposes {
+				w, err := kmsCache.GetWrapper(testCtx, tc.scopeId, purpose)
+				require.NoError(err)
+				prevKeyIds[purpose], err = w.KeyId(testCtx)
+				require.NoError(err)
+				prevBlobs[purpose], err = w.Encrypt(testCtx, []byte(purpose.String()))
+				require.NoError(err)
+			}
+
+			require.NoError(kmsCache.RotateKeys(testCtx, tc.scopeId, tc.opt...))
+
+			for _, purpose := range purposes {
+				// new data is encrypted with the new key version
+				w, err := kmsCache.GetWrapper(testCtx, tc.scopeId, purpose)
+				require.NoError(err)
+				keyId, err := w.KeyId(testCtx)
+				require.NoError(err)
+				assert.NotEqual(prevKeyIds[purpose], keyId)
+
+				// data encrypted with the previous key version is still readable
+				w, err = kmsCache.GetWrapper(testCtx, tc.scopeId, purpose, kms.WithKeyId(prevBlobs[purpose].GetKeyInfo().GetKeyId()))
+				require.NoError(err)
+				pt, err := w.Decrypt(testCtx, prevBlobs[purpose])
+				require.NoError(err)
+				assert.Equal(purpose.String(), string(pt))
+			}
+		})
+	}
+}
+
+func TestKms_ListPreviousDataKeyVersions(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	got, err := kmsCache.ListPreviousDataKeyVersions(testCtx)
+	require.NoError(err)
+	assert.Empty(got)
+
+	purposes := kms.ValidDekPurposes()
+	prevKeyIds := make(map[string]string, len(purposes))
+	for _, purpose := range purposes {
+		w, err := kmsCache.GetWrapper(testCtx, org.GetPublicId(), purpose)
+		require.NoError(err)
+		keyId, err := w.KeyId(testCtx)
+		require.NoError(err)
+		prevKeyIds[keyId] = purpose.String()
+	}
+
+	require.NoError(kmsCache.RotateKeys(testCtx, org.GetPublicId()))
+
+	got, err = kmsCache.ListPreviousDataKeyVersions(testCtx)
+	require.NoError(err)
+	var gotPurposes []string
+	for _, v := range got {
+		assert.Equal(org.GetPublicId(), v.ScopeId)
+		assert.Equal(uint32(1), v.Version)
+		if purpose, ok := prevKeyIds[v.PrivateId]; ok {
+			assert.Equal(purpose, v.Purpose)
+			gotPurposes = append(gotPurposes, v.Purpose)
+		}
+	}
+	assert.Len(gotPurposes, len(purposes))
+}
+
+func TestKms_DataKeyVersionReferenced(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	_, err := kmsCache.DataKeyVersionReferenced(testCtx, "")
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+	w, err := kmsCache.GetWrapper(testCtx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	keyId, err := w.KeyId(testCtx)
+	require.NoError(err)
+
+	// no table with a registered rewrap function has been populated
+	referenced, err := kmsCache.DataKeyVersionReferenced(testCtx, keyId)
+	require.NoError(err)
+	assert.False(referenced)
+}
+
+func TestKms_ListKeys(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	_, err := kmsCache.ListKeys(testCtx, "")
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+	keys, err := kmsCache.ListKeys(testCtx, "o_1234567890")
+	require.NoError(err)
+	assert.Empty(keys)
+
+	keys, err = kmsCache.ListKeys(testCtx, org.GetPublicId())
+	require.NoError(err)
+	require.NotEmpty(keys)
+	assert.Equal(kms.KeyTypeKek, keys[0].Type)
+	assert.Equal(kms.KeyPurposeRootKey.String(), keys[0].Purpose)
+	gotPurposes := map[string]bool{}
+	for _, k := range keys {
+		assert.NotEmpty(k.Id)
+		assert.Equal(org.GetPublicId(), k.Scope)
+		assert.False(k.CreateTime.IsZero())
+		require.Len(k.Versions, 1)
+		assert.Equal(uint32(1), k.Versions[0].Version)
+		gotPurposes[k.Purpose] = true
+	}
+	for _, purpose := range kms.ValidDekPurposes() {
+		assert.True(gotPurposes[purpose.String()], "missing key with purpose %s", purpose)
+	}
+
+	w, err := kmsCache.GetWrapper(testCtx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	currentKeyId, err := w.KeyId(testCtx)
+	require.NoError(err)
+
+	require.NoError(kmsCache.RotateKeys(testCtx, org.GetPublicId()))
+	w, err = kmsCache.GetWrapper(testCtx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	rotatedKeyId, err := w.KeyId(testCtx)
+	require.NoError(err)
+
+	rotated, err := kmsCache.ListKeys(testCtx, org.GetPublicId())
+	require.NoError(err)
+	require.Len(rotated, len(keys))
+	for i, k := range rotated {
+		assert.Equal(keys[i].Id, k.Id)
+		require.Len(k.Versions, 2)
+		assert.Equal(keys[i].Versions[0].Id, k.Versions[0].Id)
+		assert.Equal(uint32(2), k.Versions[1].Version)
+		if k.Purpose == kms.KeyPurposeDatabase.String() {
+			assert.Equal(currentKeyId, k.Versions[0].Id)
+			assert.Equal(rotatedKeyId, k.Versions[1].Id)
+		}
+	}
+}
+
+func TestKms_DestroyKeyVersion(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, rootWrapper))
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+
+	require.NoError(t, kmsCache.RotateKeys(testCtx, org.GetPublicId()))
+	keys, err := kmsCache.ListKeys(testCtx, org.GetPublicId())
+	require.NoError(t, err)
+	previousVersions := map[string]string{}
+	latestVersions := map[string]string{}
+	for _, k := range keys {
+		require.Len(t, k.Versions, 2)
+		previousVersions[k.Purpose] = k.Versions[0].Id
+		latestVersions[k.Purpose] = k.Versions[1].Id
+	}
+	prjKeys, err := kmsCache.ListKeys(testCtx, prj.GetPublicId())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		scopeId         string
+		keyVersionId    string
+		wantErrMatch    *errors.Template
+		wantErrContains string
+	}{
+		{
+			name:            "missing-scope-id",
+			keyVersionId:    previousVersions[kms.KeyPurposeSessions.String()],
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing scope id",
+		},
+		{
+			name:            "missing-key-version-id",
+			scopeId:         org.GetPublicId(),
+			wantErrMatch:    errors.T(errors.InvalidParameter),
+			wantErrContains: "missing key version id",
+		},
+		{
+			name:         "unknown-key-version",
+			scopeId:      org.GetPublicId(),
+			keyVersionId: "kdkv_1234567890",
+			wantErrMatch: errors.T(errors.RecordNotFound),
+		},
+		{
+			name:         "key-version-of-other-scope",
+			scopeId:      org.GetPublicId(),
+			keyVersionId: prjKeys[0].Versions[0].Id,
+			wantErrMatch: errors.T(errors.RecordNotFound),
+		},
+		{
+			name:            "latest-key-version",
+			scopeId:         org.GetPublicId(),
+			keyVersionId:    latestVersions[kms.KeyPurposeSessions.String()],
+			wantErrMatch:    errors.T(errors.KeyVersionInUse),
+			wantErrContains: "latest version",
+		},
+		{
+			name:            "database-key-version-encrypting-auth-token",
+			scopeId:         org.GetPublicId(),
+			keyVersionId:    previousVersions[kms.KeyPurposeDatabase.String()],
+			wantErrMatch:    errors.T(errors.KeyVersionInUse),
+			wantErrContains: "still protects data",
+		},
+		{
+			name:            "tokens-key-version-of-issued-auth-token",
+			scopeId:         org.GetPublicId(),
+			keyVersionId:    previousVersions[kms.KeyPurposeTokens.String()],
+			wantErrMatch:    errors.T(errors.KeyVersionInUse),
+			wantErrContains: "still protects data",
+		},
+		{
+			name:            "oplog-key-version",
+			scopeId:         org.GetPublicId(),
+			keyVersionId:    previousVersions[kms.KeyPurposeOplog.String()],
+			wantErrMatch:    errors.T(errors.KeyVersionInUse),
+			wantErrContains: "still protects data",
+		},
+		{
+			name:         "sessions-key-version",
+			scopeId:      org.GetPublicId(),
+			keyVersionId: previousVersions[kms.KeyPurposeSessions.String()],
+		},
+		{
+			name:         "root-key-version",
+			scopeId:      org.GetPublicId(),
+			keyVersionId: previousVersions[kms.KeyPurposeRootKey.String()],
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			err := kmsCache.DestroyKeyVersion(testCtx, tc.scopeId, tc.keyVersionId)
+			if tc.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tc.wantErrMatch, err), "expected %q and got err: %+v", tc.wantErrMatch.Code, err)
+				if tc.wantErrContains != "" {
+					assert.Contains(err.Error(), tc.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+
+			keys, err := kmsCache.ListKeys(testCtx, tc.scopeId)
+			require.NoError(err)
+			for _, k := range keys {
+				for _, v := range k.Versions {
+					assert.NotEqual(tc.keyVersionId, v.Id)
+				}
+			}
+		})
+	}
+
+	// the auth token encrypted with the previous database key version can
+	// still be validated after the root key version was destroyed
+	atRepo, err := authtoken.NewRepository(db.New(conn), db.New(conn), kmsCache)
+	require.NoError(t, err)
+	got, err := atRepo.ValidateToken(testCtx, at.GetPublicId(), at.GetToken())
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+}
+
+func TestKms_DestroyKeyVersion_SessionTofuToken(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	rootWrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, rootWrapper)
+	iamRepo := iam.TestRepo(t, conn, rootWrapper)
+	sessRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+
+	s := session.TestDefaultSession(t, conn, rootWrapper, iamRepo)
+	tofu := session.TestTofu(t)
+	_, _, err = sessRepo.ActivateSession(testCtx, s.GetPublicId(), s.Version, tofu)
+	require.NoError(err)
+	w, err := kmsCache.GetWrapper(testCtx, s.ProjectId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	keyId, err := w.KeyId(testCtx)
+	require.NoError(err)
+
+	// the tofu token of the session is still encrypted with the previous
+	// version of the database key
+	require.NoError(kmsCache.RotateKeys(testCtx, s.ProjectId))
+	err = kmsCache.DestroyKeyVersion(testCtx, s.ProjectId, keyId)
+	require.Error(err)
+	assert.Truef(errors.Match(errors.T(errors.KeyVersionInUse), err), "expected %q and got err: %+v", errors.KeyVersionInUse, err)
+	assert.Contains(err.Error(), "still protects data")
+
+	got, _, err := sessRepo.LookupSession(testCtx, s.GetPublicId())
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(tofu, got.TofuToken)
+}
@@ -175,3 +175,34 @@ type invalidReader struct {
 type invalidWriter struct {
 	db.Writer
 }
+
+func Test_RegisterTableRewrapFn(t *testing.T) {
+	assert := assert.New(t)
+	fn := func(context.Context, string, string, db.Reader, db.Writer, *Kms) error { return nil }
+
+	RegisterTableRewrapFn("test_register_table_rewrap_fn", fn)
+	t.Cleanup(func() {
+		tableRewrapFnsMu.Lock()
+		defer tableRewrapFnsMu.Unlock()
+		delete(tableRewrapFns, "test_register_table_rewrap_fn")
+	})
+	fns := ListTablesRewrapFns()
+	assert.Contains(fns, "test_register_table_rewrap_fn")
+
+	// the returned map is a copy
+	delete(fns, "test_register_table_rewrap_fn")
+	assert.Contains(ListTablesRewrapFns(), "test_register_table_rewrap_fn")
+
+	assert.Panics(func() { RegisterTableRewrapFn("test_register_table_rewrap_fn", fn) })
+
+	// a column of the same table is registered under its own name
+	RegisterTableColumnRewrapFn("test_register_table_rewrap_fn", "other_key_id", fn)
+	t.Cleanup(func() {
+		tableRewrapFnsMu.Lock()
+		defer tableRewrapFnsMu.Unlock()
+		delete(tableRewrapFns, "test_register_table_rewrap_fn.other_key_id")
+	})
+	assert.Contains(ListTablesRewrapFns(), "test_register_table_rewrap_fn.other_key_id")
+	assert.Panics(func() { RegisterTableColumnRewrapFn("test_register_table_rewrap_fn", "other_key_id", fn) })
+	assert.Panics(func() { RegisterTableColumnRewrapFn("test_register_table_rewrap_fn", "key_id", fn) })
+}
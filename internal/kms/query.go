@@ -0,0 +1,87 @@
+package kms
+
+const (
+	// previousDataKeyVersionsQuery selects every data key version that is not
+	// the latest version of its data key, along with the scope and purpose of
+	// the data key it belongs to.
+	previousDataKeyVersionsQuery = `
+select dkv.private_id,
+       dkv.version,
+       dk.purpose,
+       rk.scope_id
+  from kms_data_key_version as dkv
+  join kms_data_key as dk
+    on dk.private_id = dkv.data_key_id
+  join kms_root_key as rk
+    on rk.private_id = dk.root_key_id
+ where dkv.version < (
+         select max(latest.version)
+           from kms_data_key_version as latest
+          where latest.data_key_id = dkv.data_key_id
+       )
+order by rk.scope_id, dk.purpose, dkv.version;
+`
+
+	// dataKeyVersionReferencedQuery must be formatted with a table name and
+	// the name of its column recording the data key version of its rows.
+	dataKeyVersionReferencedQuery = `
+select exists (
+  select 1
+    from %s
+   where %s = ?
+);
+`
+
+	// listKeysQuery selects every version of the root key and of the data keys
+	// of a scope, ordered by key type, purpose and version.
+	listKeysQuery = `
+select rk.private_id   as key_id,
+       'kek'           as type,
+       'rootKey'       as purpose,
+       rk.create_time  as key_create_time,
+       rkv.private_id  as version_id,
+       rkv.version     as version,
+       rkv.create_time as version_create_time
+  from kms_root_key as rk
+  join kms_root_key_version as rkv
+    on rkv.root_key_id = rk.private_id
+ where rk.scope_id = @scope_id
+union all
+select dk.private_id   as key_id,
+       'dek'           as type,
+       dk.purpose      as purpose,
+       dk.create_time  as key_create_time,
+       dkv.private_id  as version_id,
+       dkv.version     as version,
+       dkv.create_time as version_create_time
+  from kms_data_key as dk
+  join kms_root_key as rk
+    on rk.private_id = dk.root_key_id
+  join kms_data_key_version as dkv
+    on dkv.data_key_id = dk.private_id
+ where rk.scope_id = @scope_id
+order by type desc, purpose, version;
+`
+
+	// rootKeyVersionReferencedQuery selects whether a data key version is
+	// still encrypted with a root key version.
+	rootKeyVersionReferencedQuery = `
+select exists (
+  select 1
+    from kms_data_key_version
+   where root_key_version_id = ?
+);
+`
+
+	// authTokenIssuedBeforeQuery selects whether an auth token of a scope was
+	// issued before a tokens data key version was superseded, in which case
+	// the token returned to its client may be encrypted with that version.
+	authTokenIssuedBeforeQuery = `
+select exists (
+  select 1
+    from auth_token_account
+   where scope_id = @scope_id
+     and create_time < @superseded_time
+);
+`
+)
@@ -0,0 +1,161 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// RewrapFn re-encrypts the rows of a table which were encrypted using the
+// data key version identified by dataKeyVersionId, so they are encrypted
+// using the current version of the data key of the same scope. A RewrapFn
+// must update the key id column it was registered for in every row it
+// re-encrypts and must use the provided reader and writer, which may be part
+// of an inflight transaction.
+type RewrapFn func(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kms *Kms) error
+
+// defaultKeyIdColumn is the column recording the data key version used to
+// encrypt the rows of a table registered with RegisterTableRewrapFn.
+const defaultKeyIdColumn = "key_id"
+
+// tableRewrapFn is a RewrapFn registered for a key id column of a table.
+type tableRewrapFn struct {
+	tableName   string
+	keyIdColumn string
+	fn          RewrapFn
+}
+
+var (
+	tableRewrapFnsMu sync.RWMutex
+	tableRewrapFns   = map[string]tableRewrapFn{}
+)
+
+// RegisterTableRewrapFn registers the RewrapFn for a table whose rows record
+// the data key version used to encrypt them in a key_id column. It is intended
+// to be called from the init function of the package which owns the table and
+// it panics if a RewrapFn has already been registered for the table.
+func RegisterTableRewrapFn(tableName string, fn RewrapFn) {
+	RegisterTableColumnRewrapFn(tableName, defaultKeyIdColumn, fn)
+}
+
+// RegisterTableColumnRewrapFn registers the RewrapFn for a table whose rows
+// record the data key version used to encrypt some of their columns in
+// keyIdColumn. It is used when a row holds values encrypted with the data
+// keys of different purposes, each recorded in its own column. It is intended
+// to be called from the init function of the package which owns the table and
+// it panics if a RewrapFn has already been registered for the column.
+func RegisterTableColumnRewrapFn(tableName, keyIdColumn string, fn RewrapFn) {
+	tableRewrapFnsMu.Lock()
+	defer tableRewrapFnsMu.Unlock()
+	name := rewrapFnName(tableName, keyIdColumn)
+	if _, ok := tableRewrapFns[name]; ok {
+		panic(fmt.Sprintf("kms: rewrap function already registered for %q", name))
+	}
+	tableRewrapFns[name] = tableRewrapFn{
+		tableName:   tableName,
+		keyIdColumn: keyIdColumn,
+		fn:          fn,
+	}
+}
+
+// rewrapFnName returns the name of a RewrapFn registered for the key id
+// column of the table: the table name for the key_id column, and the table
+// name followed by the column name otherwise.
+func rewrapFnName(tableName, keyIdColumn string) string {
+	if keyIdColumn == defaultKeyIdColumn {
+		return tableName
+	}
+	return tableName + "." + keyIdColumn
+}
+
+// ListTablesRewrapFns returns a copy of the registered RewrapFns, keyed by
+// table name, or by table name and key id column for the RewrapFns registered
+// with RegisterTableColumnRewrapFn.
+func ListTablesRewrapFns() map[string]RewrapFn {
+	tableRewrapFnsMu.RLock()
+	defer tableRewrapFnsMu.RUnlock()
+	fns := make(map[string]RewrapFn, len(tableRewrapFns))
+	for name, t := range tableRewrapFns {
+		fns[name] = t.fn
+	}
+	return fns
+}
+
+// DataKeyVersion identifies a version of the data key used for a purpose in a
+// scope.
+type DataKeyVersion struct {
+	PrivateId string
+	Version   uint32
+	Purpose   string
+	ScopeId   string
+}
+
+// ListPreviousDataKeyVersions returns the data key versions of every scope
+// which have been superseded by a newer version of the same data key, ordered
+// by scope, purpose and version.
+func (k *Kms) ListPreviousDataKeyVersions(ctx context.Context) ([]*DataKeyVersion, error) {
+	const op = "kms.(Kms).ListPreviousDataKeyVersions"
+	rows, err := k.reader.Query(ctx, previousDataKeyVersionsQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var versions []*DataKeyVersion
+	for rows.Next() {
+		var v DataKeyVersion
+		if err := k.reader.ScanRows(ctx, rows, &v); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		versions = append(versions, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return versions, nil
+}
+
+// DataKeyVersionReferenced reports whether a row of any table with a
+// registered RewrapFn is still encrypted using the data key version, according
+// to the key id column the RewrapFn was registered for. A data key version
+// should not be destroyed while it is referenced, since the rows encrypted
+// with it could no longer be decrypted.
+func (k *Kms) DataKeyVersionReferenced(ctx context.Context, dataKeyVersionId string) (bool, error) {
+	const op = "kms.(Kms).DataKeyVersionReferenced"
+	if dataKeyVersionId == "" {
+		return false, errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	}
+	tableRewrapFnsMu.RLock()
+	tables := make([]tableRewrapFn, 0, len(tableRewrapFns))
+	for _, t := range tableRewrapFns {
+		tables = append(tables, t)
+	}
+	tableRewrapFnsMu.RUnlock()
+	sort.Slice(tables, func(i, j int) bool {
+		return rewrapFnName(tables[i].tableName, tables[i].keyIdColumn) < rewrapFnName(tables[j].tableName, tables[j].keyIdColumn)
+	})
+
+	for _, t := range tables {
+		referenced, err := k.tableReferencesDataKeyVersion(ctx, t.tableName, t.keyIdColumn, dataKeyVersionId)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		if referenced {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (k *Kms) tableReferencesDataKeyVersion(ctx context.Context, tableName, keyIdColumn, dataKeyVersionId string) (bool, error) {
+	const op = "kms.(Kms).tableReferencesDataKeyVersion"
+	referenced, err := k.exists(ctx, fmt.Sprintf(dataKeyVersionReferencedQuery, tableName, keyIdColumn), dataKeyVersionId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to query %s", rewrapFnName(tableName, keyIdColumn))))
+	}
+	return referenced, nil
+}
@@ -49,6 +49,12 @@ type flushable interface {
 	FlushAll(ctx context.Context) error
 }
 
+// closable defines an interface that all eventlogger Nodes must implement if
+// they have to be closed when the eventer is closed
+type closable interface {
+	Close(ctx context.Context) error
+}
+
 // broker defines an interface for an eventlogger Broker... which will allow us
 // to substitute our testing broker when needed to write tests for things
 // like event send retrying.
@@ -72,6 +78,7 @@ type queuedEvent struct {
 type Eventer struct {
 	broker               broker
 	flushableNodes       []flushable
+	closableNodes        []closable
 	conf                 EventerConfig
 	logger               hclog.Logger
 	auditPipelines       []pipeline
@@ -268,6 +275,10 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 	// reused.
 	allSinkFilenames := map[string]bool{}
 
+	// flushableSinks are flushed after the gated filters, so the events
+	// released by the gates are flushed by the sinks as well.
+	var flushableSinks []flushable
+
 	for _, s := range c.Sinks {
 		fmtId, fmtNode, err := newFmtFilterNode(serverName, *s, opt...)
 		e.auditWrapperNodes = append(e.auditWrapperNodes, fmtNode)
@@ -322,6 +333,45 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case KafkaSink:
+			kafkaNode, err := newKafkaSink(s.Format, s.KafkaConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			flushableSinks = append(flushableSinks, kafkaNode)
+			e.closableNodes = append(e.closableNodes, kafkaNode)
+			sinkNode = kafkaNode
+			id, err := NewId(fmt.Sprintf("kafka_%s_", s.KafkaConfig.Topic))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
+		case SyslogSink:
+			syslogNode, err := newSyslogSink(s.Format, s.SyslogConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			flushableSinks = append(flushableSinks, syslogNode)
+			e.closableNodes = append(e.closableNodes, syslogNode)
+			sinkNode = syslogNode
+			id, err := NewId(fmt.Sprintf("syslog_%s_", s.SyslogConfig.Address))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
+		case HttpSink:
+			httpNode, err := newHttpSink(s.Format, s.HttpConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			flushableSinks = append(flushableSinks, httpNode)
+			e.closableNodes = append(e.closableNodes, httpNode)
+			sinkNode = httpNode
+			id, err := NewId("http")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		default:
 			return nil, fmt.Errorf("%s: unknown sink type %s", op, s.Type)
 		}
@@ -514,6 +564,8 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		sysNodeIds = append(sysNodeIds, p.sinkId)
 	}
 
+	e.flushableNodes = append(e.flushableNodes, flushableSinks...)
+
 	err := e.broker.SetSuccessThreshold(eventlogger.EventType(ObservationType), len(observationNodeIds))
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to set success threshold for observation events: %w", op, err)
@@ -559,6 +611,18 @@ func newFmtFilterNode(serverName string, c SinkConfig, opt ...Option) (eventlogg
 			return "", nil, fmt.Errorf("%s: %w", op, err)
 		}
 
+	case SyslogSinkFormat:
+		id, err := NewId(string(c.Format))
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: unable to generate id: %w", op, err)
+		}
+		fmtId = eventlogger.NodeID(id)
+
+		fmtNode, err = newSyslogFormatterFilter(WithAllow(c.AllowFilters...), WithDeny(c.DenyFilters...))
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %w", op, err)
+		}
+
 	default:
 		id, err := NewId("cloudevents")
 		if err != nil {
@@ -617,6 +681,8 @@ func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wr
 			w.Rotate(newWrapper)
 		case *cloudEventsFormatterFilter:
 			w.Rotate(newWrapper)
+		case *syslogFormatterFilter:
+			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
 		default:
@@ -748,6 +814,26 @@ func (e *Eventer) FlushNodes(ctx context.Context) error {
 	return nil
 }
 
+// Close flushes the eventer's flushable nodes and closes its closable nodes,
+// which stops their background sending of events. This needs to be called
+// once Boundary has stopped, since events sent to closed nodes are dropped.
+func (e *Eventer) Close(ctx context.Context) error {
+	const op = "event.(Eventer).Close"
+	if err := e.FlushNodes(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	var errs error
+	for _, n := range e.closableNodes {
+		if err := n.Close(ctx); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("%s: %w", op, errs)
+	}
+	return nil
+}
+
 // ReleaseGate releases queued events. If any event isn't successfully written,
 // it remains in the queue and we could try a flush later.
 func (e *Eventer) ReleaseGate() error {
@@ -0,0 +1,272 @@
+package event
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// httpDefaultBatchSize is the maximum number of events posted in a single
+	// request when no batch size is configured.
+	httpDefaultBatchSize = 100
+
+	// httpDefaultBatchInterval is how long a best-effort http sink waits for a
+	// batch to fill up when no batch interval is configured.
+	httpDefaultBatchInterval = time.Second
+
+	// httpDefaultQueueSize is the number of events queued by an http sink when
+	// no queue size is configured.
+	httpDefaultQueueSize = 1024
+
+	// httpDefaultTimeout is the timeout of every request when no timeout is
+	// configured.
+	httpDefaultTimeout = 10 * time.Second
+)
+
+// httpSink is an eventlogger sink node which posts batches of the formatted
+// events to an http endpoint. The body of a request is the formatted events of
+// the batch, each followed by a newline.
+//
+// Requests which fail with a network error, a 408, a 429 or a 5xx status are
+// retried with the same exponential backoff as the eventer. With an enforced
+// delivery guarantee Process blocks until the batch of the event has been
+// posted and returns the error when it could not be posted, so the eventer
+// retries sending the event. Otherwise events are queued, and they are dropped
+// when the queue is full or when they cannot be posted. The queued events are
+// posted when the sink is closed.
+type httpSink struct {
+	format            string
+	url               string
+	headers           http.Header
+	gzip              bool
+	deliveryGuarantee DeliveryGuarantee
+	batchSize         int
+	batchInterval     time.Duration
+	retries           uint
+	backoff           backoff
+	client            *http.Client
+	batcher           *sinkBatcher
+}
+
+var _ eventlogger.Node = (*httpSink)(nil)
+
+func newHttpSink(format SinkFormat, c *HttpSinkTypeConfig) (*httpSink, error) {
+	const op = "event.newHttpSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing config: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid url: %w", op, ErrInvalidParameter)
+	}
+	s := &httpSink{
+		format:            string(format),
+		url:               c.Url,
+		headers:           http.Header{},
+		gzip:              c.Gzip,
+		deliveryGuarantee: c.DeliveryGuarantee,
+		batchSize:         c.BatchSize,
+		batchInterval:     c.BatchInterval,
+		retries:           stdRetryCount,
+		backoff:           expBackoff{},
+	}
+	switch format {
+	case JSONSinkFormat, JSONHclogSinkFormat:
+		s.headers.Set("Content-Type", "application/x-ndjson")
+	default:
+		s.headers.Set("Content-Type", "text/plain; charset=utf-8")
+	}
+	for k, v := range c.Headers {
+		s.headers.Set(k, v)
+	}
+	if c.BearerToken != "" {
+		s.headers.Set("Authorization", "Bearer "+c.BearerToken)
+	}
+	if s.batchSize == 0 {
+		s.batchSize = httpDefaultBatchSize
+	}
+	if s.batchInterval == 0 {
+		s.batchInterval = httpDefaultBatchInterval
+	}
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = httpDefaultTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if u.Scheme == "https" {
+		if transport.TLSClientConfig, err = sinkTlsConfig(c.TlsCaFile, c.TlsCertFile, c.TlsKeyFile, c.TlsServerName, c.TlsSkipVerify); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	s.client = &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+
+	queueSize := c.QueueSize
+	if queueSize == 0 {
+		queueSize = httpDefaultQueueSize
+	}
+	// Best-effort events wait for the batch to fill up, so fewer requests are
+	// sent. Enforced events are posted right away, since Process is blocked
+	// until they are.
+	batchInterval := s.batchInterval
+	if s.deliveryGuarantee == Enforced {
+		batchInterval = 0
+	}
+	if s.batcher, err = newSinkBatcher(queueSize, s.batchSize, batchInterval, s.sendBatch); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return s, nil
+}
+
+// Process will queue the event to be posted to the endpoint of the sink. With
+// an enforced delivery guarantee it blocks until the event has been posted.
+func (s *httpSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(httpSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+	// The events of a batch are separated by newlines.
+	if !bytes.HasSuffix(val, []byte("\n")) {
+		val = append(val[:len(val):len(val)], '\n')
+	}
+
+	if s.deliveryGuarantee == Enforced {
+		if err := s.batcher.enqueue(ctx, val); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// Sinks are leafs, so do not return the event, since nothing more can
+		// happen to it downstream.
+		return nil, nil
+	}
+	if !s.batcher.tryEnqueue(val) {
+		fallbackLogger().Error("http sink queue is full or closed, dropping event", "operation", op, "url", s.url)
+	}
+	return nil, nil
+}
+
+// Reopen closes the idle connections to the endpoint, they are dialed again
+// for the next requests.
+func (s *httpSink) Reopen() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// Type defines the httpSink as a NodeTypeSink
+func (s *httpSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// FlushAll posts the queued events. It blocks until the events queued before
+// it was called have been posted or dropped, or until ctx is done.
+func (s *httpSink) FlushAll(ctx context.Context) error {
+	const op = "event.(httpSink).FlushAll"
+	if err := s.batcher.flushAll(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Close posts the queued events and stops the sink. It blocks until the
+// queued events have been posted or dropped, or until ctx is done. Events
+// processed after Close is called are dropped, or returned as an error with
+// an enforced delivery guarantee.
+func (s *httpSink) Close(ctx context.Context) error {
+	const op = "event.(httpSink).Close"
+	if err := s.batcher.close(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// sendBatch posts the events of the batch in a single request.
+func (s *httpSink) sendBatch(batch [][]byte) error {
+	const op = "event.(httpSink).sendBatch"
+	var body bytes.Buffer
+	for _, val := range batch {
+		body.Write(val)
+	}
+	err := s.post(body.Bytes())
+	if err != nil && s.deliveryGuarantee != Enforced {
+		fallbackLogger().Error("unable to post events, dropping them", "operation", op, "url", s.url, "events", len(batch), "error", err)
+	}
+	return err
+}
+
+// post sends the body to the endpoint of the sink, retrying requests which
+// may succeed when sent again.
+func (s *httpSink) post(body []byte) error {
+	const op = "event.(httpSink).post"
+	if s.gzip {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(body); err != nil {
+			return fmt.Errorf("%s: unable to compress body: %w", op, err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("%s: unable to compress body: %w", op, err)
+		}
+		body = buf.Bytes()
+	}
+	var retryErrors error
+	for attempt := uint(1); ; attempt++ {
+		retryable, err := s.postOnce(body)
+		if err == nil {
+			return nil
+		}
+		retryErrors = multierror.Append(retryErrors, fmt.Errorf("%s: %w", op, err))
+		if !retryable {
+			return retryErrors
+		}
+		if attempt > s.retries {
+			return multierror.Append(retryErrors, fmt.Errorf("%s: reached max of %d: %w", op, s.retries, ErrMaxRetries))
+		}
+		time.Sleep(s.backoff.duration(attempt))
+	}
+}
+
+// postOnce sends a single request with the body and reports if a failed
+// request may succeed when it is retried.
+func (s *httpSink) postOnce(body []byte) (bool, error) {
+	const op = "event.(httpSink).postOnce"
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("%s: unable to create request: %w", op, err)
+	}
+	req.Header = s.headers.Clone()
+	if s.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+	// Drain the body, so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusRequestTimeout,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= 500:
+		return true, fmt.Errorf("%s: unexpected status %s", op, resp.Status)
+	default:
+		return false, fmt.Errorf("%s: unexpected status %s", op, resp.Status)
+	}
+}
@@ -0,0 +1,358 @@
+package event
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpSink_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testEvent := func(val string) *eventlogger.Event {
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(JSONSinkFormat), []byte(val+"\n"))
+		return e
+	}
+
+	t.Run("missing-event", func(t *testing.T) {
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{Url: srv.url()})
+		require.NoError(t, err)
+		_, err = s.Process(ctx, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("not-formatted", func(t *testing.T) {
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{Url: srv.url()})
+		require.NoError(t, err)
+		_, err = s.Process(ctx, &eventlogger.Event{})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("enforced", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{
+			Url:               srv.url(),
+			DeliveryGuarantee: Enforced,
+			BearerToken:       "test-token",
+			Headers:           map[string]string{"X-Test": "value"},
+		})
+		require.NoError(err)
+
+		// The event is posted before Process returns.
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		reqs := srv.requests()
+		require.Len(reqs, 1)
+		assert.Equal(`{"id":1}`+"\n", reqs[0].body)
+		assert.Equal("Bearer test-token", reqs[0].header.Get("Authorization"))
+		assert.Equal("value", reqs[0].header.Get("X-Test"))
+		assert.Equal("application/x-ndjson", reqs[0].header.Get("Content-Type"))
+
+		// Requests which may succeed later are retried.
+		srv.setStatuses(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		assert.Len(srv.requests(), 4)
+		assert.Equal(`{"id":2}`+"\n", srv.requests()[3].body)
+
+		// Other errors are returned, so the eventer retries sending the event.
+		srv.setStatuses(http.StatusBadRequest)
+		_, err = s.Process(ctx, testEvent(`{"id":3}`))
+		require.Error(err)
+		assert.Contains(err.Error(), "400 Bad Request")
+		assert.Len(srv.requests(), 5)
+
+		srv.setStatuses(http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+		_, err = s.Process(ctx, testEvent(`{"id":4}`))
+		assert.ErrorIs(err, ErrMaxRetries)
+		assert.Len(srv.requests(), 9)
+	})
+	t.Run("enforced-canceled", func(t *testing.T) {
+		s := &httpSink{format: string(JSONSinkFormat), deliveryGuarantee: Enforced, batcher: &sinkBatcher{queue: make(chan sinkBatchEvent)}}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := s.Process(canceled, testEvent(`{"id":1}`))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("best-effort", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{
+			Url:           srv.url(),
+			Gzip:          true,
+			BatchSize:     2,
+			BatchInterval: time.Minute,
+		})
+		require.NoError(err)
+
+		for _, v := range []string{`{"id":1}`, `{"id":2}`, `{"id":3}`} {
+			_, err = s.Process(ctx, testEvent(v))
+			require.NoError(err)
+		}
+		// The full batch is posted without waiting for the batch interval.
+		assert.Eventually(func() bool {
+			return len(srv.requests()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.NoError(s.FlushAll(ctx))
+		reqs := srv.requests()
+		require.Len(reqs, 2)
+		assert.Equal("gzip", reqs[0].header.Get("Content-Encoding"))
+		assert.Equal(`{"id":1}`+"\n"+`{"id":2}`+"\n", reqs[0].body)
+		assert.Equal(`{"id":3}`+"\n", reqs[1].body)
+
+		// Events which cannot be posted are dropped.
+		srv.setStatuses(http.StatusForbidden)
+		_, err = s.Process(ctx, testEvent(`{"id":4}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Len(srv.requests(), 3)
+		_, err = s.Process(ctx, testEvent(`{"id":5}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		reqs = srv.requests()
+		require.Len(reqs, 4)
+		assert.Equal(`{"id":5}`+"\n", reqs[3].body)
+	})
+	t.Run("best-effort-queue-full", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &httpSink{
+			format:  string(JSONSinkFormat),
+			batcher: &sinkBatcher{queue: make(chan sinkBatchEvent, 1)},
+		}
+		_, err := s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		require.Len(s.batcher.queue, 1)
+		assert.Equal(`{"id":1}`+"\n", string((<-s.batcher.queue).val))
+	})
+	t.Run("close", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{
+			Url:           srv.url(),
+			BatchInterval: time.Minute,
+		})
+		require.NoError(err)
+
+		// The queued events are posted when the sink is closed.
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		require.NoError(s.Close(ctx))
+		reqs := srv.requests()
+		require.Len(reqs, 1)
+		assert.Equal(`{"id":1}`+"\n", reqs[0].body)
+
+		// Events processed after the sink is closed are dropped.
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		require.NoError(s.Close(ctx))
+		assert.Len(srv.requests(), 1)
+	})
+	t.Run("enforced-closed", func(t *testing.T) {
+		srv := newTestHttpEndpoint(t)
+		s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{Url: srv.url(), DeliveryGuarantee: Enforced})
+		require.NoError(t, err)
+		require.NoError(t, s.Close(ctx))
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		assert.ErrorIs(t, err, errSinkClosed)
+		assert.Empty(t, srv.requests())
+	})
+	t.Run("mtls", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tc := testSinkTls(t, "events.example.com")
+		srv := newTestHttpEndpoint(t)
+		srv.srv.Close()
+		srv.srv = httptest.NewUnstartedServer(srv.srv.Config.Handler)
+		srv.srv.TLS = tc.serverConfig
+		srv.srv.StartTLS()
+
+		s, err := newHttpSink(TextSinkFormat, &HttpSinkTypeConfig{
+			Url:               srv.url(),
+			DeliveryGuarantee: Enforced,
+			TlsCaFile:         tc.caFile,
+			TlsCertFile:       tc.certFile,
+			TlsKeyFile:        tc.keyFile,
+			TlsServerName:     "events.example.com",
+		})
+		require.NoError(err)
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(TextSinkFormat), []byte("event\n"))
+		_, err = s.Process(ctx, e)
+		require.NoError(err)
+		reqs := srv.requests()
+		require.Len(reqs, 1)
+		assert.Equal("event\n", reqs[0].body)
+		assert.Equal("text/plain; charset=utf-8", reqs[0].header.Get("Content-Type"))
+		assert.True(reqs[0].clientCert)
+	})
+	t.Run("flush-canceled", func(t *testing.T) {
+		s := &httpSink{batcher: &sinkBatcher{flush: make(chan chan struct{})}}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, s.FlushAll(canceled), context.Canceled)
+	})
+}
+
+func TestEventer_HttpSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	srv := newTestHttpEndpoint(t)
+
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "http-sink",
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{AuditType},
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:               srv.url(),
+					DeliveryGuarantee: BestEffort,
+				},
+			},
+		},
+	}
+	require.NoError(c.Validate())
+	e, err := NewEventer(testLogger, testLock, "TestEventer_HttpSink", c)
+	require.NoError(err)
+
+	testAudit, err := newAudit(
+		"TestEventer_HttpSink",
+		WithRequestInfo(TestRequestInfo(t)),
+		WithAuth(testAuth(t)),
+		WithRequest(testRequest(t)),
+		WithResponse(testResponse(t)),
+		WithFlush())
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, testAudit))
+	require.NoError(e.FlushNodes(ctx))
+
+	reqs := srv.requests()
+	require.Len(reqs, 1)
+	var got map[string]any
+	require.NoError(json.Unmarshal([]byte(reqs[0].body), &got))
+	assert.Equal(string(AuditType), got["type"])
+	data, ok := got["data"].(map[string]any)
+	require.True(ok)
+	assert.Equal(testAudit.Id, data["id"])
+
+	// The audit config of the sink is applied, so the sensitive fields are
+	// redacted.
+	auth, ok := data["auth"].(map[string]any)
+	require.True(ok)
+	assert.Equal(encrypt.RedactedData, auth["email"])
+
+	// Closing the eventer posts the queued events.
+	require.NoError(e.writeAudit(ctx, testAudit))
+	require.NoError(e.Close(ctx))
+	assert.Len(srv.requests(), 2)
+}
+
+// testHttpEndpoint is an http endpoint recording the requests it receives.
+type testHttpEndpoint struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	reqs     []testHttpRequest
+	statuses []int
+}
+
+type testHttpRequest struct {
+	header     http.Header
+	body       string
+	clientCert bool
+}
+
+func newTestHttpEndpoint(t *testing.T) *testHttpEndpoint {
+	t.Helper()
+	e := &testHttpEndpoint{}
+	e.srv = httptest.NewServer(http.HandlerFunc(e.handle))
+	t.Cleanup(func() { e.srv.Close() })
+	return e
+}
+
+func (e *testHttpEndpoint) handle(w http.ResponseWriter, r *http.Request) {
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = zr
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.reqs = append(e.reqs, testHttpRequest{
+		header:     r.Header.Clone(),
+		body:       string(b),
+		clientCert: r.TLS != nil && len(r.TLS.PeerCertificates) > 0,
+	})
+	if len(e.statuses) > 0 {
+		w.WriteHeader(e.statuses[0])
+		e.statuses = e.statuses[1:]
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (e *testHttpEndpoint) url() string {
+	return e.srv.URL + "/events"
+}
+
+func (e *testHttpEndpoint) requests() []testHttpRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]testHttpRequest(nil), e.reqs...)
+}
+
+// setStatuses sets the statuses of the next responses, the responses after
+// them have a 202 status.
+func (e *testHttpEndpoint) setStatuses(statuses ...int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.statuses = statuses
+}
+
+func Test_httpSinkContentType(t *testing.T) {
+	t.Parallel()
+	for _, f := range []SinkFormat{JSONSinkFormat, JSONHclogSinkFormat} {
+		s, err := newHttpSink(f, &HttpSinkTypeConfig{Url: "http://localhost/events"})
+		require.NoError(t, err)
+		assert.Equal(t, "application/x-ndjson", s.headers.Get("Content-Type"))
+	}
+	for _, f := range []SinkFormat{TextSinkFormat, TextHclogSinkFormat, SyslogSinkFormat} {
+		s, err := newHttpSink(f, &HttpSinkTypeConfig{Url: "http://localhost/events"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(s.headers.Get("Content-Type"), "text/plain"))
+	}
+	// Configured headers override the defaults.
+	s, err := newHttpSink(JSONSinkFormat, &HttpSinkTypeConfig{Url: "http://localhost/events", Headers: map[string]string{"content-type": "application/json"}})
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", s.headers.Get("Content-Type"))
+}
@@ -0,0 +1,249 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	// kafkaDefaultQueueSize is the number of events queued by a kafka sink
+	// when no queue size is configured.
+	kafkaDefaultQueueSize = 1024
+
+	// kafkaMaxBatchSize is the maximum number of queued events produced in a
+	// single request by a kafka sink.
+	kafkaMaxBatchSize = 128
+
+	// kafkaDefaultClientId is the client id sent to the brokers when no client
+	// id is configured.
+	kafkaDefaultClientId = "boundary"
+
+	// kafkaDefaultTimeout is the timeout for dialing and requests to the
+	// brokers when no timeout is configured.
+	kafkaDefaultTimeout = 10 * time.Second
+)
+
+// kafkaSink is an eventlogger sink node which produces the formatted events
+// as records to a Kafka topic. Records are spread over the partitions of the
+// topic round robin.
+//
+// With an enforced delivery guarantee every event is produced before Process
+// returns and errors are returned, so the eventer retries sending the event.
+// Otherwise events are queued and produced in batches in the background. They
+// are dropped when the queue is full or when they cannot be produced. The
+// queued events are produced when the sink is closed.
+type kafkaSink struct {
+	format            string
+	topic             string
+	brokers           []string
+	deliveryGuarantee DeliveryGuarantee
+	config            *sarama.Config
+	batcher           *sinkBatcher
+
+	// producerLock guards producer, which is connected to the brokers when
+	// the first events are produced and after the sink is reopened.
+	producerLock sync.Mutex
+	producer     sarama.SyncProducer
+}
+
+var _ eventlogger.Node = (*kafkaSink)(nil)
+
+func newKafkaSink(format SinkFormat, c *KafkaSinkTypeConfig) (*kafkaSink, error) {
+	const op = "event.newKafkaSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing config: %w", op, ErrInvalidParameter)
+	}
+	config, err := kafkaProducerConfig(c)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &kafkaSink{
+		format:            string(format),
+		topic:             c.Topic,
+		brokers:           c.Brokers,
+		deliveryGuarantee: c.DeliveryGuarantee,
+		config:            config,
+	}
+	queueSize := c.QueueSize
+	if queueSize == 0 {
+		queueSize = kafkaDefaultQueueSize
+	}
+	if s.batcher, err = newSinkBatcher(queueSize, kafkaMaxBatchSize, 0, s.sendBatch); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return s, nil
+}
+
+// kafkaProducerConfig returns the config of the producer of a kafka sink.
+func kafkaProducerConfig(c *KafkaSinkTypeConfig) (*sarama.Config, error) {
+	const op = "event.kafkaProducerConfig"
+	config := sarama.NewConfig()
+	config.ClientID = c.ClientId
+	if config.ClientID == "" {
+		config.ClientID = kafkaDefaultClientId
+	}
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = kafkaDefaultTimeout
+	}
+	config.Net.DialTimeout = timeout
+	config.Net.ReadTimeout = timeout
+	config.Net.WriteTimeout = timeout
+	config.Producer.Timeout = timeout
+	switch c.RequiredAcks {
+	case KafkaLeaderAcks:
+		config.Producer.RequiredAcks = sarama.WaitForLocal
+	case KafkaNoAcks:
+		config.Producer.RequiredAcks = sarama.NoResponse
+	default:
+		config.Producer.RequiredAcks = sarama.WaitForAll
+	}
+	config.Producer.Partitioner = sarama.NewRoundRobinPartitioner
+	// A sync producer reports the result of every record.
+	config.Producer.Return.Successes = true
+	if !c.TlsDisable {
+		tlsConfig, err := sinkTlsConfig(c.TlsCaFile, c.TlsCertFile, c.TlsKeyFile, c.TlsServerName, c.TlsSkipVerify)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", op, err.Error(), ErrInvalidParameter)
+	}
+	return config, nil
+}
+
+// Process will produce the event to the topic of the sink.
+func (s *kafkaSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(kafkaSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+	// Each event is its own record, so the newline separating the events
+	// written by the other sinks is dropped.
+	val = bytes.TrimSuffix(val, []byte("\n"))
+
+	if s.deliveryGuarantee == Enforced {
+		if err := s.batcher.enqueue(ctx, val); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// Sinks are leafs, so do not return the event, since nothing more can
+		// happen to it downstream.
+		return nil, nil
+	}
+	if !s.batcher.tryEnqueue(val) {
+		fallbackLogger().Error("kafka sink queue is full or closed, dropping event", "operation", op, "topic", s.topic)
+	}
+	return nil, nil
+}
+
+// Reopen closes the connections to the brokers, they are dialed again for the
+// next events.
+func (s *kafkaSink) Reopen() error {
+	const op = "event.(kafkaSink).Reopen"
+	if err := s.closeProducer(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Type defines the kafkaSink as a NodeTypeSink
+func (s *kafkaSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// FlushAll produces the queued events. It blocks until the events queued
+// before it was called have been produced or dropped, or until ctx is done.
+func (s *kafkaSink) FlushAll(ctx context.Context) error {
+	const op = "event.(kafkaSink).FlushAll"
+	if err := s.batcher.flushAll(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Close produces the queued events and stops the sink. It blocks until the
+// queued events have been produced or dropped, or until ctx is done. Events
+// processed after Close is called are dropped, or returned as an error with
+// an enforced delivery guarantee.
+func (s *kafkaSink) Close(ctx context.Context) error {
+	const op = "event.(kafkaSink).Close"
+	if err := s.batcher.close(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.closeProducer(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// sendBatch produces the events of the batch.
+func (s *kafkaSink) sendBatch(batch [][]byte) error {
+	const op = "event.(kafkaSink).sendBatch"
+	err := s.produce(batch)
+	if err != nil && s.deliveryGuarantee != Enforced {
+		fallbackLogger().Error("unable to produce events, dropping them", "operation", op, "topic", s.topic, "events", len(batch), "error", err)
+	}
+	return err
+}
+
+// produce sends the values as records to the topic of the sink. It connects
+// the producer to the brokers if it isn't connected yet.
+func (s *kafkaSink) produce(values [][]byte) error {
+	const op = "event.(kafkaSink).produce"
+	s.producerLock.Lock()
+	defer s.producerLock.Unlock()
+	if s.producer == nil {
+		p, err := sarama.NewSyncProducer(s.brokers, s.config)
+		if err != nil {
+			return fmt.Errorf("%s: unable to connect to brokers: %w", op, err)
+		}
+		s.producer = p
+	}
+	msgs := make([]*sarama.ProducerMessage, 0, len(values))
+	for _, v := range values {
+		msgs = append(msgs, &sarama.ProducerMessage{
+			Topic: s.topic,
+			Value: sarama.ByteEncoder(v),
+		})
+	}
+	if err := s.producer.SendMessages(msgs); err != nil {
+		// The records of a batch usually fail for the same reason, so only
+		// the first error is returned.
+		var errs sarama.ProducerErrors
+		if errors.As(err, &errs) && len(errs) > 0 {
+			return fmt.Errorf("%s: unable to produce %d of %d records: %w", op, len(errs), len(msgs), errs[0].Err)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// closeProducer closes the producer, if it is connected to the brokers.
+func (s *kafkaSink) closeProducer() error {
+	const op = "event.(kafkaSink).closeProducer"
+	s.producerLock.Lock()
+	defer s.producerLock.Unlock()
+	if s.producer == nil {
+		return nil
+	}
+	err := s.producer.Close()
+	s.producer = nil
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaSink_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testEvent := func(val string) *eventlogger.Event {
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(JSONSinkFormat), []byte(val+"\n"))
+		return e
+	}
+	newSink := func(t *testing.T, dg DeliveryGuarantee) (*kafkaSink, *testKafkaProducer) {
+		t.Helper()
+		s, err := newKafkaSink(JSONSinkFormat, &KafkaSinkTypeConfig{Brokers: []string{"127.0.0.1:9092"}, Topic: "events", DeliveryGuarantee: dg, TlsDisable: true})
+		require.NoError(t, err)
+		p := &testKafkaProducer{}
+		s.producer = p
+		t.Cleanup(func() { s.Close(ctx) })
+		return s, p
+	}
+
+	t.Run("missing-event", func(t *testing.T) {
+		s, _ := newSink(t, Enforced)
+		_, err := s.Process(ctx, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("not-formatted", func(t *testing.T) {
+		s, _ := newSink(t, Enforced)
+		_, err := s.Process(ctx, &eventlogger.Event{})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("enforced", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, p := newSink(t, Enforced)
+
+		// The event is produced before Process returns.
+		_, err := s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		assert.Equal([]string{`{"id":1}`}, p.values())
+
+		p.setError(sarama.ErrNotEnoughReplicas)
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		assert.ErrorIs(err, sarama.ErrNotEnoughReplicas)
+		assert.Equal([]string{`{"id":1}`}, p.values())
+	})
+	t.Run("best-effort", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, p := newSink(t, BestEffort)
+
+		var want []string
+		for _, v := range []string{`{"id":1}`, `{"id":2}`, `{"id":3}`} {
+			_, err := s.Process(ctx, testEvent(v))
+			require.NoError(err)
+			want = append(want, v)
+		}
+		require.NoError(s.FlushAll(ctx))
+		assert.Equal(want, p.values())
+
+		// Events which cannot be produced are dropped.
+		p.setError(sarama.ErrNotEnoughReplicas)
+		_, err := s.Process(ctx, testEvent(`{"id":4}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Equal(want, p.values())
+	})
+	t.Run("best-effort-queue-full", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &kafkaSink{
+			format:  string(JSONSinkFormat),
+			topic:   "events",
+			batcher: &sinkBatcher{queue: make(chan sinkBatchEvent, 1)},
+		}
+		_, err := s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		require.Len(s.batcher.queue, 1)
+		assert.Equal(`{"id":1}`, string((<-s.batcher.queue).val))
+	})
+	t.Run("flush-canceled", func(t *testing.T) {
+		s := &kafkaSink{batcher: &sinkBatcher{flush: make(chan chan struct{})}}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, s.FlushAll(canceled), context.Canceled)
+	})
+	t.Run("close", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, p := newSink(t, BestEffort)
+
+		// The queued events are produced and the producer is closed when the
+		// sink is closed.
+		_, err := s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		require.NoError(s.Close(ctx))
+		assert.Equal([]string{`{"id":1}`}, p.values())
+		assert.True(p.isClosed())
+
+		// Events processed after the sink is closed are dropped.
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		require.NoError(s.Close(ctx))
+		assert.Equal([]string{`{"id":1}`}, p.values())
+	})
+	t.Run("enforced-closed", func(t *testing.T) {
+		s, p := newSink(t, Enforced)
+		require.NoError(t, s.Close(ctx))
+		_, err := s.Process(ctx, testEvent(`{"id":1}`))
+		assert.ErrorIs(t, err, errSinkClosed)
+		assert.Empty(t, p.values())
+	})
+}
+
+func TestKafkaSink_Broker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testEvent := func(val string) *eventlogger.Event {
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(JSONSinkFormat), []byte(val+"\n"))
+		return e
+	}
+
+	t.Run("produce", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		b := newTestKafkaBroker(t, "events")
+		s, err := newKafkaSink(JSONSinkFormat, &KafkaSinkTypeConfig{Brokers: []string{b.Addr()}, Topic: "events", DeliveryGuarantee: Enforced, TlsDisable: true})
+		require.NoError(err)
+		t.Cleanup(func() { s.Close(ctx) })
+
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		require.NoError(err)
+		assert.Equal(1, testKafkaProduceRequests(b))
+
+		// Reopening drops the connections, which are dialed again for the
+		// next events.
+		require.NoError(s.Reopen())
+		_, err = s.Process(ctx, testEvent(`{"id":2}`))
+		require.NoError(err)
+		assert.Equal(2, testKafkaProduceRequests(b))
+	})
+	t.Run("produce-error", func(t *testing.T) {
+		b := newTestKafkaBroker(t, "events")
+		b.SetHandlerByMap(map[string]sarama.MockResponse{
+			"MetadataRequest": sarama.NewMockMetadataResponse(t).
+				SetBroker(b.Addr(), b.BrokerID()).
+				SetLeader("events", 0, b.BrokerID()),
+			"ProduceRequest": sarama.NewMockProduceResponse(t).
+				SetVersion(testKafkaProduceVersion).
+				SetError("events", 0, sarama.ErrInvalidMessage),
+		})
+		s, err := newKafkaSink(JSONSinkFormat, &KafkaSinkTypeConfig{Brokers: []string{b.Addr()}, Topic: "events", DeliveryGuarantee: Enforced, TlsDisable: true})
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close(ctx) })
+
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		assert.ErrorIs(t, err, sarama.ErrInvalidMessage)
+	})
+	t.Run("unreachable-brokers", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := l.Addr().String()
+		require.NoError(t, l.Close())
+		s, err := newKafkaSink(JSONSinkFormat, &KafkaSinkTypeConfig{Brokers: []string{addr}, Topic: "events", DeliveryGuarantee: Enforced, TlsDisable: true})
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close(ctx) })
+
+		_, err = s.Process(ctx, testEvent(`{"id":1}`))
+		assert.ErrorIs(t, err, sarama.ErrOutOfBrokers)
+	})
+}
+
+func TestEventer_KafkaSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "kafka-sink",
+				Type:       KafkaSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{AuditType},
+				KafkaConfig: &KafkaSinkTypeConfig{
+					Brokers:           []string{"127.0.0.1:9092"},
+					Topic:             "audit",
+					DeliveryGuarantee: BestEffort,
+					TlsDisable:        true,
+				},
+			},
+		},
+	}
+	require.NoError(c.Validate())
+	e, err := NewEventer(testLogger, testLock, "TestEventer_KafkaSink", c)
+	require.NoError(err)
+	require.Len(e.closableNodes, 1)
+	s, ok := e.closableNodes[0].(*kafkaSink)
+	require.True(ok)
+	p := &testKafkaProducer{}
+	s.producer = p
+
+	testAudit, err := newAudit(
+		"TestEventer_KafkaSink",
+		WithRequestInfo(TestRequestInfo(t)),
+		WithAuth(testAuth(t)),
+		WithRequest(testRequest(t)),
+		WithResponse(testResponse(t)),
+		WithFlush())
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, testAudit))
+	require.NoError(e.FlushNodes(ctx))
+
+	values := p.values()
+	require.Len(values, 1)
+	var got map[string]any
+	require.NoError(json.Unmarshal([]byte(values[0]), &got))
+	assert.Equal(string(AuditType), got["type"])
+	assert.Equal("https://hashicorp.com/boundary/TestEventer_KafkaSink", got["source"])
+	data, ok := got["data"].(map[string]any)
+	require.True(ok)
+	assert.Equal(testAudit.Id, data["id"])
+
+	// The audit config of the sink is applied, so the sensitive fields are
+	// redacted.
+	auth, ok := data["auth"].(map[string]any)
+	require.True(ok)
+	assert.Equal(encrypt.RedactedData, auth["email"])
+	assert.Equal(encrypt.RedactedData, auth["name"])
+
+	// Closing the eventer produces the queued events and closes the producer.
+	require.NoError(e.writeAudit(ctx, testAudit))
+	require.NoError(e.Close(ctx))
+	assert.Len(p.values(), 2)
+	assert.True(p.isClosed())
+}
+
+// testKafkaProducer is a stand-in for the producer of a kafka sink which keeps
+// the values of the produced records.
+type testKafkaProducer struct {
+	// The embedded interface is nil, only the methods used by the sink are
+	// implemented.
+	sarama.SyncProducer
+
+	mu     sync.Mutex
+	vals   []string
+	err    error
+	closed bool
+}
+
+func (p *testKafkaProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return errors.New("producer is closed")
+	}
+	if p.err != nil {
+		return p.err
+	}
+	for _, m := range msgs {
+		v, err := m.Value.Encode()
+		if err != nil {
+			return err
+		}
+		p.vals = append(p.vals, string(v))
+	}
+	return nil
+}
+
+func (p *testKafkaProducer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *testKafkaProducer) values() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.vals...)
+}
+
+// setError sets the error returned when producing records, records are not
+// kept while it is set.
+func (p *testKafkaProducer) setError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *testKafkaProducer) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closed
+}
+
+// testKafkaProduceVersion is the version of the produce requests sent by the
+// producer of a kafka sink, which the mock produce responses must match.
+const testKafkaProduceVersion = 3
+
+// newTestKafkaBroker returns a mock broker leading the single partition of the
+// topic, which accepts every produce request.
+func newTestKafkaBroker(t *testing.T, topic string) *sarama.MockBroker {
+	t.Helper()
+	b := sarama.NewMockBroker(t, 1)
+	b.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(b.Addr(), b.BrokerID()).
+			SetLeader(topic, 0, b.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(testKafkaProduceVersion),
+	})
+	t.Cleanup(b.Close)
+	return b
+}
+
+// testKafkaProduceRequests returns the number of produce requests the broker
+// received.
+func testKafkaProduceRequests(b *sarama.MockBroker) int {
+	var n int
+	for _, rr := range b.History() {
+		if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,233 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// errSinkClosed is returned for events sent to a sink which has been closed.
+var errSinkClosed = errors.New("sink is closed")
+
+// sinkBatcher queues the formatted events of a sink and sends them in batches
+// from a single goroutine. It is shared by the sinks which send events to a
+// remote endpoint, so their events are batched, flushed and drained on
+// shutdown the same way.
+//
+// Events are sent as soon as they are queued, along with whatever else is
+// queued at that time. With a batch interval the batcher first waits up to the
+// interval for the batch to fill up, so fewer batches are sent.
+type sinkBatcher struct {
+	batchSize     int
+	batchInterval time.Duration
+	send          func(batch [][]byte) error
+
+	queue     chan sinkBatchEvent
+	flush     chan chan struct{}
+	closing   chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// sinkBatchEvent is a formatted event queued by a sink. The result of sending
+// the batch of an event which must be delivered is sent to its sent channel.
+type sinkBatchEvent struct {
+	val  []byte
+	sent chan error
+}
+
+// newSinkBatcher starts a batcher sending the batches of the queued events
+// with send. Close must be called to stop it.
+func newSinkBatcher(queueSize, batchSize int, batchInterval time.Duration, send func(batch [][]byte) error) (*sinkBatcher, error) {
+	const op = "event.newSinkBatcher"
+	switch {
+	case queueSize <= 0:
+		return nil, fmt.Errorf("%s: queue size must be positive: %w", op, ErrInvalidParameter)
+	case batchSize <= 0:
+		return nil, fmt.Errorf("%s: batch size must be positive: %w", op, ErrInvalidParameter)
+	case batchInterval < 0:
+		return nil, fmt.Errorf("%s: batch interval must not be negative: %w", op, ErrInvalidParameter)
+	case send == nil:
+		return nil, fmt.Errorf("%s: missing send function: %w", op, ErrInvalidParameter)
+	}
+	b := &sinkBatcher{
+		batchSize:     batchSize,
+		batchInterval: batchInterval,
+		send:          send,
+		queue:         make(chan sinkBatchEvent, queueSize),
+		flush:         make(chan chan struct{}),
+		closing:       make(chan struct{}),
+		closed:        make(chan struct{}),
+	}
+	go b.run()
+	return b, nil
+}
+
+// enqueue queues the value and blocks until its batch has been sent. It
+// returns the error of sending the batch.
+func (b *sinkBatcher) enqueue(ctx context.Context, val []byte) error {
+	const op = "event.(sinkBatcher).enqueue"
+	ev := sinkBatchEvent{val: val, sent: make(chan error, 1)}
+	select {
+	case <-b.closing:
+		return fmt.Errorf("%s: %w", op, errSinkClosed)
+	default:
+	}
+	select {
+	case b.queue <- ev:
+	case <-b.closing:
+		return fmt.Errorf("%s: %w", op, errSinkClosed)
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+	select {
+	case err := <-ev.sent:
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	case <-b.closed:
+		// The batcher may have sent the event while it was closing.
+		select {
+		case err := <-ev.sent:
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			return nil
+		default:
+			return fmt.Errorf("%s: %w", op, errSinkClosed)
+		}
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// tryEnqueue queues the value without waiting for it to be sent. It reports
+// false when the value could not be queued, since the queue is full or the
+// batcher is closed.
+func (b *sinkBatcher) tryEnqueue(val []byte) bool {
+	select {
+	case <-b.closing:
+		return false
+	default:
+	}
+	select {
+	case b.queue <- sinkBatchEvent{val: val}:
+		return true
+	default:
+		return false
+	}
+}
+
+// flushAll sends the queued events. It blocks until the events queued before
+// it was called have been sent, or until ctx is done.
+func (b *sinkBatcher) flushAll(ctx context.Context) error {
+	const op = "event.(sinkBatcher).flushAll"
+	done := make(chan struct{})
+	select {
+	case b.flush <- done:
+	case <-b.closed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// close sends the queued events and stops the batcher. It blocks until the
+// batcher has stopped, or until ctx is done. Events queued after close is
+// called are rejected.
+func (b *sinkBatcher) close(ctx context.Context) error {
+	const op = "event.(sinkBatcher).close"
+	b.closeOnce.Do(func() { close(b.closing) })
+	select {
+	case <-b.closed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// run sends the queued events in batches until the batcher is closed.
+func (b *sinkBatcher) run() {
+	defer close(b.closed)
+	batch := make([]sinkBatchEvent, 0, b.batchSize)
+	for {
+		var flushed chan struct{}
+		var closing bool
+		select {
+		case ev := <-b.queue:
+			batch = append(batch, ev)
+		case flushed = <-b.flush:
+		case <-b.closing:
+			closing = true
+		}
+		if flushed == nil && !closing && b.batchInterval > 0 {
+			interval := time.NewTimer(b.batchInterval)
+		WAIT:
+			for len(batch) < b.batchSize {
+				select {
+				case ev := <-b.queue:
+					batch = append(batch, ev)
+				case flushed = <-b.flush:
+					break WAIT
+				case <-b.closing:
+					closing = true
+					break WAIT
+				case <-interval.C:
+					break WAIT
+				}
+			}
+			interval.Stop()
+		}
+		// Without a batch interval, or when flushing or closing, take whatever
+		// else is queued, so events are batched when they arrive faster than
+		// they are sent.
+	DRAIN:
+		for flushed != nil || closing || b.batchInterval == 0 {
+			if len(batch) == b.batchSize {
+				b.sendBatch(batch)
+				batch = batch[:0]
+			}
+			select {
+			case ev := <-b.queue:
+				batch = append(batch, ev)
+			default:
+				break DRAIN
+			}
+		}
+		b.sendBatch(batch)
+		batch = batch[:0]
+		if flushed != nil {
+			close(flushed)
+		}
+		if closing {
+			return
+		}
+	}
+}
+
+// sendBatch sends the batch and sends the result to the events of the batch
+// waiting for it.
+func (b *sinkBatcher) sendBatch(batch []sinkBatchEvent) {
+	if len(batch) == 0 {
+		return
+	}
+	vals := make([][]byte, 0, len(batch))
+	for _, ev := range batch {
+		vals = append(vals, ev.val)
+	}
+	err := b.send(vals)
+	for _, ev := range batch {
+		if ev.sent != nil {
+			ev.sent <- err
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newSinkBatcher(t *testing.T) {
+	t.Parallel()
+	send := func([][]byte) error { return nil }
+	tests := []struct {
+		name          string
+		queueSize     int
+		batchSize     int
+		batchInterval time.Duration
+		send          func([][]byte) error
+		wantErrIs     error
+	}{
+		{name: "valid", queueSize: 1, batchSize: 1, send: send},
+		{name: "missing-queue-size", batchSize: 1, send: send, wantErrIs: ErrInvalidParameter},
+		{name: "missing-batch-size", queueSize: 1, send: send, wantErrIs: ErrInvalidParameter},
+		{name: "negative-batch-interval", queueSize: 1, batchSize: 1, batchInterval: -time.Second, send: send, wantErrIs: ErrInvalidParameter},
+		{name: "missing-send", queueSize: 1, batchSize: 1, wantErrIs: ErrInvalidParameter},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := newSinkBatcher(tt.queueSize, tt.batchSize, tt.batchInterval, tt.send)
+			if tt.wantErrIs != nil {
+				assert.ErrorIs(t, err, tt.wantErrIs)
+				assert.Nil(t, b)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, b.close(context.Background()))
+		})
+	}
+}
+
+func TestSinkBatcher(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("enqueue", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &testSinkBatches{}
+		b, err := newSinkBatcher(10, 10, 0, s.send)
+		require.NoError(err)
+		t.Cleanup(func() { b.close(ctx) })
+
+		require.NoError(b.enqueue(ctx, []byte("one")))
+		assert.Equal([][]string{{"one"}}, s.get())
+
+		// The error of sending the batch is returned.
+		s.setError(errors.New("unavailable"))
+		assert.EqualError(b.enqueue(ctx, []byte("two")), "event.(sinkBatcher).enqueue: unavailable")
+	})
+	t.Run("batch-size", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &testSinkBatches{}
+		b, err := newSinkBatcher(10, 2, time.Minute, s.send)
+		require.NoError(err)
+		t.Cleanup(func() { b.close(ctx) })
+
+		for _, v := range []string{"one", "two", "three"} {
+			require.True(b.tryEnqueue([]byte(v)))
+		}
+		// The full batch is sent without waiting for the batch interval.
+		assert.Eventually(func() bool {
+			return len(s.get()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		require.NoError(b.flushAll(ctx))
+		assert.Equal([][]string{{"one", "two"}, {"three"}}, s.get())
+	})
+	t.Run("batch-interval", func(t *testing.T) {
+		s := &testSinkBatches{}
+		b, err := newSinkBatcher(10, 10, 10*time.Millisecond, s.send)
+		require.NoError(t, err)
+		t.Cleanup(func() { b.close(ctx) })
+
+		require.True(t, b.tryEnqueue([]byte("one")))
+		assert.Eventually(t, func() bool {
+			return len(s.get()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+	t.Run("queue-full", func(t *testing.T) {
+		b := &sinkBatcher{queue: make(chan sinkBatchEvent, 1)}
+		assert.True(t, b.tryEnqueue([]byte("one")))
+		assert.False(t, b.tryEnqueue([]byte("two")))
+	})
+	t.Run("enqueue-canceled", func(t *testing.T) {
+		b := &sinkBatcher{queue: make(chan sinkBatchEvent)}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, b.enqueue(canceled, []byte("one")), context.Canceled)
+	})
+	t.Run("flush-canceled", func(t *testing.T) {
+		b := &sinkBatcher{flush: make(chan chan struct{})}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, b.flushAll(canceled), context.Canceled)
+	})
+	t.Run("close", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &testSinkBatches{}
+		b, err := newSinkBatcher(10, 10, time.Minute, s.send)
+		require.NoError(err)
+
+		// The queued events are sent when the batcher is closed.
+		require.True(b.tryEnqueue([]byte("one")))
+		require.True(b.tryEnqueue([]byte("two")))
+		require.NoError(b.close(ctx))
+		assert.Equal([][]string{{"one", "two"}}, s.get())
+
+		// Events are rejected once the batcher is closed.
+		assert.False(b.tryEnqueue([]byte("three")))
+		assert.ErrorIs(b.enqueue(ctx, []byte("three")), errSinkClosed)
+		require.NoError(b.flushAll(ctx))
+		require.NoError(b.close(ctx))
+		assert.Equal([][]string{{"one", "two"}}, s.get())
+	})
+	t.Run("close-canceled", func(t *testing.T) {
+		b := &sinkBatcher{closing: make(chan struct{}), closed: make(chan struct{})}
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		assert.ErrorIs(t, b.close(canceled), context.Canceled)
+	})
+}
+
+// testSinkBatches keeps the batches sent by a sinkBatcher.
+type testSinkBatches struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (s *testSinkBatches) send(batch [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	vals := make([]string, 0, len(batch))
+	for _, v := range batch {
+		vals = append(vals, string(v))
+	}
+	s.batches = append(s.batches, vals)
+	return nil
+}
+
+func (s *testSinkBatches) get() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]string(nil), s.batches...)
+}
+
+func (s *testSinkBatches) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
@@ -3,6 +3,7 @@ package event
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"time"
 )
 
@@ -15,10 +16,13 @@ type SinkConfig struct {
 	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
 	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
 	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, or WriterSink).
+	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, WriterSink, KafkaSink, SyslogSink or HttpSink).
 	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
 	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
 	WriterConfig   *WriterSinkTypeConfig `hcl:"-"`                // WriterConfig defines parameters for an io.Writer output. This is not available via HCL.
+	KafkaConfig    *KafkaSinkTypeConfig  `hcl:"kafka"`            // KafkaConfig defines parameters for a Kafka output.
+	SyslogConfig   *SyslogSinkTypeConfig `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
+	HttpConfig     *HttpSinkTypeConfig   `hcl:"http"`             // HttpConfig defines parameters for an http output.
 	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
 }
 
@@ -41,6 +45,15 @@ func (sc *SinkConfig) Validate() error {
 	if sc.WriterConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.KafkaConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.HttpConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -68,6 +81,30 @@ func (sc *SinkConfig) Validate() error {
 		if sc.WriterConfig.Writer == nil {
 			return fmt.Errorf("%s: missing writer: %w", op, ErrInvalidParameter)
 		}
+	case KafkaSink:
+		if sc.KafkaConfig == nil {
+			return fmt.Errorf(`%s: missing "kafka" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.KafkaConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case SyslogSink:
+		if sc.SyslogConfig == nil {
+			return fmt.Errorf(`%s: missing "syslog" block: %w`, op, ErrInvalidParameter)
+		}
+		if sc.Format != SyslogSinkFormat {
+			return fmt.Errorf("%s: syslog sink requires the %s format: %w", op, SyslogSinkFormat, ErrInvalidParameter)
+		}
+		if err := sc.SyslogConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case HttpSink:
+		if sc.HttpConfig == nil {
+			return fmt.Errorf(`%s: missing "http" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.HttpConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -112,6 +149,194 @@ type WriterSinkTypeConfig struct {
 	Writer io.Writer `hcl:"-" mapstructure:"-"` // The writer to write to
 }
 
+// KafkaSinkTypeConfig contains configuration structures for kafka sink types
+type KafkaSinkTypeConfig struct {
+	Brokers           []string          `hcl:"brokers"            mapstructure:"brokers"`            // Brokers defines the host:port addresses of the brokers used to look up the topic
+	Topic             string            `hcl:"topic"              mapstructure:"topic"`              // Topic defines the topic events are produced to
+	ClientId          string            `hcl:"client_id"          mapstructure:"client_id"`          // ClientId defines an optional client id sent to the brokers, defaults to "boundary"
+	RequiredAcks      KafkaRequiredAcks `hcl:"required_acks"      mapstructure:"required_acks"`      // RequiredAcks defines which replicas must acknowledge events (all, leader or none), defaults to all
+	DeliveryGuarantee DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines if events are produced before returning (enforced) or queued and dropped when the queue is full (best-effort)
+	QueueSize         int               `hcl:"queue_size"         mapstructure:"queue_size"`         // QueueSize defines the number of events queued by the sink, defaults to 1024
+	Timeout           time.Duration     `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout for dialing and requests to the brokers, defaults to 10s
+	TimeoutHCL        string            `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	TlsDisable        bool              `hcl:"tls_disable"        mapstructure:"tls_disable"`        // TlsDisable defines if connections to the brokers are made without TLS
+	TlsCaFile         string            `hcl:"tls_ca_file"        mapstructure:"tls_ca_file"`        // TlsCaFile defines an optional PEM file of CAs used to verify the brokers
+	TlsCertFile       string            `hcl:"tls_cert_file"      mapstructure:"tls_cert_file"`      // TlsCertFile defines an optional PEM client certificate presented to the brokers
+	TlsKeyFile        string            `hcl:"tls_key_file"       mapstructure:"tls_key_file"`       // TlsKeyFile defines the PEM key of TlsCertFile
+	TlsServerName     string            `hcl:"tls_server_name"    mapstructure:"tls_server_name"`    // TlsServerName defines an optional name used to verify the certificates of the brokers
+	TlsSkipVerify     bool              `hcl:"tls_skip_verify"    mapstructure:"tls_skip_verify"`    // TlsSkipVerify defines if the certificates of the brokers are not verified
+}
+
+// Validate a KafkaSinkTypeConfig
+func (c *KafkaSinkTypeConfig) Validate() error {
+	const op = "event.(KafkaSinkTypeConfig).Validate"
+	if len(c.Brokers) == 0 {
+		return fmt.Errorf("%s: missing brokers: %w", op, ErrInvalidParameter)
+	}
+	if c.Topic == "" {
+		return fmt.Errorf("%s: missing topic: %w", op, ErrInvalidParameter)
+	}
+	if err := c.RequiredAcks.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.QueueSize < 0 {
+		return fmt.Errorf("%s: queue size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if (c.TlsCertFile == "") != (c.TlsKeyFile == "") {
+		return fmt.Errorf("%s: tls cert file and key file must be set together: %w", op, ErrInvalidParameter)
+	}
+	if c.TlsDisable && (c.TlsCaFile != "" || c.TlsCertFile != "" || c.TlsServerName != "" || c.TlsSkipVerify) {
+		return fmt.Errorf("%s: tls options set with tls disabled: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// KafkaRequiredAcks defines which replicas of a partition must acknowledge
+// an event before it is considered produced.
+type KafkaRequiredAcks string
+
+const (
+	KafkaDefaultAcks KafkaRequiredAcks = ""       // KafkaDefaultAcks will be KafkaAllAcks
+	KafkaAllAcks     KafkaRequiredAcks = "all"    // KafkaAllAcks waits for all in sync replicas
+	KafkaLeaderAcks  KafkaRequiredAcks = "leader" // KafkaLeaderAcks waits for the partition leader only
+	KafkaNoAcks      KafkaRequiredAcks = "none"   // KafkaNoAcks does not wait for any acknowledgement
+)
+
+func (a KafkaRequiredAcks) validate() error {
+	const op = "event.(KafkaRequiredAcks).validate"
+	switch a {
+	case KafkaDefaultAcks, KafkaAllAcks, KafkaLeaderAcks, KafkaNoAcks:
+		return nil
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid required acks: %w", op, a, ErrInvalidParameter)
+	}
+}
+
+// SyslogSinkTypeConfig contains configuration structures for syslog sink types
+type SyslogSinkTypeConfig struct {
+	Network           SyslogNetwork     `hcl:"network"            mapstructure:"network"`            // Network defines the transport used to send events (udp, tcp or tls), defaults to udp
+	Address           string            `hcl:"address"            mapstructure:"address"`            // Address defines the host:port address of the syslog server
+	DeliveryGuarantee DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines if events are sent before returning (enforced) or queued and dropped when the queue is full (best-effort)
+	QueueSize         int               `hcl:"queue_size"         mapstructure:"queue_size"`         // QueueSize defines the number of events queued by the sink, defaults to 1024
+	Timeout           time.Duration     `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout for dialing and writing to the syslog server, defaults to 10s
+	TimeoutHCL        string            `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	TlsCaFile         string            `hcl:"tls_ca_file"        mapstructure:"tls_ca_file"`        // TlsCaFile defines an optional PEM file of CAs used to verify the syslog server
+	TlsCertFile       string            `hcl:"tls_cert_file"      mapstructure:"tls_cert_file"`      // TlsCertFile defines an optional PEM client certificate presented to the syslog server
+	TlsKeyFile        string            `hcl:"tls_key_file"       mapstructure:"tls_key_file"`       // TlsKeyFile defines the PEM key of TlsCertFile
+	TlsServerName     string            `hcl:"tls_server_name"    mapstructure:"tls_server_name"`    // TlsServerName defines an optional name used to verify the certificate of the syslog server
+	TlsSkipVerify     bool              `hcl:"tls_skip_verify"    mapstructure:"tls_skip_verify"`    // TlsSkipVerify defines if the certificate of the syslog server is not verified
+}
+
+// Validate a SyslogSinkTypeConfig
+func (c *SyslogSinkTypeConfig) Validate() error {
+	const op = "event.(SyslogSinkTypeConfig).Validate"
+	if err := c.Network.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.Address == "" {
+		return fmt.Errorf("%s: missing address: %w", op, ErrInvalidParameter)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.QueueSize < 0 {
+		return fmt.Errorf("%s: queue size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if (c.TlsCertFile == "") != (c.TlsKeyFile == "") {
+		return fmt.Errorf("%s: tls cert file and key file must be set together: %w", op, ErrInvalidParameter)
+	}
+	if c.Network != SyslogTlsNetwork && (c.TlsCaFile != "" || c.TlsCertFile != "" || c.TlsServerName != "" || c.TlsSkipVerify) {
+		return fmt.Errorf("%s: tls options set without the %s network: %w", op, SyslogTlsNetwork, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// SyslogNetwork defines the transport used to send events to a syslog server.
+type SyslogNetwork string
+
+const (
+	SyslogDefaultNetwork SyslogNetwork = ""    // SyslogDefaultNetwork will be SyslogUdpNetwork
+	SyslogUdpNetwork     SyslogNetwork = "udp" // SyslogUdpNetwork sends each event as a datagram (RFC 5426)
+	SyslogTcpNetwork     SyslogNetwork = "tcp" // SyslogTcpNetwork sends octet counted events over TCP (RFC 6587)
+	SyslogTlsNetwork     SyslogNetwork = "tls" // SyslogTlsNetwork sends octet counted events over TLS (RFC 5425)
+)
+
+func (n SyslogNetwork) validate() error {
+	const op = "event.(SyslogNetwork).validate"
+	switch n {
+	case SyslogDefaultNetwork, SyslogUdpNetwork, SyslogTcpNetwork, SyslogTlsNetwork:
+		return nil
+	default:
+		return fmt.Errorf("%s: '%s' is not a valid syslog network: %w", op, n, ErrInvalidParameter)
+	}
+}
+
+// HttpSinkTypeConfig contains configuration structures for http sink types
+type HttpSinkTypeConfig struct {
+	Url               string            `hcl:"url"                mapstructure:"url"`                // Url defines the http or https endpoint batches of events are posted to
+	Headers           map[string]string `hcl:"headers"            mapstructure:"headers"`            // Headers defines optional headers added to every request
+	Gzip              bool              `hcl:"gzip"               mapstructure:"gzip"`               // Gzip defines if the request bodies are gzip compressed
+	BearerToken       string            `hcl:"bearer_token"       mapstructure:"bearer_token"`       // BearerToken defines an optional token sent in the Authorization header of every request
+	DeliveryGuarantee DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines if events are posted before returning (enforced) or queued and dropped when the queue is full (best-effort)
+	BatchSize         int               `hcl:"batch_size"         mapstructure:"batch_size"`         // BatchSize defines the maximum number of events posted in a single request, defaults to 100
+	BatchInterval     time.Duration     `hcl:"-" mapstructure:"batch_interval"`                      // BatchInterval defines how long a best-effort sink waits for a batch to fill up, defaults to 1s
+	BatchIntervalHCL  string            `hcl:"batch_interval" json:"-"`                              // BatchIntervalHCL defines hcl string version of BatchInterval
+	QueueSize         int               `hcl:"queue_size"         mapstructure:"queue_size"`         // QueueSize defines the number of events queued by the sink, defaults to 1024
+	Timeout           time.Duration     `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout of every request, defaults to 10s
+	TimeoutHCL        string            `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	TlsCaFile         string            `hcl:"tls_ca_file"        mapstructure:"tls_ca_file"`        // TlsCaFile defines an optional PEM file of CAs used to verify the endpoint
+	TlsCertFile       string            `hcl:"tls_cert_file"      mapstructure:"tls_cert_file"`      // TlsCertFile defines an optional PEM client certificate presented to the endpoint
+	TlsKeyFile        string            `hcl:"tls_key_file"       mapstructure:"tls_key_file"`       // TlsKeyFile defines the PEM key of TlsCertFile
+	TlsServerName     string            `hcl:"tls_server_name"    mapstructure:"tls_server_name"`    // TlsServerName defines an optional name used to verify the certificate of the endpoint
+	TlsSkipVerify     bool              `hcl:"tls_skip_verify"    mapstructure:"tls_skip_verify"`    // TlsSkipVerify defines if the certificate of the endpoint is not verified
+}
+
+// Validate a HttpSinkTypeConfig
+func (c *HttpSinkTypeConfig) Validate() error {
+	const op = "event.(HttpSinkTypeConfig).Validate"
+	if c.Url == "" {
+		return fmt.Errorf("%s: missing url: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return fmt.Errorf("%s: invalid url: %w", op, ErrInvalidParameter)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s: url must be an absolute http or https url: %w", op, ErrInvalidParameter)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if c.BatchSize < 0 {
+		return fmt.Errorf("%s: batch size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.BatchInterval < 0 {
+		return fmt.Errorf("%s: batch interval must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.QueueSize < 0 {
+		return fmt.Errorf("%s: queue size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if (c.TlsCertFile == "") != (c.TlsKeyFile == "") {
+		return fmt.Errorf("%s: tls cert file and key file must be set together: %w", op, ErrInvalidParameter)
+	}
+	if u.Scheme != "https" && (c.TlsCaFile != "" || c.TlsCertFile != "" || c.TlsServerName != "" || c.TlsSkipVerify) {
+		return fmt.Errorf("%s: tls options set without an https url: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
 // FilterType defines a type for filters (allow or deny)
 type FilterType string
 
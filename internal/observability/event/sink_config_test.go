@@ -204,6 +204,325 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `too many sink type config blocks`,
 		},
+		{
+			name: "kafka-sink-missing-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       KafkaSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "kafka" block`,
+		},
+		{
+			name: "kafka-sink-missing-brokers",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Topic: "events"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing brokers",
+		},
+		{
+			name: "kafka-sink-missing-topic",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing topic",
+		},
+		{
+			name: "kafka-sink-invalid-required-acks",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}, Topic: "events", RequiredAcks: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid required acks",
+		},
+		{
+			name: "kafka-sink-invalid-delivery-guarantee",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}, Topic: "events", DeliveryGuarantee: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name: "kafka-sink-cert-without-key",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}, Topic: "events", TlsCertFile: "cert.pem"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls cert file and key file must be set together",
+		},
+		{
+			name: "kafka-sink-tls-options-with-tls-disabled",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}, Topic: "events", TlsDisable: true, TlsCaFile: "ca.pem"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls options set with tls disabled",
+		},
+		{
+			name: "type mismatch kafka type file config",
+			sc: SinkConfig{
+				Name:        "sink-name",
+				EventTypes:  []Type{EveryType},
+				Type:        KafkaSink,
+				Format:      JSONSinkFormat,
+				FileConfig:  &FileSinkTypeConfig{FileName: "tmp.file"},
+				KafkaConfig: &KafkaSinkTypeConfig{Brokers: []string{"localhost:9092"}, Topic: "events"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `too many sink type config blocks`,
+		},
+		{
+			name: "valid-kafka",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       KafkaSink,
+				Format:     JSONSinkFormat,
+				KafkaConfig: &KafkaSinkTypeConfig{
+					Brokers:           []string{"localhost:9092"},
+					Topic:             "events",
+					RequiredAcks:      KafkaLeaderAcks,
+					DeliveryGuarantee: Enforced,
+				},
+			},
+		},
+		{
+			name: "syslog-sink-missing-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     SyslogSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "syslog" block`,
+		},
+		{
+			name: "syslog-sink-wrong-format",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "localhost:514"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "syslog sink requires the syslog format",
+		},
+		{
+			name: "syslog-sink-missing-address",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: SyslogTcpNetwork},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing address",
+		},
+		{
+			name: "syslog-sink-invalid-network",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: "unix", Address: "/dev/log"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog network",
+		},
+		{
+			name: "syslog-sink-invalid-delivery-guarantee",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "localhost:514", DeliveryGuarantee: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name: "syslog-sink-negative-queue-size",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "localhost:514", QueueSize: -1},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "queue size must not be negative",
+		},
+		{
+			name: "syslog-sink-tls-options-without-tls",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: SyslogTcpNetwork, Address: "localhost:601", TlsCaFile: "ca.pem"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls options set without the tls network",
+		},
+		{
+			name: "syslog-sink-cert-without-key",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Network: SyslogTlsNetwork, Address: "localhost:6514", TlsCertFile: "cert.pem"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls cert file and key file must be set together",
+		},
+		{
+			name: "valid-syslog",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       SyslogSink,
+				Format:     SyslogSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network:   SyslogTlsNetwork,
+					Address:   "localhost:6514",
+					TlsCaFile: "ca.pem",
+				},
+			},
+		},
+		{
+			name: "valid-syslog-format-file-sink",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: SyslogSinkFormat,
+			},
+		},
+		{
+			name: "http-sink-missing-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "http" block`,
+		},
+		{
+			name: "http-sink-missing-url",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing url",
+		},
+		{
+			name: "http-sink-invalid-url",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "ftp://siem.example.com/events"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "url must be an absolute http or https url",
+		},
+		{
+			name: "http-sink-invalid-delivery-guarantee",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "https://siem.example.com/events", DeliveryGuarantee: "invalid"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name: "http-sink-negative-batch-size",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "https://siem.example.com/events", BatchSize: -1},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "batch size must not be negative",
+		},
+		{
+			name: "http-sink-tls-options-without-https",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "http://siem.example.com/events", TlsCaFile: "ca.pem"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls options set without an https url",
+		},
+		{
+			name: "valid-http",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:               "https://siem.example.com/events",
+					DeliveryGuarantee: Enforced,
+					Gzip:              true,
+					TlsCertFile:       "cert.pem",
+					TlsKeyFile:        "key.pem",
+				},
+			},
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
@@ -9,6 +9,7 @@ const (
 	TextSinkFormat      SinkFormat = "cloudevents-text" // TextSinkFormat means the event is formmatted as text
 	TextHclogSinkFormat SinkFormat = "hclog-text"       // TextHclogSinkFormat means the event is formatted as an hclog text entry
 	JSONHclogSinkFormat SinkFormat = "hclog-json"       // JSONHclogSinkFormat means the event is formated as an hclog json entry
+	SyslogSinkFormat    SinkFormat = "syslog"           // SyslogSinkFormat means the event is formatted as an RFC 5424 syslog message
 )
 
 type SinkFormat string // SinkFormat defines the formatting for a sink in a config file stanza (json)
@@ -20,6 +21,8 @@ func (f SinkFormat) Validate() error {
 		return nil
 	case TextHclogSinkFormat, JSONHclogSinkFormat:
 		return nil
+	case SyslogSinkFormat:
+		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink format: %w", op, f, ErrInvalidParameter)
 	}
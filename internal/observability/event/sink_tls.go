@@ -0,0 +1,38 @@
+package event
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// sinkTlsConfig returns the client TLS config of a sink which connects to a
+// remote server. The optional caFile is used to verify the server and the
+// optional certFile and keyFile are presented as the client certificate.
+func sinkTlsConfig(caFile, certFile, keyFile, serverName string, skipVerify bool) (*tls.Config, error) {
+	const op = "event.sinkTlsConfig"
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         serverName,
+		InsecureSkipVerify: skipVerify,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to read ca file: %w", op, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found in ca file %s: %w", op, caFile, ErrInvalidParameter)
+		}
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to load client certificate: %w", op, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,112 @@
+package event
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sinkTlsConfig(t *testing.T) {
+	t.Parallel()
+	tc := testSinkTls(t, "events.example.com")
+
+	t.Run("defaults", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, err := sinkTlsConfig("", "", "", "", false)
+		require.NoError(err)
+		assert.Equal(uint16(tls.VersionTLS12), c.MinVersion)
+		assert.Nil(c.RootCAs)
+		assert.Empty(c.Certificates)
+		assert.False(c.InsecureSkipVerify)
+	})
+	t.Run("all-options", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c, err := sinkTlsConfig(tc.caFile, tc.certFile, tc.keyFile, "events.example.com", true)
+		require.NoError(err)
+		assert.NotNil(c.RootCAs)
+		assert.Len(c.Certificates, 1)
+		assert.Equal("events.example.com", c.ServerName)
+		assert.True(c.InsecureSkipVerify)
+	})
+	t.Run("missing-ca-file", func(t *testing.T) {
+		_, err := sinkTlsConfig(filepath.Join(t.TempDir(), "missing.pem"), "", "", "", false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to read ca file")
+	})
+	t.Run("invalid-ca-file", func(t *testing.T) {
+		_, err := sinkTlsConfig(tc.keyFile, "", "", "", false)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("invalid-cert-file", func(t *testing.T) {
+		_, err := sinkTlsConfig("", tc.caFile, tc.caFile, "", false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to load client certificate")
+	})
+}
+
+// testSinkTlsFiles are the files and the server config of a self signed
+// certificate, which is both the CA and the server and client certificate.
+type testSinkTlsFiles struct {
+	caFile       string
+	certFile     string
+	keyFile      string
+	serverConfig *tls.Config
+}
+
+// testSinkTls returns a self signed certificate for the serverName. The server
+// config requires clients to present a certificate issued by it.
+func testSinkTls(t *testing.T, serverName string) testSinkTlsFiles {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: serverName},
+		DNSNames:              []string{serverName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(err)
+
+	dir := t.TempDir()
+	f := testSinkTlsFiles{
+		caFile:   filepath.Join(dir, "ca.pem"),
+		certFile: filepath.Join(dir, "cert.pem"),
+		keyFile:  filepath.Join(dir, "key.pem"),
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	require.NoError(os.WriteFile(f.caFile, certPem, 0o600))
+	require.NoError(os.WriteFile(f.certFile, certPem, 0o600))
+	require.NoError(os.WriteFile(f.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	f.serverConfig = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	}
+	return f
+}
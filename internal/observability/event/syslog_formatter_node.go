@@ -0,0 +1,244 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+const (
+	syslogNodeName = "syslog-formatter-filter"
+
+	// syslogAppName is the APP-NAME of the syslog messages.
+	syslogAppName = "boundary"
+
+	// syslogTimestampFormat is the RFC 3339 TIMESTAMP format of the syslog
+	// messages, RFC 5424 allows at most microseconds.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogFacility is a facility of RFC 5424.
+type syslogFacility int
+
+const (
+	syslogDaemonFacility   syslogFacility = 3  // system daemons
+	syslogLogAuditFacility syslogFacility = 13 // log audit
+)
+
+// syslogSeverity is a severity of RFC 5424.
+type syslogSeverity int
+
+const (
+	syslogErrorSeverity  syslogSeverity = 3 // error conditions
+	syslogNoticeSeverity syslogSeverity = 5 // normal but significant condition
+	syslogInfoSeverity   syslogSeverity = 6 // informational messages
+)
+
+// syslogPriority returns the facility and severity of the syslog messages of
+// the event type. Audit events use the log audit facility, all other events
+// the system daemons facility.
+func syslogPriority(t eventlogger.EventType) (syslogFacility, syslogSeverity, error) {
+	const op = "event.syslogPriority"
+	switch Type(t) {
+	case AuditType:
+		return syslogLogAuditFacility, syslogNoticeSeverity, nil
+	case ErrorType:
+		return syslogDaemonFacility, syslogErrorSeverity, nil
+	case ObservationType, SystemType:
+		return syslogDaemonFacility, syslogInfoSeverity, nil
+	default:
+		return 0, 0, fmt.Errorf("%s: unknown event type %s: %w", op, t, ErrInvalidParameter)
+	}
+}
+
+// syslogFormatterFilter will format a boundary event as an RFC 5424 syslog
+// message. The MSG of the message is the JSON of the event and its MSGID is
+// the event type.
+type syslogFormatterFilter struct {
+	hostname  string
+	procId    string
+	predicate func(ctx context.Context, i interface{}) (bool, error)
+	allow     []*filter
+	deny      []*filter
+	signer    signer
+	l         sync.RWMutex
+}
+
+// newSyslogFormatterFilter creates a new syslog formatter node using the
+// optional allow and deny filters provided. Support for WithAllow and
+// WithDeny options.
+func newSyslogFormatterFilter(opt ...Option) (*syslogFormatterFilter, error) {
+	const op = "event.newSyslogFormatterFilter"
+	opts := getOpts(opt...)
+	n := syslogFormatterFilter{
+		hostname: syslogHeaderField(hostname(), 255),
+		procId:   strconv.Itoa(os.Getpid()),
+	}
+	// intentionally not checking if allow and/or deny optional filters were
+	// supplied since having a filter node with no filters is okay.
+
+	if len(opts.withAllow) > 0 {
+		n.allow = make([]*filter, 0, len((opts.withAllow)))
+		for i := range opts.withAllow {
+			f, err := newFilter(opts.withAllow[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid allow filter '%s': %w", op, opts.withAllow[i], err)
+			}
+			n.allow = append(n.allow, f)
+		}
+	}
+	if len(opts.withDeny) > 0 {
+		n.deny = make([]*filter, 0, len((opts.withDeny)))
+		for i := range opts.withDeny {
+			f, err := newFilter(opts.withDeny[i])
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid deny filter '%s': %w", op, opts.withDeny[i], err)
+			}
+			n.deny = append(n.deny, f)
+		}
+	}
+	// The filters are applied to the event payload like the hclog filters.
+	defaultDenyFilters, err := defaultHclogEventsDenyFilters()
+	if err != nil {
+		return nil, err
+	}
+	n.deny = append(n.deny, defaultDenyFilters...)
+	n.predicate = newPredicate(n.allow, n.deny)
+
+	return &n, nil
+}
+
+func hostname() string {
+	h, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return h
+}
+
+// syslogHeaderField returns s as a header field of at most maxLen printable
+// US-ASCII characters, or the nil value "-" when s is empty.
+func syslogHeaderField(s string, maxLen int) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s) && len(b) < maxLen; i++ {
+		if s[i] >= 33 && s[i] <= 126 {
+			b = append(b, s[i])
+		}
+	}
+	if len(b) == 0 {
+		return "-"
+	}
+	return string(b)
+}
+
+// Rotate supports rotating the filter's wrapper. No options are currently
+// supported.
+func (f *syslogFormatterFilter) Rotate(w wrapping.Wrapper, _ ...Option) error {
+	const op = "event.(syslogFormatterFilter).Rotate"
+	if w == nil {
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	f.l.Lock()
+	defer f.l.Unlock()
+	h, err := newSigner(context.Background(), w, nil, nil)
+	if err != nil {
+		return err
+	}
+	f.signer = h
+	return nil
+}
+
+// Reopen is a no op
+func (_ *syslogFormatterFilter) Reopen() error { return nil }
+
+// Type describes the type of the node as a Formatter.
+func (_ *syslogFormatterFilter) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFormatterFilter
+}
+
+// Name returns a representation of the syslogFormatterFilter's name
+func (_ *syslogFormatterFilter) Name() string {
+	return syslogNodeName
+}
+
+// Process formats the Boundary event as an RFC 5424 syslog message followed
+// by a newline and stores that formatted data in Event.Formatted with a key of
+// "syslog" (SyslogSinkFormat).
+//
+// If the node has a Predicate, then the filter will be applied to event.Payload
+func (f *syslogFormatterFilter) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogFormatterFilter).Process"
+	if e == nil {
+		return nil, errors.New("event is nil")
+	}
+	facility, severity, err := syslogPriority(e.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if f.predicate != nil {
+		// Use the predicate to see if we want to keep the event using it's
+		// formatted struct as a parmeter to the predicate.
+		keep, err := f.predicate(ctx, e.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to filter: %w", op, err)
+		}
+		if !keep {
+			// Return nil to signal that the event should be discarded.
+			return nil, nil
+		}
+	}
+
+	msg, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to format: %w", op, err)
+	}
+	f.l.RLock()
+	sign := f.signer
+	f.l.RUnlock()
+	if sign != nil && Type(e.Type) == AuditType {
+		msgHmac, err := sign(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to hmac-sha256: %w", op, err)
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(msg, &m); err != nil {
+			return nil, fmt.Errorf("%s: unable to format after hmac-sha256: %w", op, err)
+		}
+		m["serialized"] = base64.RawURLEncoding.EncodeToString(msg)
+		m["serialized_hmac"] = msgHmac
+		if msg, err = json.Marshal(m); err != nil {
+			return nil, fmt.Errorf("%s: unable to format after hmac-sha256: %w", op, err)
+		}
+	}
+
+	createdAt := e.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %s %s - ",
+		int(facility)*8+int(severity),
+		createdAt.UTC().Format(syslogTimestampFormat),
+		f.hostname,
+		syslogAppName,
+		f.procId,
+		syslogHeaderField(string(e.Type), 32),
+	)
+	buf.Write(msg)
+	buf.WriteByte('\n')
+	e.FormattedAs(string(SyslogSinkFormat), buf.Bytes())
+	return e, nil
+}
+
+var _ eventlogger.Node = &syslogFormatterFilter{}
@@ -0,0 +1,255 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogFormatter_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	f, e := newFilter(`op == "match-filter"`)
+	require.NoError(t, e)
+
+	testPredicate := newPredicate([]*filter{f}, nil)
+	testCreatedAt := time.Date(2022, 10, 11, 22, 14, 15, 3000, time.UTC)
+
+	tests := []struct {
+		name            string
+		formatter       *syslogFormatterFilter
+		e               *eventlogger.Event
+		wantErrContains string
+		want            string
+	}{
+		{
+			name:            "nil event",
+			formatter:       &syslogFormatterFilter{hostname: "host", procId: "42"},
+			wantErrContains: "event is nil",
+		},
+		{
+			name:            "invalid-event-type",
+			formatter:       &syslogFormatterFilter{hostname: "host", procId: "42"},
+			e:               &eventlogger.Event{Type: eventlogger.EventType("invalid-type")},
+			wantErrContains: "unknown event type invalid-type",
+		},
+		{
+			name:      "sys",
+			formatter: &syslogFormatterFilter{hostname: "host", procId: "42"},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(SystemType),
+				CreatedAt: testCreatedAt,
+				Payload: &sysEvent{
+					Id:      "1",
+					Version: sysVersion,
+					Op:      Op("text"),
+					Data: map[string]interface{}{
+						"msg": "hello",
+					},
+				},
+			},
+			want: `<30>1 2022-10-11T22:14:15.000003Z host boundary 42 system - {"version":"v0.1","op":"text","data":{"msg":"hello"}}` + "\n",
+		},
+		{
+			name:      "observation",
+			formatter: &syslogFormatterFilter{hostname: "host", procId: "42"},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(ObservationType),
+				CreatedAt: testCreatedAt,
+				Payload: map[string]interface{}{
+					"id":         "1",
+					"version":    observationVersion,
+					"latency-ms": 10,
+				},
+			},
+			want: `<30>1 2022-10-11T22:14:15.000003Z host boundary 42 observation - {"id":"1","latency-ms":10,"version":"v0.1"}` + "\n",
+		},
+		{
+			name:      "err",
+			formatter: &syslogFormatterFilter{hostname: "host", procId: "42"},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(ErrorType),
+				CreatedAt: testCreatedAt,
+				Payload: &err{
+					Id:      "1",
+					Version: errorVersion,
+					Error:   ErrInvalidParameter.Error(),
+					Op:      Op("text"),
+				},
+			},
+			want: `<27>1 2022-10-11T22:14:15.000003Z host boundary 42 error - {"error":"invalid parameter","error_fields":null,"id":"1","version":"v0.1","op":"text"}` + "\n",
+		},
+		{
+			name:      "audit",
+			formatter: &syslogFormatterFilter{hostname: "host", procId: "42"},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(AuditType),
+				CreatedAt: testCreatedAt,
+				Payload: &audit{
+					Id:      "1",
+					Version: auditVersion,
+					Type:    string(ApiRequest),
+				},
+			},
+			want: `<109>1 2022-10-11T22:14:15.000003Z host boundary 42 audit - {"id":"1","version":"v0.1","type":"APIRequest","timestamp":"0001-01-01T00:00:00Z"}` + "\n",
+		},
+		{
+			name: "filter-match",
+			formatter: &syslogFormatterFilter{
+				hostname:  "host",
+				procId:    "42",
+				predicate: testPredicate,
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(SystemType),
+				CreatedAt: testCreatedAt,
+				Payload: &sysEvent{
+					Id:      "1",
+					Version: sysVersion,
+					Op:      Op("match-filter"),
+				},
+			},
+			want: `<30>1 2022-10-11T22:14:15.000003Z host boundary 42 system - {"version":"v0.1","op":"match-filter","data":null}` + "\n",
+		},
+		{
+			name: "filter-no-match",
+			formatter: &syslogFormatterFilter{
+				hostname:  "host",
+				procId:    "42",
+				predicate: testPredicate,
+			},
+			e: &eventlogger.Event{
+				Type:      eventlogger.EventType(SystemType),
+				CreatedAt: testCreatedAt,
+				Payload: &sysEvent{
+					Id:      "1",
+					Version: sysVersion,
+					Op:      Op("doesn't match"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			e, err := tt.formatter.Process(ctx, tt.e)
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			if tt.want == "" {
+				assert.Nil(e)
+				return
+			}
+			require.NotNil(e)
+			b, ok := e.Format(string(SyslogSinkFormat))
+			require.True(ok)
+			assert.Equal(tt.want, string(b))
+		})
+	}
+	t.Run("with-signing", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		wrapper := testWrapper(t)
+		f, err := newSyslogFormatterFilter()
+		require.NoError(err)
+		require.NoError(f.Rotate(wrapper))
+		require.NotNil(f.signer)
+
+		e := &eventlogger.Event{
+			Type: eventlogger.EventType(AuditType),
+			Payload: &audit{
+				Id:      "1",
+				Version: auditVersion,
+				Auth:    &Auth{UserName: "alice"},
+			},
+		}
+
+		gotEvent, err := f.Process(ctx, e)
+		require.NoError(err)
+		b, ok := gotEvent.Format(string(SyslogSinkFormat))
+		require.True(ok)
+		_, msg, found := strings.Cut(string(b), " - ")
+		require.True(found)
+		var rep map[string]interface{}
+		require.NoError(json.Unmarshal([]byte(msg), &rep))
+		assert.NotEmpty(rep["serialized"])
+		assert.NotEmpty(rep["serialized_hmac"])
+	})
+}
+
+func Test_syslogPriority(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		eventType    Type
+		wantFacility syslogFacility
+		wantSeverity syslogSeverity
+		wantErr      bool
+	}{
+		{eventType: AuditType, wantFacility: syslogLogAuditFacility, wantSeverity: syslogNoticeSeverity},
+		{eventType: ObservationType, wantFacility: syslogDaemonFacility, wantSeverity: syslogInfoSeverity},
+		{eventType: ErrorType, wantFacility: syslogDaemonFacility, wantSeverity: syslogErrorSeverity},
+		{eventType: SystemType, wantFacility: syslogDaemonFacility, wantSeverity: syslogInfoSeverity},
+		{eventType: EveryType, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.eventType), func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			facility, severity, err := syslogPriority(eventlogger.EventType(tt.eventType))
+			if tt.wantErr {
+				require.Error(err)
+				assert.ErrorIs(err, ErrInvalidParameter)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantFacility, facility)
+			assert.Equal(tt.wantSeverity, severity)
+		})
+	}
+}
+
+func Test_syslogHeaderField(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal("-", syslogHeaderField("", 255))
+	assert.Equal("-", syslogHeaderField(" \n", 255))
+	assert.Equal("host.example.com", syslogHeaderField("host.example.com", 255))
+	assert.Equal("myhost", syslogHeaderField("my host", 255))
+	assert.Equal("abc", syslogHeaderField("abcdef", 3))
+}
+
+func Test_newSyslogFormatterFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("bad-allow-filter", func(t *testing.T) {
+		_, err := newSyslogFormatterFilter(WithAllow("foo=;22", "foo==bar"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid allow filter 'foo=;22'")
+	})
+	t.Run("bad-deny-filter", func(t *testing.T) {
+		_, err := newSyslogFormatterFilter(WithDeny("foo=;22", "foo==bar"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid deny filter 'foo=;22'")
+	})
+	t.Run("filters", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := newSyslogFormatterFilter(WithAllow(`"/Id" == "1"`), WithDeny(`"/Id" == "2"`))
+		require.NoError(err)
+		assert.NotEmpty(f.hostname)
+		assert.NotEmpty(f.procId)
+		assert.Len(f.allow, 1)
+		// the default deny filters are appended
+		assert.Len(f.deny, 2)
+		assert.NotNil(f.predicate)
+		assert.Equal(syslogNodeName, f.Name())
+		assert.Equal(eventlogger.NodeTypeFormatterFilter, f.Type())
+		assert.NoError(f.Reopen())
+	})
+}
@@ -0,0 +1,250 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	// syslogDefaultTimeout is the timeout for dialing and writing to the syslog
+	// server when no timeout is configured.
+	syslogDefaultTimeout = 10 * time.Second
+
+	// syslogDefaultQueueSize is the number of events queued by a syslog sink
+	// when no queue size is configured.
+	syslogDefaultQueueSize = 1024
+
+	// syslogMaxBatchSize is the maximum number of queued events written to
+	// the syslog server at once.
+	syslogMaxBatchSize = 128
+)
+
+// syslogSink is an eventlogger sink node which sends the formatted events to a
+// syslog server.
+//
+// Over UDP every event is sent as a single datagram. Over TCP and TLS the
+// events are framed using octet counting, which allows the messages to contain
+// newlines.
+//
+// With an enforced delivery guarantee every event is sent before Process
+// returns and errors are returned, so the eventer retries sending the event.
+// Otherwise events are queued and sent in batches in the background. They are
+// dropped when the queue is full or when they cannot be sent. The queued
+// events are sent when the sink is closed.
+type syslogSink struct {
+	format            string
+	network           SyslogNetwork
+	address           string
+	timeout           time.Duration
+	tlsConfig         *tls.Config
+	deliveryGuarantee DeliveryGuarantee
+	batcher           *sinkBatcher
+
+	// l guards conn, which is dialed when the first events are sent and after
+	// it failed or the sink is reopened.
+	l    sync.Mutex
+	conn net.Conn
+}
+
+var _ eventlogger.Node = (*syslogSink)(nil)
+
+func newSyslogSink(format SinkFormat, c *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing config: %w", op, ErrInvalidParameter)
+	}
+	s := &syslogSink{
+		format:            string(format),
+		network:           c.Network,
+		address:           c.Address,
+		timeout:           c.Timeout,
+		deliveryGuarantee: c.DeliveryGuarantee,
+	}
+	if s.network == SyslogDefaultNetwork {
+		s.network = SyslogUdpNetwork
+	}
+	if s.timeout == 0 {
+		s.timeout = syslogDefaultTimeout
+	}
+	if s.network == SyslogTlsNetwork {
+		var err error
+		if s.tlsConfig, err = sinkTlsConfig(c.TlsCaFile, c.TlsCertFile, c.TlsKeyFile, c.TlsServerName, c.TlsSkipVerify); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	queueSize := c.QueueSize
+	if queueSize == 0 {
+		queueSize = syslogDefaultQueueSize
+	}
+	var err error
+	if s.batcher, err = newSinkBatcher(queueSize, syslogMaxBatchSize, 0, s.sendBatch); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return s, nil
+}
+
+// Process will send the event to the syslog server.
+func (s *syslogSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	msg, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled: %w", op, ErrInvalidParameter)
+	}
+	// Each event is its own message, so the newline separating the events
+	// written by the other sinks is dropped.
+	msg = bytes.TrimSuffix(msg, []byte("\n"))
+	if s.network != SyslogUdpNetwork {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	if s.deliveryGuarantee == Enforced {
+		if err := s.batcher.enqueue(ctx, msg); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		// Sinks are leafs, so do not return the event, since nothing more can
+		// happen to it downstream.
+		return nil, nil
+	}
+	if !s.batcher.tryEnqueue(msg) {
+		fallbackLogger().Error("syslog sink queue is full or closed, dropping event", "operation", op, "address", s.address)
+	}
+	return nil, nil
+}
+
+// sendBatch writes the messages of the batch to the syslog server.
+func (s *syslogSink) sendBatch(batch [][]byte) error {
+	const op = "event.(syslogSink).sendBatch"
+	err := s.send(batch)
+	if err != nil && s.deliveryGuarantee != Enforced {
+		fallbackLogger().Error("unable to send events, dropping them", "operation", op, "address", s.address, "events", len(batch), "error", err)
+	}
+	return err
+}
+
+// send writes the messages to the syslog server. Over UDP every message is
+// written as its own datagram, otherwise the octet counted messages are
+// written at once.
+func (s *syslogSink) send(msgs [][]byte) error {
+	const op = "event.(syslogSink).send"
+	if s.network != SyslogUdpNetwork {
+		msgs = [][]byte{bytes.Join(msgs, nil)}
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	for _, msg := range msgs {
+		err := s.write(msg)
+		if err != nil && s.conn != nil {
+			// The connection may have been closed by the server since the
+			// last events, so a new connection is dialed once.
+			s.closeConn()
+			err = s.write(msg)
+		}
+		if err != nil {
+			s.closeConn()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// write sends the msg over the connection to the syslog server, dialing it
+// first when needed. The caller must hold the lock.
+func (s *syslogSink) write(msg []byte) error {
+	const op = "event.(syslogSink).write"
+	if s.conn == nil {
+		conn, err := s.dial()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := s.conn.Write(msg); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *syslogSink) dial() (net.Conn, error) {
+	const op = "event.(syslogSink).dial"
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	var conn net.Conn
+	var err error
+	switch s.network {
+	case SyslogTlsNetwork:
+		d := &tls.Dialer{Config: s.tlsConfig}
+		conn, err = d.DialContext(ctx, "tcp", s.address)
+	default:
+		var d net.Dialer
+		conn, err = d.DialContext(ctx, string(s.network), s.address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to dial %s: %w", op, s.address, err)
+	}
+	return conn, nil
+}
+
+// closeConn closes the connection to the syslog server. The caller must hold
+// the lock.
+func (s *syslogSink) closeConn() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// Reopen closes the connection to the syslog server, it is dialed again for
+// the next event.
+func (s *syslogSink) Reopen() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.closeConn()
+}
+
+// Type defines the syslogSink as a NodeTypeSink
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// FlushAll sends the queued events. It blocks until the events queued before
+// it was called have been sent or dropped, or until ctx is done.
+func (s *syslogSink) FlushAll(ctx context.Context) error {
+	const op = "event.(syslogSink).FlushAll"
+	if err := s.batcher.flushAll(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Close sends the queued events and stops the sink. It blocks until the
+// queued events have been sent or dropped, or until ctx is done. Events
+// processed after Close is called are dropped, or returned as an error with
+// an enforced delivery guarantee.
+func (s *syslogSink) Close(ctx context.Context) error {
+	const op = "event.(syslogSink).Close"
+	if err := s.batcher.close(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	if err := s.closeConn(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
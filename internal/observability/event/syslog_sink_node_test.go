@@ -0,0 +1,336 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogSink_Process(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testEvent := func(msg string) *eventlogger.Event {
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(SyslogSinkFormat), []byte(msg+"\n"))
+		return e
+	}
+
+	newSink := func(t *testing.T, c *SyslogSinkTypeConfig) *syslogSink {
+		t.Helper()
+		s, err := newSyslogSink(SyslogSinkFormat, c)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close(ctx) })
+		return s
+	}
+
+	t.Run("missing-event", func(t *testing.T) {
+		s := newSink(t, &SyslogSinkTypeConfig{Address: "localhost:514"})
+		_, err := s.Process(ctx, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("not-formatted", func(t *testing.T) {
+		s := newSink(t, &SyslogSinkTypeConfig{Address: "localhost:514"})
+		_, err := s.Process(ctx, &eventlogger.Event{})
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("udp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(err)
+		t.Cleanup(func() { pc.Close() })
+
+		s := newSink(t, &SyslogSinkTypeConfig{Address: pc.LocalAddr().String()})
+		assert.Equal(SyslogUdpNetwork, s.network)
+
+		for _, msg := range []string{"<30>1 first", "<30>1 second"} {
+			_, err := s.Process(ctx, testEvent(msg))
+			require.NoError(err)
+
+			buf := make([]byte, 1024)
+			require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+			n, _, err := pc.ReadFrom(buf)
+			require.NoError(err)
+			assert.Equal(msg, string(buf[:n]))
+		}
+	})
+	t.Run("tcp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l := newTestSyslogServer(t, nil)
+
+		s := newSink(t, &SyslogSinkTypeConfig{Network: SyslogTcpNetwork, Address: l.addr(), DeliveryGuarantee: Enforced})
+		_, err := s.Process(ctx, testEvent("<30>1 first"))
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent("<30>1 multi\nline"))
+		require.NoError(err)
+		assert.Equal([]string{"<30>1 first", "<30>1 multi\nline"}, l.waitForMessages(t, 2))
+
+		// The connection is dialed again after it was closed by the server.
+		l.closeConns()
+		require.Eventually(func() bool {
+			_, err = s.Process(ctx, testEvent("<30>1 after close"))
+			return err == nil && len(l.messages()) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal("<30>1 after close", l.messages()[2])
+
+		// Errors are returned, so the eventer retries sending the event.
+		l.close()
+		s.Reopen()
+		_, err = s.Process(ctx, testEvent("<30>1 unreachable"))
+		require.Error(err)
+	})
+	t.Run("tls", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tc := testSinkTls(t, "syslog.example.com")
+		l := newTestSyslogServer(t, tc.serverConfig)
+
+		s := newSink(t, &SyslogSinkTypeConfig{
+			Network:           SyslogTlsNetwork,
+			Address:           l.addr(),
+			DeliveryGuarantee: Enforced,
+			TlsCaFile:         tc.caFile,
+			TlsServerName:     "syslog.example.com",
+		})
+		_, err := s.Process(ctx, testEvent("<109>1 audit"))
+		require.NoError(err)
+		assert.Equal([]string{"<109>1 audit"}, l.waitForMessages(t, 1))
+
+		// The server certificate is not trusted without the ca file.
+		s = newSink(t, &SyslogSinkTypeConfig{
+			Network:           SyslogTlsNetwork,
+			Address:           l.addr(),
+			DeliveryGuarantee: Enforced,
+			TlsServerName:     "syslog.example.com",
+		})
+		_, err = s.Process(ctx, testEvent("<109>1 audit"))
+		require.Error(err)
+	})
+	t.Run("best-effort", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l := newTestSyslogServer(t, nil)
+		s := newSink(t, &SyslogSinkTypeConfig{Network: SyslogTcpNetwork, Address: l.addr(), DeliveryGuarantee: BestEffort})
+
+		want := []string{"<30>1 first", "<30>1 second", "<30>1 third"}
+		for _, msg := range want {
+			_, err := s.Process(ctx, testEvent(msg))
+			require.NoError(err)
+		}
+		require.NoError(s.FlushAll(ctx))
+		assert.Equal(want, l.waitForMessages(t, 3))
+
+		// Events which cannot be sent are dropped.
+		l.close()
+		require.NoError(s.Reopen())
+		_, err := s.Process(ctx, testEvent("<30>1 unreachable"))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		assert.Equal(want, l.messages())
+	})
+	t.Run("best-effort-queue-full", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s := &syslogSink{
+			format:  string(SyslogSinkFormat),
+			network: SyslogUdpNetwork,
+			batcher: &sinkBatcher{queue: make(chan sinkBatchEvent, 1)},
+		}
+		_, err := s.Process(ctx, testEvent("<30>1 first"))
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent("<30>1 second"))
+		require.NoError(err)
+		require.Len(s.batcher.queue, 1)
+		assert.Equal("<30>1 first", string((<-s.batcher.queue).val))
+	})
+	t.Run("close", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l := newTestSyslogServer(t, nil)
+		s := newSink(t, &SyslogSinkTypeConfig{Network: SyslogTcpNetwork, Address: l.addr()})
+
+		// The queued events are sent and the connection is closed when the
+		// sink is closed.
+		_, err := s.Process(ctx, testEvent("<30>1 first"))
+		require.NoError(err)
+		require.NoError(s.Close(ctx))
+		assert.Equal([]string{"<30>1 first"}, l.waitForMessages(t, 1))
+		assert.Nil(s.conn)
+
+		// Events processed after the sink is closed are dropped.
+		_, err = s.Process(ctx, testEvent("<30>1 second"))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		require.NoError(s.Close(ctx))
+		assert.Equal([]string{"<30>1 first"}, l.messages())
+	})
+	t.Run("enforced-closed", func(t *testing.T) {
+		l := newTestSyslogServer(t, nil)
+		s := newSink(t, &SyslogSinkTypeConfig{Network: SyslogTcpNetwork, Address: l.addr(), DeliveryGuarantee: Enforced})
+		require.NoError(t, s.Close(ctx))
+		_, err := s.Process(ctx, testEvent("<30>1 first"))
+		assert.ErrorIs(t, err, errSinkClosed)
+		assert.Empty(t, l.messages())
+	})
+	t.Run("tls-bad-ca-file", func(t *testing.T) {
+		_, err := newSyslogSink(SyslogSinkFormat, &SyslogSinkTypeConfig{
+			Network:   SyslogTlsNetwork,
+			Address:   "localhost:6514",
+			TlsCaFile: filepath.Join(t.TempDir(), "missing.pem"),
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestEventer_SyslogSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	l := newTestSyslogServer(t, nil)
+
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "syslog-sink",
+				Type:       SyslogSink,
+				Format:     SyslogSinkFormat,
+				EventTypes: []Type{AuditType},
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network: SyslogTcpNetwork,
+					Address: l.addr(),
+				},
+			},
+		},
+	}
+	require.NoError(c.Validate())
+	e, err := NewEventer(testLogger, testLock, "TestEventer_SyslogSink", c)
+	require.NoError(err)
+	require.Len(e.closableNodes, 1)
+	s, ok := e.closableNodes[0].(*syslogSink)
+	require.True(ok)
+
+	testAudit, err := newAudit(
+		"TestEventer_SyslogSink",
+		WithRequestInfo(TestRequestInfo(t)),
+		WithAuth(testAuth(t)),
+		WithRequest(testRequest(t)),
+		WithResponse(testResponse(t)),
+		WithFlush())
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, testAudit))
+
+	msgs := l.waitForMessages(t, 1)
+	assert.True(strings.HasPrefix(msgs[0], "<109>1 "))
+	assert.Contains(msgs[0], " boundary "+strconv.Itoa(os.Getpid())+" audit - ")
+	assert.Contains(msgs[0], testAudit.Id)
+
+	// Rotating the audit wrapper signs the audit events.
+	require.NoError(e.RotateAuditWrapper(ctx, testWrapper(t)))
+	require.NoError(e.writeAudit(ctx, testAudit))
+	msgs = l.waitForMessages(t, 2)
+	assert.Contains(msgs[1], `"serialized_hmac":`)
+
+	// Closing the eventer sends the queued events and closes the connection.
+	require.NoError(e.writeAudit(ctx, testAudit))
+	require.NoError(e.Close(ctx))
+	assert.Len(l.waitForMessages(t, 3), 3)
+	assert.Nil(s.conn)
+}
+
+// testSyslogServer is a syslog server receiving octet counted messages over
+// TCP or TLS.
+type testSyslogServer struct {
+	l net.Listener
+
+	mu    sync.Mutex
+	msgs  []string
+	conns []net.Conn
+}
+
+func newTestSyslogServer(t *testing.T, tlsConfig *tls.Config) *testSyslogServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if tlsConfig != nil {
+		l = tls.NewListener(l, tlsConfig)
+	}
+	s := &testSyslogServer{l: l}
+	t.Cleanup(s.close)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns = append(s.conns, conn)
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *testSyslogServer) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	for {
+		l, err := r.ReadString(' ')
+		if err != nil {
+			return
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(l, " "))
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.msgs = append(s.msgs, string(msg))
+		s.mu.Unlock()
+	}
+}
+
+func (s *testSyslogServer) addr() string {
+	return s.l.Addr().String()
+}
+
+func (s *testSyslogServer) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.msgs...)
+}
+
+func (s *testSyslogServer) waitForMessages(t *testing.T, n int) []string {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return len(s.messages()) >= n
+	}, 5*time.Second, 10*time.Millisecond)
+	return s.messages()
+}
+
+func (s *testSyslogServer) closeConns() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.conns {
+		c.Close()
+	}
+	s.conns = nil
+}
+
+func (s *testSyslogServer) close() {
+	s.l.Close()
+	s.closeConns()
+}
@@ -27,6 +27,19 @@ type ACLResults struct {
 	scopeMap map[string][]Grant
 }
 
+// GrantExplanation provides how a grant was evaluated when explaining whether
+// an action is allowed for a resource.
+type GrantExplanation struct {
+	Grant Grant
+
+	// Authorized is true if the grant allows the action for the resource.
+	Authorized bool
+
+	// Denied is true if the grant is a deny grant that denies the action for
+	// the resource.
+	Denied bool
+}
+
 // Resource defines something within boundary that requires authorization
 // capabilities. Resources must have a ScopeId.
 type Resource struct {
@@ -58,6 +71,10 @@ func NewACL(grants ...Grant) ACL {
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
+// Deny grants are evaluated before allow grants: if a deny grant matches the
+// action, the action is not authorized and no output fields are returned,
+// regardless of the allow grants. Deny grants specifying only output fields
+// mask those fields from the output fields of the allow grants.
 func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option) (results ACLResults) {
 	opts := getOpts(opt...)
 
@@ -65,148 +82,200 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	grants := a.scopeMap[r.ScopeId]
 	results.scopeMap = a.scopeMap
 
-	var parentAction action.Type
-	split := strings.Split(aType.String(), ":")
-	if len(split) == 2 {
-		parentAction = action.Map[split[0]]
-	}
-	// Now, go through and check the cases indicated above
+	parentAction := parentActionOf(aType)
+	// Check the deny grants first, as a denied action shortcuts everything
+	// else.
+	var deniedFields []string
 	for _, grant := range grants {
-		var outputFieldsOnly bool
+		if !grant.deny {
+			continue
+		}
+		found, outputFieldsOnly := grant.matches(r, aType, parentAction, userId, opts)
 		switch {
-		case len(grant.actions) == 0:
-			// Continue with the next grant, unless we have output fields
-			// specified in which case we continue to be able to apply the
-			// output fields depending on ID and type.
-			if len(grant.OutputFields) > 0 {
-				outputFieldsOnly = true
-			} else {
-				continue
-			}
-		case grant.actions[aType]:
-			// We have this action
-		case grant.actions[parentAction]:
-			// We don't have this action, but it's a subaction and we have the
-			// parent action. As an example, if we are looking for "read:self"
-			// and have "read", this is sufficient.
-		case grant.actions[action.All]:
-			// All actions are allowed
+		case !found:
+		case outputFieldsOnly:
+			deniedFields = append(deniedFields, grant.OutputFields.Fields()...)
 		default:
-			// No actions in the grant match what we're looking for, so continue
-			// with the next grant
+			results.OutputFields = OutputFieldsMap{}
+			return
+		}
+	}
+	// Now, go through and check the cases indicated in matches. We step
+	// through all grants, to fetch the full list of output fields. However, we
+	// shortcut if we find *.
+	for _, grant := range grants {
+		if grant.deny {
 			continue
 		}
-
-		// We step through all grants, to fetch the full list of output fields.
-		// However, we shortcut if we find *.
-		//
-		// If the action was not found above but we did find output fields in
-		// patterns that match, we do not authorize the request, but we do build
-		// up the output fields patterns.
-		//
-		// Note that when using IsActionOrParent it is merely to test whether it
-		// is an allowed format since some formats operate ony on collections
-		// (or don't operate at all on collections) and we want to ensure that
-		// it is/isn't a create or list command or subcommand to know whether
-		// that form is valid. The actual checking of whether the given action
-		// is granted to the user already happened above.
-		var found bool
-		switch {
-		// Case 1: We only allow specific actions on specific types for the
-		// anonymous user. ID being supplied or not doesn't matter in this case,
-		// it must be an explicit type and action(s); adding this as an explicit
-		// case here prevents duplicating logic in two of the other more
-		// general-purpose cases below (3 and 4). See notes there about ID being
-		// present or not.
-		case !opts.withSkipAnonymousUserRestrictions &&
-			(userId == AnonymousUserId || userId == ""):
-			switch {
-			// Allow discovery of scopes, so that auth methods within can be
-			// discovered
-			case grant.typ == r.Type &&
-				grant.typ == resource.Scope &&
-				(aType == action.List || aType == action.NoOp):
-				found = true
-
-			// Allow discovery of and authenticating to auth methods
-			case grant.typ == r.Type &&
-				grant.typ == resource.AuthMethod &&
-				(aType == action.List || aType == action.NoOp || aType == action.Authenticate):
-				found = true
+		found, outputFieldsOnly := grant.matches(r, aType, parentAction, userId, opts)
+		if found {
+			if !outputFieldsOnly {
+				results.Authorized = true
 			}
+			if results.OutputFields = results.OutputFields.AddFields(grant.OutputFields.Fields()); results.OutputFields.HasAll() && results.Authorized {
+				break
+			}
+		}
+	}
+	results.OutputFields = results.OutputFields.DenyFields(deniedFields)
+	return
+}
 
-		// Case 2:
-		// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
-		// id=<resource.id>;output_fields=<fields> where fields cannot be a
-		// wildcard.
-		case grant.id == r.Id &&
-			grant.id != "" &&
-			grant.id != "*" &&
-			grant.typ == resource.Unknown &&
-			!action.List.IsActionOrParent(aType) &&
-			!action.Create.IsActionOrParent(aType):
+// Explain determines if the grants for an ACL allow an action for a resource,
+// like Allowed, and also returns how each grant within the resource's scope was
+// evaluated. Grants in other scopes are never considered for the resource.
+func (a ACL) Explain(r Resource, aType action.Type, userId string, opt ...Option) (ACLResults, []GrantExplanation) {
+	opts := getOpts(opt...)
+	results := a.Allowed(r, aType, userId, opt...)
 
-			found = true
+	grants := a.scopeMap[r.ScopeId]
+	parentAction := parentActionOf(aType)
+	explanations := make([]GrantExplanation, 0, len(grants))
+	for _, grant := range grants {
+		found, outputFieldsOnly := grant.matches(r, aType, parentAction, userId, opts)
+		explanations = append(explanations, GrantExplanation{
+			Grant:      grant,
+			Authorized: found && !outputFieldsOnly && !grant.deny,
+			Denied:     found && !outputFieldsOnly && grant.deny,
+		})
+	}
+	return results, explanations
+}
 
-		// Case 3: type=<resource.type>;actions=<action> when action is list or
-		// create. Must be a top level collection, otherwise must be one of the
-		// two formats specified in cases 4 or 5. Or,
-		// type=resource.type;output_fields=<fields> and no action. This is more
-		// of a semantic difference compared to 4 more than a security
-		// difference; this type is for clarity as it ties more closely to the
-		// concept of create and list as actions on a collection, operating on a
-		// collection directly. The format in case 4 will still work for
-		// create/list on collections but that's more of a shortcut to allow
-		// things like id=*;type=*;actions=* for admin flows so that you don't
-		// need to separate out explicit collection actions into separate typed
-		// grants for each collection within a role. This does mean there are
-		// "two ways of doing things" but it's a reasonable UX tradeoff given
-		// that "all IDs" can reasonably be construed to include "and the one
-		// I'm making" and "all of them for listing".
-		case grant.id == "" &&
-			r.Id == "" &&
-			grant.typ == r.Type &&
-			grant.typ != resource.Unknown &&
-			topLevelType(r.Type) &&
-			(action.List.IsActionOrParent(aType) ||
-				action.Create.IsActionOrParent(aType)):
+// matches determines whether the grant applies to an action on a resource. If
+// found is true but outputFieldsOnly is also true, the grant does not authorize
+// the action but its output fields apply to the resource.
+func (grant Grant) matches(r Resource, aType, parentAction action.Type, userId string, opts options) (found, outputFieldsOnly bool) {
+	switch {
+	case len(grant.actions) == 0:
+		// The grant doesn't apply, unless we have output fields specified in
+		// which case we continue to be able to apply the output fields
+		// depending on ID and type.
+		if len(grant.OutputFields) > 0 {
+			outputFieldsOnly = true
+		} else {
+			return false, false
+		}
+	case grant.actions[aType]:
+		// We have this action
+	case grant.actions[parentAction]:
+		// We don't have this action, but it's a subaction and we have the
+		// parent action. As an example, if we are looking for "read:self"
+		// and have "read", this is sufficient.
+	case grant.actions[action.All]:
+		// All actions are allowed
+	default:
+		// No actions in the grant match what we're looking for, so the grant
+		// doesn't apply
+		return false, false
+	}
 
+	// If the action was not found above but we did find output fields in
+	// patterns that match, we do not authorize the request, but we do build
+	// up the output fields patterns.
+	//
+	// Note that when using IsActionOrParent it is merely to test whether it
+	// is an allowed format since some formats operate ony on collections
+	// (or don't operate at all on collections) and we want to ensure that
+	// it is/isn't a create or list command or subcommand to know whether
+	// that form is valid. The actual checking of whether the given action
+	// is granted to the user already happened above.
+	switch {
+	// Case 1: We only allow specific actions on specific types for the
+	// anonymous user. ID being supplied or not doesn't matter in this case,
+	// it must be an explicit type and action(s); adding this as an explicit
+	// case here prevents duplicating logic in two of the other more
+	// general-purpose cases below (3 and 4). See notes there about ID being
+	// present or not.
+	case !opts.withSkipAnonymousUserRestrictions &&
+		(userId == AnonymousUserId || userId == ""):
+		switch {
+		// Allow discovery of scopes, so that auth methods within can be
+		// discovered
+		case grant.typ == r.Type &&
+			grant.typ == resource.Scope &&
+			(aType == action.List || aType == action.NoOp):
 			found = true
 
-		// Case 4:
-		// id=*;type=<resource.type>;actions=<action> where type cannot be
-		// unknown but can be a wildcard to allow any resource at all; or
-		// id=*;type=<resource.type>;output_fields=<fields> with no action.
-		case grant.id == "*" &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type ||
-				grant.typ == resource.All):
-
+		// Allow discovery of and authenticating to auth methods
+		case grant.typ == r.Type &&
+			grant.typ == resource.AuthMethod &&
+			(aType == action.List || aType == action.NoOp || aType == action.Authenticate):
 			found = true
+		}
 
-		// Case 5:
-		// id=<pin>;type=<resource.type>;actions=<action> where type can be a
-		// wildcard and this this is operating on a non-top-level type. Same for
-		// output fields only.
-		case grant.id != "" &&
-			grant.id == r.Pin &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type || grant.typ == resource.All) &&
-			!topLevelType(r.Type):
+	// Case 2:
+	// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
+	// id=<resource.id>;output_fields=<fields> where fields cannot be a
+	// wildcard.
+	case grant.id == r.Id &&
+		grant.id != "" &&
+		grant.id != "*" &&
+		grant.typ == resource.Unknown &&
+		!action.List.IsActionOrParent(aType) &&
+		!action.Create.IsActionOrParent(aType):
 
-			found = true
-		}
+		found = true
 
-		if found {
-			if !outputFieldsOnly {
-				results.Authorized = true
-			}
-			if results.OutputFields = results.OutputFields.AddFields(grant.OutputFields.Fields()); results.OutputFields.HasAll() && results.Authorized {
-				return
-			}
-		}
+	// Case 3: type=<resource.type>;actions=<action> when action is list or
+	// create. Must be a top level collection, otherwise must be one of the
+	// two formats specified in cases 4 or 5. Or,
+	// type=resource.type;output_fields=<fields> and no action. This is more
+	// of a semantic difference compared to 4 more than a security
+	// difference; this type is for clarity as it ties more closely to the
+	// concept of create and list as actions on a collection, operating on a
+	// collection directly. The format in case 4 will still work for
+	// create/list on collections but that's more of a shortcut to allow
+	// things like id=*;type=*;actions=* for admin flows so that you don't
+	// need to separate out explicit collection actions into separate typed
+	// grants for each collection within a role. This does mean there are
+	// "two ways of doing things" but it's a reasonable UX tradeoff given
+	// that "all IDs" can reasonably be construed to include "and the one
+	// I'm making" and "all of them for listing".
+	case grant.id == "" &&
+		r.Id == "" &&
+		grant.typ == r.Type &&
+		grant.typ != resource.Unknown &&
+		topLevelType(r.Type) &&
+		(action.List.IsActionOrParent(aType) ||
+			action.Create.IsActionOrParent(aType)):
+
+		found = true
+
+	// Case 4:
+	// id=*;type=<resource.type>;actions=<action> where type cannot be
+	// unknown but can be a wildcard to allow any resource at all; or
+	// id=*;type=<resource.type>;output_fields=<fields> with no action.
+	case grant.id == "*" &&
+		grant.typ != resource.Unknown &&
+		(grant.typ == r.Type ||
+			grant.typ == resource.All):
+
+		found = true
+
+	// Case 5:
+	// id=<pin>;type=<resource.type>;actions=<action> where type can be a
+	// wildcard and this this is operating on a non-top-level type. Same for
+	// output fields only.
+	case grant.id != "" &&
+		grant.id == r.Pin &&
+		grant.typ != resource.Unknown &&
+		(grant.typ == r.Type || grant.typ == resource.All) &&
+		!topLevelType(r.Type):
+
+		found = true
 	}
-	return
+	return found, outputFieldsOnly
+}
+
+// parentActionOf returns the parent action of a subaction, e.g. read for
+// read:self, or unknown if the action is not a subaction.
+func parentActionOf(aType action.Type) action.Type {
+	split := strings.Split(aType.String(), ":")
+	if len(split) == 2 {
+		return action.Map[split[0]]
+	}
+	return action.Unknown
 }
 
 func topLevelType(typ resource.Type) bool {
@@ -218,6 +287,7 @@ func topLevelType(typ resource.Type) bool {
 		resource.HostCatalog,
 		resource.Role,
 		resource.Scope,
+		resource.ServiceAccount,
 		resource.Session,
 		resource.Target,
 		resource.User,
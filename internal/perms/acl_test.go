@@ -24,6 +24,7 @@ func Test_ACLAllowed(t *testing.T) {
 		action       action.Type
 		authorized   bool
 		outputFields []string
+		deniedFields []string
 	}
 	type input struct {
 		name              string
@@ -70,6 +71,15 @@ func Test_ACLAllowed(t *testing.T) {
 				"id=*;type=account;actions=update;output_fields=id,version",
 			},
 		},
+		{
+			scope: "o_e",
+			grants: []string{
+				"id=*;type=*;actions=*;output_fields=*",
+				"id=*;type=target;actions=delete;deny=true",
+				"id=ttcp_prod;actions=*;deny=true",
+				"id=*;type=host-catalog;output_fields=attributes;deny=true",
+			},
+		},
 	}
 
 	// See acl.go for expected allowed formats. The goal here is to basically
@@ -316,6 +326,35 @@ func Test_ACLAllowed(t *testing.T) {
 				{action: action.ReadSelf, authorized: true},
 			},
 		},
+		{
+			name:        "deny action by type",
+			resource:    Resource{ScopeId: "o_e", Id: "ttcp_dev", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true, outputFields: []string{"*"}},
+				{action: action.AuthorizeSession, authorized: true, outputFields: []string{"*"}},
+				{action: action.Delete},
+			},
+		},
+		{
+			name:        "deny all actions by id",
+			resource:    Resource{ScopeId: "o_e", Id: "ttcp_prod", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.AuthorizeSession},
+				{action: action.Delete},
+			},
+		},
+		{
+			name:        "deny output fields by type",
+			resource:    Resource{ScopeId: "o_e", Id: "hc_1234567890", Type: resource.HostCatalog},
+			scopeGrants: commonGrants,
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true, outputFields: []string{"*"}, deniedFields: []string{"attributes"}},
+				{action: action.Delete, authorized: true, outputFields: []string{"*"}, deniedFields: []string{"attributes"}},
+			},
+		},
 		{
 			name:     "create worker with create",
 			resource: Resource{ScopeId: scope.Global.String(), Type: resource.Worker},
@@ -367,7 +406,83 @@ func Test_ACLAllowed(t *testing.T) {
 				result := acl.Allowed(test.resource, aa.action, userId)
 				assert.True(t, result.Authorized == aa.authorized, "action: %s, acl authorized: %t, test action authorized: %t", aa.action, result.Authorized, aa.authorized)
 				assert.ElementsMatch(t, result.OutputFields.Fields(), aa.outputFields)
+				assert.ElementsMatch(t, result.OutputFields.DeniedFields(), aa.deniedFields)
+			}
+		})
+	}
+}
+
+func Test_ACLExplain(t *testing.T) {
+	t.Parallel()
+
+	type roleGrant struct {
+		roleId string
+		scope  string
+		grant  string
+	}
+	roleGrants := []roleGrant{
+		{roleId: "r_read", scope: "o_a", grant: "id=ttcp_1234567890;actions=read"},
+		{roleId: "r_connect", scope: "o_a", grant: "id=*;type=target;actions=authorize-session"},
+		{roleId: "r_host", scope: "o_a", grant: "id=*;type=host;actions=read"},
+		{roleId: "r_fields", scope: "o_a", grant: "id=*;type=target;output_fields=id"},
+		{roleId: "r_deny", scope: "o_a", grant: "id=*;type=target;actions=delete;deny=true"},
+		{roleId: "r_other", scope: "o_b", grant: "id=*;type=*;actions=*"},
+	}
+	var grants []Grant
+	for _, rg := range roleGrants {
+		grant, err := Parse(rg.scope, rg.grant, WithRoleId(rg.roleId))
+		require.NoError(t, err)
+		grants = append(grants, grant)
+	}
+	acl := NewACL(grants...)
+	res := Resource{ScopeId: "o_a", Id: "ttcp_1234567890", Type: resource.Target}
+
+	tests := []struct {
+		name              string
+		action            action.Type
+		wantAuthorized    bool
+		wantRoleIds       []string
+		wantDeniedRoleIds []string
+	}{
+		{
+			name:           "read",
+			action:         action.Read,
+			wantAuthorized: true,
+			wantRoleIds:    []string{"r_read"},
+		},
+		{
+			name:           "authorize-session",
+			action:         action.AuthorizeSession,
+			wantAuthorized: true,
+			wantRoleIds:    []string{"r_connect"},
+		},
+		{
+			name:              "delete",
+			action:            action.Delete,
+			wantDeniedRoleIds: []string{"r_deny"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			results, explanations := acl.Explain(res, tt.action, "u_1234567890")
+			assert.Equal(tt.wantAuthorized, results.Authorized)
+			assert.Equal(acl.Allowed(res, tt.action, "u_1234567890").Authorized, results.Authorized)
+
+			// Only the grants within the resource's scope are considered
+			var considered, authorized, denied []string
+			for _, e := range explanations {
+				considered = append(considered, e.Grant.RoleId())
+				if e.Authorized {
+					authorized = append(authorized, e.Grant.RoleId())
+				}
+				if e.Denied {
+					denied = append(denied, e.Grant.RoleId())
+				}
 			}
+			assert.Equal([]string{"r_read", "r_connect", "r_host", "r_fields", "r_deny"}, considered)
+			assert.Equal(tt.wantRoleIds, authorized)
+			assert.Equal(tt.wantDeniedRoleIds, denied)
 		})
 	}
 }
@@ -423,11 +538,11 @@ func Test_AnonRestrictions(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			require, assert := require.New(t), assert.New(t)
-			for i := resource.Type(1); i <= resource.CredentialLibrary; i++ {
+			for i := resource.Type(1); i <= resource.ServiceAccount; i++ {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.CreateControllerLed; j++ {
+				for j := action.Type(1); j <= action.Explain; j++ {
 					res := Resource{
 						ScopeId: scope.Global.String(),
 						Id:      "foobar",
@@ -35,6 +35,9 @@ type Grant struct {
 	// The scope ID, which will be a project ID or an org ID
 	scope Scope
 
+	// The ID of the role the grant belongs to, if provided
+	roleId string
+
 	// The ID in the grant, if provided.
 	id string
 
@@ -47,6 +50,10 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields OutputFieldsMap
 
+	// Whether the grant denies, rather than allows, its actions or output
+	// fields
+	deny bool
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -60,6 +67,14 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+func (g Grant) RoleId() string {
+	return g.roleId
+}
+
+func (g Grant) Deny() bool {
+	return g.deny
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -90,9 +105,11 @@ func (g Grant) hasActionOrSubaction(act action.Type) bool {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		typ:   g.typ,
+		scope:  g.scope,
+		roleId: g.roleId,
+		id:     g.id,
+		typ:    g.typ,
+		deny:   g.deny,
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -137,13 +154,17 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(g.OutputFields.Fields(), ",")))
 	}
 
+	if g.deny {
+		builder = append(builder, "deny=true")
+	}
+
 	return strings.Join(builder, ";")
 }
 
 // MarshalJSON provides a custom marshaller for grants
 func (g Grant) MarshalJSON() ([]byte, error) {
 	const op = "perms.(Grant).MarshalJSON"
-	res := make(map[string]interface{}, 4)
+	res := make(map[string]interface{}, 5)
 	if g.id != "" {
 		res["id"] = g.id
 	}
@@ -161,6 +182,9 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 	if len(g.OutputFields) > 0 {
 		res["output_fields"] = g.OutputFields.Fields()
 	}
+	if g.deny {
+		res["deny"] = true
+	}
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.Encode))
@@ -173,7 +197,7 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 // when JSON is detected.
 func (g *Grant) unmarshalJSON(data []byte) error {
 	const op = "perms.(Grant).unmarshalJSON"
-	raw := make(map[string]interface{}, 4)
+	raw := make(map[string]interface{}, 5)
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return errors.WrapDeprecated(err, op, errors.WithCode(errors.Decode))
 	}
@@ -234,6 +258,13 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 			}
 		}
 	}
+	if rawDeny, ok := raw["deny"]; ok {
+		deny, ok := rawDeny.(bool)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as boolean", "deny"))
+		}
+		g.deny = deny
+	}
 	return nil
 }
 
@@ -278,6 +309,16 @@ func (g *Grant) unmarshalText(grantString string) error {
 
 		case "output_fields":
 			g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
+
+		case "deny":
+			switch strings.ToLower(kv[1]) {
+			case "true":
+				g.deny = true
+			case "false":
+				g.deny = false
+			default:
+				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q value %q as boolean", "deny", kv[1]))
+			}
 		}
 	}
 
@@ -327,6 +368,7 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 	}
 
 	opts := getOpts(opt...)
+	grant.roleId = opts.withRoleId
 
 	// Check for templated values ID, and substitute in with the authenticated values
 	// if so
@@ -355,6 +397,12 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.WrapDeprecated(err, op)
 	}
 
+	// A deny grant either denies actions or masks output fields, but not both,
+	// as it would be ambiguous whether the actions themselves are denied
+	if grant.deny && len(grant.actions) > 0 && len(grant.OutputFields) > 0 {
+		return Grant{}, errors.NewDeprecated(errors.InvalidParameter, op, "parsed grant string denies both actions and output fields")
+	}
+
 	if !opts.withSkipFinalValidation {
 		// Filter out some forms that don't make sense
 
@@ -410,8 +458,11 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		// This might be zero if output fields is populated
 		if len(grant.actions) > 0 {
 			// Create a dummy resource and pass it through Allowed and ensure that
-			// we get allowed.
-			acl := NewACL(grant)
+			// we get allowed. A deny grant is checked as if it allowed its
+			// actions, to ensure that it would match something.
+			dummy := grant.clone()
+			dummy.deny = false
+			acl := NewACL(*dummy)
 			r := Resource{
 				ScopeId: scopeId,
 				Id:      grant.id,
@@ -108,7 +108,7 @@ func Test_ActionParsingValidation(t *testing.T) {
 func Test_ValidateType(t *testing.T) {
 	t.Parallel()
 	var g Grant
-	for i := resource.Unknown; i <= resource.Credential; i++ {
+	for i := resource.Unknown; i <= resource.ServiceAccount; i++ {
 		g.typ = i
 		if i == resource.Controller {
 			assert.Error(t, g.validateType())
@@ -190,6 +190,22 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"id":"baz","output_fields":["id","name","version"],"type":"group"}`,
 			canonicalString: `id=baz;type=group;actions=create,read;output_fields=id,name,version`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				id: "baz",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				typ: resource.Group,
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				deny: true,
+			},
+			jsonOutput:      `{"actions":["delete"],"deny":true,"id":"baz","type":"group"}`,
+			canonicalString: `id=baz;type=group;actions=delete;deny=true`,
+		},
 	}
 
 	for _, test := range tests {
@@ -311,6 +327,27 @@ func Test_Unmarshaling(t *testing.T) {
 			jsonInput: `{"actions":[1, true]}`,
 			jsonErr:   `perms.(Grant).unmarshalJSON: unable to interpret 1 in actions array as string: parameter violation: error #100`,
 		},
+		{
+			name: "good deny",
+			expected: Grant{
+				deny: true,
+			},
+			jsonInput: `{"deny":true}`,
+			textInput: `deny=TRUE`,
+		},
+		{
+			name:      "good deny false",
+			expected:  Grant{},
+			jsonInput: `{"deny":false}`,
+			textInput: `deny=false`,
+		},
+		{
+			name:      "bad deny",
+			jsonInput: `{"deny":"true"}`,
+			jsonErr:   `perms.(Grant).unmarshalJSON: unable to interpret "deny" as boolean: parameter violation: error #100`,
+			textInput: `deny=yes`,
+			textErr:   `perms.(Grant).unmarshalText: unable to interpret "deny" value "yes" as boolean: parameter violation: error #100`,
+		},
 	}
 
 	for _, test := range tests {
@@ -610,6 +647,48 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "deny actions and output fields",
+			input: "id=*;type=host-catalog;actions=read;output_fields=name;deny=true",
+			err:   `perms.Parse: parsed grant string denies both actions and output fields: parameter violation: error #100`,
+		},
+		{
+			name:  "deny wildcard id and actions without collection",
+			input: "id=*;actions=read;deny=true",
+			err:   `perms.Parse: parsed grant string would not result in any action being authorized: parameter violation: error #100`,
+		},
+		{
+			name:  "good deny actions",
+			input: "id=*;type=target;actions=delete;deny=true",
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "good deny output fields",
+			input: `{"id":"*","type":"target","output_fields":["attributes"],"deny":true}`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				OutputFields: OutputFieldsMap{
+					"attributes": true,
+				},
+				deny: true,
+			},
+		},
 		{
 			name:   "bad user id template",
 			input:  `id={{superman}};actions=create,read`,
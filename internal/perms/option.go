@@ -18,6 +18,7 @@ type Option func(*options)
 type options struct {
 	withUserId                        string
 	withAccountId                     string
+	withRoleId                        string
 	withSkipFinalValidation           bool
 	withSkipAnonymousUserRestrictions bool
 }
@@ -41,6 +42,14 @@ func WithAccountId(accountId string) Option {
 	}
 }
 
+// WithRoleId provides the ID of the role a grant belongs to, which is recorded
+// on the parsed grant
+func WithRoleId(roleId string) Option {
+	return func(o *options) {
+		o.withRoleId = roleId
+	}
+}
+
 // WithSkipFinalValidation allows skipping the validity step where we ensure we
 // can run a resource described by the grant successfully through the ACL check
 func WithSkipFinalValidation(skipFinalValidation bool) Option {
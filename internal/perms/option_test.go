@@ -26,6 +26,13 @@ func Test_GetOpts(t *testing.T) {
 		opts = getOpts(WithAccountId("foo"))
 		assert.Equal("foo", opts.withAccountId)
 	})
+	t.Run("with-role-id", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.Empty(opts.withRoleId)
+		opts = getOpts(WithRoleId("foo"))
+		assert.Equal("foo", opts.withRoleId)
+	})
 	t.Run("with-skip-final-validation", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts()
@@ -7,7 +7,8 @@ import (
 )
 
 // OutputFieldsMap is used to store information about allowed output fields in
-// grants
+// grants. A field with a false value has been denied and is never output, even
+// if the map contains *.
 type OutputFieldsMap map[string]bool
 
 // AddFields adds the given fields and returns the map.
@@ -35,33 +36,65 @@ func (o OutputFieldsMap) AddFields(input []string) (ret OutputFieldsMap) {
 	return
 }
 
+// DenyFields marks the given fields as denied and returns the map. Denying *
+// denies every field.
+func (o OutputFieldsMap) DenyFields(input []string) OutputFieldsMap {
+	if len(input) == 0 {
+		return o
+	}
+	if o == nil {
+		o = make(OutputFieldsMap, len(input))
+	}
+	for _, k := range input {
+		o[k] = false
+	}
+	return o
+}
+
 func (o OutputFieldsMap) HasAll() bool {
 	return o["*"]
 }
 
-// Fields returns an alphabetical string slice of the fields in the map
+// Fields returns an alphabetical string slice of the allowed fields in the map
 func (o OutputFieldsMap) Fields() (ret []string) {
 	if o == nil {
 		return nil
 	}
-	if len(o) == 0 {
-		return []string{}
+	return o.filter(true)
+}
+
+// DeniedFields returns an alphabetical string slice of the denied fields in
+// the map
+func (o OutputFieldsMap) DeniedFields() []string {
+	if o == nil {
+		return nil
 	}
-	ret = make([]string, 0, len(o))
-	for f := range o {
-		ret = append(ret, f)
+	return o.filter(false)
+}
+
+func (o OutputFieldsMap) filter(allowed bool) []string {
+	ret := make([]string, 0, len(o))
+	for f, v := range o {
+		if v == allowed {
+			ret = append(ret, f)
+		}
 	}
 	sort.Strings(ret)
-	return
+	return ret
 }
 
 // SelfOrDefaults returns either the fields map itself or the defaults for the
 // given user
 func (o OutputFieldsMap) SelfOrDefaults(userId string) OutputFieldsMap {
+	denied := o.DeniedFields()
 	switch {
-	case o != nil:
+	case len(denied) == 0 && o != nil,
+		len(denied) > 0 && len(denied) < len(o):
 		// We have values set (which may be empty) so use those
 		return o
+	case len(denied) > 0:
+		// Only denied values are set, so mask the defaults with those
+		return OutputFieldsMap(nil).SelfOrDefaults(userId).DenyFields(denied)
 	case userId == "":
 		// This shouldn't happen, and if it does, don't allow anything to be
 		// output
@@ -89,11 +122,17 @@ func (o OutputFieldsMap) SelfOrDefaults(userId string) OutputFieldsMap {
 }
 
 // Has returns true if the value exists; that is, it is directly in the map, or
-// the map contains *
+// the map contains *, and the value has not been denied
 func (o OutputFieldsMap) Has(in string) bool {
 	// Handle nil or empty case
 	if len(o) == 0 {
 		return false
 	}
-	return o.HasAll() || o[in]
+	if allowed, ok := o["*"]; ok && !allowed {
+		return false
+	}
+	if allowed, ok := o[in]; ok {
+		return allowed
+	}
+	return o.HasAll()
 }
@@ -81,6 +81,7 @@ func Test_ACLOutputFields(t *testing.T) {
 		resource   Resource
 		action     action.Type
 		fields     []string
+		denied     []string
 		authorized bool
 	}
 	tests := []input{
@@ -210,6 +211,50 @@ func Test_ACLOutputFields(t *testing.T) {
 			action: action.List,
 			fields: []string{"name"},
 		},
+		{
+			name:     "denied field",
+			resource: Resource{ScopeId: "o_myorg", Id: "bar", Type: resource.Role},
+			grants: []string{
+				"id=bar;actions=read;output_fields=id,name,version",
+				"id=*;type=role;output_fields=version;deny=true",
+			},
+			action:     action.Read,
+			fields:     []string{"id", "name"},
+			denied:     []string{"version"},
+			authorized: true,
+		},
+		{
+			name:     "denied field with star",
+			resource: Resource{ScopeId: "o_myorg", Id: "bar", Type: resource.Role},
+			grants: []string{
+				"id=bar;actions=read;output_fields=*",
+				"id=bar;output_fields=name;deny=true",
+			},
+			action:     action.Read,
+			fields:     []string{"*"},
+			denied:     []string{"name"},
+			authorized: true,
+		},
+		{
+			name:     "denied field for other resource",
+			resource: Resource{ScopeId: "o_myorg", Id: "bar", Type: resource.Role},
+			grants: []string{
+				"id=bar;actions=read;output_fields=*",
+				"id=foo;output_fields=name;deny=true",
+			},
+			action:     action.Read,
+			fields:     []string{"*"},
+			authorized: true,
+		},
+		{
+			name:     "denied action",
+			resource: Resource{ScopeId: "o_myorg", Id: "bar", Type: resource.Role},
+			grants: []string{
+				"id=*;type=role;actions=*;output_fields=*",
+				"id=bar;actions=delete;deny=true",
+			},
+			action: action.Delete,
+		},
 	}
 
 	for _, test := range tests {
@@ -223,6 +268,7 @@ func Test_ACLOutputFields(t *testing.T) {
 			acl := NewACL(grants...)
 			results := acl.Allowed(test.resource, test.action, AnonymousUserId, WithSkipAnonymousUserRestrictions(true))
 			assert.ElementsMatch(t, results.OutputFields.Fields(), test.fields)
+			assert.ElementsMatch(t, results.OutputFields.DeniedFields(), test.denied)
 			assert.True(t, test.authorized == results.Authorized)
 		})
 	}
@@ -270,6 +316,18 @@ func Test_ACLSelfOrDefault(t *testing.T) {
 			input:  OutputFieldsMap{"foo": true},
 			output: OutputFieldsMap{"foo": true},
 		},
+		{
+			name:   "only denied, non anon id",
+			input:  OutputFieldsMap{"foo": false},
+			output: OutputFieldsMap{"*": true, "foo": false},
+			userId: "u_abc123",
+		},
+		{
+			name:   "allowed and denied",
+			input:  OutputFieldsMap{"foo": true, "bar": false},
+			output: OutputFieldsMap{"foo": true, "bar": false},
+			userId: "u_abc123",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -277,3 +335,57 @@ func Test_ACLSelfOrDefault(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutputFieldsHas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input OutputFieldsMap
+		field string
+		want  bool
+	}{
+		{
+			name:  "nil",
+			field: "foo",
+		},
+		{
+			name:  "present",
+			input: OutputFieldsMap{"foo": true},
+			field: "foo",
+			want:  true,
+		},
+		{
+			name:  "missing",
+			input: OutputFieldsMap{"foo": true},
+			field: "bar",
+		},
+		{
+			name:  "star",
+			input: OutputFieldsMap{"*": true},
+			field: "bar",
+			want:  true,
+		},
+		{
+			name:  "star with denied",
+			input: OutputFieldsMap{"*": true}.DenyFields([]string{"bar"}),
+			field: "bar",
+		},
+		{
+			name:  "star with other denied",
+			input: OutputFieldsMap{"*": true}.DenyFields([]string{"bar"}),
+			field: "foo",
+			want:  true,
+		},
+		{
+			name:  "star denied",
+			input: OutputFieldsMap{"foo": true}.DenyFields([]string{"*"}),
+			field: "foo",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.input.Has(test.field))
+		})
+	}
+}
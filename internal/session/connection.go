@@ -42,6 +42,10 @@ type Connection struct {
 	// Version of the connection
 	Version uint32 `json:"version,omitempty" gorm:"default:null"`
 
+	// HttpRequests proxied over the connection are for read only and are
+	// ignored during write operations
+	HttpRequests []*ConnectionHttpRequest `gorm:"-"`
+
 	tableName string `gorm:"-"`
 }
 
@@ -108,6 +112,12 @@ func (c *Connection) Clone() interface{} {
 			},
 		}
 	}
+	if c.HttpRequests != nil {
+		clone.HttpRequests = make([]*ConnectionHttpRequest, 0, len(c.HttpRequests))
+		for _, r := range c.HttpRequests {
+			clone.HttpRequests = append(clone.HttpRequests, r.Clone().(*ConnectionHttpRequest))
+		}
+	}
 	return clone
 }
 
@@ -0,0 +1,140 @@
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultConnectionHttpRequestTableName = "session_connection_http_request"
+)
+
+// ConnectionHttpRequest contains the method, path and response status of an
+// HTTP request a worker proxied over a session connection of an http target.
+type ConnectionHttpRequest struct {
+	// ConnectionId of the connection the request was proxied over
+	ConnectionId string `json:"connection_id,omitempty" gorm:"primary_key"`
+	// Sequence of the request within the connection, starting at 1
+	Sequence uint32 `json:"sequence,omitempty" gorm:"primary_key"`
+	// Method of the request
+	Method string `json:"method,omitempty" gorm:"default:null"`
+	// Path of the request
+	Path string `json:"path,omitempty" gorm:"default:null"`
+	// StatusCode of the response returned by the endpoint
+	StatusCode uint32 `json:"status_code,omitempty" gorm:"default:null"`
+	// RequestTime is the time the worker received the request
+	RequestTime *timestamp.Timestamp `json:"request_time,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ Cloneable       = (*ConnectionHttpRequest)(nil)
+	_ db.VetForWriter = (*ConnectionHttpRequest)(nil)
+)
+
+// NewConnectionHttpRequest creates a new in memory connection http request.
+// No options are currently supported.
+func NewConnectionHttpRequest(ctx context.Context, connectionId string, sequence uint32, method, path string, statusCode uint32, requestTime *timestamp.Timestamp, _ ...Option) (*ConnectionHttpRequest, error) {
+	const op = "session.NewConnectionHttpRequest"
+	r := ConnectionHttpRequest{
+		ConnectionId: connectionId,
+		Sequence:     sequence,
+		Method:       method,
+		Path:         path,
+		StatusCode:   statusCode,
+		RequestTime:  requestTime,
+	}
+	if err := r.validateNewConnectionHttpRequest(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &r, nil
+}
+
+// AllocConnectionHttpRequest will allocate a ConnectionHttpRequest.
+func AllocConnectionHttpRequest() ConnectionHttpRequest {
+	return ConnectionHttpRequest{}
+}
+
+// Clone creates a clone of the ConnectionHttpRequest.
+func (r *ConnectionHttpRequest) Clone() interface{} {
+	clone := &ConnectionHttpRequest{
+		ConnectionId: r.ConnectionId,
+		Sequence:     r.Sequence,
+		Method:       r.Method,
+		Path:         r.Path,
+		StatusCode:   r.StatusCode,
+	}
+	if r.RequestTime != nil {
+		clone.RequestTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.RequestTime.Timestamp.Seconds,
+				Nanos:   r.RequestTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.CreateTime.Timestamp.Seconds,
+				Nanos:   r.CreateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// connection http request before it's written. Connection http requests are
+// immutable once written.
+func (r *ConnectionHttpRequest) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "session.(ConnectionHttpRequest).VetForWrite"
+	switch opType {
+	case db.CreateOp:
+		if err := r.validateNewConnectionHttpRequest(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	case db.UpdateOp:
+		return errors.New(ctx, errors.InvalidParameter, op, "connection http requests are immutable")
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *ConnectionHttpRequest) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultConnectionHttpRequestTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *ConnectionHttpRequest) SetTableName(n string) {
+	r.tableName = n
+}
+
+// validateNewConnectionHttpRequest checks everything but the create time
+func (r *ConnectionHttpRequest) validateNewConnectionHttpRequest(ctx context.Context) error {
+	const op = "session.(ConnectionHttpRequest).validateNewConnectionHttpRequest"
+	switch {
+	case r.ConnectionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	case r.Sequence == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing sequence")
+	case r.Method == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing method")
+	case r.StatusCode < 100 || r.StatusCode > 599:
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid status code")
+	case r.RequestTime == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request time")
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectionHttpRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := timestamp.Now()
+
+	type args struct {
+		connectionId string
+		sequence     uint32
+		method       string
+		path         string
+		statusCode   uint32
+		requestTime  *timestamp.Timestamp
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *ConnectionHttpRequest
+		wantIsErr errors.Code
+	}{
+		{
+			name: "valid",
+			args: args{
+				connectionId: "sc_1234567890",
+				sequence:     1,
+				method:       "GET",
+				path:         "/index.html",
+				statusCode:   200,
+				requestTime:  now,
+			},
+			want: &ConnectionHttpRequest{
+				ConnectionId: "sc_1234567890",
+				Sequence:     1,
+				Method:       "GET",
+				Path:         "/index.html",
+				StatusCode:   200,
+				RequestTime:  now,
+			},
+		},
+		{
+			name: "empty-connection-id",
+			args: args{
+				sequence:    1,
+				method:      "GET",
+				path:        "/",
+				statusCode:  200,
+				requestTime: now,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "zero-sequence",
+			args: args{
+				connectionId: "sc_1234567890",
+				method:       "GET",
+				path:         "/",
+				statusCode:   200,
+				requestTime:  now,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "empty-method",
+			args: args{
+				connectionId: "sc_1234567890",
+				sequence:     1,
+				path:         "/",
+				statusCode:   200,
+				requestTime:  now,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-status-code",
+			args: args{
+				connectionId: "sc_1234567890",
+				sequence:     1,
+				method:       "GET",
+				path:         "/",
+				statusCode:   42,
+				requestTime:  now,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-request-time",
+			args: args{
+				connectionId: "sc_1234567890",
+				sequence:     1,
+				method:       "GET",
+				path:         "/",
+				statusCode:   200,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewConnectionHttpRequest(ctx, tt.args.connectionId, tt.args.sequence, tt.args.method, tt.args.path, tt.args.statusCode, tt.args.requestTime)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestConnectionHttpRequest_Clone(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	r, err := NewConnectionHttpRequest(context.Background(), "sc_1234567890", 1, "GET", "/", 200, timestamp.Now())
+	require.NoError(err)
+	r.CreateTime = timestamp.Now()
+
+	cp := r.Clone().(*ConnectionHttpRequest)
+	assert.Equal(r, cp)
+	assert.NotSame(r.RequestTime, cp.RequestTime)
+}
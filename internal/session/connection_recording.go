@@ -0,0 +1,181 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultConnectionRecordingTableName = "session_connection_recording"
+)
+
+// ConnectionRecording indexes the recording of the bytes a worker proxied for
+// a session connection and tracks its upload. The recording itself is
+// uploaded by the worker in chunks and stored by the repository outside of
+// the database.
+type ConnectionRecording struct {
+	// ConnectionId of the recorded connection
+	ConnectionId string `json:"connection_id,omitempty" gorm:"primary_key"`
+	// SessionId of the recorded connection
+	SessionId string `json:"session_id,omitempty" gorm:"default:null"`
+	// WorkerId of the worker that recorded the connection
+	WorkerId string `json:"worker_id,omitempty" gorm:"default:null"`
+	// StartTime of the recording
+	StartTime *timestamp.Timestamp `json:"start_time,omitempty" gorm:"default:null"`
+	// EndTime of the recording
+	EndTime *timestamp.Timestamp `json:"end_time,omitempty" gorm:"default:null"`
+	// Size of the recording in bytes
+	Size uint64 `json:"size,omitempty" gorm:"default:null"`
+	// UploadedChunks is the number of chunks of the recording uploaded so far
+	UploadedChunks uint32 `json:"uploaded_chunks,omitempty" gorm:"default:null"`
+	// UploadedSize is the number of bytes of the recording uploaded so far
+	UploadedSize uint64 `json:"uploaded_size,omitempty" gorm:"default:null"`
+	// Complete is set once all the bytes of the recording have been uploaded
+	Complete bool `json:"complete,omitempty" gorm:"default:null"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	// UpdateTime from the RDBMS
+	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ Cloneable       = (*ConnectionRecording)(nil)
+	_ db.VetForWriter = (*ConnectionRecording)(nil)
+)
+
+// NewConnectionRecording creates a new in memory connection recording of size
+// bytes recorded by the worker with workerId. No options are currently
+// supported.
+func NewConnectionRecording(ctx context.Context, sessionId, connectionId, workerId string, startTime, endTime *timestamp.Timestamp, size uint64, _ ...Option) (*ConnectionRecording, error) {
+	const op = "session.NewConnectionRecording"
+	r := ConnectionRecording{
+		ConnectionId: connectionId,
+		SessionId:    sessionId,
+		WorkerId:     workerId,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Size:         size,
+	}
+	if err := r.validateNewConnectionRecording(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &r, nil
+}
+
+// AllocConnectionRecording will allocate a ConnectionRecording.
+func AllocConnectionRecording() ConnectionRecording {
+	return ConnectionRecording{}
+}
+
+// Clone creates a clone of the ConnectionRecording.
+func (r *ConnectionRecording) Clone() interface{} {
+	clone := &ConnectionRecording{
+		ConnectionId:   r.ConnectionId,
+		SessionId:      r.SessionId,
+		WorkerId:       r.WorkerId,
+		Size:           r.Size,
+		UploadedChunks: r.UploadedChunks,
+		UploadedSize:   r.UploadedSize,
+		Complete:       r.Complete,
+	}
+	if r.StartTime != nil {
+		clone.StartTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.StartTime.Timestamp.Seconds,
+				Nanos:   r.StartTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.EndTime != nil {
+		clone.EndTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.EndTime.Timestamp.Seconds,
+				Nanos:   r.EndTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.CreateTime.Timestamp.Seconds,
+				Nanos:   r.CreateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	if r.UpdateTime != nil {
+		clone.UpdateTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: r.UpdateTime.Timestamp.Seconds,
+				Nanos:   r.UpdateTime.Timestamp.Nanos,
+			},
+		}
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// connection recording before it's written. Only the progress of the upload
+// of a connection recording can be updated.
+func (r *ConnectionRecording) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, opt ...db.Option) error {
+	const op = "session.(ConnectionRecording).VetForWrite"
+	switch opType {
+	case db.CreateOp:
+		if err := r.validateNewConnectionRecording(ctx); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	case db.UpdateOp:
+		opts := db.GetOpts(opt...)
+		for _, f := range opts.WithFieldMaskPaths {
+			switch f {
+			case "UploadedChunks", "UploadedSize", "Complete":
+			default:
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is immutable", f))
+			}
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *ConnectionRecording) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultConnectionRecordingTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *ConnectionRecording) SetTableName(n string) {
+	r.tableName = n
+}
+
+// validateNewConnectionRecording checks everything but the create time
+func (r *ConnectionRecording) validateNewConnectionRecording(ctx context.Context) error {
+	const op = "session.(ConnectionRecording).validateNewConnectionRecording"
+	switch {
+	case r.ConnectionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	case r.SessionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case r.WorkerId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	case r.StartTime == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing start time")
+	case r.EndTime == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing end time")
+	case r.EndTime.AsTime().Before(r.StartTime.AsTime()):
+		return errors.New(ctx, errors.InvalidParameter, op, "end time is before start time")
+	case r.Size == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing size")
+	}
+	return nil
+}
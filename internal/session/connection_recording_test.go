@@ -0,0 +1,206 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectionRecording(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	start := timestamp.New(time.Now().Add(-time.Minute))
+	end := timestamp.Now()
+
+	type args struct {
+		sessionId    string
+		connectionId string
+		workerId     string
+		startTime    *timestamp.Timestamp
+		endTime      *timestamp.Timestamp
+		size         uint64
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      *ConnectionRecording
+		wantIsErr errors.Code
+	}{
+		{
+			name: "valid",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				startTime:    start,
+				endTime:      end,
+				size:         9,
+			},
+			want: &ConnectionRecording{
+				ConnectionId: "sc_1234567890",
+				SessionId:    "s_1234567890",
+				WorkerId:     "w_1234567890",
+				StartTime:    start,
+				EndTime:      end,
+				Size:         9,
+			},
+		},
+		{
+			name: "empty-worker-id",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				startTime:    start,
+				endTime:      end,
+				size:         9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "empty-session-id",
+			args: args{
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				startTime:    start,
+				endTime:      end,
+				size:         9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "empty-connection-id",
+			args: args{
+				sessionId: "s_1234567890",
+				workerId:  "w_1234567890",
+				startTime: start,
+				endTime:   end,
+				size:      9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-start-time",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				endTime:      end,
+				size:         9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-end-time",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				startTime:    start,
+				size:         9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "end-before-start",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				startTime:    end,
+				endTime:      start,
+				size:         9,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-size",
+			args: args{
+				sessionId:    "s_1234567890",
+				connectionId: "sc_1234567890",
+				workerId:     "w_1234567890",
+				startTime:    start,
+				endTime:      end,
+			},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewConnectionRecording(ctx, tt.args.sessionId, tt.args.connectionId, tt.args.workerId, tt.args.startTime, tt.args.endTime, tt.args.size)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestConnectionRecording_Clone(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	r, err := NewConnectionRecording(context.Background(), "s_1234567890", "sc_1234567890", "w_1234567890", timestamp.New(time.Now().Add(-time.Minute)), timestamp.Now(), 9)
+	require.NoError(err)
+	r.UploadedChunks = 1
+	r.UploadedSize = 4
+	r.CreateTime = timestamp.Now()
+	r.UpdateTime = timestamp.Now()
+
+	cp := r.Clone().(*ConnectionRecording)
+	assert.Equal(r, cp)
+
+	cp.StartTime.Timestamp.Seconds++
+	assert.NotEqual(r.StartTime, cp.StartTime, "clone must not share the start time")
+}
+
+func TestConnectionRecording_VetForWrite(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, err := NewConnectionRecording(ctx, "s_1234567890", "sc_1234567890", "w_1234567890", timestamp.New(time.Now().Add(-time.Minute)), timestamp.Now(), 9)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		fieldMask []string
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "upload-progress",
+			fieldMask: []string{"UploadedChunks", "UploadedSize", "Complete"},
+		},
+		{
+			name:      "size",
+			fieldMask: []string{"UploadedSize", "Size"},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "worker-id",
+			fieldMask: []string{"WorkerId"},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := r.VetForWrite(ctx, nil, db.UpdateOp, db.WithFieldMaskPaths(tt.fieldMask))
+			if tt.wantIsErr != 0 {
+				require.Error(t, err)
+				assert.True(t, errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -21,17 +21,18 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withLimit             int
-	withOrderByCreateTime db.OrderBy
-	withProjectIds        []string
-	withUserId            string
-	withExpirationTime    *timestamp.Timestamp
-	withTestTofu          []byte
-	withListingConvert    bool
-	withSessionIds        []string
-	withDbOpts            []db.Option
-	withWorkerStateDelay  time.Duration
-	withTerminated        bool
+	withLimit                int
+	withOrderByCreateTime    db.OrderBy
+	withProjectIds           []string
+	withUserId               string
+	withExpirationTime       *timestamp.Timestamp
+	withTestTofu             []byte
+	withListingConvert       bool
+	withSessionIds           []string
+	withDbOpts               []db.Option
+	withWorkerStateDelay     time.Duration
+	withTerminated           bool
+	withRecordingStoragePath string
 }
 
 func getDefaultOptions() options {
@@ -120,3 +121,11 @@ func WithTerminated(withTerminated bool) Option {
 		o.withTerminated = withTerminated
 	}
 }
+
+// WithRecordingStoragePath sets the directory the repository stores the
+// recordings of session connections in.
+func WithRecordingStoragePath(path string) Option {
+	return func(o *options) {
+		o.withRecordingStoragePath = path
+	}
+}
@@ -75,4 +75,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withSessionIds = []string{"s_1", "s_2", "s_3"}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRecordingStoragePath", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRecordingStoragePath("/var/lib/boundary/recordings"))
+		testOpts := getDefaultOptions()
+		testOpts.withRecordingStoragePath = "/var/lib/boundary/recordings"
+		assert.Equal(opts, testOpts)
+	})
 }
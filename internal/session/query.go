@@ -402,6 +402,20 @@ update session_connection
  where
 	public_id in (select public_id from connections_to_close)
 returning public_id;
+`
+	updateConnectionBytes = `
+update session_connection
+   set
+	  bytes_up   = @bytes_up,
+	  bytes_down = @bytes_down
+ where
+	public_id = @public_id
+	-- Only connections handled by the worker reporting the bytes
+	and worker_id = @worker_id
+	-- Closed connections have their bytes set when they are closed
+	and closed_reason is null
+	-- Avoid bumping the version when nothing changed
+	and (bytes_up is distinct from @bytes_up or bytes_down is distinct from @bytes_down);
 `
 	checkIfNotActive = `
 select session_id, state
@@ -422,6 +436,17 @@ and
 and
 	session_state.start_time < wt_sub_seconds_from_now(@threshold_seconds)
 ;
+`
+	rewrapSessionCredential = `
+update session_credential
+set
+	credential = @credential,
+	key_id = @key_id
+where
+	session_id = @session_id
+and
+	credential = @previous_credential
+;
 `
 )
 
@@ -23,10 +23,16 @@ type Repository struct {
 
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// recordingStoragePath is the directory the recordings of session
+	// connections are stored in
+	recordingStoragePath string
 }
 
 // NewRepository creates a new session Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations and
+// WithRecordingStoragePath which sets the directory connection recordings are
+// stored in.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "session.NewRepository"
 	if r == nil {
@@ -44,10 +50,11 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		opts.withLimit = db.DefaultLimit
 	}
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:               r,
+		writer:               w,
+		kms:                  kms,
+		defaultLimit:         opts.withLimit,
+		recordingStoragePath: opts.withRecordingStoragePath,
 	}, nil
 }
 
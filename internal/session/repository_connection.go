@@ -393,6 +393,42 @@ func (r *ConnectionRepository) closeOrphanedConnections(ctx context.Context, wor
 	return orphanedConns, nil
 }
 
+// updateConnectionBytes sets the bytes sent up and down over the open
+// connections handled by the worker. Connections which are closed or handled
+// by another worker are left unchanged.
+func (r *ConnectionRepository) updateConnectionBytes(ctx context.Context, workerId string, connBytes []ConnectionBytes) error {
+	const op = "session.(ConnectionRepository).updateConnectionBytes"
+	if workerId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	}
+	if len(connBytes) == 0 {
+		return nil
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			for _, cb := range connBytes {
+				_, err := w.Exec(ctx, updateConnectionBytes, []interface{}{
+					sql.Named("public_id", cb.ConnectionId),
+					sql.Named("worker_id", workerId),
+					sql.Named("bytes_up", int64(cb.BytesUp)),
+					sql.Named("bytes_down", int64(cb.BytesDown)),
+				})
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update bytes of connection %s", cb.ConnectionId)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
 func fetchConnectionStates(ctx context.Context, r db.Reader, connectionId string, opt ...db.Option) ([]*ConnectionState, error) {
 	const op = "session.fetchConnectionStates"
 	var states []*ConnectionState
@@ -0,0 +1,104 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// AddConnectionHttpRequests stores the http requests proxied over connections
+// of the session. Every connection of the requests must belong to the session
+// and a request can only be stored once for its connection and sequence. No
+// options are currently supported.
+func (r *Repository) AddConnectionHttpRequests(ctx context.Context, sessionId string, requests []*ConnectionHttpRequest, _ ...Option) ([]*ConnectionHttpRequest, error) {
+	const op = "session.(Repository).AddConnectionHttpRequests"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection http requests")
+	}
+	connectionIds := make([]string, 0, len(requests))
+	seen := make(map[string]struct{}, len(requests))
+	for _, req := range requests {
+		if req == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection http request")
+		}
+		if err := req.validateNewConnectionHttpRequest(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if _, ok := seen[req.ConnectionId]; !ok {
+			seen[req.ConnectionId] = struct{}{}
+			connectionIds = append(connectionIds, req.ConnectionId)
+		}
+	}
+
+	var returnedRequests []*ConnectionHttpRequest
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			for _, id := range connectionIds {
+				connection := AllocConnection()
+				if err := reader.LookupWhere(ctx, &connection, "public_id = ? and session_id = ?", []interface{}{id, sessionId}); err != nil {
+					if errors.IsNotFoundError(err) {
+						return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("connection %s not found for session %s", id, sessionId))
+					}
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			returnedRequests = make([]*ConnectionHttpRequest, 0, len(requests))
+			items := make([]interface{}, 0, len(requests))
+			for _, req := range requests {
+				cp := req.Clone().(*ConnectionHttpRequest)
+				returnedRequests = append(returnedRequests, cp)
+				items = append(items, cp)
+			}
+			if err := w.CreateItems(ctx, items); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, "connection http request has already been stored")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedRequests, nil
+}
+
+// ListConnectionHttpRequests returns the http requests proxied over the
+// connections of the session ordered by connection and sequence. Supports
+// the WithLimit option.
+func (r *Repository) ListConnectionHttpRequests(ctx context.Context, sessionId string, opt ...Option) ([]*ConnectionHttpRequest, error) {
+	const op = "session.(Repository).ListConnectionHttpRequests"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	requests, err := fetchConnectionHttpRequests(ctx, r.reader, sessionId, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return requests, nil
+}
+
+func fetchConnectionHttpRequests(ctx context.Context, r db.Reader, sessionId string, opt ...db.Option) ([]*ConnectionHttpRequest, error) {
+	const op = "session.fetchConnectionHttpRequests"
+	var requests []*ConnectionHttpRequest
+	opt = append(opt, db.WithOrder("connection_id asc, sequence asc"))
+	if err := r.SearchWhere(ctx, &requests, "connection_id in (select public_id from session_connection where session_id = ?)", []interface{}{sessionId}, opt...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return requests, nil
+}
@@ -0,0 +1,184 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AddConnectionHttpRequests(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	otherSession := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 80, "127.0.0.1")
+	stored := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 80, "127.0.0.1")
+
+	newRequest := func(connectionId string, sequence uint32) *ConnectionHttpRequest {
+		r, err := NewConnectionHttpRequest(ctx, connectionId, sequence, "GET", "/", 200, timestamp.Now())
+		require.NoError(t, err)
+		return r
+	}
+	_, err = repo.AddConnectionHttpRequests(ctx, s.PublicId, []*ConnectionHttpRequest{newRequest(stored.PublicId, 1)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		sessionId string
+		requests  []*ConnectionHttpRequest
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "valid",
+			sessionId: s.PublicId,
+			requests:  []*ConnectionHttpRequest{newRequest(c.PublicId, 1), newRequest(c.PublicId, 2)},
+		},
+		{
+			name:      "missing-session-id",
+			requests:  []*ConnectionHttpRequest{newRequest(c.PublicId, 3)},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "no-requests",
+			sessionId: s.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "nil-request",
+			sessionId: s.PublicId,
+			requests:  []*ConnectionHttpRequest{nil},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "connection-of-other-session",
+			sessionId: otherSession.PublicId,
+			requests:  []*ConnectionHttpRequest{newRequest(c.PublicId, 3)},
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "unknown-connection",
+			sessionId: s.PublicId,
+			requests:  []*ConnectionHttpRequest{newRequest("sc_1234567890", 1)},
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "already-stored",
+			sessionId: s.PublicId,
+			requests:  []*ConnectionHttpRequest{newRequest(stored.PublicId, 1)},
+			wantIsErr: errors.NotUnique,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.AddConnectionHttpRequests(ctx, tt.sessionId, tt.requests)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "unexpected error %s", err.Error())
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.Len(got, len(tt.requests))
+			for i, r := range got {
+				assert.Equal(tt.requests[i].ConnectionId, r.ConnectionId)
+				assert.Equal(tt.requests[i].Sequence, r.Sequence)
+				assert.NotNil(r.CreateTime)
+			}
+		})
+	}
+}
+
+func TestRepository_ListConnectionHttpRequests(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	const testLimit = 3
+	repo, err := NewRepository(rw, rw, kms, WithLimit(testLimit))
+	require.NoError(t, err)
+
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 80, "127.0.0.1")
+	start := time.Now().Add(-time.Hour)
+	var requests []*ConnectionHttpRequest
+	for i := 0; i < testLimit+1; i++ {
+		r, err := NewConnectionHttpRequest(ctx, c.PublicId, uint32(i+1), "GET", "/", 200, timestamp.New(start.Add(time.Duration(i)*time.Minute)))
+		require.NoError(t, err)
+		requests = append(requests, r)
+	}
+	_, err = repo.AddConnectionHttpRequests(ctx, s.PublicId, requests)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		sessionId string
+		opt       []Option
+		wantCnt   int
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "default-limit",
+			sessionId: s.PublicId,
+			wantCnt:   testLimit,
+		},
+		{
+			name:      "no-limit",
+			sessionId: s.PublicId,
+			opt:       []Option{WithLimit(-1)},
+			wantCnt:   testLimit + 1,
+		},
+		{
+			name:      "unknown-session",
+			sessionId: "s_1234567890",
+			wantCnt:   0,
+		},
+		{
+			name:      "missing-session-id",
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ListConnectionHttpRequests(ctx, tt.sessionId, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			require.Len(got, tt.wantCnt)
+			for i, r := range got {
+				assert.Equal(uint32(i+1), r.Sequence)
+			}
+		})
+	}
+
+	t.Run("lookup-session", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, _, err := repo.LookupSession(ctx, s.PublicId)
+		require.NoError(err)
+		require.Len(got.Connections, 1)
+		assert.Len(got.Connections[0].HttpRequests, testLimit+1)
+	})
+}
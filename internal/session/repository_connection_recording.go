@@ -0,0 +1,265 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// connectionRecordingFileExtension is the extension of the files the
+// recordings of session connections are stored in.
+const connectionRecordingFileExtension = ".rec"
+
+// AddConnectionRecording indexes the recording of a session connection. The
+// connection must belong to the session of the recording and must have been
+// proxied by the worker of the recording. Indexing the recording of a
+// connection again returns the recording indexed before, including how much
+// of it has been uploaded, so the worker can resume an interrupted upload. The
+// recording itself is added afterwards with AddConnectionRecordingChunk. No
+// options are currently supported.
+func (r *Repository) AddConnectionRecording(ctx context.Context, recording *ConnectionRecording, _ ...Option) (*ConnectionRecording, error) {
+	const op = "session.(Repository).AddConnectionRecording"
+	if recording == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection recording")
+	}
+	if err := recording.validateNewConnectionRecording(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if r.recordingStoragePath == "" {
+		return nil, errors.New(ctx, errors.Internal, op, "no recording storage path configured")
+	}
+
+	var returnedRecording *ConnectionRecording
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := lookupRecordedConnection(ctx, reader, recording.SessionId, recording.ConnectionId, recording.WorkerId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			existing := AllocConnectionRecording()
+			err := reader.LookupWhere(ctx, &existing, "connection_id = ?", []interface{}{recording.ConnectionId})
+			switch {
+			case err == nil:
+				if existing.Size != recording.Size {
+					return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("connection %s has already been recorded with a size of %d bytes", recording.ConnectionId, existing.Size))
+				}
+				returnedRecording = &existing
+				return nil
+			case !errors.IsNotFoundError(err):
+				return errors.Wrap(ctx, err, op)
+			}
+
+			returnedRecording = recording.Clone().(*ConnectionRecording)
+			if err := w.Create(ctx, returnedRecording); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedRecording, nil
+}
+
+// AddConnectionRecordingChunk stores the next chunk of the recording of a
+// session connection in the recording storage path of the repository and
+// returns the updated progress of the upload. The recording must have been
+// indexed with AddConnectionRecording, the chunk must be uploaded by the
+// worker which recorded the connection, chunks must be added in order
+// starting at 0 and the chunks of a recording must not exceed its size. The
+// recording is marked complete once all of its bytes have been added. No
+// options are currently supported.
+func (r *Repository) AddConnectionRecordingChunk(ctx context.Context, sessionId, connectionId, workerId string, chunkNumber uint32, data []byte, _ ...Option) (*ConnectionRecording, error) {
+	const op = "session.(Repository).AddConnectionRecordingChunk"
+	switch {
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case connectionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	case workerId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	case len(data) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing data")
+	case r.recordingStoragePath == "":
+		return nil, errors.New(ctx, errors.Internal, op, "no recording storage path configured")
+	}
+
+	var returnedRecording *ConnectionRecording
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			recording := AllocConnectionRecording()
+			if err := reader.LookupWhere(ctx, &recording, "connection_id = ? and session_id = ?", []interface{}{connectionId, sessionId}); err != nil {
+				if errors.IsNotFoundError(err) {
+					return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("recording of connection %s not found for session %s", connectionId, sessionId))
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			switch {
+			case recording.WorkerId != workerId:
+				return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("connection %s was not recorded by worker %s", connectionId, workerId))
+			case chunkNumber < recording.UploadedChunks:
+				return errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("chunk %d of the recording of connection %s has already been added", chunkNumber, connectionId))
+			case chunkNumber > recording.UploadedChunks:
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("expected chunk %d of the recording of connection %s but got chunk %d", recording.UploadedChunks, connectionId, chunkNumber))
+			case recording.UploadedSize+uint64(len(data)) > recording.Size:
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("chunk %d exceeds the size of the recording of connection %s", chunkNumber, connectionId))
+			}
+
+			// The chunk is written at the offset of the bytes uploaded so far
+			// before the progress is updated, so writing it again after a
+			// failed update overwrites the same bytes.
+			if err := r.writeConnectionRecording(ctx, &recording, data); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			returnedRecording = recording.Clone().(*ConnectionRecording)
+			returnedRecording.UploadedChunks++
+			returnedRecording.UploadedSize += uint64(len(data))
+			returnedRecording.Complete = returnedRecording.UploadedSize == returnedRecording.Size
+			rowsUpdated, err := w.Update(ctx, returnedRecording, []string{"UploadedChunks", "UploadedSize", "Complete"}, nil, db.WithWhere("uploaded_chunks = ?", chunkNumber))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("chunk %d of the recording of connection %s has already been added", chunkNumber, connectionId))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedRecording, nil
+}
+
+// ListConnectionRecordings returns the complete recordings of the connections
+// of the session ordered by their start time. The recordings themselves are
+// not returned, only their metadata. Supports the WithLimit option.
+func (r *Repository) ListConnectionRecordings(ctx context.Context, sessionId string, opt ...Option) ([]*ConnectionRecording, error) {
+	const op = "session.(Repository).ListConnectionRecordings"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var recordings []*ConnectionRecording
+	if err := r.reader.SearchWhere(ctx, &recordings, "session_id = ? and complete", []interface{}{sessionId}, db.WithLimit(limit), db.WithOrder("start_time asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return recordings, nil
+}
+
+// LookupConnectionRecording returns the metadata of the complete recording of
+// the connection. Returns nil, nil if the connection of the session has no
+// recording or the recording has not been completely uploaded yet. No options
+// are currently supported.
+func (r *Repository) LookupConnectionRecording(ctx context.Context, sessionId, connectionId string, _ ...Option) (*ConnectionRecording, error) {
+	const op = "session.(Repository).LookupConnectionRecording"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	if connectionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+	recording := AllocConnectionRecording()
+	if err := r.reader.LookupWhere(ctx, &recording, "connection_id = ? and session_id = ? and complete", []interface{}{connectionId, sessionId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", connectionId)))
+	}
+	return &recording, nil
+}
+
+// ReadConnectionRecording returns the recorded data of a complete recording
+// returned by LookupConnectionRecording from the recording storage path of
+// the repository. No options are currently supported.
+func (r *Repository) ReadConnectionRecording(ctx context.Context, recording *ConnectionRecording, _ ...Option) ([]byte, error) {
+	const op = "session.(Repository).ReadConnectionRecording"
+	switch {
+	case recording == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection recording")
+	case !recording.Complete:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("recording of connection %s is not complete", recording.ConnectionId))
+	case r.recordingStoragePath == "":
+		return nil, errors.New(ctx, errors.Internal, op, "no recording storage path configured")
+	}
+	f, err := os.Open(r.connectionRecordingPath(recording))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to open recording of connection %s", recording.ConnectionId)))
+	}
+	defer f.Close()
+	data := make([]byte, recording.Size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to read recording of connection %s", recording.ConnectionId)))
+	}
+	return data, nil
+}
+
+// writeConnectionRecording writes data to the file of the recording at the
+// offset of the bytes of the recording uploaded so far.
+func (r *Repository) writeConnectionRecording(ctx context.Context, recording *ConnectionRecording, data []byte) error {
+	const op = "session.(Repository).writeConnectionRecording"
+	path := r.connectionRecordingPath(recording)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg("unable to create recording directory"))
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0o600)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to open recording of connection %s", recording.ConnectionId)))
+	}
+	if _, err := f.WriteAt(data, int64(recording.UploadedSize)); err != nil {
+		_ = f.Close()
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to write recording of connection %s", recording.ConnectionId)))
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to write recording of connection %s", recording.ConnectionId)))
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Io), errors.WithMsg(fmt.Sprintf("unable to write recording of connection %s", recording.ConnectionId)))
+	}
+	return nil
+}
+
+// connectionRecordingPath returns the path of the file the recording is
+// stored in: <recording storage path>/<session id>/<connection id>.rec
+func (r *Repository) connectionRecordingPath(recording *ConnectionRecording) string {
+	return filepath.Join(r.recordingStoragePath, recording.SessionId, recording.ConnectionId+connectionRecordingFileExtension)
+}
+
+// lookupRecordedConnection checks that the connection belongs to the session
+// and was proxied by the worker.
+func lookupRecordedConnection(ctx context.Context, reader db.Reader, sessionId, connectionId, workerId string) error {
+	const op = "session.lookupRecordedConnection"
+	connection := AllocConnection()
+	if err := reader.LookupWhere(ctx, &connection, "public_id = ? and session_id = ?", []interface{}{connectionId, sessionId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("connection %s not found for session %s", connectionId, sessionId))
+		}
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := reader.LookupWhere(ctx, &connection, "public_id = ? and worker_id = ?", []interface{}{connectionId, workerId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("connection %s was not proxied by worker %s", connectionId, workerId))
+		}
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
@@ -0,0 +1,495 @@
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWorkerConnection creates a connection of the session proxied by the
+// worker.
+func testWorkerConnection(t *testing.T, conn *db.DB, sessionId, workerId string) *Connection {
+	t.Helper()
+	c := TestConnection(t, conn, sessionId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+	rw := db.New(conn)
+	rowsUpdated, err := rw.Exec(context.Background(), "update session_connection set worker_id = ? where public_id = ?", []interface{}{workerId, c.PublicId})
+	require.NoError(t, err)
+	require.Equal(t, 1, rowsUpdated)
+	return c
+}
+
+func TestRepository_AddConnectionRecording(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms, WithRecordingStoragePath(t.TempDir()))
+	require.NoError(t, err)
+
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	otherWorker := server.TestKmsWorker(t, conn, wrapper)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	otherSession := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	recorded := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+
+	start := timestamp.New(time.Now().Add(-time.Minute))
+	end := timestamp.Now()
+	newRecording := func(sessionId, connectionId, workerId string) *ConnectionRecording {
+		r, err := NewConnectionRecording(ctx, sessionId, connectionId, workerId, start, end, 9)
+		require.NoError(t, err)
+		return r
+	}
+	_, err = repo.AddConnectionRecording(ctx, newRecording(s.PublicId, recorded.PublicId, worker.PublicId))
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecordingChunk(ctx, s.PublicId, recorded.PublicId, worker.PublicId, 0, []byte("reco"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name               string
+		recording          *ConnectionRecording
+		wantUploadedChunks uint32
+		wantUploadedSize   uint64
+		wantIsErr          errors.Code
+	}{
+		{
+			name:      "valid",
+			recording: newRecording(s.PublicId, c.PublicId, worker.PublicId),
+		},
+		{
+			name:      "nil-recording",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "missing-size",
+			recording: func() *ConnectionRecording {
+				r := newRecording(s.PublicId, c.PublicId, worker.PublicId)
+				r.Size = 0
+				return r
+			}(),
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "connection-of-other-session",
+			recording: newRecording(otherSession.PublicId, c.PublicId, worker.PublicId),
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "unknown-connection",
+			recording: newRecording(s.PublicId, "sc_1234567890", worker.PublicId),
+			wantIsErr: errors.RecordNotFound,
+		},
+		{
+			name:      "connection-of-other-worker",
+			recording: newRecording(s.PublicId, c.PublicId, otherWorker.PublicId),
+			wantIsErr: errors.Forbidden,
+		},
+		{
+			name:               "already-recorded",
+			recording:          newRecording(s.PublicId, recorded.PublicId, worker.PublicId),
+			wantUploadedChunks: 1,
+			wantUploadedSize:   4,
+		},
+		{
+			name: "already-recorded-with-other-size",
+			recording: func() *ConnectionRecording {
+				r := newRecording(s.PublicId, recorded.PublicId, worker.PublicId)
+				r.Size = 10
+				return r
+			}(),
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.AddConnectionRecording(ctx, tt.recording)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "unexpected error %s", err.Error())
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.recording.ConnectionId, got.ConnectionId)
+			assert.Equal(tt.recording.SessionId, got.SessionId)
+			assert.Equal(tt.recording.WorkerId, got.WorkerId)
+			assert.Equal(tt.recording.Size, got.Size)
+			assert.Equal(tt.wantUploadedChunks, got.UploadedChunks)
+			assert.Equal(tt.wantUploadedSize, got.UploadedSize)
+			assert.False(got.Complete)
+			assert.NotNil(got.CreateTime)
+		})
+	}
+
+	t.Run("no-recording-storage-path", func(t *testing.T) {
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(t, err)
+		_, err = repo.AddConnectionRecording(ctx, newRecording(s.PublicId, c.PublicId, worker.PublicId))
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.Internal), err))
+	})
+}
+
+func TestRepository_AddConnectionRecordingChunk(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	storagePath := t.TempDir()
+	repo, err := NewRepository(rw, rw, kms, WithRecordingStoragePath(storagePath))
+	require.NoError(t, err)
+
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	otherWorker := server.TestKmsWorker(t, conn, wrapper)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	otherSession := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	notRecorded := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	r, err := NewConnectionRecording(ctx, s.PublicId, c.PublicId, worker.PublicId, timestamp.New(time.Now().Add(-time.Minute)), timestamp.Now(), 9)
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecording(ctx, r)
+	require.NoError(t, err)
+
+	// The test cases run in order since each chunk depends on the chunks
+	// added before it.
+	tests := []struct {
+		name         string
+		sessionId    string
+		connectionId string
+		workerId     string
+		chunkNumber  uint32
+		data         string
+		wantComplete bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "first-chunk",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  0,
+			data:         "reco",
+		},
+		{
+			name:         "missing-session-id",
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  1,
+			data:         "rdi",
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:        "missing-connection-id",
+			sessionId:   s.PublicId,
+			workerId:    worker.PublicId,
+			chunkNumber: 1,
+			data:        "rdi",
+			wantIsErr:   errors.InvalidParameter,
+		},
+		{
+			name:         "missing-worker-id",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			chunkNumber:  1,
+			data:         "rdi",
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "missing-data",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  1,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "other-session",
+			sessionId:    otherSession.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  1,
+			data:         "rdi",
+			wantIsErr:    errors.RecordNotFound,
+		},
+		{
+			name:         "other-worker",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     otherWorker.PublicId,
+			chunkNumber:  1,
+			data:         "rdi",
+			wantIsErr:    errors.Forbidden,
+		},
+		{
+			name:         "not-recorded",
+			sessionId:    s.PublicId,
+			connectionId: notRecorded.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  0,
+			data:         "reco",
+			wantIsErr:    errors.RecordNotFound,
+		},
+		{
+			name:         "already-added",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  0,
+			data:         "reco",
+			wantIsErr:    errors.NotUnique,
+		},
+		{
+			name:         "out-of-order",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  2,
+			data:         "ng",
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "exceeds-size",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  1,
+			data:         "rding!",
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:         "second-chunk",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  1,
+			data:         "rdi",
+		},
+		{
+			name:         "last-chunk",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			workerId:     worker.PublicId,
+			chunkNumber:  2,
+			data:         "ng",
+			wantComplete: true,
+		},
+	}
+	var wantUploadedSize uint64
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.AddConnectionRecordingChunk(ctx, tt.sessionId, tt.connectionId, tt.workerId, tt.chunkNumber, []byte(tt.data))
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "unexpected error %s", err.Error())
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			wantUploadedSize += uint64(len(tt.data))
+			assert.Equal(tt.chunkNumber+1, got.UploadedChunks)
+			assert.Equal(wantUploadedSize, got.UploadedSize)
+			assert.Equal(tt.wantComplete, got.Complete)
+
+			lookup, err := repo.LookupConnectionRecording(ctx, s.PublicId, c.PublicId)
+			require.NoError(err)
+			if !tt.wantComplete {
+				assert.Nil(lookup, "incomplete recordings must not be returned in test case %d", i)
+				return
+			}
+			require.NotNil(lookup)
+			assert.True(lookup.Complete)
+		})
+	}
+
+	data, err := os.ReadFile(filepath.Join(storagePath, s.PublicId, c.PublicId+connectionRecordingFileExtension))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("recording"), data)
+
+	_, err = repo.AddConnectionRecordingChunk(ctx, s.PublicId, c.PublicId, worker.PublicId, 3, []byte("!"))
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err), "chunks must not be added to a complete recording")
+}
+
+func TestRepository_ListConnectionRecordings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	const testLimit = 3
+	repo, err := NewRepository(rw, rw, kms, WithLimit(testLimit), WithRecordingStoragePath(t.TempDir()))
+	require.NoError(t, err)
+
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	start := time.Now().Add(-time.Hour)
+	var want []*ConnectionRecording
+	for i := 0; i < testLimit+1; i++ {
+		c := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+		r, err := NewConnectionRecording(ctx, s.PublicId, c.PublicId, worker.PublicId, timestamp.New(start.Add(time.Duration(i)*time.Minute)), timestamp.New(start.Add(time.Duration(i+1)*time.Minute)), 9)
+		require.NoError(t, err)
+		r, err = repo.AddConnectionRecording(ctx, r)
+		require.NoError(t, err)
+		_, err = repo.AddConnectionRecordingChunk(ctx, s.PublicId, c.PublicId, worker.PublicId, 0, []byte("recording"))
+		require.NoError(t, err)
+		want = append(want, r)
+	}
+
+	// An incomplete recording is not listed.
+	incomplete := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	r, err := NewConnectionRecording(ctx, s.PublicId, incomplete.PublicId, worker.PublicId, timestamp.New(start), timestamp.New(start.Add(time.Minute)), 9)
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecording(ctx, r)
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecordingChunk(ctx, s.PublicId, incomplete.PublicId, worker.PublicId, 0, []byte("record"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		sessionId string
+		opt       []Option
+		wantCnt   int
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "default-limit",
+			sessionId: s.PublicId,
+			wantCnt:   testLimit,
+		},
+		{
+			name:      "no-limit",
+			sessionId: s.PublicId,
+			opt:       []Option{WithLimit(-1)},
+			wantCnt:   testLimit + 1,
+		},
+		{
+			name:      "unknown-session",
+			sessionId: "s_1234567890",
+			wantCnt:   0,
+		},
+		{
+			name:      "missing-session-id",
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.ListConnectionRecordings(ctx, tt.sessionId, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			require.Len(got, tt.wantCnt)
+			for i, r := range got {
+				assert.Equal(want[i].ConnectionId, r.ConnectionId)
+				assert.Equal(uint64(9), r.Size)
+				assert.True(r.Complete)
+			}
+		})
+	}
+}
+
+func TestRepository_LookupConnectionRecording(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms, WithRecordingStoragePath(t.TempDir()))
+	require.NoError(t, err)
+
+	worker := server.TestKmsWorker(t, conn, wrapper)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	notRecorded := testWorkerConnection(t, conn, s.PublicId, worker.PublicId)
+	r, err := NewConnectionRecording(ctx, s.PublicId, c.PublicId, worker.PublicId, timestamp.New(time.Now().Add(-time.Minute)), timestamp.Now(), 9)
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecording(ctx, r)
+	require.NoError(t, err)
+	_, err = repo.AddConnectionRecordingChunk(ctx, s.PublicId, c.PublicId, worker.PublicId, 0, []byte("recording"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		sessionId    string
+		connectionId string
+		wantFound    bool
+		wantIsErr    errors.Code
+	}{
+		{
+			name:         "found",
+			sessionId:    s.PublicId,
+			connectionId: c.PublicId,
+			wantFound:    true,
+		},
+		{
+			name:         "not-recorded",
+			sessionId:    s.PublicId,
+			connectionId: notRecorded.PublicId,
+		},
+		{
+			name:         "other-session",
+			sessionId:    "s_1234567890",
+			connectionId: c.PublicId,
+		},
+		{
+			name:         "missing-session-id",
+			connectionId: c.PublicId,
+			wantIsErr:    errors.InvalidParameter,
+		},
+		{
+			name:      "missing-connection-id",
+			sessionId: s.PublicId,
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.LookupConnectionRecording(ctx, tt.sessionId, tt.connectionId)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			if !tt.wantFound {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(uint64(9), got.Size)
+			assert.True(got.Complete)
+
+			data, err := repo.ReadConnectionRecording(ctx, got)
+			require.NoError(err)
+			assert.Equal([]byte("recording"), data)
+		})
+	}
+}
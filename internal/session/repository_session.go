@@ -35,13 +35,13 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if newSession.TargetId == "" {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
 	}
-	if newSession.HostId == "" {
+	if newSession.HostId == "" && newSession.HostSetId != "" {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing host id")
 	}
 	if newSession.UserId == "" {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
 	}
-	if newSession.HostSetId == "" {
+	if newSession.HostSetId == "" && newSession.HostId != "" {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
 	}
 	if newSession.AuthTokenId == "" {
@@ -199,6 +199,19 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, _ ...O
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if len(connections) > 0 {
+				httpRequests, err := fetchConnectionHttpRequests(ctx, read, sessionId, db.WithLimit(-1))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				byConnection := make(map[string][]*ConnectionHttpRequest, len(connections))
+				for _, r := range httpRequests {
+					byConnection[r.ConnectionId] = append(byConnection[r.ConnectionId], r)
+				}
+				for _, c := range connections {
+					c.HttpRequests = byConnection[c.PublicId]
+				}
+			}
 			session.Connections = connections
 			return nil
 		},
@@ -560,7 +573,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 			if err := updatedSession.encrypt(ctx, databaseWrapper); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
-			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken"}, nil)
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken", "TofuKeyId"}, nil)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -1064,6 +1064,43 @@ func TestRepository_CancelSession(t *testing.T) {
 	}
 }
 
+func TestRepository_CancelSessionsOfDisabledUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+	pending := TestSession(t, conn, wrapper, composedOf)
+	active := TestSession(t, conn, wrapper, composedOf)
+	_, _, err = repo.ActivateSession(ctx, active.PublicId, active.Version, TestTofu(t))
+	require.NoError(t, err)
+	other := TestDefaultSession(t, conn, wrapper, iamRepo)
+
+	assert, require := assert.New(t), require.New(t)
+	u, _, err := iamRepo.LookupUser(ctx, composedOf.UserId)
+	require.NoError(err)
+	u.State = string(iam.DisabledUserState)
+	_, _, rowsUpdated, err := iamRepo.UpdateUser(ctx, u, u.Version, []string{"State"})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+
+	// the sessions of the disabled user are canceled in the same transaction
+	for _, id := range []string{pending.PublicId, active.PublicId} {
+		s, _, err := repo.LookupSession(ctx, id)
+		require.NoError(err)
+		assert.Equal(StatusCanceling, s.States[0].Status)
+	}
+	s, _, err := repo.LookupSession(ctx, other.PublicId)
+	require.NoError(err)
+	assert.Equal(StatusPending, s.States[0].Status)
+}
+
 func TestRepository_CancelSessionViaFKNull(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,144 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+func init() {
+	kms.RegisterTableRewrapFn(defaultSessionTableName, sessionRewrapFn)
+	kms.RegisterTableColumnRewrapFn(defaultSessionTableName, "tofu_key_id", sessionTofuTokenRewrapFn)
+	kms.RegisterTableRewrapFn("session_credential", sessionCredentialRewrapFn)
+}
+
+func rewrapParameterChecks(ctx context.Context, op errors.Op, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	switch {
+	case dataKeyVersionId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing data key version id")
+	case scopeId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case reader == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database reader")
+	case writer == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing database writer")
+	case kmsCache == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	return nil
+}
+
+// sessionRewrapFn moves the terminated sessions whose key id is the data key
+// version to the current version of the sessions key of the project. The key
+// id of a session identifies the key its certificate and private key are
+// derived from, so the sessions which have not been terminated yet are left
+// alone and picked up once they are. The tofu token is encrypted with the
+// database key and is rewrapped by sessionTofuTokenRewrapFn.
+func sessionRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "session.sessionRewrapFn"
+	if err := rewrapParameterChecks(ctx, op, dataKeyVersionId, scopeId, reader, writer, kmsCache); err != nil {
+		return err
+	}
+
+	var sessions []*Session
+	if err := reader.SearchWhere(ctx, &sessions, "key_id = ? and termination_reason is not null", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+	sessionWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeSessions)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms sessions wrapper for rewrapping"))
+	}
+	sessionKeyId, err := sessionWrapper.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to get sessions wrapper key id"))
+	}
+	for _, s := range sessions {
+		s.KeyId = sessionKeyId
+		if _, err := writer.Update(ctx, s, []string{"KeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update session row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// sessionTofuTokenRewrapFn re-encrypts the tofu tokens of the sessions which
+// were encrypted with the data key version using the current database wrapper
+// of the project.
+func sessionTofuTokenRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "session.sessionTofuTokenRewrapFn"
+	if err := rewrapParameterChecks(ctx, op, dataKeyVersionId, scopeId, reader, writer, kmsCache); err != nil {
+		return err
+	}
+
+	var sessions []*Session
+	if err := reader.SearchWhere(ctx, &sessions, "tofu_key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, s := range sessions {
+		if err := s.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt session tofu token"))
+		}
+		if err := s.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt session tofu token"))
+		}
+		if _, err := writer.Update(ctx, s, []string{"CtTofuToken", "TofuKeyId"}, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update session row with rewrapped fields"))
+		}
+	}
+	return nil
+}
+
+// sessionCredentialRewrapFn re-encrypts the session credentials which were
+// encrypted with the data key version using the current database wrapper of
+// the project.
+func sessionCredentialRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsCache *kms.Kms) error {
+	const op = "session.sessionCredentialRewrapFn"
+	if err := rewrapParameterChecks(ctx, op, dataKeyVersionId, scopeId, reader, writer, kmsCache); err != nil {
+		return err
+	}
+
+	var creds []*credential
+	if err := reader.SearchWhere(ctx, &creds, "key_id = ?", []interface{}{dataKeyVersionId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	if len(creds) == 0 {
+		return nil
+	}
+	wrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, cred := range creds {
+		// session_credential has no primary key, so the row is identified by
+		// its session id and its previous ciphertext.
+		previousCredential := cred.CtCredential
+		if err := cred.decrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt session credential"))
+		}
+		if err := cred.encrypt(ctx, wrapper); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt session credential"))
+		}
+		if _, err := writer.Exec(ctx, rewrapSessionCredential, []interface{}{
+			sql.Named("credential", cred.CtCredential),
+			sql.Named("key_id", cred.KeyId),
+			sql.Named("session_id", cred.SessionId),
+			sql.Named("previous_credential", previousCredential),
+		}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update session credential row with rewrapped fields"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrap_sessionRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := sessionRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = sessionRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		iamRepo := iam.TestRepo(t, conn, wrapper)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kmsCache)
+		require.NoError(err)
+
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		sessionWrapper, err := kmsCache.GetWrapper(ctx, composedOf.ProjectId, kms.KeyPurposeSessions)
+		require.NoError(err)
+		s, _, err := repo.CreateSession(ctx, sessionWrapper, &Session{
+			UserId:          composedOf.UserId,
+			HostId:          composedOf.HostId,
+			TargetId:        composedOf.TargetId,
+			HostSetId:       composedOf.HostSetId,
+			AuthTokenId:     composedOf.AuthTokenId,
+			ProjectId:       composedOf.ProjectId,
+			Endpoint:        "tcp://127.0.0.1:22",
+			ExpirationTime:  composedOf.ExpirationTime,
+			ConnectionLimit: composedOf.ConnectionLimit,
+		}, []string{"1.2.3.4"})
+		require.NoError(err)
+		tofu := TestTofu(t)
+		_, _, err = repo.ActivateSession(ctx, s.GetPublicId(), s.Version, tofu)
+		require.NoError(err)
+
+		orig := AllocSession()
+		orig.PublicId = s.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &orig))
+
+		require.NoError(kmsCache.RotateKeys(ctx, composedOf.ProjectId))
+
+		// sessions which have not been terminated keep their key id
+		require.NoError(sessionRewrapFn(ctx, orig.KeyId, composedOf.ProjectId, rw, rw, kmsCache))
+		got := AllocSession()
+		got.PublicId = s.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &got))
+		assert.Equal(orig.KeyId, got.KeyId)
+		assert.Equal(orig.CtTofuToken, got.CtTofuToken)
+
+		_, err = repo.CancelSession(ctx, s.GetPublicId(), got.Version)
+		require.NoError(err)
+		_, err = repo.TerminateCompletedSessions(ctx)
+		require.NoError(err)
+
+		require.NoError(sessionRewrapFn(ctx, orig.KeyId, composedOf.ProjectId, rw, rw, kmsCache))
+		got = AllocSession()
+		got.PublicId = s.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &got))
+
+		sessionWrapper, err = kmsCache.GetWrapper(ctx, composedOf.ProjectId, kms.KeyPurposeSessions)
+		require.NoError(err)
+		currentKeyId, err := sessionWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.KeyId)
+		// the tofu token is encrypted with the database key
+		assert.Equal(orig.CtTofuToken, got.CtTofuToken)
+		assert.Equal(orig.TofuKeyId, got.TofuKeyId)
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.KeyId)
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
+
+func TestRewrap_sessionTofuTokenRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := sessionTofuTokenRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = sessionTofuTokenRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		iamRepo := iam.TestRepo(t, conn, wrapper)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kmsCache)
+		require.NoError(err)
+
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		tofu := TestTofu(t)
+		_, _, err = repo.ActivateSession(ctx, s.GetPublicId(), s.Version, tofu)
+		require.NoError(err)
+
+		orig := AllocSession()
+		orig.PublicId = s.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &orig))
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+		require.NoError(err)
+		origDatabaseKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(origDatabaseKeyId, orig.TofuKeyId)
+
+		require.NoError(kmsCache.RotateKeys(ctx, s.ProjectId))
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.TofuKeyId)
+		require.NoError(err)
+		assert.True(referenced)
+
+		// Only the previous version of the database key is rewrapped, the
+		// session keeps using the previous version of the sessions key.
+		for name, fn := range kms.ListTablesRewrapFns() {
+			require.NoError(fn(ctx, orig.TofuKeyId, s.ProjectId, rw, rw, kmsCache), name)
+		}
+		got := AllocSession()
+		got.PublicId = s.GetPublicId()
+		require.NoError(rw.LookupById(ctx, &got))
+		databaseWrapper, err = kmsCache.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentDatabaseKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentDatabaseKeyId, got.TofuKeyId)
+		assert.NotEqual(orig.CtTofuToken, got.CtTofuToken)
+		assert.Equal(orig.KeyId, got.KeyId)
+
+		referenced, err = kmsCache.DataKeyVersionReferenced(ctx, orig.TofuKeyId)
+		require.NoError(err)
+		assert.False(referenced)
+
+		// The session can still be read once the previous version of the
+		// database key is gone.
+		require.NoError(kmsCache.DestroyKeyVersion(ctx, s.ProjectId, orig.TofuKeyId))
+		found, _, err := repo.LookupSession(ctx, s.GetPublicId())
+		require.NoError(err)
+		require.NotNil(found)
+		assert.Equal(tofu, found.TofuToken)
+	})
+}
+
+func TestRewrap_sessionCredentialRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-missing-parameters", func(t *testing.T) {
+		err := sessionCredentialRewrapFn(ctx, "", "some_id", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+		err = sessionCredentialRewrapFn(ctx, "some_id", "", &db.Db{}, &db.Db{}, &kms.Kms{})
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		conn, _ := db.TestSetup(t, "postgres")
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+		iamRepo := iam.TestRepo(t, conn, wrapper)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kmsCache)
+		require.NoError(err)
+
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		require.NoError(repo.AddSessionCredentials(ctx, s.ProjectId, s.GetPublicId(), []Credential{[]byte("secret")}))
+
+		var origCreds []*credential
+		require.NoError(rw.SearchWhere(ctx, &origCreds, "session_id = ?", []interface{}{s.GetPublicId()}))
+		require.Len(origCreds, 1)
+		orig := origCreds[0]
+
+		require.NoError(kmsCache.RotateKeys(ctx, s.ProjectId))
+
+		require.NoError(sessionCredentialRewrapFn(ctx, orig.KeyId, s.ProjectId, rw, rw, kmsCache))
+
+		var gotCreds []*credential
+		require.NoError(rw.SearchWhere(ctx, &gotCreds, "session_id = ?", []interface{}{s.GetPublicId()}))
+		require.Len(gotCreds, 1)
+		got := gotCreds[0]
+
+		databaseWrapper, err := kmsCache.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+		require.NoError(err)
+		currentKeyId, err := databaseWrapper.KeyId(ctx)
+		require.NoError(err)
+		assert.Equal(currentKeyId, got.KeyId)
+		assert.NotEqual(orig.CtCredential, got.CtCredential)
+
+		creds, err := repo.ListSessionCredentials(ctx, s.ProjectId, s.GetPublicId())
+		require.NoError(err)
+		assert.Equal([]Credential{[]byte("secret")}, creds)
+
+		referenced, err := kmsCache.DataKeyVersionReferenced(ctx, orig.KeyId)
+		require.NoError(err)
+		assert.False(referenced)
+	})
+}
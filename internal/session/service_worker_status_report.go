@@ -13,6 +13,18 @@ type StateReport struct {
 	SessionId     string
 	Status        Status
 	ConnectionIds []string
+
+	// ConnectionBytes are the bytes sent over the open connections of the
+	// session as reported by the worker.
+	ConnectionBytes []ConnectionBytes
+}
+
+// ConnectionBytes is used to report the bytes sent up and down over an open
+// connection.
+type ConnectionBytes struct {
+	ConnectionId string
+	BytesUp      uint64
+	BytesDown    uint64
 }
 
 // WorkerStatusReport is a domain service function that compares the state of
@@ -21,15 +33,18 @@ type StateReport struct {
 // canceling or terminated state. It also will check for any orphaned
 // connections, which is defined as a connection that is in an active state,
 // but was not reported by worker. Any orphaned connections will be marked as
-// closed.
+// closed. The bytes sent over the reported connections which are still open
+// are updated.
 func WorkerStatusReport(ctx context.Context, repo *Repository, connRepo *ConnectionRepository, workerId string, report []StateReport) ([]StateReport, error) {
 	const op = "session.WorkerStatusReport"
 
 	reportedConnections := make([]string, 0)
 	reportedSessions := make([]string, 0, len(report))
+	var reportedBytes []ConnectionBytes
 	for _, r := range report {
 		reportedSessions = append(reportedSessions, r.SessionId)
 		reportedConnections = append(reportedConnections, r.ConnectionIds...)
+		reportedBytes = append(reportedBytes, r.ConnectionBytes...)
 	}
 
 	notActive, err := repo.checkIfNoLongerActive(ctx, reportedSessions)
@@ -44,5 +59,9 @@ func WorkerStatusReport(ctx context.Context, repo *Repository, connRepo *Connect
 	if len(closed) > 0 {
 		event.WriteSysEvent(ctx, op, "marked unclaimed connections as closed", "controller_id", workerId, "count", len(closed))
 	}
-	return notActive, err
+
+	if err := connRepo.updateConnectionBytes(ctx, workerId, reportedBytes); err != nil {
+		return notActive, errors.New(ctx, errors.Internal, op, fmt.Sprintf("Error updating connection bytes for worker %s: %v", workerId, err))
+	}
+	return notActive, nil
 }
@@ -56,6 +56,7 @@ func TestWorkerStatusReport(t *testing.T) {
 		req                 []session.StateReport
 		want                []session.StateReport
 		orphanedConnections []string
+		connectionBytes     []session.ConnectionBytes
 	}
 	cases := []struct {
 		name   string
@@ -366,6 +367,45 @@ func TestWorkerStatusReport(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "ConnectionBytes",
+			caseFn: func(t *testing.T) testCase {
+				worker := server.TestKmsWorker(t, conn, wrapper)
+				sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+					UserId:          uId,
+					HostId:          h.GetPublicId(),
+					TargetId:        tar.GetPublicId(),
+					HostSetId:       hs.GetPublicId(),
+					AuthTokenId:     at.GetPublicId(),
+					ProjectId:       prj.GetPublicId(),
+					Endpoint:        "tcp://127.0.0.1:22",
+					ConnectionLimit: 10,
+				})
+				tofu := session.TestTofu(t)
+				sess, _, err = repo.ActivateSession(ctx, sess.PublicId, sess.Version, tofu)
+				require.NoError(t, err)
+				connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker.PublicId)
+				require.NoError(t, err)
+				connBytes := session.ConnectionBytes{
+					ConnectionId: connection.PublicId,
+					BytesUp:      1024,
+					BytesDown:    2048,
+				}
+				return testCase{
+					worker: worker,
+					req: []session.StateReport{
+						{
+							SessionId:       sess.PublicId,
+							Status:          session.StatusActive,
+							ConnectionIds:   []string{connection.PublicId},
+							ConnectionBytes: []session.ConnectionBytes{connBytes},
+						},
+					},
+					want:            []session.StateReport{},
+					connectionBytes: []session.ConnectionBytes{connBytes},
+				}
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -385,6 +425,13 @@ func TestWorkerStatusReport(t *testing.T) {
 				assert.Nil(states[0].EndTime)
 				assert.Equal(session.StatusClosed, states[0].Status)
 			}
+			for _, cb := range tc.connectionBytes {
+				gotConn, _, err := connRepo.LookupConnection(ctx, cb.ConnectionId)
+				require.NoError(err)
+				assert.Equal(cb.BytesUp, gotConn.BytesUp)
+				assert.Equal(cb.BytesDown, gotConn.BytesDown)
+				assert.Empty(gotConn.ClosedReason)
+			}
 		})
 	}
 }
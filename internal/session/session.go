@@ -28,11 +28,13 @@ const (
 type ComposedOf struct {
 	// UserId of the session
 	UserId string
-	// HostId of the session
+	// HostId of the session. It is empty when the target has an address
+	// instead of host sources.
 	HostId string
 	// TargetId of the session
 	TargetId string
-	// HostSetId of the session
+	// HostSetId of the session. It is empty when the target has an address
+	// instead of host sources.
 	HostSetId string
 	// AuthTokenId of the session
 	AuthTokenId string
@@ -51,6 +53,14 @@ type ComposedOf struct {
 	// existed at creation time. Round tripping it through here saves a lookup
 	// in the DB. It is not stored in the warehouse.
 	WorkerFilter string
+	// EnableSessionRecording indicates whether the worker records the
+	// connections of the session. It is copied from the target when the
+	// session is created.
+	EnableSessionRecording bool
+	// IdleTimeoutSeconds is the number of seconds a connection of the session
+	// may carry no traffic before the worker closes it. It is copied from the
+	// target when the session is created.
+	IdleTimeoutSeconds uint32
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
@@ -99,6 +109,12 @@ type Session struct {
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
 	// Worker filter
 	WorkerFilter string `json:"-" gorm:"default:null"`
+	// EnableSessionRecording indicates whether the connections of the session
+	// are recorded
+	EnableSessionRecording bool `json:"enable_session_recording,omitempty" gorm:"default:null"`
+	// IdleTimeoutSeconds is the number of seconds a connection of the session
+	// may carry no traffic before the worker closes it
+	IdleTimeoutSeconds uint32 `json:"idle_timeout_seconds,omitempty" gorm:"default:null"`
 
 	// key_id is the key ID that was used for the encryption operation. It can be
 	// used to identify a specific version of the key needed to decrypt the value,
@@ -106,6 +122,10 @@ type Session struct {
 	// @inject_tag: `gorm:"not_null"`
 	KeyId string `json:"key_id,omitempty" gorm:"not_null"`
 
+	// TofuKeyId is the id of the database key version used to encrypt the
+	// tofu token. It is set along with the tofu token.
+	TofuKeyId string `json:"tofu_key_id,omitempty" gorm:"default:null"`
+
 	// States for the session which are for read only and are ignored during
 	// write operations
 	States []*State `gorm:"-"`
@@ -144,18 +164,20 @@ var (
 func New(c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:             c.UserId,
-		HostId:             c.HostId,
-		TargetId:           c.TargetId,
-		HostSetId:          c.HostSetId,
-		AuthTokenId:        c.AuthTokenId,
-		ProjectId:          c.ProjectId,
-		Endpoint:           c.Endpoint,
-		ExpirationTime:     c.ExpirationTime,
-		ConnectionLimit:    c.ConnectionLimit,
-		WorkerFilter:       c.WorkerFilter,
-		DynamicCredentials: c.DynamicCredentials,
-		StaticCredentials:  c.StaticCredentials,
+		UserId:                 c.UserId,
+		HostId:                 c.HostId,
+		TargetId:               c.TargetId,
+		HostSetId:              c.HostSetId,
+		AuthTokenId:            c.AuthTokenId,
+		ProjectId:              c.ProjectId,
+		Endpoint:               c.Endpoint,
+		ExpirationTime:         c.ExpirationTime,
+		ConnectionLimit:        c.ConnectionLimit,
+		WorkerFilter:           c.WorkerFilter,
+		EnableSessionRecording: c.EnableSessionRecording,
+		IdleTimeoutSeconds:     c.IdleTimeoutSeconds,
+		DynamicCredentials:     c.DynamicCredentials,
+		StaticCredentials:      c.StaticCredentials,
 	}
 	if err := s.validateNewSession(); err != nil {
 		return nil, errors.WrapDeprecated(err, op)
@@ -171,19 +193,22 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() interface{} {
 	clone := &Session{
-		PublicId:          s.PublicId,
-		UserId:            s.UserId,
-		HostId:            s.HostId,
-		TargetId:          s.TargetId,
-		HostSetId:         s.HostSetId,
-		AuthTokenId:       s.AuthTokenId,
-		ProjectId:         s.ProjectId,
-		TerminationReason: s.TerminationReason,
-		Version:           s.Version,
-		Endpoint:          s.Endpoint,
-		ConnectionLimit:   s.ConnectionLimit,
-		WorkerFilter:      s.WorkerFilter,
-		KeyId:             s.KeyId,
+		PublicId:               s.PublicId,
+		UserId:                 s.UserId,
+		HostId:                 s.HostId,
+		TargetId:               s.TargetId,
+		HostSetId:              s.HostSetId,
+		AuthTokenId:            s.AuthTokenId,
+		ProjectId:              s.ProjectId,
+		TerminationReason:      s.TerminationReason,
+		Version:                s.Version,
+		Endpoint:               s.Endpoint,
+		ConnectionLimit:        s.ConnectionLimit,
+		WorkerFilter:           s.WorkerFilter,
+		EnableSessionRecording: s.EnableSessionRecording,
+		IdleTimeoutSeconds:     s.IdleTimeoutSeconds,
+		KeyId:                  s.KeyId,
+		TofuKeyId:              s.TofuKeyId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -289,6 +314,10 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "worker filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "EnableSessionRecording"):
+			return errors.New(ctx, errors.InvalidParameter, op, "enable session recording is immutable")
+		case contains(opts.WithFieldMaskPaths, "IdleTimeoutSeconds"):
+			return errors.New(ctx, errors.InvalidParameter, op, "idle timeout seconds is immutable")
 		case contains(opts.WithFieldMaskPaths, "DynamicCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "StaticCredentials"):
@@ -323,13 +352,15 @@ func (s *Session) validateNewSession() error {
 	if s.UserId == "" {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
 	}
-	if s.HostId == "" {
+	// A session for a target with an address has neither a host nor a host
+	// set; otherwise both are required.
+	if s.HostId == "" && s.HostSetId != "" {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "missing host id")
 	}
 	if s.TargetId == "" {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "missing target id")
 	}
-	if s.HostSetId == "" {
+	if s.HostSetId == "" && s.HostId != "" {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "missing host set id")
 	}
 	if s.AuthTokenId == "" {
@@ -436,7 +467,7 @@ func (s *Session) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
 	if err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error getting cipher key id"))
 	}
-	s.KeyId = keyId
+	s.TofuKeyId = keyId
 	return nil
 }
 
@@ -0,0 +1,114 @@
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultTargetAddressTableName = "target_address"
+
+	MinAddressLength = 3
+	MaxAddressLength = 255
+)
+
+// Address represents a network address that is assigned directly to a target.
+// A target can have either an Address or host sources, but not both.
+type Address struct {
+	*store.TargetAddress
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ db.VetForWriter         = (*Address)(nil)
+	_ oplog.ReplayableMessage = (*Address)(nil)
+)
+
+// NewAddress creates a new in memory address for the target. No options are
+// currently supported.
+func NewAddress(targetId, address string, _ ...Option) (*Address, error) {
+	const op = "target.NewAddress"
+	if targetId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing target id")
+	}
+	if address == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing address")
+	}
+	return &Address{
+		TargetAddress: &store.TargetAddress{
+			TargetId: targetId,
+			Address:  address,
+		},
+	}, nil
+}
+
+// allocAddress will allocate an empty address
+func allocAddress() *Address {
+	return &Address{
+		TargetAddress: &store.TargetAddress{},
+	}
+}
+
+// Clone creates a clone of the address
+func (a *Address) Clone() interface{} {
+	cp := proto.Clone(a.TargetAddress)
+	return &Address{
+		TargetAddress: cp.(*store.TargetAddress),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// address before it's written.
+func (a *Address) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "target.(Address).VetForWrite"
+	if a.TargetId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if opType == db.CreateOp && a.Address == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing address")
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (a *Address) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return DefaultTargetAddressTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (a *Address) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *Address) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.TargetId},
+		"resource-type":      []string{"target address"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+// fetchAddress returns the address of the target or nil if the target does
+// not have an address.
+func fetchAddress(ctx context.Context, r db.Reader, targetId string) (*Address, error) {
+	const op = "target.fetchAddress"
+	address := allocAddress()
+	if err := r.LookupWhere(ctx, address, "target_id = ?", []interface{}{targetId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return address, nil
+}
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+type targetHooks struct{}
+
+func init() {
+	target.Register(Subtype, targetHooks{}, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of an http.Target.
+	TargetPrefix = "thttp"
+)
+
+// Vet validates that the given target.Target is an http.Target, that it
+// has a Target store and that its auth scheme is supported.
+func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
+	const op = "http.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	if tt.GetDefaultPort() == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target default port")
+	}
+	if !validAuthScheme(tt.GetAuthScheme()) {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported auth scheme %q", tt.GetAuthScheme()))
+	}
+	return nil
+}
+
+// VetForUpdate validates that the given target.Target is an http.Target,
+// and that it has a Target store, that it isn't attempting to clear or
+// set to zero the default port and that any updated auth scheme is supported.
+func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []string) error {
+	const op = "http.vetForUpdate"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	switch {
+	case tt == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case tt.Target == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	for _, f := range paths {
+		switch {
+		case strings.EqualFold("defaultport", f) && tt.GetDefaultPort() == 0:
+			return errors.New(ctx, errors.InvalidParameter, op, "clearing or setting default port to zero")
+		case strings.EqualFold("authscheme", f) && !validAuthScheme(tt.GetAuthScheme()):
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported auth scheme %q", tt.GetAuthScheme()))
+		}
+	}
+
+	return nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of BrokeredPurpose or InjectedApplicationPurpose. Any other
+// CredentialPurpose will result in an error. Injected application credentials
+// are used by the worker to set the Authorization header of the requests sent
+// to the endpoint.
+func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "http.VetCredentialSources"
+
+	for _, c := range libs {
+		if !validPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	for _, c := range creds {
+		if !validPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	return nil
+}
+
+func validPurpose(p string) bool {
+	switch credential.Purpose(p) {
+	case credential.BrokeredPurpose, credential.InjectedApplicationPurpose:
+		return true
+	default:
+		return false
+	}
+}
+
+func validAuthScheme(s string) bool {
+	switch s {
+	case BasicAuthScheme, BearerAuthScheme:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,220 @@
+// Package http provides a Target subtype for an HTTP Target.
+// Importing this package will register it with the target package and
+// allow the target.Repository to support http.Targets.
+package http
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http/store"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultTableName = "target_http"
+	Subtype          = subtypes.Subtype("http")
+
+	// DefaultPort is used as the default port of an http.Target when none is
+	// provided.
+	DefaultPort = 80
+)
+
+// The schemes of the Authorization header the worker sets on the requests sent
+// to the endpoint of an http.Target.
+const (
+	// BasicAuthScheme sets the username and password of the injected
+	// application credentials as basic authentication credentials.
+	BasicAuthScheme = "basic"
+
+	// BearerAuthScheme sets the password of the injected application
+	// credentials as a bearer token.
+	BearerAuthScheme = "bearer"
+)
+
+// Target is a resource that represents a web application that is accessed via
+// HTTP. The worker acts as a reverse proxy between the client and the endpoint
+// and authorizes the requests it forwards using the injected application
+// credentials of the target. It is a subtype of target.Target.
+type Target struct {
+	*store.Target
+	tableName string `gorm:"-"`
+}
+
+// Ensure Target implements interfaces
+var (
+	_ target.Target           = (*Target)(nil)
+	_ db.VetForWriter         = (*Target)(nil)
+	_ oplog.ReplayableMessage = (*Target)(nil)
+)
+
+// NewTarget creates a new in memory http target.  WithName, WithDescription,
+// WithDefaultPort, WithAddress, WithEnableSessionRecording, WithEnableTls and
+// WithAuthScheme options are supported. The default port is DefaultPort unless
+// WithDefaultPort is used and the auth scheme is BasicAuthScheme unless
+// WithAuthScheme is used.
+func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.Target, error) {
+	const op = "http.NewTarget"
+	opts := target.GetOpts(opt...)
+	if projectId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing project id")
+	}
+	if opts.WithDefaultPort == 0 {
+		opts.WithDefaultPort = DefaultPort
+	}
+	if opts.WithAuthScheme == "" {
+		opts.WithAuthScheme = BasicAuthScheme
+	}
+	t := &Target{
+		Target: &store.Target{
+			ProjectId:              projectId,
+			Name:                   opts.WithName,
+			Description:            opts.WithDescription,
+			DefaultPort:            opts.WithDefaultPort,
+			SessionConnectionLimit: opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
+			WorkerFilter:           opts.WithWorkerFilter,
+			Address:                opts.WithAddress,
+			EnableSessionRecording: opts.WithEnableSessionRecording,
+			EnableTls:              opts.WithEnableTls,
+			AuthScheme:             opts.WithAuthScheme,
+		},
+	}
+	return t, nil
+}
+
+// AllocTarget will allocate an http target
+func (h targetHooks) AllocTarget() target.Target {
+	return &Target{
+		Target: &store.Target{},
+	}
+}
+
+// Clone creates a clone of the Target
+func (t *Target) Clone() target.Target {
+	cp := proto.Clone(t.Target)
+	return &Target{
+		Target: cp.(*store.Target),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the http target
+// before it's written.
+func (t *Target) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "http.(Target).VetForWrite"
+	if t.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if opType == db.CreateOp {
+		if t.ProjectId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+		}
+		if t.Name == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing name")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *Target) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *Target) SetTableName(n string) {
+	t.tableName = n
+}
+
+// Oplog provides the oplog.Metadata for recording operations taken on a Target.
+func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.PublicId},
+		"resource-type":      []string{"http target"},
+		"op-type":            []string{op.String()},
+		"project-id":         []string{t.ProjectId},
+	}
+	return metadata
+}
+
+func (t *Target) GetType() subtypes.Subtype {
+	return Subtype
+}
+
+func (t *Target) SetPublicId(ctx context.Context, publicId string) error {
+	const op = "http.(Target).SetPublicId"
+	if !strings.HasPrefix(publicId, TargetPrefix+"_") {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", publicId, TargetPrefix))
+	}
+
+	t.PublicId = publicId
+	return nil
+}
+
+func (t *Target) SetProjectId(projectId string) {
+	t.ProjectId = projectId
+}
+
+func (t *Target) SetName(name string) {
+	t.Name = name
+}
+
+func (t *Target) SetDescription(description string) {
+	t.Description = description
+}
+
+func (t *Target) SetVersion(v uint32) {
+	t.Version = v
+}
+
+func (t *Target) SetDefaultPort(port uint32) {
+	t.DefaultPort = port
+}
+
+func (t *Target) SetCreateTime(ts *timestamp.Timestamp) {
+	t.CreateTime = ts
+}
+
+func (t *Target) SetUpdateTime(ts *timestamp.Timestamp) {
+	t.UpdateTime = ts
+}
+
+func (t *Target) SetSessionMaxSeconds(s uint32) {
+	t.SessionMaxSeconds = s
+}
+
+func (t *Target) SetSessionConnectionLimit(limit int32) {
+	t.SessionConnectionLimit = limit
+}
+
+func (t *Target) SetWorkerFilter(filter string) {
+	t.WorkerFilter = filter
+}
+
+func (t *Target) SetAddress(address string) {
+	t.Address = address
+}
+
+func (t *Target) SetEnableSessionRecording(enable bool) {
+	t.EnableSessionRecording = enable
+}
+
+func (t *Target) SetEnableTls(enable bool) {
+	t.EnableTls = enable
+}
+
+func (t *Target) SetAuthScheme(scheme string) {
+	t.AuthScheme = scheme
+}
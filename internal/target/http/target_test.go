@@ -0,0 +1,143 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		projectId  string
+		opt        []target.Option
+		wantPort   uint32
+		wantScheme string
+		wantIsErr  errors.Code
+	}{
+		{
+			name:      "empty-projectId",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:       "defaults",
+			projectId:  "p_1234567890",
+			wantPort:   http.DefaultPort,
+			wantScheme: http.BasicAuthScheme,
+		},
+		{
+			name:       "with-default-port",
+			projectId:  "p_1234567890",
+			opt:        []target.Option{target.WithDefaultPort(8080)},
+			wantPort:   8080,
+			wantScheme: http.BasicAuthScheme,
+		},
+		{
+			name:       "with-auth-scheme",
+			projectId:  "p_1234567890",
+			opt:        []target.Option{target.WithAuthScheme(http.BearerAuthScheme)},
+			wantPort:   http.DefaultPort,
+			wantScheme: http.BearerAuthScheme,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, http.Subtype, tt.projectId, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantPort, got.GetDefaultPort())
+			assert.Equal(tt.wantScheme, got.(*http.Target).GetAuthScheme())
+			assert.Equal(http.Subtype, got.GetType())
+		})
+	}
+}
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := target.New(ctx, http.Subtype, prj.PublicId,
+		target.WithName("valid-proj-id"),
+		target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+		target.WithSessionConnectionLimit(-1),
+		target.WithEnableTls(true),
+		target.WithAuthScheme(http.BearerAuthScheme),
+	)
+	require.NoError(err)
+	id, err := db.NewPublicId(http.TargetPrefix)
+	require.NoError(err)
+	require.NoError(got.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).Create(ctx, got))
+
+	found := &http.Target{}
+	found.Target = got.Clone().(*http.Target).Target
+	require.NoError(db.New(conn).LookupByPublicId(ctx, found))
+	assert.True(proto.Equal(got.(*http.Target).Target, found.Target))
+	assert.Equal(uint32(http.DefaultPort), found.GetDefaultPort())
+	assert.True(found.GetEnableTls())
+	assert.Equal(http.BearerAuthScheme, found.GetAuthScheme())
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	assert := assert.New(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	tar := http.TestTarget(ctx, t, conn, proj.PublicId, http.TestTargetName(t, proj.PublicId))
+	cp := tar.Clone()
+	assert.True(proto.Equal(cp.(*http.Target).Target, tar.(*http.Target).Target))
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	ss, err := target.New(ctx, http.Subtype, "testScope")
+	require.NoError(err)
+	s := ss.(*http.Target)
+	assert.Equal(http.DefaultTableName, s.TableName())
+	s.SetTableName("new-name")
+	assert.Equal("new-name", s.TableName())
+	s.SetTableName("")
+	assert.Equal(http.DefaultTableName, s.TableName())
+}
+
+func TestTarget_oplog(t *testing.T) {
+	ctx := context.Background()
+	id := http.TestId(t)
+	tar, err := target.New(ctx, http.Subtype, id)
+	require.NoError(t, err)
+	require.NoError(t, tar.SetPublicId(ctx, id))
+	want := oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"http target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"project-id":         []string{id},
+	}
+	assert.Equal(t, want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+}
@@ -37,6 +37,14 @@ type options struct {
 	WithPublicId               string
 	WithWorkerFilter           string
 	WithTargetIds              []string
+	WithAddress                string
+	WithEnableSessionRecording bool
+	WithEnableTls              bool
+	WithAuthScheme             string
+	WithIdleTimeoutSeconds     uint32
+	WithHostKey                string
+	WithSslMode                string
+	WithSslRootCert            string
 }
 
 func getDefaultOptions() options {
@@ -57,6 +65,14 @@ func getDefaultOptions() options {
 		WithSessionConnectionLimit: -1,
 		WithPublicId:               "",
 		WithWorkerFilter:           "",
+		WithAddress:                "",
+		WithEnableSessionRecording: false,
+		WithEnableTls:              false,
+		WithAuthScheme:             "",
+		WithIdleTimeoutSeconds:     0,
+		WithHostKey:                "",
+		WithSslMode:                "",
+		WithSslRootCert:            "",
 	}
 }
 
@@ -178,3 +194,66 @@ func WithTargetIds(with []string) Option {
 		o.WithTargetIds = with
 	}
 }
+
+// WithAddress provides an optional network address
+func WithAddress(address string) Option {
+	return func(o *options) {
+		o.WithAddress = address
+	}
+}
+
+// WithEnableSessionRecording provides an optional flag to enable the
+// recording of session connections
+func WithEnableSessionRecording(enable bool) Option {
+	return func(o *options) {
+		o.WithEnableSessionRecording = enable
+	}
+}
+
+// WithEnableTls provides an optional flag to connect to the endpoint of the
+// target using TLS
+func WithEnableTls(enable bool) Option {
+	return func(o *options) {
+		o.WithEnableTls = enable
+	}
+}
+
+// WithAuthScheme provides an optional scheme of the Authorization header set
+// on requests sent to the endpoint of the target
+func WithAuthScheme(scheme string) Option {
+	return func(o *options) {
+		o.WithAuthScheme = scheme
+	}
+}
+
+// WithIdleTimeoutSeconds provides an optional number of seconds a connection
+// may carry no traffic before the worker closes it
+func WithIdleTimeoutSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.WithIdleTimeoutSeconds = seconds
+	}
+}
+
+// WithHostKey provides an optional public key, in authorized_keys format, the
+// endpoint of the target must present
+func WithHostKey(key string) Option {
+	return func(o *options) {
+		o.WithHostKey = key
+	}
+}
+
+// WithSslMode provides an optional mode the worker uses to secure its
+// connection to the endpoint of the target
+func WithSslMode(mode string) Option {
+	return func(o *options) {
+		o.WithSslMode = mode
+	}
+}
+
+// WithSslRootCert provides optional PEM encoded certificate authorities the
+// certificate of the endpoint of the target is verified against
+func WithSslRootCert(cert string) Option {
+	return func(o *options) {
+		o.WithSslRootCert = cert
+	}
+}
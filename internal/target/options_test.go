@@ -108,6 +108,62 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithAddress", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAddress("8.8.8.8"))
+		testOpts := getDefaultOptions()
+		testOpts.WithAddress = "8.8.8.8"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEnableSessionRecording", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithEnableSessionRecording(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithEnableSessionRecording = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEnableTls", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithEnableTls(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithEnableTls = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAuthScheme", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAuthScheme("bearer"))
+		testOpts := getDefaultOptions()
+		testOpts.WithAuthScheme = "bearer"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIdleTimeoutSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithIdleTimeoutSeconds(300))
+		testOpts := getDefaultOptions()
+		testOpts.WithIdleTimeoutSeconds = 300
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHostKey", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostKey("ssh-ed25519 AAAA"))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostKey = "ssh-ed25519 AAAA"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSslMode", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSslMode("verify-ca"))
+		testOpts := getDefaultOptions()
+		testOpts.WithSslMode = "verify-ca"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSslRootCert", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSslRootCert("-----BEGIN CERTIFICATE-----"))
+		testOpts := getDefaultOptions()
+		testOpts.WithSslRootCert = "-----BEGIN CERTIFICATE-----"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithCredentialLibraries", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithCredentialLibraries([]*CredentialLibrary{
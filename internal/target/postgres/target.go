@@ -0,0 +1,232 @@
+// Package postgres provides a Target subtype for a PostgreSQL Target.
+// Importing this package will register it with the target package and
+// allow the target.Repository to support postgres.Targets.
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres/store"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultTableName = "target_postgres"
+	Subtype          = subtypes.Subtype("postgres")
+
+	// DefaultPort is used as the default port of a postgres.Target when none is
+	// provided.
+	DefaultPort = 5432
+)
+
+// The modes in which the worker secures its connection to the endpoint of a
+// postgres.Target. They match the sslmode values of libpq which require the
+// endpoint to support TLS.
+const (
+	// SslModeDisable connects to the endpoint without TLS. The worker then
+	// only authenticates to the endpoint using SCRAM-SHA-256.
+	SslModeDisable = "disable"
+
+	// SslModeRequire connects to the endpoint using TLS without verifying its
+	// certificate. The worker then only authenticates to the endpoint using
+	// SCRAM-SHA-256.
+	SslModeRequire = "require"
+
+	// SslModeVerifyCa connects to the endpoint using TLS and verifies its
+	// certificate was issued by a trusted certificate authority.
+	SslModeVerifyCa = "verify-ca"
+
+	// SslModeVerifyFull connects to the endpoint using TLS and verifies its
+	// certificate was issued by a trusted certificate authority for the
+	// address of the endpoint.
+	SslModeVerifyFull = "verify-full"
+)
+
+// Target is a resource that represents a PostgreSQL database server. The worker
+// answers the startup of the client's connection, secures its connection to the
+// endpoint according to the ssl mode of the target, authenticates to the
+// endpoint using the injected application credentials of the target and then
+// forwards the remaining messages unchanged. It is a subtype of target.Target.
+type Target struct {
+	*store.Target
+	tableName string `gorm:"-"`
+}
+
+// Ensure Target implements interfaces
+var (
+	_ target.Target           = (*Target)(nil)
+	_ db.VetForWriter         = (*Target)(nil)
+	_ oplog.ReplayableMessage = (*Target)(nil)
+)
+
+// NewTarget creates a new in memory postgres target.  WithName, WithDescription,
+// WithDefaultPort, WithAddress, WithEnableSessionRecording, WithSslMode and
+// WithSslRootCert options are supported. The default port is DefaultPort
+// unless WithDefaultPort is used and the ssl mode is SslModeVerifyFull unless
+// WithSslMode is used.
+func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.Target, error) {
+	const op = "postgres.NewTarget"
+	opts := target.GetOpts(opt...)
+	if projectId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing project id")
+	}
+	if opts.WithDefaultPort == 0 {
+		opts.WithDefaultPort = DefaultPort
+	}
+	if opts.WithSslMode == "" {
+		opts.WithSslMode = SslModeVerifyFull
+	}
+	t := &Target{
+		Target: &store.Target{
+			ProjectId:              projectId,
+			Name:                   opts.WithName,
+			Description:            opts.WithDescription,
+			DefaultPort:            opts.WithDefaultPort,
+			SessionConnectionLimit: opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
+			WorkerFilter:           opts.WithWorkerFilter,
+			Address:                opts.WithAddress,
+			EnableSessionRecording: opts.WithEnableSessionRecording,
+			SslMode:                opts.WithSslMode,
+			SslRootCert:            opts.WithSslRootCert,
+		},
+	}
+	return t, nil
+}
+
+// AllocTarget will allocate a postgres target
+func (h targetHooks) AllocTarget() target.Target {
+	return &Target{
+		Target: &store.Target{},
+	}
+}
+
+// Clone creates a clone of the Target
+func (t *Target) Clone() target.Target {
+	cp := proto.Clone(t.Target)
+	return &Target{
+		Target: cp.(*store.Target),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the postgres target
+// before it's written.
+func (t *Target) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "postgres.(Target).VetForWrite"
+	if t.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if opType == db.CreateOp {
+		if t.ProjectId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+		}
+		if t.Name == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing name")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *Target) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *Target) SetTableName(n string) {
+	t.tableName = n
+}
+
+// Oplog provides the oplog.Metadata for recording operations taken on a Target.
+func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.PublicId},
+		"resource-type":      []string{"postgres target"},
+		"op-type":            []string{op.String()},
+		"project-id":         []string{t.ProjectId},
+	}
+	return metadata
+}
+
+func (t *Target) GetType() subtypes.Subtype {
+	return Subtype
+}
+
+func (t *Target) SetPublicId(ctx context.Context, publicId string) error {
+	const op = "postgres.(Target).SetPublicId"
+	if !strings.HasPrefix(publicId, TargetPrefix+"_") {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", publicId, TargetPrefix))
+	}
+
+	t.PublicId = publicId
+	return nil
+}
+
+func (t *Target) SetProjectId(projectId string) {
+	t.ProjectId = projectId
+}
+
+func (t *Target) SetName(name string) {
+	t.Name = name
+}
+
+func (t *Target) SetDescription(description string) {
+	t.Description = description
+}
+
+func (t *Target) SetVersion(v uint32) {
+	t.Version = v
+}
+
+func (t *Target) SetDefaultPort(port uint32) {
+	t.DefaultPort = port
+}
+
+func (t *Target) SetCreateTime(ts *timestamp.Timestamp) {
+	t.CreateTime = ts
+}
+
+func (t *Target) SetUpdateTime(ts *timestamp.Timestamp) {
+	t.UpdateTime = ts
+}
+
+func (t *Target) SetSessionMaxSeconds(s uint32) {
+	t.SessionMaxSeconds = s
+}
+
+func (t *Target) SetSessionConnectionLimit(limit int32) {
+	t.SessionConnectionLimit = limit
+}
+
+func (t *Target) SetWorkerFilter(filter string) {
+	t.WorkerFilter = filter
+}
+
+func (t *Target) SetAddress(address string) {
+	t.Address = address
+}
+
+func (t *Target) SetEnableSessionRecording(enable bool) {
+	t.EnableSessionRecording = enable
+}
+
+func (t *Target) SetSslMode(mode string) {
+	t.SslMode = mode
+}
+
+func (t *Target) SetSslRootCert(cert string) {
+	t.SslRootCert = cert
+}
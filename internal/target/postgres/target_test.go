@@ -0,0 +1,139 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		projectId   string
+		opt         []target.Option
+		wantPort    uint32
+		wantSslMode string
+		wantIsErr   errors.Code
+	}{
+		{
+			name:      "empty-projectId",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:        "default-port",
+			projectId:   "p_1234567890",
+			wantPort:    postgres.DefaultPort,
+			wantSslMode: postgres.SslModeVerifyFull,
+		},
+		{
+			name:        "with-default-port",
+			projectId:   "p_1234567890",
+			opt:         []target.Option{target.WithDefaultPort(6432)},
+			wantPort:    6432,
+			wantSslMode: postgres.SslModeVerifyFull,
+		},
+		{
+			name:        "with-ssl-mode",
+			projectId:   "p_1234567890",
+			opt:         []target.Option{target.WithSslMode(postgres.SslModeVerifyCa), target.WithSslRootCert("cert")},
+			wantPort:    postgres.DefaultPort,
+			wantSslMode: postgres.SslModeVerifyCa,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, postgres.Subtype, tt.projectId, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantPort, got.GetDefaultPort())
+			assert.Equal(tt.wantSslMode, got.(*postgres.Target).GetSslMode())
+			assert.Equal(postgres.Subtype, got.GetType())
+		})
+	}
+}
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := target.New(ctx, postgres.Subtype, prj.PublicId,
+		target.WithName("valid-proj-id"),
+		target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+		target.WithSessionConnectionLimit(-1),
+	)
+	require.NoError(err)
+	id, err := db.NewPublicId(postgres.TargetPrefix)
+	require.NoError(err)
+	require.NoError(got.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).Create(ctx, got))
+
+	found := &postgres.Target{}
+	found.Target = got.Clone().(*postgres.Target).Target
+	require.NoError(db.New(conn).LookupByPublicId(ctx, found))
+	assert.True(proto.Equal(got.(*postgres.Target).Target, found.Target))
+	assert.Equal(uint32(postgres.DefaultPort), found.GetDefaultPort())
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	assert := assert.New(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	tar := postgres.TestTarget(ctx, t, conn, proj.PublicId, postgres.TestTargetName(t, proj.PublicId))
+	cp := tar.Clone()
+	assert.True(proto.Equal(cp.(*postgres.Target).Target, tar.(*postgres.Target).Target))
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	ss, err := target.New(ctx, postgres.Subtype, "testScope")
+	require.NoError(err)
+	s := ss.(*postgres.Target)
+	assert.Equal(postgres.DefaultTableName, s.TableName())
+	s.SetTableName("new-name")
+	assert.Equal("new-name", s.TableName())
+	s.SetTableName("")
+	assert.Equal(postgres.DefaultTableName, s.TableName())
+}
+
+func TestTarget_oplog(t *testing.T) {
+	ctx := context.Background()
+	id := postgres.TestId(t)
+	tar, err := target.New(ctx, postgres.Subtype, id)
+	require.NoError(t, err)
+	require.NoError(t, tar.SetPublicId(ctx, id))
+	want := oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"postgres target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"project-id":         []string{id},
+	}
+	assert.Equal(t, want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+}
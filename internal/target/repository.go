@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/go-dbw"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
 
 // Cloneable provides a cloning interface
@@ -325,8 +326,9 @@ func (r *Repository) DeleteTarget(ctx context.Context, publicId string, _ ...Opt
 	return rowsDeleted, nil
 }
 
-// update a target in the db repository with an oplog entry.
-// It currently supports no options.
+// update a target in the db repository with an oplog entry. If the field mask
+// paths include the address, the target's address is set or, when empty,
+// removed. It currently supports no options.
 func (r *Repository) update(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, setToNullPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).update"
 	if version == 0 {
@@ -358,6 +360,26 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 	metadata := target.Oplog(oplog.OpType_OP_TYPE_UPDATE)
 	dbOpts = append(dbOpts, db.WithOplog(oplogWrapper, metadata))
 
+	// The address is stored in its own table, so it is removed from the
+	// paths used to update the target.
+	var updateAddress bool
+	dbMask := make([]string, 0, len(fieldMaskPaths))
+	for _, f := range fieldMaskPaths {
+		if strings.EqualFold("address", f) {
+			updateAddress = true
+			continue
+		}
+		dbMask = append(dbMask, f)
+	}
+	nullFields := make([]string, 0, len(setToNullPaths))
+	for _, f := range setToNullPaths {
+		if strings.EqualFold("address", f) {
+			updateAddress = true
+			continue
+		}
+		nullFields = append(nullFields, f)
+	}
+
 	var rowsUpdated int
 	var returnedTarget interface{}
 	var hostSources []HostSource
@@ -368,13 +390,27 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
 			returnedTarget = cloner.Clone()
-			rowsUpdated, err = w.Update(
-				ctx,
-				returnedTarget,
-				fieldMaskPaths,
-				setToNullPaths,
-				dbOpts...,
-			)
+			switch {
+			case len(dbMask) == 0 && len(nullFields) == 0:
+				// Only the address is changing, but the target's version
+				// still needs to be incremented.
+				returnedTarget.(Target).SetVersion(version + 1)
+				rowsUpdated, err = w.Update(
+					ctx,
+					returnedTarget,
+					[]string{"Version"},
+					nil,
+					dbOpts...,
+				)
+			default:
+				rowsUpdated, err = w.Update(
+					ctx,
+					returnedTarget,
+					dbMask,
+					nullFields,
+					dbOpts...,
+				)
+			}
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -382,6 +418,24 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 				// return err, which will result in a rollback of the update
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+
+			if updateAddress {
+				if err := setAddress(ctx, reader, w, oplogWrapper, target.GetPublicId(), target.GetAddress()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			address, err := fetchAddress(ctx, reader, target.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			var addr string
+			if address != nil {
+				addr = address.GetAddress()
+			}
+			returnedTarget.(Target).SetAddress(addr)
 
 			if hostSources, err = fetchHostSources(ctx, reader, target.GetPublicId()); err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -400,7 +454,8 @@ func (r *Repository) update(ctx context.Context, target Target, version uint32,
 }
 
 // CreateTarget inserts into the repository and returns the new Target with
-// its list of host sets and credential libraries.
+// its list of host sets and credential libraries. If the target has an
+// address, the address is assigned to the new target.
 // WithPublicId is the only supported option.
 func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).CreateTarget"
@@ -469,6 +524,17 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target"))
 			}
 			msgs = append(msgs, &targetOplogMsg)
+			if t.GetAddress() != "" {
+				address, err := NewAddress(t.GetPublicId(), t.GetAddress())
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory target address"))
+				}
+				var addressOplogMsg oplog.Message
+				if err := w.Create(ctx, address, db.NewOplogMsg(&addressOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target address"))
+				}
+				msgs = append(msgs, &addressOplogMsg)
+			}
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -485,9 +551,13 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // UpdateTarget will update a target in the repository and return the written
 // target. fieldMaskPaths provides field_mask.proto paths for fields that should
 // be updated.  Fields will be set to NULL if the field is a zero value and
-// included in fieldMask. Name, Description, and WorkerFilter are the only
-// updatable fields. If no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// included in fieldMask. Name, Description, DefaultPort, SessionMaxSeconds,
+// SessionConnectionLimit, WorkerFilter, Address, EnableSessionRecording,
+// EnableTls, AuthScheme, IdleTimeoutSeconds, HostKey, SslMode and SslRootCert
+// are the only updatable fields, EnableSessionRecording and the last six only
+// being supported by subtypes which have them. Setting Address to a zero value
+// removes the target's address. If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
 func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).UpdateTarget"
 	if target == nil {
@@ -505,6 +575,12 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target public id")
 	}
 
+	_, isSessionRecordingTarget := target.(sessionRecordingTarget)
+	_, isTlsTarget := target.(tlsTarget)
+	_, isAuthSchemeTarget := target.(authSchemeTarget)
+	_, isIdleTimeoutTarget := target.(idleTimeoutTarget)
+	_, isHostKeyTarget := target.(hostKeyTarget)
+	_, isSslTarget := target.(sslTarget)
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
@@ -513,22 +589,51 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("sessionmaxseconds", f):
 		case strings.EqualFold("sessionconnectionlimit", f):
 		case strings.EqualFold("workerfilter", f):
+		case strings.EqualFold("address", f):
+		case strings.EqualFold("enablesessionrecording", f) && isSessionRecordingTarget:
+		case strings.EqualFold("enabletls", f) && isTlsTarget:
+		case strings.EqualFold("authscheme", f) && isAuthSchemeTarget:
+		case strings.EqualFold("idletimeoutseconds", f) && isIdleTimeoutTarget:
+		case strings.EqualFold("hostkey", f) && isHostKeyTarget:
+		case strings.EqualFold("sslmode", f) && isSslTarget:
+		case strings.EqualFold("sslrootcert", f) && isSslTarget:
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
 	}
+	fieldValues := map[string]interface{}{
+		"Name":                   target.GetName(),
+		"Description":            target.GetDescription(),
+		"DefaultPort":            target.GetDefaultPort(),
+		"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
+		"SessionConnectionLimit": target.GetSessionConnectionLimit(),
+		"WorkerFilter":           target.GetWorkerFilter(),
+		"Address":                target.GetAddress(),
+	}
+	if rt, ok := target.(sessionRecordingTarget); ok {
+		fieldValues["EnableSessionRecording"] = rt.GetEnableSessionRecording()
+	}
+	if tlst, ok := target.(tlsTarget); ok {
+		fieldValues["EnableTls"] = tlst.GetEnableTls()
+	}
+	if at, ok := target.(authSchemeTarget); ok {
+		fieldValues["AuthScheme"] = at.GetAuthScheme()
+	}
+	if it, ok := target.(idleTimeoutTarget); ok {
+		fieldValues["IdleTimeoutSeconds"] = it.GetIdleTimeoutSeconds()
+	}
+	if ht, ok := target.(hostKeyTarget); ok {
+		fieldValues["HostKey"] = ht.GetHostKey()
+	}
+	if st, ok := target.(sslTarget); ok {
+		fieldValues["SslMode"] = st.GetSslMode()
+		fieldValues["SslRootCert"] = st.GetSslRootCert()
+	}
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
-		map[string]interface{}{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-		},
+		fieldValues,
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "EnableTls", "IdleTimeoutSeconds"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -559,3 +664,51 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	}
 	return returnedTarget, hostSources, credSources, rowsUpdated, nil
 }
+
+// setAddress sets the address of the target within the writer's transaction.
+// An empty address removes any existing address from the target. An error is
+// returned if an address is set on a target that has host sources.
+func setAddress(ctx context.Context, reader db.Reader, w db.Writer, oplogWrapper wrapping.Wrapper, targetId, address string) error {
+	const op = "target.setAddress"
+	existing, err := fetchAddress(ctx, reader, targetId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case address == "" && existing == nil:
+		return nil
+	case address == "":
+		rowsDeleted, err := w.Delete(ctx, existing, db.WithOplog(oplogWrapper, existing.oplog(oplog.OpType_OP_TYPE_DELETE)))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target address"))
+		}
+		if rowsDeleted != 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("deleted target address and %d rows deleted", rowsDeleted))
+		}
+	case existing == nil:
+		hostSources, err := fetchHostSources(ctx, reader, targetId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if len(hostSources) > 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "unable to set address because the target has host sources")
+		}
+		a, err := NewAddress(targetId, address)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := w.Create(ctx, a, db.WithOplog(oplogWrapper, a.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target address"))
+		}
+	default:
+		existing.Address = address
+		rowsUpdated, err := w.Update(ctx, existing, []string{"Address"}, nil, db.WithOplog(oplogWrapper, existing.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target address"))
+		}
+		if rowsUpdated != 1 {
+			return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated target address and %d rows updated", rowsUpdated))
+		}
+	}
+	return nil
+}
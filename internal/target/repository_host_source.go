@@ -11,7 +11,8 @@ import (
 )
 
 // AddTargetHostSources provides the ability to add host sources (hostSourceIds)
-// to a target (targetId). The target's current db version must match the
+// to a target (targetId). Host sources cannot be added to a target that has an
+// address. The target's current db version must match the
 // targetVersion or an error will be returned.  The target and a list of current
 // host source ids will be returned on success. Zero is not a valid value for the
 // WithVersion option and will return an error.
@@ -39,6 +40,9 @@ func (r *Repository) AddTargetHostSources(ctx context.Context, targetId string,
 	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
 	}
+	if t.GetAddress() != "" {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "unable to add host sources because the target has an address")
+	}
 	var metadata oplog.Metadata
 
 	alloc, ok := subtypeRegistry.allocFunc(t.Subtype())
@@ -216,6 +220,9 @@ func (r *Repository) SetTargetHostSources(ctx context.Context, targetId string,
 	if err := r.reader.LookupByPublicId(ctx, &t); err != nil {
 		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
 	}
+	if t.GetAddress() != "" && len(hostSourceIds) > 0 {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "unable to set host sources because the target has an address")
+	}
 
 	// NOTE: calculating that to set can safely happen outside of the write
 	// transaction since we're using targetVersion to ensure that the only
@@ -0,0 +1,101 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+type targetHooks struct{}
+
+func init() {
+	target.Register(Subtype, targetHooks{}, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of a ssh.Target.
+	TargetPrefix = "tssh"
+)
+
+// Vet validates that the given target.Target is a ssh.Target and that it
+// has a Target store.
+func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
+	const op = "ssh.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not a ssh.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	if tt.GetDefaultPort() == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target default port")
+	}
+	return nil
+}
+
+// VetForUpdate validates that the given target.Target is a ssh.Target,
+// and that it has a Target store and that it isn't attempting to clear or
+// set to zero the default port.
+func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []string) error {
+	const op = "ssh.vetForUpdate"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not a ssh.Target")
+	}
+
+	switch {
+	case tt == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case tt.Target == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	for _, f := range paths {
+		if strings.EqualFold("defaultport", f) && tt.GetDefaultPort() == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "clearing or setting default port to zero")
+		}
+	}
+
+	return nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of BrokeredPurpose or InjectedApplicationPurpose. Any other
+// CredentialPurpose will result in an error. Injected application credentials
+// are used by the worker to authenticate to the endpoint.
+func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "ssh.VetCredentialSources"
+
+	for _, c := range libs {
+		if !validPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	for _, c := range creds {
+		if !validPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	return nil
+}
+
+func validPurpose(p string) bool {
+	switch credential.Purpose(p) {
+	case credential.BrokeredPurpose, credential.InjectedApplicationPurpose:
+		return true
+	default:
+		return false
+	}
+}
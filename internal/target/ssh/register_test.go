@@ -0,0 +1,80 @@
+package ssh
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetHooks_VetCredentialSources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lib := func(p credential.Purpose) *target.CredentialLibrary {
+		l, err := target.NewCredentialLibrary("tssh_1234567890", "clvlt_1234567890", p)
+		require.NoError(t, err)
+		return l
+	}
+	cred := func(p credential.Purpose) *target.StaticCredential {
+		c, err := target.NewStaticCredential("tssh_1234567890", "credup_1234567890", p)
+		require.NoError(t, err)
+		return c
+	}
+	tests := []struct {
+		name    string
+		libs    []*target.CredentialLibrary
+		creds   []*target.StaticCredential
+		wantErr bool
+	}{
+		{
+			name:  "brokered",
+			libs:  []*target.CredentialLibrary{lib(credential.BrokeredPurpose)},
+			creds: []*target.StaticCredential{cred(credential.BrokeredPurpose)},
+		},
+		{
+			name:  "injected-application",
+			libs:  []*target.CredentialLibrary{lib(credential.InjectedApplicationPurpose)},
+			creds: []*target.StaticCredential{cred(credential.InjectedApplicationPurpose)},
+		},
+		{
+			name:    "unknown-library-purpose",
+			libs:    []*target.CredentialLibrary{lib(credential.Purpose("egress"))},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-static-purpose",
+			creds:   []*target.StaticCredential{cred(credential.Purpose("egress"))},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := targetHooks{}.VetCredentialSources(ctx, tt.libs, tt.creds)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTargetHooks_Vet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tar, err := targetHooks{}.NewTarget("p_1234567890")
+	require.NoError(t, err)
+	assert.NoError(t, targetHooks{}.Vet(ctx, tar))
+
+	tar.(*Target).DefaultPort = 0
+	assert.Error(t, targetHooks{}.Vet(ctx, tar))
+	assert.Error(t, targetHooks{}.VetForUpdate(ctx, tar, []string{"DefaultPort"}))
+	assert.NoError(t, targetHooks{}.VetForUpdate(ctx, tar, []string{"Name"}))
+}
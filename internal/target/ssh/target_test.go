@@ -0,0 +1,128 @@
+package ssh_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		projectId string
+		opt       []target.Option
+		wantPort  uint32
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "empty-projectId",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "default-port",
+			projectId: "p_1234567890",
+			wantPort:  ssh.DefaultPort,
+		},
+		{
+			name:      "with-default-port",
+			projectId: "p_1234567890",
+			opt:       []target.Option{target.WithDefaultPort(2222)},
+			wantPort:  2222,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, ssh.Subtype, tt.projectId, tt.opt...)
+			if tt.wantIsErr != 0 {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantPort, got.GetDefaultPort())
+			assert.Equal(ssh.Subtype, got.GetType())
+		})
+	}
+}
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := target.New(ctx, ssh.Subtype, prj.PublicId,
+		target.WithName("valid-proj-id"),
+		target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+		target.WithSessionConnectionLimit(-1),
+	)
+	require.NoError(err)
+	id, err := db.NewPublicId(ssh.TargetPrefix)
+	require.NoError(err)
+	require.NoError(got.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).Create(ctx, got))
+
+	found := &ssh.Target{}
+	found.Target = got.Clone().(*ssh.Target).Target
+	require.NoError(db.New(conn).LookupByPublicId(ctx, found))
+	assert.True(proto.Equal(got.(*ssh.Target).Target, found.Target))
+	assert.Equal(uint32(ssh.DefaultPort), found.GetDefaultPort())
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	assert := assert.New(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	tar := ssh.TestTarget(ctx, t, conn, proj.PublicId, ssh.TestTargetName(t, proj.PublicId))
+	cp := tar.Clone()
+	assert.True(proto.Equal(cp.(*ssh.Target).Target, tar.(*ssh.Target).Target))
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	ss, err := target.New(ctx, ssh.Subtype, "testScope")
+	require.NoError(err)
+	s := ss.(*ssh.Target)
+	assert.Equal(ssh.DefaultTableName, s.TableName())
+	s.SetTableName("new-name")
+	assert.Equal("new-name", s.TableName())
+	s.SetTableName("")
+	assert.Equal(ssh.DefaultTableName, s.TableName())
+}
+
+func TestTarget_oplog(t *testing.T) {
+	ctx := context.Background()
+	id := ssh.TestId(t)
+	tar, err := target.New(ctx, ssh.Subtype, id)
+	require.NoError(t, err)
+	require.NoError(t, tar.SetPublicId(ctx, id))
+	want := oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"ssh target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"project-id":         []string{id},
+	}
+	assert.Equal(t, want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+}
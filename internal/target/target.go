@@ -26,6 +26,7 @@ type Target interface {
 	GetSessionMaxSeconds() uint32
 	GetSessionConnectionLimit() int32
 	GetWorkerFilter() string
+	GetAddress() string
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetProjectId(string)
@@ -38,9 +39,54 @@ type Target interface {
 	SetSessionMaxSeconds(uint32)
 	SetSessionConnectionLimit(int32)
 	SetWorkerFilter(string)
+	SetAddress(string)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
+// sessionRecordingTarget is implemented by target subtypes whose sessions can
+// be recorded.
+type sessionRecordingTarget interface {
+	GetEnableSessionRecording() bool
+	SetEnableSessionRecording(bool)
+}
+
+// tlsTarget is implemented by target subtypes whose worker can connect to the
+// endpoint using TLS.
+type tlsTarget interface {
+	GetEnableTls() bool
+	SetEnableTls(bool)
+}
+
+// authSchemeTarget is implemented by target subtypes whose worker sets an
+// Authorization header on the requests sent to the endpoint.
+type authSchemeTarget interface {
+	GetAuthScheme() string
+	SetAuthScheme(string)
+}
+
+// idleTimeoutTarget is implemented by target subtypes whose worker closes
+// connections which have been idle for too long.
+type idleTimeoutTarget interface {
+	GetIdleTimeoutSeconds() uint32
+	SetIdleTimeoutSeconds(uint32)
+}
+
+// hostKeyTarget is implemented by target subtypes whose worker verifies the
+// endpoint using a host key.
+type hostKeyTarget interface {
+	GetHostKey() string
+	SetHostKey(string)
+}
+
+// sslTarget is implemented by target subtypes whose worker secures the
+// connection to the endpoint according to an ssl mode.
+type sslTarget interface {
+	GetSslMode() string
+	SetSslMode(string)
+	GetSslRootCert() string
+	SetSslRootCert(string)
+}
+
 const (
 	targetsViewDefaultTable = "target_all_subtypes"
 )
@@ -123,5 +169,25 @@ func (t *targetView) targetSubtype(ctx context.Context) (Target, error) {
 	tt.SetSessionMaxSeconds(t.SessionMaxSeconds)
 	tt.SetSessionConnectionLimit(t.SessionConnectionLimit)
 	tt.SetWorkerFilter(t.WorkerFilter)
+	tt.SetAddress(t.Address)
+	if rt, ok := tt.(sessionRecordingTarget); ok {
+		rt.SetEnableSessionRecording(t.EnableSessionRecording)
+	}
+	if tlst, ok := tt.(tlsTarget); ok {
+		tlst.SetEnableTls(t.EnableTls)
+	}
+	if at, ok := tt.(authSchemeTarget); ok {
+		at.SetAuthScheme(t.AuthScheme)
+	}
+	if it, ok := tt.(idleTimeoutTarget); ok {
+		it.SetIdleTimeoutSeconds(t.IdleTimeoutSeconds)
+	}
+	if ht, ok := tt.(hostKeyTarget); ok {
+		ht.SetHostKey(t.HostKey)
+	}
+	if st, ok := tt.(sslTarget); ok {
+		st.SetSslMode(t.SslMode)
+		st.SetSslRootCert(t.SslRootCert)
+	}
 	return tt, nil
 }
@@ -117,6 +117,14 @@ func (t *Target) GetWorkerFilter() string {
 	return t.WorkerFilter
 }
 
+func (t *Target) GetAddress() string {
+	return t.Address
+}
+
+func (t *Target) GetEnableSessionRecording() bool {
+	return t.EnableSessionRecording
+}
+
 func (t *Target) Clone() target.Target {
 	cp := proto.Clone(t.Target)
 	return &Target{
@@ -169,6 +177,14 @@ func (t *Target) SetWorkerFilter(f string) {
 	t.WorkerFilter = f
 }
 
+func (t *Target) SetAddress(a string) {
+	t.Address = a
+}
+
+func (t *Target) SetEnableSessionRecording(enable bool) {
+	t.EnableSessionRecording = enable
+}
+
 func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
 	return oplog.Metadata{
 		"resource-public-id": []string{t.PublicId},
@@ -251,6 +267,8 @@ func New(projectId string, opt ...target.Option) (target.Target, error) {
 			SessionConnectionLimit: opts.WithSessionConnectionLimit,
 			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
 			WorkerFilter:           opts.WithWorkerFilter,
+			Address:                opts.WithAddress,
+			EnableSessionRecording: opts.WithEnableSessionRecording,
 		},
 	}
 	return t, nil
@@ -0,0 +1,98 @@
+package tcp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_TargetAddress(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(t, err)
+
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hsets := static.TestSets(t, conn, cats[0].GetPublicId(), 1)
+
+	t.Run("update-address", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "update-address")
+		assert.Empty(tar.GetAddress())
+
+		tar.SetAddress("8.8.8.8")
+		updated, _, _, rowsUpdated, err := repo.UpdateTarget(ctx, tar, tar.GetVersion(), []string{"Address"})
+		require.NoError(err)
+		assert.Equal(1, rowsUpdated)
+		assert.Equal("8.8.8.8", updated.GetAddress())
+		assert.Equal(tar.GetVersion()+1, updated.GetVersion())
+
+		updated.SetAddress("8.8.4.4")
+		updated, _, _, rowsUpdated, err = repo.UpdateTarget(ctx, updated, updated.GetVersion(), []string{"Address"})
+		require.NoError(err)
+		assert.Equal(1, rowsUpdated)
+		assert.Equal("8.8.4.4", updated.GetAddress())
+
+		found, _, _, err := repo.LookupTarget(ctx, updated.GetPublicId())
+		require.NoError(err)
+		assert.Equal("8.8.4.4", found.GetAddress())
+
+		updated.SetAddress("")
+		updated, _, _, rowsUpdated, err = repo.UpdateTarget(ctx, updated, updated.GetVersion(), []string{"Address"})
+		require.NoError(err)
+		assert.Equal(1, rowsUpdated)
+		assert.Empty(updated.GetAddress())
+
+		found, _, _, err = repo.LookupTarget(ctx, updated.GetPublicId())
+		require.NoError(err)
+		assert.Empty(found.GetAddress())
+	})
+
+	t.Run("address-with-host-sources", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "address-with-host-sources", target.WithHostSources([]string{hsets[0].GetPublicId()}))
+
+		tar.SetAddress("8.8.8.8")
+		updated, _, _, rowsUpdated, err := repo.UpdateTarget(ctx, tar, tar.GetVersion(), []string{"Address"})
+		require.Error(err)
+		assert.Nil(updated)
+		assert.Equal(db.NoRowsAffected, rowsUpdated)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("host-sources-with-address", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		tar := tcp.TestTarget(ctx, t, conn, proj.PublicId, "host-sources-with-address", target.WithAddress("8.8.8.8"))
+
+		found, _, _, err := repo.LookupTarget(ctx, tar.GetPublicId())
+		require.NoError(err)
+		assert.Equal("8.8.8.8", found.GetAddress())
+
+		_, _, _, err = repo.AddTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), []string{hsets[0].GetPublicId()})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		_, _, _, err = repo.SetTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), []string{hsets[0].GetPublicId()})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		// Clearing the host sources of a target with an address is allowed.
+		_, _, _, err = repo.SetTargetHostSources(ctx, tar.GetPublicId(), tar.GetVersion(), nil)
+		require.NoError(err)
+	})
+}
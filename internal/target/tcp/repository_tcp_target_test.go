@@ -64,6 +64,36 @@ func TestRepository_CreateTarget(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid-with-address",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-with-address"),
+						target.WithDescription("valid-with-address"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithAddress("8.8.8.8"))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid-with-idle-timeout",
+			args: args{
+				target: func() target.Target {
+					target, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+						target.WithName("valid-with-idle-timeout"),
+						target.WithDescription("valid-with-idle-timeout"),
+						target.WithDefaultPort(uint32(22)),
+						target.WithIdleTimeoutSeconds(300))
+					require.NoError(t, err)
+					return target
+				}(),
+			},
+			wantErr: false,
+		},
 		{
 			name: "nil-target",
 			args: args{
@@ -308,6 +338,19 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 			wantErrMsg:     "target.(Repository).UpdateTarget: invalid field mask: Alice: parameter violation: error #103",
 			wantIsError:    errors.InvalidFieldMask,
 		},
+		{
+			name: "unsupported-subtype-fields",
+			args: args{
+				name:           "valid" + id,
+				fieldMaskPaths: []string{"EnableTls"},
+				ProjectId:      proj.PublicId,
+			},
+			newProjectId:   proj.PublicId,
+			wantErr:        true,
+			wantRowsUpdate: 0,
+			wantErrMsg:     "target.(Repository).UpdateTarget: invalid field mask: EnableTls: parameter violation: error #103",
+			wantIsError:    errors.InvalidFieldMask,
+		},
 		{
 			name: "no-public-id",
 			args: args{
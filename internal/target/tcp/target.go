@@ -37,8 +37,9 @@ var (
 	_ oplog.ReplayableMessage = (*Target)(nil)
 )
 
-// NewTarget creates a new in memory tcp target.  WithName, WithDescription and
-// WithDefaultPort options are supported
+// NewTarget creates a new in memory tcp target.  WithName, WithDescription,
+// WithDefaultPort, WithAddress, WithEnableSessionRecording and
+// WithIdleTimeoutSeconds options are supported
 func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.Target, error) {
 	const op = "tcp.NewTarget"
 	opts := target.GetOpts(opt...)
@@ -54,6 +55,9 @@ func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.T
 			SessionConnectionLimit: opts.WithSessionConnectionLimit,
 			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
 			WorkerFilter:           opts.WithWorkerFilter,
+			Address:                opts.WithAddress,
+			EnableSessionRecording: opts.WithEnableSessionRecording,
+			IdleTimeoutSeconds:     opts.WithIdleTimeoutSeconds,
 		},
 	}
 	return t, nil
@@ -171,3 +175,15 @@ func (t *Target) SetSessionConnectionLimit(limit int32) {
 func (t *Target) SetWorkerFilter(filter string) {
 	t.WorkerFilter = filter
 }
+
+func (t *Target) SetAddress(address string) {
+	t.Address = address
+}
+
+func (t *Target) SetEnableSessionRecording(enable bool) {
+	t.EnableSessionRecording = enable
+}
+
+func (t *Target) SetIdleTimeoutSeconds(seconds uint32) {
+	t.IdleTimeoutSeconds = seconds
+}
@@ -0,0 +1,93 @@
+package scopes_test
+
+import (
+	"net/http"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/daemon/controller"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	tests_api "github.com/hashicorp/boundary/internal/tests/api"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeys tests the key api calls and the audit events they should produce
+func TestKeys(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	eventConfig := event.TestEventerConfig(t, "TestKeysAuditEntry", event.TestWithAuditSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	require.NoError(event.InitSysEventer(testLogger, testLock, "TestKeysAuditEntry", event.WithEventerConfig(&eventConfig.EventerConfig)))
+	tcConfig, err := config.DevController()
+	require.NoError(err)
+	tcConfig.Eventing = &eventConfig.EventerConfig
+
+	tc := controller.NewTestController(t, &controller.TestControllerOpts{Config: tcConfig})
+	defer tc.Shutdown()
+
+	client := tc.Client()
+	client.SetToken(tc.Token().Token)
+	scps := scopes.NewClient(client)
+
+	rootKey := func() *scopes.Key {
+		t.Helper()
+		kl, err := scps.ListKeys(tc.Context(), scope.Global.String())
+		require.NoError(err)
+		require.NotEmpty(kl.Items)
+		k := kl.Items[0]
+		require.Equal(kms.KeyTypeKek, k.Type)
+		assert.Equal(scope.Global.String(), k.Scope.Id)
+		return k
+	}
+
+	k := rootKey()
+	require.Len(k.Versions, 1)
+	previous := k.Versions[0]
+
+	_, err = scps.RotateKeys(tc.Context(), scope.Global.String())
+	require.NoError(err)
+
+	k = rootKey()
+	require.Len(k.Versions, 2)
+	latest := k.Versions[1]
+	assert.Equal(previous.Id, k.Versions[0].Id)
+
+	_, err = scps.DestroyKeyVersion(tc.Context(), scope.Global.String(), latest.Id)
+	require.Error(err)
+	apiErr := api.AsServerError(err)
+	require.NotNil(apiErr)
+	assert.EqualValues(http.StatusBadRequest, apiErr.Response().StatusCode())
+
+	require.NotNil(eventConfig.AuditEvents)
+	_ = os.WriteFile(eventConfig.AuditEvents.Name(), nil, 0o666) // clean out audit events from previous calls
+
+	_, err = scps.DestroyKeyVersion(tc.Context(), scope.Global.String(), previous.Id)
+	require.NoError(err)
+	got := tests_api.CloudEventFromFile(t, eventConfig.AuditEvents.Name())
+
+	reqDetails := tests_api.GetEventDetails(t, got, "request")
+	tests_api.AssertRedactedValues(t, reqDetails)
+	assert.Equal(scope.Global.String(), reqDetails["id"])
+	assert.Equal(previous.Id, reqDetails["key_version_id"])
+
+	k = rootKey()
+	require.Len(k.Versions, 1)
+	assert.Equal(latest.Id, k.Versions[0].Id)
+
+	_, err = scps.DestroyKeyVersion(tc.Context(), scope.Global.String(), previous.Id)
+	require.Error(err)
+	apiErr = api.AsServerError(err)
+	require.NotNil(apiErr)
+	assert.EqualValues(http.StatusNotFound, apiErr.Response().StatusCode())
+}
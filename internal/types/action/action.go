@@ -58,6 +58,20 @@ const (
 	SetWorkerTags             Type = 47
 	RemoveWorkerTags          Type = 48
 	CreateControllerLed       Type = 49
+	ListRecordings            Type = 50
+	DownloadRecording         Type = 51
+	RotateKeys                Type = 52
+	ListKeys                  Type = 53
+	DestroyKeyVersion         Type = 54
+	Unlock                    Type = 55
+	EnrollTotp                Type = 56
+	VerifyTotp                Type = 57
+	CreateToken               Type = 58
+	ListTokens                Type = 59
+	RevokeToken               Type = 60
+	Refresh                   Type = 61
+	RefreshSelf               Type = 62
+	Explain                   Type = 63
 
 	// When adding new actions, be sure to update:
 	//
@@ -114,6 +128,20 @@ var Map = map[string]Type{
 	SetWorkerTags.String():             SetWorkerTags,
 	RemoveWorkerTags.String():          RemoveWorkerTags,
 	CreateControllerLed.String():       CreateControllerLed,
+	ListRecordings.String():            ListRecordings,
+	DownloadRecording.String():         DownloadRecording,
+	RotateKeys.String():                RotateKeys,
+	ListKeys.String():                  ListKeys,
+	DestroyKeyVersion.String():         DestroyKeyVersion,
+	Unlock.String():                    Unlock,
+	EnrollTotp.String():                EnrollTotp,
+	VerifyTotp.String():                VerifyTotp,
+	CreateToken.String():               CreateToken,
+	ListTokens.String():                ListTokens,
+	RevokeToken.String():               RevokeToken,
+	Refresh.String():                   Refresh,
+	RefreshSelf.String():               RefreshSelf,
+	Explain.String():                   Explain,
 }
 
 func (a Type) String() string {
@@ -168,6 +196,20 @@ func (a Type) String() string {
 		"set-worker-tags",
 		"remove-worker-tags",
 		"create:controller-led",
+		"list-recordings",
+		"download-recording",
+		"rotate-keys",
+		"list-keys",
+		"destroy-key-version",
+		"unlock",
+		"enroll-totp",
+		"verify-totp",
+		"create-token",
+		"list-tokens",
+		"revoke-token",
+		"refresh",
+		"refresh:self",
+		"explain",
 	}[a]
 }
 
@@ -115,6 +115,62 @@ func TestAction(t *testing.T) {
 			action: CreateControllerLed,
 			want:   "create:controller-led",
 		},
+		{
+			action: ListRecordings,
+			want:   "list-recordings",
+		},
+		{
+			action: DownloadRecording,
+			want:   "download-recording",
+		},
+		{
+			action: RotateKeys,
+			want:   "rotate-keys",
+		},
+		{
+			action: ListKeys,
+			want:   "list-keys",
+		},
+		{
+			action: DestroyKeyVersion,
+			want:   "destroy-key-version",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: VerifyTotp,
+			want:   "verify-totp",
+		},
+		{
+			action: CreateToken,
+			want:   "create-token",
+		},
+		{
+			action: ListTokens,
+			want:   "list-tokens",
+		},
+		{
+			action: RevokeToken,
+			want:   "revoke-token",
+		},
+		{
+			action: Refresh,
+			want:   "refresh",
+		},
+		{
+			action: RefreshSelf,
+			want:   "refresh:self",
+		},
+		{
+			action: Explain,
+			want:   "explain",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
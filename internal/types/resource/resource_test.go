@@ -87,6 +87,10 @@ func Test_Resource(t *testing.T) {
 			typeString: "credential-library",
 			want:       CredentialLibrary,
 		},
+		{
+			typeString: "service-account",
+			want:       ServiceAccount,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.typeString, func(t *testing.T) {
@@ -73,6 +73,7 @@ func main() {
 		managedGroup,
 		role,
 		scope,
+		serviceAccount,
 		session,
 		target,
 		user,
@@ -348,6 +349,30 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "unlock",
+					Description: "Unlock an account locked after too many failed authentication attempts",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Enroll an account in TOTP multi-factor authentication",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "verify-totp",
+					Description: "Verify the TOTP enrollment of an account",
+					Examples: []string{
+						"id=<id>;actions=verify-totp",
+						"id=<pin>;type=<type>;actions=verify-totp",
+					},
+				},
 			),
 		},
 	},
@@ -424,6 +449,13 @@ var authToken = &Resource{
 						"id=<id>;actions=delete",
 					},
 				},
+				{
+					Name:        "refresh",
+					Description: "Extend the expiration time of an auth token",
+					Examples: []string{
+						"id=<id>;actions=refresh",
+					},
+				},
 			},
 		},
 	},
@@ -600,7 +632,16 @@ var role = &Resource{
 			Params: map[string]string{
 				"Type": "role",
 			},
-			Actions: clActions("a role"),
+			Actions: append(
+				clActions("a role"),
+				&Action{
+					Name:        "explain",
+					Description: "Explain whether an action is allowed on a resource within the scope",
+					Examples: []string{
+						"id=*;type=<type>;actions=explain",
+					},
+				},
+			),
 		},
 		{
 			Path: "/roles/<id>",
@@ -674,7 +715,75 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "rotate-keys",
+					Description: "Rotate the root key and data encryption keys of a scope",
+					Examples: []string{
+						"id=<id>;actions=rotate-keys",
+					},
+				},
+				&Action{
+					Name:        "list-keys",
+					Description: "List the root key and data encryption keys of a scope along with their versions",
+					Examples: []string{
+						"id=<id>;actions=list-keys",
+					},
+				},
+				&Action{
+					Name:        "destroy-key-version",
+					Description: "Destroy a version of a key of a scope which no longer protects data",
+					Examples: []string{
+						"id=<id>;actions=destroy-key-version",
+					},
+				},
+			),
+		},
+	},
+}
+
+var serviceAccount = &Resource{
+	Type:   "Service Account",
+	Scopes: iamScopes,
+	Endpoints: []*Endpoint{
+		{
+			Path: "/service-accounts",
+			Params: map[string]string{
+				"Type": "service-account",
+			},
+			Actions: clActions("a service account"),
+		},
+		{
+			Path: "/service-accounts/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "service-account",
+			},
+			Actions: append(
+				rudActions("a service account", false),
+				&Action{
+					Name:        "create-token",
+					Description: "Create a token for a service account",
+					Examples: []string{
+						"id=<id>;actions=create-token",
+					},
+				},
+				&Action{
+					Name:        "list-tokens",
+					Description: "List the tokens of a service account",
+					Examples: []string{
+						"id=<id>;actions=list-tokens",
+					},
+				},
+				&Action{
+					Name:        "revoke-token",
+					Description: "Revoke a token of a service account",
+					Examples: []string{
+						"id=<id>;actions=revoke-token",
+					},
+				},
+			),
 		},
 	},
 }
@@ -733,6 +842,20 @@ var session = &Resource{
 						"id=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "list-recordings",
+					Description: "List the connection recordings of a session",
+					Examples: []string{
+						"id=<id>;actions=list-recordings",
+					},
+				},
+				{
+					Name:        "download-recording",
+					Description: "Download the recording of a session connection",
+					Examples: []string{
+						"id=<id>;actions=download-recording",
+					},
+				},
 			},
 		},
 	},